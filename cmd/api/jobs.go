@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/config"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// startTrashRetention purges soft-deleted records older than the configured
+// retention once on startup and then on every interval, until ctx is done
+func startTrashRetention(ctx context.Context, trashUseCase usecase.TrashUseCase, cfg config.TrashConfig) {
+	if cfg.RetentionDays <= 0 {
+		return
+	}
+
+	retention := time.Duration(cfg.RetentionDays) * 24 * time.Hour
+	interval := time.Duration(cfg.PurgeIntervalHours) * time.Hour
+	if interval <= 0 {
+		interval = 24 * time.Hour
+	}
+
+	log.Printf("Trash retention enabled: purging records deleted more than %d days ago every %s", cfg.RetentionDays, interval)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			summary, err := trashUseCase.PurgeExpired(ctx, retention)
+			if err != nil {
+				log.Printf("Warning: Trash retention failed: %v", err)
+			} else if summary.Total() > 0 {
+				log.Printf("Trash retention purged %d goals, %d cards, %d matches, %d players, %d teams",
+					summary.Goals, summary.Cards, summary.Matches, summary.Players, summary.Teams)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
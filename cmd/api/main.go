@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"os"
@@ -10,14 +11,29 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/zenkriztao/ayo-football-backend/internal/app"
 	"github.com/zenkriztao/ayo-football-backend/internal/config"
-	httpDelivery "github.com/zenkriztao/ayo-football-backend/internal/delivery/http"
-	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/handler"
-	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
 	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/database"
-	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/security"
 )
 
+const usage = `Usage: api [command]
+
+Commands:
+  serve                  Start the HTTP server (default)
+  migrate up             Apply all pending migrations
+  migrate down [N]       Roll back the last N migrations (default 1)
+  migrate to <version>   Migrate up or down to the given version
+  migrate status         Show applied and pending migrations
+  migrate force <version> [applied|pending]
+                         Resolve a migration left dirty by a failure on
+                         MySQL, which commits DDL immediately, after
+                         finishing or undoing its changes by hand
+  migrate convert-utc <zone>
+                         Convert MySQL DATETIME values written by an older
+                         release on a non-UTC host to UTC, once, before
+                         migrate up
+  seed [flags]           Create a demo league (see seed -h)`
+
 func main() {
 	// Load configuration
 	cfg, err := config.Load()
@@ -25,6 +41,32 @@ func main() {
 		log.Fatalf("Failed to load configuration: %v", err)
 	}
 
+	command := "serve"
+	if len(os.Args) > 1 {
+		command = os.Args[1]
+	}
+
+	switch command {
+	case "serve":
+		runServer(cfg)
+	case "migrate":
+		if err := runMigrate(cfg, os.Args[2:]); err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+	case "seed":
+		if err := runSeed(cfg, os.Args[2:]); err != nil {
+			log.Fatalf("Seeding failed: %v", err)
+		}
+	case "help", "-h", "--help":
+		fmt.Println(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command: %s\n\n%s\n", command, usage)
+		os.Exit(2)
+	}
+}
+
+// runServer wires up all dependencies and serves the HTTP API until interrupted
+func runServer(cfg *config.Config) {
 	// Set Gin mode
 	gin.SetMode(cfg.Server.Mode)
 
@@ -34,51 +76,26 @@ func main() {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 
-	// Initialize repositories
-	userRepo := database.NewUserRepository(db)
-	teamRepo := database.NewTeamRepository(db)
-	playerRepo := database.NewPlayerRepository(db)
-	matchRepo := database.NewMatchRepository(db)
-	goalRepo := database.NewGoalRepository(db)
-
-	// Initialize services
-	jwtService := security.NewJWTService(cfg)
+	// Warn about unapplied schema migrations
+	warnPendingMigrations(db)
 
-	// Initialize use cases
-	authUseCase := usecase.NewAuthUseCase(userRepo, jwtService)
-	teamUseCase := usecase.NewTeamUseCase(teamRepo)
-	playerUseCase := usecase.NewPlayerUseCase(playerRepo, teamRepo)
-	matchUseCase := usecase.NewMatchUseCase(matchRepo, teamRepo, playerRepo, goalRepo)
-	reportUseCase := usecase.NewReportUseCase(matchRepo, goalRepo, teamRepo)
+	// Wire repositories, use cases and handlers
+	application, err := app.New(cfg, db)
+	if err != nil {
+		log.Fatalf("Failed to initialize application: %v", err)
+	}
 
 	// Create default admin user
 	ctx := context.Background()
-	if err := authUseCase.CreateDefaultAdmin(ctx, cfg.Admin.Email, cfg.Admin.Password); err != nil {
+	if err := application.AuthUseCase.CreateDefaultAdmin(ctx, cfg.Admin.Email, cfg.Admin.Password); err != nil {
 		log.Printf("Warning: Failed to create default admin: %v", err)
 	} else {
 		log.Printf("Default admin user ensured: %s", cfg.Admin.Email)
 	}
 
-	// Initialize handlers
-	authHandler := handler.NewAuthHandler(authUseCase)
-	teamHandler := handler.NewTeamHandler(teamUseCase)
-	playerHandler := handler.NewPlayerHandler(playerUseCase)
-	matchHandler := handler.NewMatchHandler(matchUseCase)
-	reportHandler := handler.NewReportHandler(reportUseCase)
-
-	// Initialize router
-	router := httpDelivery.NewRouter(
-		authHandler,
-		teamHandler,
-		playerHandler,
-		matchHandler,
-		reportHandler,
-		jwtService,
-	)
-
 	// Setup Gin engine
 	engine := gin.Default()
-	router.Setup(engine)
+	application.Router.Setup(engine)
 
 	// Create HTTP server
 	server := &http.Server{
@@ -89,6 +106,11 @@ func main() {
 		IdleTimeout:  60 * time.Second,
 	}
 
+	// Purge expired soft-deleted records in the background
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	startTrashRetention(jobCtx, application.TrashUseCase, cfg.Trash)
+
 	// Start server in goroutine
 	go func() {
 		log.Printf("Starting server on port %s", cfg.Server.Port)
@@ -103,6 +125,7 @@ func main() {
 	<-quit
 
 	log.Println("Shutting down server...")
+	stopJobs()
 
 	// Graceful shutdown with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/config"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// runMigrate executes the migrate subcommand
+func runMigrate(cfg *config.Config, args []string) error {
+	if len(args) == 0 {
+		return errors.New("missing migrate action (up, down, to, status, force, convert-utc)")
+	}
+
+	// Versioned migrations own the schema here, never AutoMigrate
+	cfg.Database.AutoMigrate = false
+
+	db, err := database.NewDatabase(cfg)
+	if err != nil {
+		return err
+	}
+
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			return err
+		}
+		log.Printf("Applied %d migration(s)", applied)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of steps: %s", args[1])
+			}
+		}
+		rolledBack, err := migrator.Down(ctx, steps)
+		if err != nil {
+			return err
+		}
+		log.Printf("Rolled back %d migration(s)", rolledBack)
+	case "to":
+		if len(args) < 2 {
+			return errors.New("missing target version")
+		}
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil || version < 0 {
+			return fmt.Errorf("invalid version: %s", args[1])
+		}
+		changed, err := migrator.To(ctx, version)
+		if err != nil {
+			return err
+		}
+		log.Printf("Migrated to version %d (%d migration(s) changed)", version, changed)
+	case "force":
+		if len(args) < 2 {
+			return errors.New("missing version")
+		}
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil || version < 1 {
+			return fmt.Errorf("invalid version: %s", args[1])
+		}
+		state := "applied"
+		if len(args) > 2 {
+			state = args[2]
+		}
+		if state != "applied" && state != "pending" {
+			return fmt.Errorf("invalid state: %s (use applied or pending)", state)
+		}
+		if err := migrator.Force(ctx, version, state == "applied"); err != nil {
+			return err
+		}
+		log.Printf("Marked migration %06d as %s", version, state)
+	case "convert-utc":
+		if len(args) < 2 {
+			return errors.New("missing the time zone the API host ran in, e.g. +07:00")
+		}
+		converted, err := database.ConvertDatetimesToUTC(ctx, db, args[1])
+		if err != nil {
+			return err
+		}
+		log.Printf("Converted %d DATETIME column(s) from %s to UTC", converted, args[1])
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			state := "pending"
+			if status.Dirty {
+				state = "dirty, run `migrate force` once repaired"
+			} else if status.Applied {
+				state = "applied " + status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%06d  %-40s  %s\n", status.Version, status.Name, state)
+		}
+	default:
+		return fmt.Errorf("unknown migrate action: %s", args[0])
+	}
+
+	return nil
+}
+
+// warnPendingMigrations logs migrations that have not been applied to the database
+func warnPendingMigrations(db *gorm.DB) {
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		log.Printf("Warning: Failed to load migrations: %v", err)
+		return
+	}
+
+	pending, err := migrator.Pending(context.Background())
+	if err != nil {
+		log.Printf("Warning: Failed to check migration status: %v", err)
+		return
+	}
+
+	for _, migration := range pending {
+		log.Printf("Warning: Migration %06d_%s has not been applied, run `migrate up`", migration.Version, migration.Name)
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/app"
+	"github.com/zenkriztao/ayo-football-backend/internal/config"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/database"
+	"github.com/zenkriztao/ayo-football-backend/internal/seed"
+)
+
+// runSeed executes the seed subcommand
+func runSeed(cfg *config.Config, args []string) error {
+	defaults := seed.DefaultOptions()
+	flags := flag.NewFlagSet("seed", flag.ContinueOnError)
+	seedValue := flags.Int64("seed", defaults.Seed, "seed value; the same value always produces the same league")
+	teams := flags.Int("teams", defaults.Teams, "number of teams")
+	start := flags.String("start", defaults.Start.Format("2006-01-02"), "date of the first round (YYYY-MM-DD)")
+	played := flags.Int("played", defaults.PlayedRounds, "number of rounds with results (-1 for half the season)")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	startDate, err := time.Parse("2006-01-02", *start)
+	if err != nil {
+		return fmt.Errorf("invalid start date: %s", *start)
+	}
+
+	league, err := seed.Generate(seed.Options{
+		Seed:         *seedValue,
+		Teams:        *teams,
+		Start:        startDate,
+		PlayedRounds: *played,
+	})
+	if err != nil {
+		return err
+	}
+
+	db, err := database.NewDatabase(cfg)
+	if err != nil {
+		return err
+	}
+	warnPendingMigrations(db)
+
+	application, err := app.New(cfg, db)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	if err := application.AuthUseCase.CreateDefaultAdmin(ctx, cfg.Admin.Email, cfg.Admin.Password); err != nil {
+		return fmt.Errorf("failed to create default admin: %w", err)
+	}
+
+	seeder := seed.NewSeeder(application.TeamUseCase, application.PlayerUseCase, application.MatchUseCase)
+	summary, err := seeder.Seed(ctx, league)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Seeded %d team(s), %d player(s), %d match(es) and %d result(s) with seed %d",
+		summary.Teams, summary.Players, summary.Matches, summary.Results, *seedValue)
+	return nil
+}
@@ -0,0 +1,112 @@
+// Package app wires the repositories, use cases and HTTP handlers of the API
+// together, so the server, CLI commands and end-to-end tests share one
+// dependency graph.
+package app
+
+import (
+	"github.com/zenkriztao/ayo-football-backend/internal/config"
+	httpDelivery "github.com/zenkriztao/ayo-football-backend/internal/delivery/http"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/handler"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/database"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/security"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/storage"
+	"gorm.io/gorm"
+)
+
+// App holds the use cases and router built on top of one database
+type App struct {
+	JWTService security.JWTService
+
+	AuditUseCase    usecase.AuditUseCase
+	AuthUseCase     usecase.AuthUseCase
+	TeamUseCase     usecase.TeamUseCase
+	PlayerUseCase   usecase.PlayerUseCase
+	MatchUseCase    usecase.MatchUseCase
+	ReportUseCase   usecase.ReportUseCase
+	TrashUseCase    usecase.TrashUseCase
+	ImportUseCase   usecase.ImportUseCase
+	ExportUseCase   usecase.ExportUseCase
+	FixtureUseCase  usecase.FixtureUseCase
+	VenueUseCase    usecase.VenueUseCase
+	OfficialUseCase usecase.OfficialUseCase
+	TransferUseCase usecase.TransferUseCase
+
+	DisciplinaryUseCase usecase.DisciplinaryUseCase
+	AvailabilityUseCase usecase.AvailabilityUseCase
+	MediaUseCase        usecase.MediaUseCase
+	SearchUseCase       usecase.SearchUseCase
+
+	Router *httpDelivery.Router
+}
+
+// New builds the application on top of db. It fails when the configured
+// blob storage is invalid.
+func New(cfg *config.Config, db *gorm.DB) (*App, error) {
+	// Initialize repositories
+	userRepo := database.NewUserRepository(db)
+	teamRepo := database.NewTeamRepository(db)
+	playerRepo := database.NewPlayerRepository(db)
+	matchRepo := database.NewMatchRepository(db)
+	goalRepo := database.NewGoalRepository(db)
+	auditRepo := database.NewAuditRepository(db)
+	venueRepo := database.NewVenueRepository(db)
+	cardRepo := database.NewCardRepository(db)
+	officialRepo := database.NewOfficialRepository(db)
+	matchOfficialRepo := database.NewMatchOfficialRepository(db)
+	contractRepo := database.NewPlayerContractRepository(db)
+	windowRepo := database.NewTransferWindowRepository(db)
+	ruleRepo := database.NewDisciplinaryRuleRepository(db)
+	availabilityRepo := database.NewPlayerAvailabilityRepository(db)
+
+	// Initialize services
+	jwtService := security.NewJWTService(cfg)
+	blobStore, err := storage.NewBlobStore(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize use cases
+	a := &App{JWTService: jwtService}
+	a.AuditUseCase = usecase.NewAuditUseCase(auditRepo)
+	a.AuthUseCase = usecase.NewAuthUseCase(userRepo, jwtService)
+	a.TeamUseCase = usecase.NewTeamUseCase(teamRepo, playerRepo, matchRepo, venueRepo, a.AuditUseCase)
+	a.PlayerUseCase = usecase.NewPlayerUseCase(playerRepo, teamRepo, contractRepo, a.AuditUseCase)
+	a.MatchUseCase = usecase.NewMatchUseCase(matchRepo, teamRepo, playerRepo, contractRepo, goalRepo, cardRepo, ruleRepo, availabilityRepo, venueRepo, matchOfficialRepo, a.AuditUseCase)
+	a.ReportUseCase = usecase.NewReportUseCase(matchRepo, goalRepo, teamRepo)
+	a.TrashUseCase = usecase.NewTrashUseCase(teamRepo, playerRepo, matchRepo, goalRepo, cardRepo, a.AuditUseCase)
+	a.ImportUseCase = usecase.NewImportUseCase(teamRepo, playerRepo, a.AuditUseCase)
+	a.ExportUseCase = usecase.NewExportUseCase(teamRepo, playerRepo, matchRepo, goalRepo)
+	a.FixtureUseCase = usecase.NewFixtureUseCase(matchRepo, teamRepo)
+	a.VenueUseCase = usecase.NewVenueUseCase(venueRepo, a.AuditUseCase)
+	a.OfficialUseCase = usecase.NewOfficialUseCase(officialRepo, matchOfficialRepo, matchRepo, a.AuditUseCase)
+	a.TransferUseCase = usecase.NewTransferUseCase(playerRepo, teamRepo, contractRepo, windowRepo, a.AuditUseCase)
+	a.DisciplinaryUseCase = usecase.NewDisciplinaryUseCase(ruleRepo, matchRepo, cardRepo, contractRepo, a.AuditUseCase)
+	a.AvailabilityUseCase = usecase.NewAvailabilityUseCase(availabilityRepo, playerRepo, teamRepo, contractRepo, a.AuditUseCase)
+	a.MediaUseCase = usecase.NewMediaUseCase(blobStore, teamRepo, playerRepo, a.AuditUseCase, cfg.Storage.PublicURL)
+	a.SearchUseCase = usecase.NewSearchUseCase(teamRepo, playerRepo, venueRepo)
+
+	// Initialize handlers and router
+	a.Router = httpDelivery.NewRouter(
+		handler.NewAuthHandler(a.AuthUseCase),
+		handler.NewTeamHandler(a.TeamUseCase),
+		handler.NewPlayerHandler(a.PlayerUseCase),
+		handler.NewMatchHandler(a.MatchUseCase),
+		handler.NewReportHandler(a.ReportUseCase),
+		handler.NewAuditHandler(a.AuditUseCase),
+		handler.NewTrashHandler(a.TrashUseCase),
+		handler.NewImportHandler(a.ImportUseCase),
+		handler.NewExportHandler(a.ExportUseCase, a.ReportUseCase),
+		handler.NewFixtureHandler(a.FixtureUseCase),
+		handler.NewVenueHandler(a.VenueUseCase),
+		handler.NewOfficialHandler(a.OfficialUseCase),
+		handler.NewTransferHandler(a.TransferUseCase),
+		handler.NewDisciplinaryHandler(a.DisciplinaryUseCase),
+		handler.NewAvailabilityHandler(a.AvailabilityUseCase),
+		handler.NewMediaHandler(a.MediaUseCase),
+		handler.NewSearchHandler(a.SearchUseCase),
+		jwtService,
+	)
+
+	return a, nil
+}
@@ -13,6 +13,8 @@ type Config struct {
 	Database DatabaseConfig
 	JWT      JWTConfig
 	Admin    AdminConfig
+	Trash    TrashConfig
+	Storage  StorageConfig
 }
 
 // ServerConfig holds server-related configuration
@@ -30,6 +32,9 @@ type DatabaseConfig struct {
 	Password string
 	Name     string
 	SSLMode  string
+	// AutoMigrate runs GORM AutoMigrate on startup. Development only;
+	// production schemas are managed by versioned migrations.
+	AutoMigrate bool
 }
 
 // JWTConfig holds JWT-related configuration
@@ -44,12 +49,45 @@ type AdminConfig struct {
 	Password string
 }
 
+// TrashConfig holds soft-delete retention configuration
+type TrashConfig struct {
+	// RetentionDays is how long soft-deleted records are kept before they
+	// are purged permanently. Zero disables the retention job.
+	RetentionDays      int
+	PurgeIntervalHours int
+}
+
+// StorageConfig holds configuration of the blob store for uploaded images
+type StorageConfig struct {
+	// Driver is local or s3
+	Driver string
+	// LocalDir is the directory of the local driver
+	LocalDir string
+	// PublicURL prefixes the URLs of uploaded images, e.g.
+	// https://api.example.com. Empty makes them relative to the API host.
+	PublicURL string
+
+	// S3-compatible object storage, e.g. AWS S3, MinIO or Cloudflare R2
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+	// S3PathStyle addresses the bucket in the path rather than the host name,
+	// as MinIO needs
+	S3PathStyle bool
+}
+
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	// Load .env file if exists
 	_ = godotenv.Load()
 
 	jwtExpHours, _ := strconv.Atoi(getEnv("JWT_EXPIRATION_HOURS", "24"))
+	autoMigrate, _ := strconv.ParseBool(getEnv("DB_AUTO_MIGRATE", "false"))
+	retentionDays, _ := strconv.Atoi(getEnv("TRASH_RETENTION_DAYS", "0"))
+	purgeIntervalHours, _ := strconv.Atoi(getEnv("TRASH_PURGE_INTERVAL_HOURS", "24"))
+	s3PathStyle, _ := strconv.ParseBool(getEnv("STORAGE_S3_PATH_STYLE", "false"))
 
 	return &Config{
 		Server: ServerConfig{
@@ -64,6 +102,8 @@ func Load() (*Config, error) {
 			Password: getEnv("DB_PASSWORD", "password"),
 			Name:     getEnv("DB_NAME", "ayo_football"),
 			SSLMode:  getEnv("DB_SSLMODE", "disable"),
+
+			AutoMigrate: autoMigrate,
 		},
 		JWT: JWTConfig{
 			Secret:          getEnv("JWT_SECRET", "default-secret-key-change-me"),
@@ -73,6 +113,22 @@ func Load() (*Config, error) {
 			Email:    getEnv("ADMIN_EMAIL", "admin@ayofootball.com"),
 			Password: getEnv("ADMIN_PASSWORD", "Admin@123"),
 		},
+		Trash: TrashConfig{
+			RetentionDays:      retentionDays,
+			PurgeIntervalHours: purgeIntervalHours,
+		},
+		Storage: StorageConfig{
+			Driver:    getEnv("STORAGE_DRIVER", "local"),
+			LocalDir:  getEnv("STORAGE_LOCAL_DIR", "uploads"),
+			PublicURL: getEnv("STORAGE_PUBLIC_URL", ""),
+
+			S3Endpoint:        getEnv("STORAGE_S3_ENDPOINT", "https://s3.amazonaws.com"),
+			S3Region:          getEnv("STORAGE_S3_REGION", "us-east-1"),
+			S3Bucket:          getEnv("STORAGE_S3_BUCKET", ""),
+			S3AccessKeyID:     getEnv("STORAGE_S3_ACCESS_KEY_ID", ""),
+			S3SecretAccessKey: getEnv("STORAGE_S3_SECRET_ACCESS_KEY", ""),
+			S3PathStyle:       s3PathStyle,
+		},
 	}, nil
 }
 
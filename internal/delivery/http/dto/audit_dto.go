@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// AuditLogResponse represents audit log entry data in response
+type AuditLogResponse struct {
+	ID         string          `json:"id"`
+	ActorID    *string         `json:"actor_id"`
+	Action     string          `json:"action"`
+	EntityType string          `json:"entity_type"`
+	EntityID   string          `json:"entity_id"`
+	Before     json.RawMessage `json:"before"`
+	After      json.RawMessage `json:"after"`
+	Changes    json.RawMessage `json:"changes"`
+	IPAddress  string          `json:"ip_address"`
+	RequestID  string          `json:"request_id"`
+	CreatedAt  string          `json:"created_at"`
+}
+
+// ToAuditLogResponse converts entity.AuditLog to AuditLogResponse
+func ToAuditLogResponse(log *entity.AuditLog) AuditLogResponse {
+	response := AuditLogResponse{
+		ID:         log.ID.String(),
+		Action:     string(log.Action),
+		EntityType: log.EntityType,
+		EntityID:   log.EntityID.String(),
+		Before:     rawJSON(log.Before),
+		After:      rawJSON(log.After),
+		Changes:    rawJSON(log.Changes),
+		IPAddress:  log.IPAddress,
+		RequestID:  log.RequestID,
+		CreatedAt:  log.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	if log.ActorID != nil {
+		actorID := log.ActorID.String()
+		response.ActorID = &actorID
+	}
+
+	return response
+}
+
+// ToAuditLogResponseList converts a slice of entity.AuditLog to AuditLogResponse slice
+func ToAuditLogResponseList(logs []entity.AuditLog) []AuditLogResponse {
+	responses := make([]AuditLogResponse, len(logs))
+	for i, log := range logs {
+		responses[i] = ToAuditLogResponse(&log)
+	}
+	return responses
+}
+
+// rawJSON returns stored JSON text as a raw message, or null when empty
+func rawJSON(value string) json.RawMessage {
+	if value == "" {
+		return json.RawMessage("null")
+	}
+	return json.RawMessage(value)
+}
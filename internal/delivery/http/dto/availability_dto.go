@@ -0,0 +1,163 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// PlayerAvailabilityRequest represents create and update player availability
+// request body. Dates are local to the default time zone: the player is out
+// from midnight of starts_on and back from midnight of the return date.
+type PlayerAvailabilityRequest struct {
+	Status           string `json:"status" binding:"required,oneof=injured ill international_duty personal other"`
+	Note             string `json:"note" binding:"max=255"`
+	StartsOn         string `json:"starts_on" binding:"required,datetime=2006-01-02"`           // Format: 2006-01-02
+	ExpectedReturnOn string `json:"expected_return_on" binding:"omitempty,datetime=2006-01-02"` // Format: 2006-01-02
+	ReturnedOn       string `json:"returned_on" binding:"omitempty,datetime=2006-01-02"`        // Format: 2006-01-02
+}
+
+// PlayerAvailabilityResponse represents player availability data in response
+type PlayerAvailabilityResponse struct {
+	ID               string  `json:"id"`
+	PlayerID         string  `json:"player_id"`
+	Status           string  `json:"status"`
+	Note             string  `json:"note"`
+	StartsAt         string  `json:"starts_at"`          // UTC, RFC 3339
+	ExpectedReturnAt *string `json:"expected_return_at"` // UTC, RFC 3339; null when unknown
+	ReturnedAt       *string `json:"returned_at"`        // UTC, RFC 3339; null until the player is back
+	IsActive         bool    `json:"is_active"`
+	CreatedAt        string  `json:"created_at"`
+	UpdatedAt        string  `json:"updated_at"`
+}
+
+// SquadAvailabilityResponse represents a squad member and the records that
+// keep them out
+type SquadAvailabilityResponse struct {
+	PlayerID     string                       `json:"player_id"`
+	Name         string                       `json:"name"`
+	Position     string                       `json:"position"`
+	PositionName string                       `json:"position_name"`
+	JerseyNumber int                          `json:"jersey_number"`
+	IsAvailable  bool                         `json:"is_available"`
+	Unavailable  []PlayerAvailabilityResponse `json:"unavailable"`
+}
+
+// AvailabilityWarningResponse represents a player used in a match while
+// recorded as unavailable
+type AvailabilityWarningResponse struct {
+	PlayerID         string  `json:"player_id"`
+	PlayerName       string  `json:"player_name,omitempty"`
+	AvailabilityID   string  `json:"availability_id"`
+	Status           string  `json:"status"`
+	Note             string  `json:"note"`
+	ExpectedReturnAt *string `json:"expected_return_at"`
+}
+
+// MatchResultResponse represents a recorded match result with the
+// availability warnings raised while recording it
+type MatchResultResponse struct {
+	MatchResponse
+	Warnings []AvailabilityWarningResponse `json:"warnings"`
+}
+
+// ToAvailabilityEntity converts PlayerAvailabilityRequest to entity.PlayerAvailability
+func (r *PlayerAvailabilityRequest) ToAvailabilityEntity() (*entity.PlayerAvailability, error) {
+	startsAt, err := parseLocalDate(r.StartsOn)
+	if err != nil {
+		return nil, err
+	}
+	availability := &entity.PlayerAvailability{
+		Status:   entity.AvailabilityStatus(r.Status),
+		Note:     r.Note,
+		StartsAt: startsAt,
+	}
+	if r.ExpectedReturnOn != "" {
+		expected, err := parseLocalDate(r.ExpectedReturnOn)
+		if err != nil {
+			return nil, err
+		}
+		availability.ExpectedReturnAt = &expected
+	}
+	if r.ReturnedOn != "" {
+		returned, err := parseLocalDate(r.ReturnedOn)
+		if err != nil {
+			return nil, err
+		}
+		availability.ReturnedAt = &returned
+	}
+	return availability, nil
+}
+
+// ToPlayerAvailabilityResponse converts entity.PlayerAvailability to PlayerAvailabilityResponse
+func ToPlayerAvailabilityResponse(availability *entity.PlayerAvailability) PlayerAvailabilityResponse {
+	return PlayerAvailabilityResponse{
+		ID:               availability.ID.String(),
+		PlayerID:         availability.PlayerID.String(),
+		Status:           string(availability.Status),
+		Note:             availability.Note,
+		StartsAt:         availability.StartsAt.UTC().Format(time.RFC3339),
+		ExpectedReturnAt: formatOptionalTime(availability.ExpectedReturnAt),
+		ReturnedAt:       formatOptionalTime(availability.ReturnedAt),
+		IsActive:         availability.CoversTime(time.Now()),
+		CreatedAt:        availability.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:        availability.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// ToPlayerAvailabilityResponseList converts a slice of entity.PlayerAvailability to PlayerAvailabilityResponse slice
+func ToPlayerAvailabilityResponseList(availabilities []entity.PlayerAvailability) []PlayerAvailabilityResponse {
+	responses := make([]PlayerAvailabilityResponse, len(availabilities))
+	for i, availability := range availabilities {
+		responses[i] = ToPlayerAvailabilityResponse(&availability)
+	}
+	return responses
+}
+
+// ToSquadAvailabilityResponseList converts a slice of usecase.SquadMember to SquadAvailabilityResponse slice
+func ToSquadAvailabilityResponseList(squad []usecase.SquadMember) []SquadAvailabilityResponse {
+	responses := make([]SquadAvailabilityResponse, len(squad))
+	for i, member := range squad {
+		responses[i] = SquadAvailabilityResponse{
+			PlayerID:     member.Player.ID.String(),
+			Name:         member.Player.Name,
+			Position:     string(member.Player.Position),
+			PositionName: getPositionDisplayName(member.Player.Position),
+			JerseyNumber: member.Player.JerseyNumber,
+			IsAvailable:  member.IsAvailable(),
+			Unavailable:  ToPlayerAvailabilityResponseList(member.Unavailable),
+		}
+	}
+	return responses
+}
+
+// ToMatchResultResponse converts a recorded match and its warnings to MatchResultResponse
+func ToMatchResultResponse(match *entity.Match, warnings []usecase.AvailabilityWarning) MatchResultResponse {
+	response := MatchResultResponse{
+		MatchResponse: ToMatchResponse(match),
+		Warnings:      make([]AvailabilityWarningResponse, len(warnings)),
+	}
+	for i, warning := range warnings {
+		response.Warnings[i] = AvailabilityWarningResponse{
+			PlayerID:         warning.PlayerID.String(),
+			AvailabilityID:   warning.Availability.ID.String(),
+			Status:           string(warning.Availability.Status),
+			Note:             warning.Availability.Note,
+			ExpectedReturnAt: formatOptionalTime(warning.Availability.ExpectedReturnAt),
+		}
+		if warning.Availability.Player != nil {
+			response.Warnings[i].PlayerName = warning.Availability.Player.Name
+		}
+	}
+	return response
+}
+
+// formatOptionalTime formats a time as UTC RFC 3339, or returns nil
+func formatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.UTC().Format(time.RFC3339)
+	return &formatted
+}
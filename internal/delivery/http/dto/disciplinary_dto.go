@@ -0,0 +1,93 @@
+package dto
+
+import (
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// SetDisciplinaryRuleRequest represents set disciplinary rule request body
+type SetDisciplinaryRuleRequest struct {
+	YellowCardLimit int `json:"yellow_card_limit" binding:"required,min=1,max=20"`
+	RedCardBan      int `json:"red_card_ban" binding:"required,min=1,max=20"`
+}
+
+// DisciplinaryRuleResponse represents disciplinary rule data in response
+type DisciplinaryRuleResponse struct {
+	ID              string `json:"id"`
+	Season          string `json:"season"`
+	YellowCardLimit int    `json:"yellow_card_limit"`
+	RedCardBan      int    `json:"red_card_ban"`
+	CreatedAt       string `json:"created_at"`
+	UpdatedAt       string `json:"updated_at"`
+}
+
+// SuspensionResponse represents a suspension in response
+type SuspensionResponse struct {
+	PlayerID      string          `json:"player_id"`
+	PlayerName    string          `json:"player_name,omitempty"`
+	TeamID        string          `json:"team_id,omitempty"`
+	TeamName      string          `json:"team_name,omitempty"`
+	Reason        string          `json:"reason"`
+	Match         *MatchResponse  `json:"match,omitempty"` // the match the card was shown in
+	Length        int             `json:"length"`
+	Matches       []MatchResponse `json:"matches"` // the matches the player misses
+	ServedMatches int             `json:"served_matches"`
+	IsActive      bool            `json:"is_active"`
+}
+
+// ToDisciplinaryRuleEntity converts SetDisciplinaryRuleRequest to entity.DisciplinaryRule
+func (r *SetDisciplinaryRuleRequest) ToDisciplinaryRuleEntity() *entity.DisciplinaryRule {
+	return &entity.DisciplinaryRule{
+		YellowCardLimit: r.YellowCardLimit,
+		RedCardBan:      r.RedCardBan,
+	}
+}
+
+// ToDisciplinaryRuleResponse converts entity.DisciplinaryRule to DisciplinaryRuleResponse
+func ToDisciplinaryRuleResponse(rule *entity.DisciplinaryRule) DisciplinaryRuleResponse {
+	return DisciplinaryRuleResponse{
+		ID:              rule.ID.String(),
+		Season:          rule.Season,
+		YellowCardLimit: rule.YellowCardLimit,
+		RedCardBan:      rule.RedCardBan,
+		CreatedAt:       rule.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:       rule.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// ToSuspensionResponse converts usecase.Suspension to SuspensionResponse
+func ToSuspensionResponse(suspension *usecase.Suspension) SuspensionResponse {
+	response := SuspensionResponse{
+		PlayerID: suspension.PlayerID.String(),
+		Reason:   string(suspension.Reason),
+		Length:   suspension.Length,
+		Matches:  ToMatchResponseList(suspension.Matches),
+		IsActive: suspension.IsActive(),
+	}
+	if suspension.Player != nil {
+		response.PlayerName = suspension.Player.Name
+	}
+	if suspension.Team != nil {
+		response.TeamID = suspension.Team.ID.String()
+		response.TeamName = suspension.Team.Name
+	}
+	if suspension.Match != nil {
+		match := ToMatchResponse(suspension.Match)
+		response.Match = &match
+	}
+	for _, match := range suspension.Matches {
+		if match.Status == entity.MatchStatusCompleted {
+			response.ServedMatches++
+		}
+	}
+	return response
+}
+
+// ToSuspensionResponseList converts a slice of usecase.Suspension to SuspensionResponse slice
+func ToSuspensionResponseList(suspensions []usecase.Suspension) []SuspensionResponse {
+	responses := make([]SuspensionResponse, len(suspensions))
+	for i, suspension := range suspensions {
+		responses[i] = ToSuspensionResponse(&suspension)
+	}
+	return responses
+}
@@ -0,0 +1,230 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// Export records are flat, so the same record can be written as a CSV row
+// or a JSON line. Related records are referenced by ID and name.
+
+// TeamExportRecord represents an exported team
+type TeamExportRecord struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Logo        string `json:"logo"`
+	FoundedYear int    `json:"founded_year"`
+	Address     string `json:"address"`
+	City        string `json:"city"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// PlayerExportRecord represents an exported player
+type PlayerExportRecord struct {
+	ID           string  `json:"id"`
+	TeamID       string  `json:"team_id"`
+	TeamName     string  `json:"team_name"`
+	Name         string  `json:"name"`
+	Height       float64 `json:"height"`
+	Weight       float64 `json:"weight"`
+	Position     string  `json:"position"`
+	JerseyNumber int     `json:"jersey_number"`
+	// Profile; secondary positions are comma-separated like the import column
+	SecondaryPositions string `json:"secondary_positions"`
+	DateOfBirth        string `json:"date_of_birth"`
+	Nationality        string `json:"nationality"`
+	PreferredFoot      string `json:"preferred_foot"`
+	Photo              string `json:"photo"`
+	CreatedAt          string `json:"created_at"`
+	UpdatedAt          string `json:"updated_at"`
+}
+
+// MatchExportRecord represents an exported match
+type MatchExportRecord struct {
+	ID           string `json:"id"`
+	KickoffAt    string `json:"kickoff_at"`
+	Timezone     string `json:"timezone"`
+	MatchDate    string `json:"match_date"`
+	MatchTime    string `json:"match_time"`
+	HomeTeamID   string `json:"home_team_id"`
+	HomeTeamName string `json:"home_team_name"`
+	AwayTeamID   string `json:"away_team_id"`
+	AwayTeamName string `json:"away_team_name"`
+	HomeScore    *int   `json:"home_score"`
+	AwayScore    *int   `json:"away_score"`
+	Status       string `json:"status"`
+	Result       string `json:"result"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+// GoalExportRecord represents an exported goal
+type GoalExportRecord struct {
+	ID         string `json:"id"`
+	MatchID    string `json:"match_id"`
+	MatchDate  string `json:"match_date"`
+	PlayerID   string `json:"player_id"`
+	PlayerName string `json:"player_name"`
+	TeamID     string `json:"team_id"`
+	TeamName   string `json:"team_name"`
+	Minute     int    `json:"minute"`
+	IsOwnGoal  bool   `json:"is_own_goal"`
+	CreatedAt  string `json:"created_at"`
+}
+
+// StandingExportRecord represents a row of the exported league table
+type StandingExportRecord struct {
+	Position       int    `json:"position"`
+	TeamID         string `json:"team_id"`
+	TeamName       string `json:"team_name"`
+	Played         int64  `json:"played"`
+	Won            int64  `json:"won"`
+	Drawn          int64  `json:"drawn"`
+	Lost           int64  `json:"lost"`
+	GoalsFor       int64  `json:"goals_for"`
+	GoalsAgainst   int64  `json:"goals_against"`
+	GoalDifference int64  `json:"goal_difference"`
+	Points         int64  `json:"points"`
+}
+
+// TopScorerExportRecord represents a row of the exported top scorers
+type TopScorerExportRecord struct {
+	Rank       int    `json:"rank"`
+	PlayerID   string `json:"player_id"`
+	PlayerName string `json:"player_name"`
+	TeamID     string `json:"team_id"`
+	TeamName   string `json:"team_name"`
+	Goals      int64  `json:"goals"`
+}
+
+// ToTeamExportRecord converts entity.Team to TeamExportRecord
+func ToTeamExportRecord(team *entity.Team) TeamExportRecord {
+	return TeamExportRecord{
+		ID:          team.ID.String(),
+		Name:        team.Name,
+		Logo:        team.Logo,
+		FoundedYear: team.FoundedYear,
+		Address:     team.Address,
+		City:        team.City,
+		CreatedAt:   team.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:   team.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// ToPlayerExportRecord converts entity.Player to PlayerExportRecord
+func ToPlayerExportRecord(player *entity.Player) PlayerExportRecord {
+	record := PlayerExportRecord{
+		ID:            player.ID.String(),
+		TeamID:        player.TeamID.String(),
+		Name:          player.Name,
+		Height:        player.Height,
+		Weight:        player.Weight,
+		Position:      string(player.Position),
+		JerseyNumber:  player.JerseyNumber,
+		Nationality:   player.Nationality,
+		PreferredFoot: string(player.PreferredFoot),
+		Photo:         player.Photo,
+		CreatedAt:     player.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:     player.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+	if player.Team != nil {
+		record.TeamName = player.Team.Name
+	}
+	if player.DateOfBirth != nil {
+		record.DateOfBirth = player.DateOfBirth.Format("2006-01-02")
+	}
+	positions := make([]string, len(player.SecondaryPositions))
+	for i, position := range player.SecondaryPositions {
+		positions[i] = string(position)
+	}
+	record.SecondaryPositions = strings.Join(positions, ",")
+	return record
+}
+
+// ToMatchExportRecord converts entity.Match to MatchExportRecord
+func ToMatchExportRecord(match *entity.Match) MatchExportRecord {
+	local := match.LocalKickoff()
+	record := MatchExportRecord{
+		ID:         match.ID.String(),
+		KickoffAt:  match.KickoffAt.UTC().Format(time.RFC3339),
+		Timezone:   match.Timezone,
+		MatchDate:  local.Format("2006-01-02"),
+		MatchTime:  local.Format("15:04"),
+		HomeTeamID: match.HomeTeamID.String(),
+		AwayTeamID: match.AwayTeamID.String(),
+		HomeScore:  match.HomeScore,
+		AwayScore:  match.AwayScore,
+		Status:     string(match.Status),
+		CreatedAt:  match.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:  match.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+	if match.Status == entity.MatchStatusCompleted {
+		record.Result = string(match.GetResult())
+	}
+	if match.HomeTeam != nil {
+		record.HomeTeamName = match.HomeTeam.Name
+	}
+	if match.AwayTeam != nil {
+		record.AwayTeamName = match.AwayTeam.Name
+	}
+	return record
+}
+
+// ToGoalExportRecord converts entity.Goal to GoalExportRecord
+func ToGoalExportRecord(goal *entity.Goal) GoalExportRecord {
+	record := GoalExportRecord{
+		ID:        goal.ID.String(),
+		MatchID:   goal.MatchID.String(),
+		PlayerID:  goal.PlayerID.String(),
+		TeamID:    goal.TeamID.String(),
+		Minute:    goal.Minute,
+		IsOwnGoal: goal.IsOwnGoal,
+		CreatedAt: goal.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+	if goal.Match != nil {
+		record.MatchDate = goal.Match.LocalKickoff().Format("2006-01-02")
+	}
+	if goal.Player != nil {
+		record.PlayerName = goal.Player.Name
+	}
+	if goal.Team != nil {
+		record.TeamName = goal.Team.Name
+	}
+	return record
+}
+
+// ToStandingExportRecord converts a usecase.LeaderboardEntry at the given
+// 1-based position to StandingExportRecord
+func ToStandingExportRecord(position int, entry *usecase.LeaderboardEntry) StandingExportRecord {
+	return StandingExportRecord{
+		Position:       position,
+		TeamID:         entry.Team.ID.String(),
+		TeamName:       entry.Team.Name,
+		Played:         entry.Played,
+		Won:            entry.Won,
+		Drawn:          entry.Drawn,
+		Lost:           entry.Lost,
+		GoalsFor:       entry.GoalsFor,
+		GoalsAgainst:   entry.GoalsAgainst,
+		GoalDifference: entry.GoalDiff,
+		Points:         entry.Points,
+	}
+}
+
+// ToTopScorerExportRecord converts a repository.TopScorerResult at the given
+// 1-based rank to TopScorerExportRecord
+func ToTopScorerExportRecord(rank int, scorer *repository.TopScorerResult) TopScorerExportRecord {
+	return TopScorerExportRecord{
+		Rank:       rank,
+		PlayerID:   scorer.PlayerID.String(),
+		PlayerName: scorer.PlayerName,
+		TeamID:     scorer.TeamID.String(),
+		TeamName:   scorer.TeamName,
+		Goals:      scorer.GoalCount,
+	}
+}
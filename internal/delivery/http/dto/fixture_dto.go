@@ -0,0 +1,90 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/pkg/ical"
+)
+
+const (
+	// fixtureProductID identifies this API in the calendar feeds it writes
+	fixtureProductID = "-//AYO Football//Fixtures//EN"
+	// fixtureRefreshInterval is how often subscribed calendars should poll
+	// for reschedules and results
+	fixtureRefreshInterval = time.Hour
+	// fixtureDuration is the calendar length of a match, including half time
+	fixtureDuration = 2 * time.Hour
+)
+
+// ToFixtureCalendar converts matches to an iCalendar feed with the given name
+func ToFixtureCalendar(name string, matches []entity.Match) *ical.Calendar {
+	cal := &ical.Calendar{
+		ProductID:       fixtureProductID,
+		Name:            name,
+		RefreshInterval: fixtureRefreshInterval,
+		Events:          make([]ical.Event, len(matches)),
+	}
+	for i := range matches {
+		cal.Events[i] = ToFixtureEvent(&matches[i])
+	}
+	return cal
+}
+
+// ToFixtureEvent converts entity.Match to a calendar event. The UID comes
+// from the match ID and LAST-MODIFIED from its last update, so subscribed
+// calendars move a rescheduled match instead of adding it twice.
+func ToFixtureEvent(match *entity.Match) ical.Event {
+	home, away := fixtureTeamName(match.HomeTeam), fixtureTeamName(match.AwayTeam)
+	event := ical.Event{
+		UID:      match.ID.String() + "@ayo-football",
+		Modified: match.UpdatedAt,
+		Summary:  home + " vs " + away,
+		Status:   ical.StatusConfirmed,
+	}
+	event.Start = match.KickoffAt.UTC()
+	event.End = event.Start.Add(fixtureDuration)
+
+	switch match.Status {
+	case entity.MatchStatusCompleted:
+		if match.HomeScore != nil && match.AwayScore != nil {
+			event.Summary = fmt.Sprintf("%s %d-%d %s", home, *match.HomeScore, *match.AwayScore, away)
+		}
+		event.Description = "Full time"
+	case entity.MatchStatusOngoing:
+		event.Description = "In play"
+	case entity.MatchStatusCancelled:
+		event.Status = ical.StatusCancelled
+		event.Description = "Cancelled"
+	default:
+		event.Description = "Scheduled"
+	}
+
+	// Without a venue, matches are played at the home team's ground
+	switch {
+	case match.Venue != nil:
+		event.Location = joinLocation(match.Venue.Name, match.Venue.Address, match.Venue.City)
+	case match.HomeTeam != nil:
+		event.Location = joinLocation(match.HomeTeam.Address, match.HomeTeam.City)
+	}
+	return event
+}
+
+func joinLocation(parts ...string) string {
+	var nonEmpty []string
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+	return strings.Join(nonEmpty, ", ")
+}
+
+func fixtureTeamName(team *entity.Team) string {
+	if team == nil {
+		return "TBD"
+	}
+	return team.Name
+}
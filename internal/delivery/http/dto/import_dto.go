@@ -0,0 +1,304 @@
+package dto
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/spreadsheet"
+)
+
+var ErrEmptyImport = errors.New("the file has no rows")
+
+// MissingColumnsError is returned when the header row of an import lacks required columns
+type MissingColumnsError struct {
+	Columns []string
+}
+
+func (e *MissingColumnsError) Error() string {
+	return "missing required columns: " + strings.Join(e.Columns, ", ")
+}
+
+// ImportRowErrorResponse represents a rejected row of an import
+type ImportRowErrorResponse struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
+
+// ImportResponse represents the outcome of an import
+type ImportResponse struct {
+	DryRun       bool                     `json:"dry_run"`
+	AllOrNothing bool                     `json:"all_or_nothing"`
+	Total        int                      `json:"total"`
+	Valid        int                      `json:"valid"`
+	Created      int                      `json:"created"`
+	Errors       []ImportRowErrorResponse `json:"errors"`
+	Teams        []TeamResponse           `json:"teams,omitempty"`
+	Players      []PlayerResponse         `json:"players,omitempty"`
+}
+
+// ToImportResponse converts usecase.ImportResult to ImportResponse
+func ToImportResponse(result *usecase.ImportResult, opts usecase.ImportOptions) ImportResponse {
+	errs := make([]ImportRowErrorResponse, len(result.Errors))
+	for i, rowErr := range result.Errors {
+		errs[i] = ImportRowErrorResponse{Row: rowErr.Row, Message: rowErr.Message}
+	}
+
+	resp := ImportResponse{
+		DryRun:       opts.DryRun,
+		AllOrNothing: opts.AllOrNothing,
+		Total:        result.Total,
+		Valid:        result.Valid,
+		Created:      result.Created,
+		Errors:       errs,
+	}
+	if len(result.Teams) > 0 {
+		resp.Teams = ToTeamResponseList(result.Teams)
+	}
+	if len(result.Players) > 0 {
+		resp.Players = ToPlayerResponseList(result.Players)
+	}
+	return resp
+}
+
+var (
+	teamImportColumns   = []string{"name", "logo", "founded_year", "address", "city"}
+	playerImportColumns = []string{
+		"team_id", "name", "height", "weight", "position", "jersey_number",
+		"secondary_positions", "date_of_birth", "nationality", "preferred_foot", "photo",
+	}
+)
+
+// ParseTeamImportRows converts spreadsheet rows into team import rows. The
+// first row is the header; columns are matched by name, in any order.
+// Rows are validated with the same rules as CreateTeamRequest.
+func ParseTeamImportRows(rows []spreadsheet.Row) ([]usecase.TeamImportRow, error) {
+	header, data, err := splitHeader(rows, teamImportColumns, []string{"name", "founded_year", "city"})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]usecase.TeamImportRow, len(data))
+	for i, row := range data {
+		cells := header.cells(row)
+		req := CreateTeamRequest{
+			Name:    cells["name"],
+			Logo:    cells["logo"],
+			Address: cells["address"],
+			City:    cells["city"],
+		}
+		result[i].Row = row.Number
+
+		var parseErr error
+		if req.FoundedYear, parseErr = parseIntCell(cells, "founded_year"); parseErr != nil {
+			result[i].ParseError = parseErr.Error()
+			continue
+		}
+		if err := validateImportRequest(&req); err != nil {
+			result[i].ParseError = err.Error()
+			continue
+		}
+		result[i].Team = *req.ToTeamEntity()
+	}
+	return result, nil
+}
+
+// ParsePlayerImportRows converts spreadsheet rows into player import rows.
+// The first row is the header; columns are matched by name, in any order.
+// teamID is used for rows without a team_id, so a roster can leave the
+// column out. Rows are validated with the same rules as CreatePlayerRequest.
+func ParsePlayerImportRows(rows []spreadsheet.Row, teamID string) ([]usecase.PlayerImportRow, error) {
+	required := []string{"name", "height", "weight", "position", "jersey_number"}
+	if teamID == "" {
+		required = append([]string{"team_id"}, required...)
+	}
+	header, data, err := splitHeader(rows, playerImportColumns, required)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]usecase.PlayerImportRow, len(data))
+	for i, row := range data {
+		cells := header.cells(row)
+		req := CreatePlayerRequest{
+			TeamID:             cells["team_id"],
+			Name:               cells["name"],
+			Position:           strings.ToLower(cells["position"]),
+			SecondaryPositions: splitListCell(strings.ToLower(cells["secondary_positions"])),
+			DateOfBirth:        cells["date_of_birth"],
+			Nationality:        cells["nationality"],
+			PreferredFoot:      strings.ToLower(cells["preferred_foot"]),
+			Photo:              cells["photo"],
+		}
+		if req.TeamID == "" {
+			req.TeamID = teamID
+		}
+		result[i].Row = row.Number
+
+		var parseErr error
+		if req.Height, parseErr = parseFloatCell(cells, "height"); parseErr != nil {
+			result[i].ParseError = parseErr.Error()
+			continue
+		}
+		if req.Weight, parseErr = parseFloatCell(cells, "weight"); parseErr != nil {
+			result[i].ParseError = parseErr.Error()
+			continue
+		}
+		if req.JerseyNumber, parseErr = parseIntCell(cells, "jersey_number"); parseErr != nil {
+			result[i].ParseError = parseErr.Error()
+			continue
+		}
+		if err := validateImportRequest(&req); err != nil {
+			result[i].ParseError = err.Error()
+			continue
+		}
+
+		player, err := req.ToPlayerEntity()
+		if err != nil {
+			result[i].ParseError = "team_id must be a valid UUID"
+			continue
+		}
+		result[i].Player = *player
+	}
+	return result, nil
+}
+
+// splitListCell splits a comma-separated cell, dropping empty items
+func splitListCell(cell string) []string {
+	var items []string
+	for _, item := range strings.Split(cell, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// importHeader maps known column names to their position in a row
+type importHeader map[string]int
+
+func (h importHeader) cells(row spreadsheet.Row) map[string]string {
+	cells := make(map[string]string, len(h))
+	for name, index := range h {
+		if index < len(row.Cells) {
+			cells[name] = strings.TrimSpace(row.Cells[index])
+		}
+	}
+	return cells
+}
+
+// splitHeader reads the header row and checks that it has the required
+// columns. Header names ignore case, and spaces or dashes match underscores.
+// Unknown columns are ignored.
+func splitHeader(rows []spreadsheet.Row, known, required []string) (importHeader, []spreadsheet.Row, error) {
+	if len(rows) == 0 {
+		return nil, nil, ErrEmptyImport
+	}
+
+	header := make(importHeader)
+	for index, cell := range rows[0].Cells {
+		name := strings.ToLower(strings.TrimSpace(cell))
+		name = strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+		for _, column := range known {
+			if name == column {
+				if _, seen := header[name]; !seen {
+					header[name] = index
+				}
+			}
+		}
+	}
+
+	var missing []string
+	for _, column := range required {
+		if _, ok := header[column]; !ok {
+			missing = append(missing, column)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, nil, &MissingColumnsError{Columns: missing}
+	}
+	return header, rows[1:], nil
+}
+
+// parseIntCell parses a whole number cell; empty cells are 0 and left to validation
+func parseIntCell(cells map[string]string, column string) (int, error) {
+	value := cells[column]
+	if value == "" {
+		return 0, nil
+	}
+	// Spreadsheets may store whole numbers as decimals, e.g. 10.0
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || number != float64(int(number)) {
+		return 0, fmt.Errorf("%s must be a whole number", column)
+	}
+	return int(number), nil
+}
+
+// parseFloatCell parses a number cell; empty cells are 0 and left to validation
+func parseFloatCell(cells map[string]string, column string) (float64, error) {
+	value := cells[column]
+	if value == "" {
+		return 0, nil
+	}
+	number, err := strconv.ParseFloat(strings.Replace(value, ",", ".", 1), 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a number", column)
+	}
+	return number, nil
+}
+
+// validateImportRequest runs the binding rules of a request struct and
+// describes the broken rules in terms of the import's column names
+func validateImportRequest(req interface{}) error {
+	err := binding.Validator.ValidateStruct(req)
+	if err == nil {
+		return nil
+	}
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return err
+	}
+
+	reqType := reflect.TypeOf(req).Elem()
+	messages := make([]string, len(fieldErrs))
+	for i, fieldErr := range fieldErrs {
+		column := fieldErr.Field()
+		if field, ok := reqType.FieldByName(fieldErr.StructField()); ok {
+			column = strings.Split(field.Tag.Get("json"), ",")[0]
+		}
+		messages[i] = describeRule(column, fieldErr)
+	}
+	return errors.New(strings.Join(messages, "; "))
+}
+
+// describeRule explains a broken binding rule in plain words
+func describeRule(column string, fieldErr validator.FieldError) string {
+	isText := fieldErr.Kind() == reflect.String
+	switch fieldErr.Tag() {
+	case "required":
+		return column + " is required"
+	case "min":
+		if isText {
+			return fmt.Sprintf("%s must be at least %s characters", column, fieldErr.Param())
+		}
+		return fmt.Sprintf("%s must be at least %s", column, fieldErr.Param())
+	case "max":
+		if isText {
+			return fmt.Sprintf("%s must be at most %s characters", column, fieldErr.Param())
+		}
+		return fmt.Sprintf("%s must be at most %s", column, fieldErr.Param())
+	case "oneof":
+		return fmt.Sprintf("%s must be one of: %s", column, strings.ReplaceAll(fieldErr.Param(), " ", ", "))
+	case "uuid":
+		return column + " must be a valid UUID"
+	case "url":
+		return column + " must be a valid URL"
+	default:
+		return column + " is invalid"
+	}
+}
@@ -5,22 +5,31 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
 )
 
-// CreateMatchRequest represents create match request body
+// CreateMatchRequest represents create match request body. The kickoff is
+// either kickoff_at, or match_date and match_time local to timezone.
 type CreateMatchRequest struct {
-	MatchDate  string `json:"match_date" binding:"required"` // Format: 2006-01-02
-	MatchTime  string `json:"match_time" binding:"required"` // Format: 15:04
+	KickoffAt  string `json:"kickoff_at" binding:"required_without=MatchDate,omitempty,excluded_with=MatchDate MatchTime,datetime=2006-01-02T15:04:05Z07:00"` // Format: RFC 3339
+	MatchDate  string `json:"match_date" binding:"required_without=KickoffAt,omitempty,datetime=2006-01-02"`                                                  // Format: 2006-01-02
+	MatchTime  string `json:"match_time" binding:"required_with=MatchDate,omitempty,datetime=15:04"`                                                          // Format: 15:04
+	Timezone   string `json:"timezone" binding:"omitempty,timezone"`                                                                                          // IANA name, default Asia/Jakarta
 	HomeTeamID string `json:"home_team_id" binding:"required,uuid"`
 	AwayTeamID string `json:"away_team_id" binding:"required,uuid"`
+	VenueID    string `json:"venue_id" binding:"omitempty,uuid"` // default: the home venue of the home team
 }
 
-// UpdateMatchRequest represents update match request body
+// UpdateMatchRequest represents update match request body. A new match_date,
+// match_time or timezone keeps the other parts of the local kickoff.
 type UpdateMatchRequest struct {
-	MatchDate  string `json:"match_date" binding:"omitempty"` // Format: 2006-01-02
-	MatchTime  string `json:"match_time" binding:"omitempty"` // Format: 15:04
+	KickoffAt  string `json:"kickoff_at" binding:"omitempty,excluded_with=MatchDate MatchTime,datetime=2006-01-02T15:04:05Z07:00"` // Format: RFC 3339
+	MatchDate  string `json:"match_date" binding:"omitempty,datetime=2006-01-02"`                                                  // Format: 2006-01-02
+	MatchTime  string `json:"match_time" binding:"omitempty,datetime=15:04"`                                                       // Format: 15:04
+	Timezone   string `json:"timezone" binding:"omitempty,timezone"`                                                               // IANA name
 	HomeTeamID string `json:"home_team_id" binding:"omitempty,uuid"`
 	AwayTeamID string `json:"away_team_id" binding:"omitempty,uuid"`
+	VenueID    string `json:"venue_id" binding:"omitempty,uuid"`
 	Status     string `json:"status" binding:"omitempty,oneof=scheduled ongoing completed cancelled"`
 }
 
@@ -29,6 +38,7 @@ type RecordMatchResultRequest struct {
 	HomeScore int        `json:"home_score" binding:"min=0"`
 	AwayScore int        `json:"away_score" binding:"min=0"`
 	Goals     []GoalRequest `json:"goals" binding:"dive"`
+	Cards     []CardRequest `json:"cards" binding:"dive"`
 }
 
 // GoalRequest represents a goal input
@@ -39,20 +49,34 @@ type GoalRequest struct {
 	IsOwnGoal bool   `json:"is_own_goal"`
 }
 
+// CardRequest represents a card input
+type CardRequest struct {
+	PlayerID string `json:"player_id" binding:"required,uuid"`
+	TeamID   string `json:"team_id" binding:"required,uuid"`
+	Minute   int    `json:"minute" binding:"required,min=1,max=120"`
+	Type     string `json:"type" binding:"required,oneof=yellow red"`
+}
+
 // MatchResponse represents match data in response
 type MatchResponse struct {
 	ID           string              `json:"id"`
-	MatchDate    string              `json:"match_date"`
-	MatchTime    string              `json:"match_time"`
+	KickoffAt    string              `json:"kickoff_at"`    // UTC, RFC 3339
+	KickoffLocal string              `json:"kickoff_local"` // RFC 3339 with the offset of Timezone
+	Timezone     string              `json:"timezone"`
+	MatchDate    string              `json:"match_date"` // Local to Timezone
+	MatchTime    string              `json:"match_time"` // Local to Timezone
 	HomeTeamID   string              `json:"home_team_id"`
 	AwayTeamID   string              `json:"away_team_id"`
+	VenueID      *uuid.UUID          `json:"venue_id"`
 	HomeScore    *int                `json:"home_score"`
 	AwayScore    *int                `json:"away_score"`
 	Status       string              `json:"status"`
 	StatusName   string              `json:"status_name"`
 	HomeTeam     *TeamSimpleResponse `json:"home_team,omitempty"`
 	AwayTeam     *TeamSimpleResponse `json:"away_team,omitempty"`
+	Venue        *VenueSimpleResponse `json:"venue,omitempty"`
 	Goals        []GoalResponse      `json:"goals,omitempty"`
+	Cards        []CardResponse      `json:"cards,omitempty"`
 	MatchResult  string              `json:"match_result,omitempty"`
 	ResultDisplay string             `json:"result_display,omitempty"`
 	CreatedAt    string              `json:"created_at"`
@@ -71,6 +95,17 @@ type GoalResponse struct {
 	IsOwnGoal  bool   `json:"is_own_goal"`
 }
 
+// CardResponse represents card data in response
+type CardResponse struct {
+	ID         string `json:"id"`
+	PlayerID   string `json:"player_id"`
+	PlayerName string `json:"player_name,omitempty"`
+	TeamID     string `json:"team_id"`
+	TeamName   string `json:"team_name,omitempty"`
+	Minute     int    `json:"minute"`
+	Type       string `json:"type"`
+}
+
 // ToMatchEntity converts CreateMatchRequest to entity.Match
 func (r *CreateMatchRequest) ToMatchEntity() (*entity.Match, error) {
 	homeTeamID, err := uuid.Parse(r.HomeTeamID)
@@ -83,31 +118,51 @@ func (r *CreateMatchRequest) ToMatchEntity() (*entity.Match, error) {
 		return nil, err
 	}
 
-	matchDate, err := time.Parse("2006-01-02", r.MatchDate)
+	timezone := r.Timezone
+	if timezone == "" {
+		timezone = entity.DefaultTimezone
+	}
+	kickoff, err := parseKickoff(r.KickoffAt, r.MatchDate, r.MatchTime, timezone)
 	if err != nil {
 		return nil, err
 	}
 
-	return &entity.Match{
-		MatchDate:  matchDate,
-		MatchTime:  r.MatchTime,
+	match := &entity.Match{
+		KickoffAt:  kickoff,
+		Timezone:   timezone,
 		HomeTeamID: homeTeamID,
 		AwayTeamID: awayTeamID,
 		Status:     entity.MatchStatusScheduled,
-	}, nil
+	}
+	if r.VenueID != "" {
+		venueID, err := uuid.Parse(r.VenueID)
+		if err != nil {
+			return nil, err
+		}
+		match.VenueID = &venueID
+	}
+	return match, nil
 }
 
 // UpdateMatchEntity updates entity.Match with UpdateMatchRequest values
 func (r *UpdateMatchRequest) UpdateMatchEntity(match *entity.Match) error {
-	if r.MatchDate != "" {
-		matchDate, err := time.Parse("2006-01-02", r.MatchDate)
+	if r.KickoffAt != "" || r.MatchDate != "" || r.MatchTime != "" || r.Timezone != "" {
+		local := match.LocalKickoff()
+		date, clock := local.Format("2006-01-02"), local.Format("15:04")
+		if r.MatchDate != "" {
+			date = r.MatchDate
+		}
+		if r.MatchTime != "" {
+			clock = r.MatchTime
+		}
+		if r.Timezone != "" {
+			match.Timezone = r.Timezone
+		}
+		kickoff, err := parseKickoff(r.KickoffAt, date, clock, match.Timezone)
 		if err != nil {
 			return err
 		}
-		match.MatchDate = matchDate
-	}
-	if r.MatchTime != "" {
-		match.MatchTime = r.MatchTime
+		match.KickoffAt = kickoff
 	}
 	if r.HomeTeamID != "" {
 		homeTeamID, err := uuid.Parse(r.HomeTeamID)
@@ -123,20 +178,50 @@ func (r *UpdateMatchRequest) UpdateMatchEntity(match *entity.Match) error {
 		}
 		match.AwayTeamID = awayTeamID
 	}
+	if r.VenueID != "" {
+		venueID, err := uuid.Parse(r.VenueID)
+		if err != nil {
+			return err
+		}
+		if match.VenueID == nil || *match.VenueID != venueID {
+			match.VenueID = &venueID
+			match.Venue = nil // a loaded venue would overwrite venue_id on save
+		}
+	}
 	if r.Status != "" {
 		match.Status = entity.MatchStatus(r.Status)
 	}
 	return nil
 }
 
+// parseKickoff returns the kickoff in UTC, from an RFC 3339 timestamp or
+// else from a date and time local to timezone
+func parseKickoff(kickoffAt, date, clock, timezone string) (time.Time, error) {
+	if kickoffAt != "" {
+		kickoff, err := time.Parse(time.RFC3339, kickoffAt)
+		return kickoff.UTC(), err
+	}
+	loc, err := entity.LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	kickoff, err := time.ParseInLocation("2006-01-02 15:04", date+" "+clock, loc)
+	return kickoff.UTC(), err
+}
+
 // ToMatchResponse converts entity.Match to MatchResponse
 func ToMatchResponse(match *entity.Match) MatchResponse {
+	local := match.LocalKickoff()
 	response := MatchResponse{
 		ID:            match.ID.String(),
-		MatchDate:     match.MatchDate.Format("2006-01-02"),
-		MatchTime:     match.MatchTime,
+		KickoffAt:     match.KickoffAt.UTC().Format(time.RFC3339),
+		KickoffLocal:  local.Format(time.RFC3339),
+		Timezone:      match.Timezone,
+		MatchDate:     local.Format("2006-01-02"),
+		MatchTime:     local.Format("15:04"),
 		HomeTeamID:    match.HomeTeamID.String(),
 		AwayTeamID:    match.AwayTeamID.String(),
+		VenueID:       match.VenueID,
 		HomeScore:     match.HomeScore,
 		AwayScore:     match.AwayScore,
 		Status:        string(match.Status),
@@ -157,10 +242,16 @@ func ToMatchResponse(match *entity.Match) MatchResponse {
 		response.AwayTeam = &awayTeam
 	}
 
+	response.Venue = ToVenueSimpleResponse(match.Venue)
+
 	if match.Goals != nil {
 		response.Goals = ToGoalResponseList(match.Goals)
 	}
 
+	if match.Cards != nil {
+		response.Cards = ToCardResponseList(match.Cards)
+	}
+
 	return response
 }
 
@@ -173,6 +264,20 @@ func ToMatchResponseList(matches []entity.Match) []MatchResponse {
 	return responses
 }
 
+// ScheduleConflictResponse describes the match a schedule clashes with
+type ScheduleConflictResponse struct {
+	Conflict string        `json:"conflict"` // venue_booked, team_busy or official_busy
+	Match    MatchResponse `json:"match"`
+}
+
+// ToScheduleConflictResponse converts usecase.ScheduleConflictError to ScheduleConflictResponse
+func ToScheduleConflictResponse(conflict *usecase.ScheduleConflictError) ScheduleConflictResponse {
+	return ScheduleConflictResponse{
+		Conflict: string(conflict.Conflict),
+		Match:    ToMatchResponse(&conflict.Match),
+	}
+}
+
 // ToGoalResponse converts entity.Goal to GoalResponse
 func ToGoalResponse(goal *entity.Goal) GoalResponse {
 	response := GoalResponse{
@@ -204,6 +309,27 @@ func ToGoalResponseList(goals []entity.Goal) []GoalResponse {
 	return responses
 }
 
+// ToCardResponseList converts a slice of entity.Card to CardResponse slice
+func ToCardResponseList(cards []entity.Card) []CardResponse {
+	responses := make([]CardResponse, len(cards))
+	for i, card := range cards {
+		responses[i] = CardResponse{
+			ID:       card.ID.String(),
+			PlayerID: card.PlayerID.String(),
+			TeamID:   card.TeamID.String(),
+			Minute:   card.Minute,
+			Type:     string(card.Type),
+		}
+		if card.Player != nil {
+			responses[i].PlayerName = card.Player.Name
+		}
+		if card.Team != nil {
+			responses[i].TeamName = card.Team.Name
+		}
+	}
+	return responses
+}
+
 // getMatchStatusDisplayName returns the display name for match status
 func getMatchStatusDisplayName(status entity.MatchStatus) string {
 	switch status {
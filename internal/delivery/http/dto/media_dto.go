@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// ImageUploadResponse represents a stored image and its thumbnail
+type ImageUploadResponse struct {
+	Key          string `json:"key"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+	ContentType  string `json:"content_type"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	Size         int    `json:"size"` // in bytes
+}
+
+// TeamLogoResponse represents a team with its uploaded logo
+type TeamLogoResponse struct {
+	Team  TeamResponse        `json:"team"`
+	Image ImageUploadResponse `json:"image"`
+}
+
+// PlayerPhotoResponse represents a player with their uploaded photo
+type PlayerPhotoResponse struct {
+	Player PlayerResponse      `json:"player"`
+	Image  ImageUploadResponse `json:"image"`
+}
+
+// ToImageUploadResponse converts usecase.ImageUpload to ImageUploadResponse
+func ToImageUploadResponse(upload *usecase.ImageUpload) ImageUploadResponse {
+	return ImageUploadResponse{
+		Key:          upload.Key,
+		URL:          upload.URL,
+		ThumbnailURL: upload.ThumbnailURL,
+		ContentType:  upload.ContentType,
+		Width:        upload.Width,
+		Height:       upload.Height,
+		Size:         upload.Size,
+	}
+}
+
+// ToTeamLogoResponse converts an uploaded team logo to TeamLogoResponse
+func ToTeamLogoResponse(team *entity.Team, upload *usecase.ImageUpload) TeamLogoResponse {
+	return TeamLogoResponse{Team: ToTeamResponse(team), Image: ToImageUploadResponse(upload)}
+}
+
+// ToPlayerPhotoResponse converts an uploaded player photo to PlayerPhotoResponse
+func ToPlayerPhotoResponse(player *entity.Player, upload *usecase.ImageUpload) PlayerPhotoResponse {
+	return PlayerPhotoResponse{Player: ToPlayerResponse(player), Image: ToImageUploadResponse(upload)}
+}
@@ -0,0 +1,134 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+)
+
+// CreateOfficialRequest represents create official request body
+type CreateOfficialRequest struct {
+	Name         string `json:"name" binding:"required,min=2,max=255"`
+	LicenseLevel string `json:"license_level" binding:"required,oneof=fifa national regional"`
+	City         string `json:"city" binding:"omitempty,max=100"`
+}
+
+// UpdateOfficialRequest represents update official request body
+type UpdateOfficialRequest struct {
+	Name         string `json:"name" binding:"omitempty,min=2,max=255"`
+	LicenseLevel string `json:"license_level" binding:"omitempty,oneof=fifa national regional"`
+	City         string `json:"city" binding:"omitempty,max=100"`
+}
+
+// AssignOfficialsRequest represents the officials appointed to a match.
+// It replaces any previous appointments; an empty list clears them.
+type AssignOfficialsRequest struct {
+	Officials []OfficialAssignmentRequest `json:"officials" binding:"dive"`
+}
+
+// OfficialAssignmentRequest represents one appointment
+type OfficialAssignmentRequest struct {
+	OfficialID string `json:"official_id" binding:"required,uuid"`
+	Role       string `json:"role" binding:"required,oneof=referee assistant_1 assistant_2 fourth_official"`
+}
+
+// OfficialResponse represents official data in response
+type OfficialResponse struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	LicenseLevel string `json:"license_level"`
+	City         string `json:"city"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+// MatchOfficialResponse represents an appointment in response
+type MatchOfficialResponse struct {
+	OfficialID   uuid.UUID `json:"official_id"`
+	Name         string    `json:"name"`
+	LicenseLevel string    `json:"license_level"`
+	Role         string    `json:"role"`
+}
+
+// OfficialStatsResponse represents the statistics of an official
+type OfficialStatsResponse struct {
+	Official      OfficialResponse `json:"official"`
+	Matches       int64            `json:"matches"`
+	MatchesByRole map[string]int64 `json:"matches_by_role"`
+	YellowCards   int64            `json:"yellow_cards"` // shown in matches they refereed
+	RedCards      int64            `json:"red_cards"`    // shown in matches they refereed
+}
+
+// ToOfficialEntity converts CreateOfficialRequest to entity.Official
+func (r *CreateOfficialRequest) ToOfficialEntity() *entity.Official {
+	return &entity.Official{
+		Name:         r.Name,
+		LicenseLevel: entity.LicenseLevel(r.LicenseLevel),
+		City:         r.City,
+	}
+}
+
+// UpdateOfficialEntity updates entity.Official with UpdateOfficialRequest values
+func (r *UpdateOfficialRequest) UpdateOfficialEntity(official *entity.Official) {
+	if r.Name != "" {
+		official.Name = r.Name
+	}
+	if r.LicenseLevel != "" {
+		official.LicenseLevel = entity.LicenseLevel(r.LicenseLevel)
+	}
+	if r.City != "" {
+		official.City = r.City
+	}
+}
+
+// ToOfficialResponse converts entity.Official to OfficialResponse
+func ToOfficialResponse(official *entity.Official) OfficialResponse {
+	return OfficialResponse{
+		ID:           official.ID.String(),
+		Name:         official.Name,
+		LicenseLevel: string(official.LicenseLevel),
+		City:         official.City,
+		CreatedAt:    official.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:    official.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// ToOfficialResponseList converts a slice of entity.Official to OfficialResponse slice
+func ToOfficialResponseList(officials []entity.Official) []OfficialResponse {
+	responses := make([]OfficialResponse, len(officials))
+	for i, official := range officials {
+		responses[i] = ToOfficialResponse(&official)
+	}
+	return responses
+}
+
+// ToMatchOfficialResponseList converts a slice of entity.MatchOfficial to MatchOfficialResponse slice
+func ToMatchOfficialResponseList(appointments []entity.MatchOfficial) []MatchOfficialResponse {
+	responses := make([]MatchOfficialResponse, len(appointments))
+	for i, appointment := range appointments {
+		responses[i] = MatchOfficialResponse{
+			OfficialID: appointment.OfficialID,
+			Role:       string(appointment.Role),
+		}
+		if appointment.Official != nil {
+			responses[i].Name = appointment.Official.Name
+			responses[i].LicenseLevel = string(appointment.Official.LicenseLevel)
+		}
+	}
+	return responses
+}
+
+// ToOfficialStatsResponse converts repository.OfficialStats to OfficialStatsResponse
+func ToOfficialStatsResponse(official *entity.Official, stats *repository.OfficialStats) OfficialStatsResponse {
+	byRole := make(map[string]int64, len(entity.ValidOfficialRoles()))
+	for _, role := range entity.ValidOfficialRoles() {
+		byRole[string(role)] = stats.MatchesBy[role]
+	}
+	return OfficialStatsResponse{
+		Official:      ToOfficialResponse(official),
+		Matches:       stats.Matches,
+		MatchesByRole: byRole,
+		YellowCards:   stats.YellowCards,
+		RedCards:      stats.RedCards,
+	}
+}
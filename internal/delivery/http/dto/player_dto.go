@@ -1,18 +1,28 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
 )
 
 // CreatePlayerRequest represents create player request body
 type CreatePlayerRequest struct {
-	TeamID       string `json:"team_id" binding:"required,uuid"`
-	Name         string `json:"name" binding:"required,min=2,max=255"`
+	TeamID       string  `json:"team_id" binding:"required,uuid"`
+	Name         string  `json:"name" binding:"required,min=2,max=255"`
 	Height       float64 `json:"height" binding:"required,min=100,max=250"`
 	Weight       float64 `json:"weight" binding:"required,min=30,max=200"`
-	Position     string `json:"position" binding:"required,oneof=forward midfielder defender goalkeeper"`
-	JerseyNumber int    `json:"jersey_number" binding:"required,min=1,max=99"`
+	Position     string  `json:"position" binding:"required,oneof=forward midfielder defender goalkeeper"`
+	JerseyNumber int     `json:"jersey_number" binding:"required,min=1,max=99"`
+
+	// Profile, all optional
+	SecondaryPositions []string `json:"secondary_positions" binding:"omitempty,max=3,dive,oneof=forward midfielder defender goalkeeper"`
+	DateOfBirth        string   `json:"date_of_birth" binding:"omitempty,datetime=2006-01-02"` // Format: 2006-01-02
+	Nationality        string   `json:"nationality" binding:"omitempty,len=2,alpha"`           // ISO 3166-1 alpha-2
+	PreferredFoot      string   `json:"preferred_foot" binding:"omitempty,oneof=left right both"`
+	Photo              string   `json:"photo" binding:"omitempty,url,max=500"`
 }
 
 // UpdatePlayerRequest represents update player request body
@@ -23,21 +33,46 @@ type UpdatePlayerRequest struct {
 	Weight       float64 `json:"weight" binding:"omitempty,min=30,max=200"`
 	Position     string  `json:"position" binding:"omitempty,oneof=forward midfielder defender goalkeeper"`
 	JerseyNumber int     `json:"jersey_number" binding:"omitempty,min=1,max=99"`
+
+	// Profile. Omitted fields are left unchanged; an empty list clears the
+	// secondary positions.
+	SecondaryPositions []string `json:"secondary_positions" binding:"omitempty,max=3,dive,oneof=forward midfielder defender goalkeeper"`
+	DateOfBirth        string   `json:"date_of_birth" binding:"omitempty,datetime=2006-01-02"` // Format: 2006-01-02
+	Nationality        string   `json:"nationality" binding:"omitempty,len=2,alpha"`           // ISO 3166-1 alpha-2
+	PreferredFoot      string   `json:"preferred_foot" binding:"omitempty,oneof=left right both"`
+	Photo              string   `json:"photo" binding:"omitempty,url,max=500"`
 }
 
 // PlayerResponse represents player data in response
 type PlayerResponse struct {
-	ID           string             `json:"id"`
-	TeamID       string             `json:"team_id"`
-	Name         string             `json:"name"`
-	Height       float64            `json:"height"`
-	Weight       float64            `json:"weight"`
-	Position     string             `json:"position"`
-	PositionName string             `json:"position_name"`
-	JerseyNumber int                `json:"jersey_number"`
-	Team         *TeamSimpleResponse `json:"team,omitempty"`
-	CreatedAt    string             `json:"created_at"`
-	UpdatedAt    string             `json:"updated_at"`
+	ID                 string              `json:"id"`
+	TeamID             string              `json:"team_id"`
+	Name               string              `json:"name"`
+	Height             float64             `json:"height"`
+	Weight             float64             `json:"weight"`
+	Position           string              `json:"position"`
+	PositionName       string              `json:"position_name"`
+	JerseyNumber       int                 `json:"jersey_number"`
+	SecondaryPositions []string            `json:"secondary_positions"`
+	DateOfBirth        *string             `json:"date_of_birth"` // Format: 2006-01-02; null when unknown
+	Age                *int                `json:"age"`           // Today in the default time zone; null when unknown
+	Nationality        string              `json:"nationality"`
+	PreferredFoot      string              `json:"preferred_foot"`
+	Photo              string              `json:"photo"`
+	Team               *TeamSimpleResponse `json:"team,omitempty"`
+	CreatedAt          string              `json:"created_at"`
+	UpdatedAt          string              `json:"updated_at"`
+}
+
+// PlayerEligibilityResponse represents the outcome of checking a player
+// against an age group
+type PlayerEligibilityResponse struct {
+	Player     PlayerResponse `json:"player"`
+	AgeGroup   string         `json:"age_group"`
+	On         string         `json:"on"`         // Cut-off date, format: 2006-01-02
+	BornAfter  string         `json:"born_after"` // Eligible players are born after this date
+	Age        *int           `json:"age"`        // On the cut-off date; null when the date of birth is unknown
+	IsEligible bool           `json:"is_eligible"`
 }
 
 // ToPlayerEntity converts CreatePlayerRequest to entity.Player
@@ -47,13 +82,23 @@ func (r *CreatePlayerRequest) ToPlayerEntity() (*entity.Player, error) {
 		return nil, err
 	}
 
+	dateOfBirth, err := parseDateOfBirth(r.DateOfBirth)
+	if err != nil {
+		return nil, err
+	}
+
 	return &entity.Player{
-		TeamID:       teamID,
-		Name:         r.Name,
-		Height:       r.Height,
-		Weight:       r.Weight,
-		Position:     entity.PlayerPosition(r.Position),
-		JerseyNumber: r.JerseyNumber,
+		TeamID:             teamID,
+		Name:               r.Name,
+		Height:             r.Height,
+		Weight:             r.Weight,
+		Position:           entity.PlayerPosition(r.Position),
+		SecondaryPositions: toPlayerPositions(r.SecondaryPositions),
+		JerseyNumber:       r.JerseyNumber,
+		DateOfBirth:        dateOfBirth,
+		Nationality:        r.Nationality,
+		PreferredFoot:      entity.PreferredFoot(r.PreferredFoot),
+		Photo:              r.Photo,
 	}, nil
 }
 
@@ -81,22 +126,56 @@ func (r *UpdatePlayerRequest) UpdatePlayerEntity(player *entity.Player) error {
 	if r.JerseyNumber != 0 {
 		player.JerseyNumber = r.JerseyNumber
 	}
+	if r.SecondaryPositions != nil {
+		player.SecondaryPositions = toPlayerPositions(r.SecondaryPositions)
+	}
+	if r.DateOfBirth != "" {
+		dateOfBirth, err := parseDateOfBirth(r.DateOfBirth)
+		if err != nil {
+			return err
+		}
+		player.DateOfBirth = dateOfBirth
+	}
+	if r.Nationality != "" {
+		player.Nationality = r.Nationality
+	}
+	if r.PreferredFoot != "" {
+		player.PreferredFoot = entity.PreferredFoot(r.PreferredFoot)
+	}
+	if r.Photo != "" {
+		player.Photo = r.Photo
+	}
 	return nil
 }
 
 // ToPlayerResponse converts entity.Player to PlayerResponse
 func ToPlayerResponse(player *entity.Player) PlayerResponse {
 	response := PlayerResponse{
-		ID:           player.ID.String(),
-		TeamID:       player.TeamID.String(),
-		Name:         player.Name,
-		Height:       player.Height,
-		Weight:       player.Weight,
-		Position:     string(player.Position),
-		PositionName: getPositionDisplayName(player.Position),
-		JerseyNumber: player.JerseyNumber,
-		CreatedAt:    player.CreatedAt.Format("2006-01-02T15:04:05Z"),
-		UpdatedAt:    player.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		ID:                 player.ID.String(),
+		TeamID:             player.TeamID.String(),
+		Name:               player.Name,
+		Height:             player.Height,
+		Weight:             player.Weight,
+		Position:           string(player.Position),
+		PositionName:       getPositionDisplayName(player.Position),
+		JerseyNumber:       player.JerseyNumber,
+		SecondaryPositions: make([]string, len(player.SecondaryPositions)),
+		Nationality:        player.Nationality,
+		PreferredFoot:      string(player.PreferredFoot),
+		Photo:              player.Photo,
+		CreatedAt:          player.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:          player.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	for i, position := range player.SecondaryPositions {
+		response.SecondaryPositions[i] = string(position)
+	}
+	if player.DateOfBirth != nil {
+		dateOfBirth := player.DateOfBirth.Format("2006-01-02")
+		response.DateOfBirth = &dateOfBirth
+		if age, ok := player.AgeOn(entity.Today()); ok {
+			response.Age = &age
+		}
 	}
 
 	if player.Team != nil {
@@ -116,6 +195,40 @@ func ToPlayerResponseList(players []entity.Player) []PlayerResponse {
 	return responses
 }
 
+// ToPlayerEligibilityResponse converts usecase.Eligibility to PlayerEligibilityResponse
+func ToPlayerEligibilityResponse(eligibility *usecase.Eligibility) PlayerEligibilityResponse {
+	return PlayerEligibilityResponse{
+		Player:     ToPlayerResponse(eligibility.Player),
+		AgeGroup:   eligibility.AgeGroup.String(),
+		On:         eligibility.On.Format("2006-01-02"),
+		BornAfter:  eligibility.BornAfter.Format("2006-01-02"),
+		Age:        eligibility.Age,
+		IsEligible: eligibility.Eligible,
+	}
+}
+
+// parseDateOfBirth parses an optional YYYY-MM-DD date of birth as a
+// calendar date
+func parseDateOfBirth(date string) (*time.Time, error) {
+	if date == "" {
+		return nil, nil
+	}
+	dateOfBirth, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil, err
+	}
+	return &dateOfBirth, nil
+}
+
+// toPlayerPositions converts position names to entity.PlayerPositions
+func toPlayerPositions(names []string) entity.PlayerPositions {
+	positions := make(entity.PlayerPositions, len(names))
+	for i, name := range names {
+		positions[i] = entity.PlayerPosition(name)
+	}
+	return positions
+}
+
 // getPositionDisplayName returns the display name for a position
 func getPositionDisplayName(position entity.PlayerPosition) string {
 	switch position {
@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"math"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// SearchResponse represents the results of a search grouped by type, each
+// group best match first
+type SearchResponse struct {
+	Query   string               `json:"query"`
+	Teams   []TeamSearchResult   `json:"teams"`
+	Players []PlayerSearchResult `json:"players"`
+	Venues  []VenueSearchResult  `json:"venues"`
+}
+
+// TeamSearchResult represents a team found by a search. Score is the
+// relevance from 0 to 1, comparable within one response.
+type TeamSearchResult struct {
+	Score float64 `json:"score"`
+	TeamResponse
+}
+
+// PlayerSearchResult represents a player found by a search
+type PlayerSearchResult struct {
+	Score float64 `json:"score"`
+	PlayerResponse
+}
+
+// VenueSearchResult represents a venue found by a search
+type VenueSearchResult struct {
+	Score float64 `json:"score"`
+	VenueResponse
+}
+
+// ToSearchResponse converts usecase.SearchResult to SearchResponse
+func ToSearchResponse(query string, result *usecase.SearchResult) SearchResponse {
+	response := SearchResponse{
+		Query:   query,
+		Teams:   make([]TeamSearchResult, len(result.Teams)),
+		Players: make([]PlayerSearchResult, len(result.Players)),
+		Venues:  make([]VenueSearchResult, len(result.Venues)),
+	}
+	for i, hit := range result.Teams {
+		response.Teams[i] = TeamSearchResult{Score: roundScore(hit.Score), TeamResponse: ToTeamResponse(&hit.Item)}
+	}
+	for i, hit := range result.Players {
+		response.Players[i] = PlayerSearchResult{Score: roundScore(hit.Score), PlayerResponse: ToPlayerResponse(&hit.Item)}
+	}
+	for i, hit := range result.Venues {
+		response.Venues[i] = VenueSearchResult{Score: roundScore(hit.Score), VenueResponse: ToVenueResponse(&hit.Item)}
+	}
+	return response
+}
+
+// roundScore rounds a relevance score to three decimals
+func roundScore(score float64) float64 {
+	return math.Round(score*1000) / 1000
+}
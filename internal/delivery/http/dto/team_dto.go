@@ -3,6 +3,7 @@ package dto
 import (
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
 )
 
 // CreateTeamRequest represents create team request body
@@ -12,6 +13,7 @@ type CreateTeamRequest struct {
 	FoundedYear int    `json:"founded_year" binding:"required,min=1800,max=2100"`
 	Address     string `json:"address" binding:"omitempty,max=500"`
 	City        string `json:"city" binding:"required,min=2,max=100"`
+	HomeVenueID string `json:"home_venue_id" binding:"omitempty,uuid"`
 }
 
 // UpdateTeamRequest represents update team request body
@@ -21,30 +23,38 @@ type UpdateTeamRequest struct {
 	FoundedYear int    `json:"founded_year" binding:"omitempty,min=1800,max=2100"`
 	Address     string `json:"address" binding:"omitempty,max=500"`
 	City        string `json:"city" binding:"omitempty,min=2,max=100"`
+	HomeVenueID string `json:"home_venue_id" binding:"omitempty,uuid"`
 }
 
 // TeamResponse represents team data in response
 type TeamResponse struct {
-	ID          string           `json:"id"`
-	Name        string           `json:"name"`
-	Logo        string           `json:"logo"`
-	FoundedYear int              `json:"founded_year"`
-	Address     string           `json:"address"`
-	City        string           `json:"city"`
-	Players     []PlayerResponse `json:"players,omitempty"`
-	CreatedAt   string           `json:"created_at"`
-	UpdatedAt   string           `json:"updated_at"`
+	ID          string               `json:"id"`
+	Name        string               `json:"name"`
+	Logo        string               `json:"logo"`
+	FoundedYear int                  `json:"founded_year"`
+	Address     string               `json:"address"`
+	City        string               `json:"city"`
+	HomeVenueID *uuid.UUID           `json:"home_venue_id"`
+	HomeVenue   *VenueSimpleResponse `json:"home_venue,omitempty"`
+	Players     []PlayerResponse     `json:"players,omitempty"`
+	CreatedAt   string               `json:"created_at"`
+	UpdatedAt   string               `json:"updated_at"`
 }
 
 // ToTeamEntity converts CreateTeamRequest to entity.Team
 func (r *CreateTeamRequest) ToTeamEntity() *entity.Team {
-	return &entity.Team{
+	team := &entity.Team{
 		Name:        r.Name,
 		Logo:        r.Logo,
 		FoundedYear: r.FoundedYear,
 		Address:     r.Address,
 		City:        r.City,
 	}
+	if r.HomeVenueID != "" {
+		venueID := uuid.MustParse(r.HomeVenueID)
+		team.HomeVenueID = &venueID
+	}
+	return team
 }
 
 // UpdateTeamEntity updates entity.Team with UpdateTeamRequest values
@@ -64,6 +74,13 @@ func (r *UpdateTeamRequest) UpdateTeamEntity(team *entity.Team) {
 	if r.City != "" {
 		team.City = r.City
 	}
+	if r.HomeVenueID != "" {
+		venueID := uuid.MustParse(r.HomeVenueID)
+		if team.HomeVenueID == nil || *team.HomeVenueID != venueID {
+			team.HomeVenueID = &venueID
+			team.HomeVenue = nil // a loaded venue would overwrite home_venue_id on save
+		}
+	}
 }
 
 // ToTeamResponse converts entity.Team to TeamResponse
@@ -75,6 +92,8 @@ func ToTeamResponse(team *entity.Team) TeamResponse {
 		FoundedYear: team.FoundedYear,
 		Address:     team.Address,
 		City:        team.City,
+		HomeVenueID: team.HomeVenueID,
+		HomeVenue:   ToVenueSimpleResponse(team.HomeVenue),
 		CreatedAt:   team.CreatedAt.Format("2006-01-02T15:04:05Z"),
 		UpdatedAt:   team.UpdatedAt.Format("2006-01-02T15:04:05Z"),
 	}
@@ -115,3 +134,19 @@ func ToTeamSimpleResponse(team *entity.Team) TeamSimpleResponse {
 		City: team.City,
 	}
 }
+
+// TeamDependenciesResponse represents the records that depend on a team
+type TeamDependenciesResponse struct {
+	PlayerCount int64           `json:"player_count"`
+	MatchCount  int64           `json:"match_count"`
+	Matches     []MatchResponse `json:"matches"`
+}
+
+// ToTeamDependenciesResponse converts usecase.TeamDependencies to TeamDependenciesResponse
+func ToTeamDependenciesResponse(dependencies *usecase.TeamDependencies) TeamDependenciesResponse {
+	return TeamDependenciesResponse{
+		PlayerCount: dependencies.PlayerCount,
+		MatchCount:  dependencies.MatchCount,
+		Matches:     ToMatchResponseList(dependencies.Matches),
+	}
+}
@@ -0,0 +1,151 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// TransferPlayerRequest represents transfer player request body. The player
+// joins the team at midnight of date, local to the default time zone; without
+// a date the transfer takes effect now.
+type TransferPlayerRequest struct {
+	TeamID       string `json:"team_id" binding:"required,uuid"`
+	JerseyNumber int    `json:"jersey_number" binding:"required,min=1,max=99"`
+	Date         string `json:"date" binding:"omitempty,datetime=2006-01-02"` // Format: 2006-01-02
+}
+
+// CreateTransferWindowRequest represents create transfer window request
+// body. Both dates are inclusive and local to the default time zone.
+type CreateTransferWindowRequest struct {
+	Name     string `json:"name" binding:"required,min=2,max=100"`
+	OpensOn  string `json:"opens_on" binding:"required,datetime=2006-01-02"`  // Format: 2006-01-02
+	ClosesOn string `json:"closes_on" binding:"required,datetime=2006-01-02"` // Format: 2006-01-02
+}
+
+// PlayerContractResponse represents a spell of a player at a team
+type PlayerContractResponse struct {
+	ID           string  `json:"id"`
+	PlayerID     string  `json:"player_id"`
+	TeamID       string  `json:"team_id"`
+	TeamName     string  `json:"team_name,omitempty"`
+	JerseyNumber int     `json:"jersey_number"`
+	StartsAt     *string `json:"starts_at"` // UTC, RFC 3339; null for the first contract
+	EndsAt       *string `json:"ends_at"`   // UTC, RFC 3339; null while current
+	IsCurrent    bool    `json:"is_current"`
+}
+
+// TransferWindowResponse represents transfer window data in response
+type TransferWindowResponse struct {
+	ID        string `json:"id"`
+	Season    string `json:"season"`
+	Name      string `json:"name"`
+	OpensOn   string `json:"opens_on"`  // local date, inclusive
+	ClosesOn  string `json:"closes_on"` // local date, inclusive
+	OpensAt   string `json:"opens_at"`  // UTC, RFC 3339
+	ClosesAt  string `json:"closes_at"` // UTC, RFC 3339, exclusive
+	CreatedAt string `json:"created_at"`
+}
+
+// ToTransferInput converts TransferPlayerRequest to usecase.TransferInput
+func (r *TransferPlayerRequest) ToTransferInput() (usecase.TransferInput, error) {
+	teamID, err := uuid.Parse(r.TeamID)
+	if err != nil {
+		return usecase.TransferInput{}, err
+	}
+
+	at := time.Now()
+	if r.Date != "" {
+		if at, err = parseLocalDate(r.Date); err != nil {
+			return usecase.TransferInput{}, err
+		}
+	}
+	return usecase.TransferInput{TeamID: teamID, JerseyNumber: r.JerseyNumber, At: at}, nil
+}
+
+// ToTransferWindowEntity converts CreateTransferWindowRequest to
+// entity.TransferWindow. The window closes at midnight after closes_on.
+func (r *CreateTransferWindowRequest) ToTransferWindowEntity() (*entity.TransferWindow, error) {
+	opensAt, err := parseLocalDate(r.OpensOn)
+	if err != nil {
+		return nil, err
+	}
+	closesOn, err := parseLocalDate(r.ClosesOn)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.TransferWindow{
+		Name:     r.Name,
+		OpensAt:  opensAt,
+		ClosesAt: closesOn.AddDate(0, 0, 1),
+	}, nil
+}
+
+// ToPlayerContractResponse converts entity.PlayerContract to PlayerContractResponse
+func ToPlayerContractResponse(contract *entity.PlayerContract) PlayerContractResponse {
+	response := PlayerContractResponse{
+		ID:           contract.ID.String(),
+		PlayerID:     contract.PlayerID.String(),
+		TeamID:       contract.TeamID.String(),
+		JerseyNumber: contract.JerseyNumber,
+		IsCurrent:    contract.IsCurrent(),
+	}
+	if contract.Team != nil {
+		response.TeamName = contract.Team.Name
+	}
+	if contract.StartsAt != nil {
+		start := contract.StartsAt.UTC().Format(time.RFC3339)
+		response.StartsAt = &start
+	}
+	if contract.EndsAt != nil {
+		end := contract.EndsAt.UTC().Format(time.RFC3339)
+		response.EndsAt = &end
+	}
+	return response
+}
+
+// ToPlayerContractResponseList converts a slice of entity.PlayerContract to PlayerContractResponse slice
+func ToPlayerContractResponseList(contracts []entity.PlayerContract) []PlayerContractResponse {
+	responses := make([]PlayerContractResponse, len(contracts))
+	for i, contract := range contracts {
+		responses[i] = ToPlayerContractResponse(&contract)
+	}
+	return responses
+}
+
+// ToTransferWindowResponse converts entity.TransferWindow to TransferWindowResponse
+func ToTransferWindowResponse(window *entity.TransferWindow) TransferWindowResponse {
+	response := TransferWindowResponse{
+		ID:        window.ID.String(),
+		Season:    window.Season,
+		Name:      window.Name,
+		OpensAt:   window.OpensAt.UTC().Format(time.RFC3339),
+		ClosesAt:  window.ClosesAt.UTC().Format(time.RFC3339),
+		CreatedAt: window.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+	if loc, err := entity.LoadLocation(entity.DefaultTimezone); err == nil {
+		response.OpensOn = window.OpensAt.In(loc).Format("2006-01-02")
+		response.ClosesOn = window.ClosesAt.In(loc).AddDate(0, 0, -1).Format("2006-01-02")
+	}
+	return response
+}
+
+// ToTransferWindowResponseList converts a slice of entity.TransferWindow to TransferWindowResponse slice
+func ToTransferWindowResponseList(windows []entity.TransferWindow) []TransferWindowResponse {
+	responses := make([]TransferWindowResponse, len(windows))
+	for i, window := range windows {
+		responses[i] = ToTransferWindowResponse(&window)
+	}
+	return responses
+}
+
+// parseLocalDate returns midnight of a YYYY-MM-DD date in the default time zone
+func parseLocalDate(date string) (time.Time, error) {
+	loc, err := entity.LoadLocation(entity.DefaultTimezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.ParseInLocation("2006-01-02", date, loc)
+}
@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"gorm.io/gorm"
+)
+
+// TrashedTeamResponse represents a soft-deleted team
+type TrashedTeamResponse struct {
+	TeamResponse
+	DeletedAt string `json:"deleted_at"`
+}
+
+// TrashedPlayerResponse represents a soft-deleted player
+type TrashedPlayerResponse struct {
+	PlayerResponse
+	DeletedAt string `json:"deleted_at"`
+}
+
+// TrashedMatchResponse represents a soft-deleted match
+type TrashedMatchResponse struct {
+	MatchResponse
+	DeletedAt string `json:"deleted_at"`
+}
+
+// ToTrashedTeamResponseList converts soft-deleted teams to TrashedTeamResponse slice
+func ToTrashedTeamResponseList(teams []entity.Team) []TrashedTeamResponse {
+	responses := make([]TrashedTeamResponse, len(teams))
+	for i, team := range teams {
+		responses[i] = TrashedTeamResponse{
+			TeamResponse: ToTeamResponse(&team),
+			DeletedAt:    formatDeletedAt(team.DeletedAt),
+		}
+	}
+	return responses
+}
+
+// ToTrashedPlayerResponseList converts soft-deleted players to TrashedPlayerResponse slice
+func ToTrashedPlayerResponseList(players []entity.Player) []TrashedPlayerResponse {
+	responses := make([]TrashedPlayerResponse, len(players))
+	for i, player := range players {
+		responses[i] = TrashedPlayerResponse{
+			PlayerResponse: ToPlayerResponse(&player),
+			DeletedAt:      formatDeletedAt(player.DeletedAt),
+		}
+	}
+	return responses
+}
+
+// ToTrashedMatchResponseList converts soft-deleted matches to TrashedMatchResponse slice
+func ToTrashedMatchResponseList(matches []entity.Match) []TrashedMatchResponse {
+	responses := make([]TrashedMatchResponse, len(matches))
+	for i, match := range matches {
+		responses[i] = TrashedMatchResponse{
+			MatchResponse: ToMatchResponse(&match),
+			DeletedAt:     formatDeletedAt(match.DeletedAt),
+		}
+	}
+	return responses
+}
+
+func formatDeletedAt(deletedAt gorm.DeletedAt) string {
+	return deletedAt.Time.UTC().Format("2006-01-02T15:04:05Z")
+}
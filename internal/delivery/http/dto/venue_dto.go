@@ -0,0 +1,114 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// CreateVenueRequest represents create venue request body
+type CreateVenueRequest struct {
+	Name      string   `json:"name" binding:"required,min=2,max=255"`
+	Address   string   `json:"address" binding:"omitempty,max=500"`
+	City      string   `json:"city" binding:"required,min=2,max=100"`
+	Capacity  int      `json:"capacity" binding:"required,min=1"`
+	Latitude  *float64 `json:"latitude" binding:"required_with=Longitude,omitempty,min=-90,max=90"`
+	Longitude *float64 `json:"longitude" binding:"required_with=Latitude,omitempty,min=-180,max=180"`
+}
+
+// UpdateVenueRequest represents update venue request body
+type UpdateVenueRequest struct {
+	Name      string   `json:"name" binding:"omitempty,min=2,max=255"`
+	Address   string   `json:"address" binding:"omitempty,max=500"`
+	City      string   `json:"city" binding:"omitempty,min=2,max=100"`
+	Capacity  int      `json:"capacity" binding:"omitempty,min=1"`
+	Latitude  *float64 `json:"latitude" binding:"required_with=Longitude,omitempty,min=-90,max=90"`
+	Longitude *float64 `json:"longitude" binding:"required_with=Latitude,omitempty,min=-180,max=180"`
+}
+
+// VenueResponse represents venue data in response
+type VenueResponse struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Address   string   `json:"address"`
+	City      string   `json:"city"`
+	Capacity  int      `json:"capacity"`
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+	CreatedAt string   `json:"created_at"`
+	UpdatedAt string   `json:"updated_at"`
+}
+
+// ToVenueEntity converts CreateVenueRequest to entity.Venue
+func (r *CreateVenueRequest) ToVenueEntity() *entity.Venue {
+	return &entity.Venue{
+		Name:      r.Name,
+		Address:   r.Address,
+		City:      r.City,
+		Capacity:  r.Capacity,
+		Latitude:  r.Latitude,
+		Longitude: r.Longitude,
+	}
+}
+
+// UpdateVenueEntity updates entity.Venue with UpdateVenueRequest values
+func (r *UpdateVenueRequest) UpdateVenueEntity(venue *entity.Venue) {
+	if r.Name != "" {
+		venue.Name = r.Name
+	}
+	if r.Address != "" {
+		venue.Address = r.Address
+	}
+	if r.City != "" {
+		venue.City = r.City
+	}
+	if r.Capacity != 0 {
+		venue.Capacity = r.Capacity
+	}
+	if r.Latitude != nil {
+		venue.Latitude = r.Latitude
+		venue.Longitude = r.Longitude
+	}
+}
+
+// ToVenueResponse converts entity.Venue to VenueResponse
+func ToVenueResponse(venue *entity.Venue) VenueResponse {
+	return VenueResponse{
+		ID:        venue.ID.String(),
+		Name:      venue.Name,
+		Address:   venue.Address,
+		City:      venue.City,
+		Capacity:  venue.Capacity,
+		Latitude:  venue.Latitude,
+		Longitude: venue.Longitude,
+		CreatedAt: venue.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt: venue.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// ToVenueResponseList converts a slice of entity.Venue to VenueResponse slice
+func ToVenueResponseList(venues []entity.Venue) []VenueResponse {
+	responses := make([]VenueResponse, len(venues))
+	for i, venue := range venues {
+		responses[i] = ToVenueResponse(&venue)
+	}
+	return responses
+}
+
+// VenueSimpleResponse represents simplified venue data
+type VenueSimpleResponse struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	City string    `json:"city"`
+}
+
+// ToVenueSimpleResponse converts entity.Venue to VenueSimpleResponse
+func ToVenueSimpleResponse(venue *entity.Venue) *VenueSimpleResponse {
+	if venue == nil {
+		return nil
+	}
+	return &VenueSimpleResponse{
+		ID:   venue.ID,
+		Name: venue.Name,
+		City: venue.City,
+	}
+}
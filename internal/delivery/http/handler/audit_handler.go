@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// AuditHandler handles audit log related requests
+type AuditHandler struct {
+	auditUseCase usecase.AuditUseCase
+}
+
+// NewAuditHandler creates a new instance of AuditHandler
+func NewAuditHandler(auditUseCase usecase.AuditUseCase) *AuditHandler {
+	return &AuditHandler{auditUseCase: auditUseCase}
+}
+
+// GetAll handles getting audit log entries with filtering and pagination
+// @Summary Get Audit Log
+// @Description Get administrative change history with optional filters
+// @Tags Audit
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param entity_type query string false "Filter by entity type (team, player, match, venue)"
+// @Param entity_id query string false "Filter by entity ID"
+// @Param actor_id query string false "Filter by actor user ID"
+// @Param from query string false "Start of time range (RFC3339 or YYYY-MM-DD)"
+// @Param to query string false "End of time range (RFC3339 or YYYY-MM-DD)"
+// @Success 200 {object} response.Response{data=[]dto.AuditLogResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /api/v1/audit [get]
+func (h *AuditHandler) GetAll(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	filter := repository.AuditLogFilter{
+		EntityType: c.Query("entity_type"),
+	}
+
+	if entityIDStr := c.Query("entity_id"); entityIDStr != "" {
+		entityID, err := uuid.Parse(entityIDStr)
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid entity ID", nil)
+			return
+		}
+		filter.EntityID = &entityID
+	}
+
+	if actorIDStr := c.Query("actor_id"); actorIDStr != "" {
+		actorID, err := uuid.Parse(actorIDStr)
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid actor ID", nil)
+			return
+		}
+		filter.ActorID = &actorID
+	}
+
+	if fromStr := c.Query("from"); fromStr != "" {
+		from, err := parseTimeQuery(fromStr, false)
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid from date format", nil)
+			return
+		}
+		filter.From = &from
+	}
+
+	if toStr := c.Query("to"); toStr != "" {
+		to, err := parseTimeQuery(toStr, true)
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid to date format", nil)
+			return
+		}
+		filter.To = &to
+	}
+
+	logs, total, err := h.auditUseCase.GetAll(c.Request.Context(), filter, page, limit)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to get audit log", err.Error())
+		return
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, "Audit log retrieved successfully", dto.ToAuditLogResponseList(logs), response.NewMeta(page, limit, total))
+}
+
+// parseTimeQuery parses an RFC3339 timestamp or a YYYY-MM-DD date. A bare
+// date used as an upper bound covers the whole day.
+func parseTimeQuery(value string, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Nanosecond)
+	}
+	return t, nil
+}
@@ -0,0 +1,249 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// AvailabilityHandler handles player availability requests
+type AvailabilityHandler struct {
+	availabilityUseCase usecase.AvailabilityUseCase
+}
+
+// NewAvailabilityHandler creates a new instance of AvailabilityHandler
+func NewAvailabilityHandler(availabilityUseCase usecase.AvailabilityUseCase) *AvailabilityHandler {
+	return &AvailabilityHandler{availabilityUseCase: availabilityUseCase}
+}
+
+// GetByPlayer handles getting the availability records of a player
+// @Summary Get Player Availability
+// @Description Get the injuries and other absences of a player, latest first
+// @Tags Players
+// @Accept json
+// @Produce json
+// @Param id path string true "Player ID"
+// @Success 200 {object} response.Response{data=[]dto.PlayerAvailabilityResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/players/{id}/availability [get]
+func (h *AvailabilityHandler) GetByPlayer(c *gin.Context) {
+	playerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid player ID", nil)
+		return
+	}
+
+	availabilities, err := h.availabilityUseCase.GetByPlayerID(c.Request.Context(), playerID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrPlayerNotFound) {
+			response.Error(c, http.StatusNotFound, "Player not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get availability", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Availability retrieved successfully", dto.ToPlayerAvailabilityResponseList(availabilities))
+}
+
+// Create handles marking a player unavailable
+// @Summary Create Player Availability
+// @Description Mark a player injured or otherwise unavailable from starts_on. Without a return date the absence is open-ended.
+// @Tags Players
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Player ID"
+// @Param request body dto.PlayerAvailabilityRequest true "Availability details"
+// @Success 201 {object} response.Response{data=dto.PlayerAvailabilityResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/players/{id}/availability [post]
+func (h *AvailabilityHandler) Create(c *gin.Context) {
+	playerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid player ID", nil)
+		return
+	}
+
+	var req dto.PlayerAvailabilityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	availability, err := req.ToAvailabilityEntity()
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+	availability.PlayerID = playerID
+
+	if err := h.availabilityUseCase.Create(c.Request.Context(), availability); err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrPlayerNotFound):
+			response.Error(c, http.StatusNotFound, "Player not found", nil)
+		case errors.Is(err, usecase.ErrInvalidAvailabilityStatus), errors.Is(err, usecase.ErrInvalidAvailabilityPeriod):
+			response.Error(c, http.StatusBadRequest, err.Error(), nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to create availability", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Availability created successfully", dto.ToPlayerAvailabilityResponse(availability))
+}
+
+// Update handles replacing an availability record of a player
+// @Summary Update Player Availability
+// @Description Replace an availability record of a player, for instance to set the date they returned
+// @Tags Players
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Player ID"
+// @Param availability_id path string true "Availability ID"
+// @Param request body dto.PlayerAvailabilityRequest true "Availability details"
+// @Success 200 {object} response.Response{data=dto.PlayerAvailabilityResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/players/{id}/availability/{availability_id} [put]
+func (h *AvailabilityHandler) Update(c *gin.Context) {
+	playerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid player ID", nil)
+		return
+	}
+	id, err := uuid.Parse(c.Param("availability_id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid availability ID", nil)
+		return
+	}
+
+	var req dto.PlayerAvailabilityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	availability, err := req.ToAvailabilityEntity()
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+	availability.ID = id
+	availability.PlayerID = playerID
+
+	if err := h.availabilityUseCase.Update(c.Request.Context(), availability); err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrAvailabilityNotFound):
+			response.Error(c, http.StatusNotFound, "Availability not found", nil)
+		case errors.Is(err, usecase.ErrInvalidAvailabilityStatus), errors.Is(err, usecase.ErrInvalidAvailabilityPeriod):
+			response.Error(c, http.StatusBadRequest, err.Error(), nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to update availability", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Availability updated successfully", dto.ToPlayerAvailabilityResponse(availability))
+}
+
+// Delete handles removing an availability record of a player
+// @Summary Delete Player Availability
+// @Description Delete an availability record of a player (soft delete)
+// @Tags Players
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Player ID"
+// @Param availability_id path string true "Availability ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/players/{id}/availability/{availability_id} [delete]
+func (h *AvailabilityHandler) Delete(c *gin.Context) {
+	playerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid player ID", nil)
+		return
+	}
+	id, err := uuid.Parse(c.Param("availability_id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid availability ID", nil)
+		return
+	}
+
+	if err := h.availabilityUseCase.Delete(c.Request.Context(), playerID, id); err != nil {
+		if errors.Is(err, usecase.ErrAvailabilityNotFound) {
+			response.Error(c, http.StatusNotFound, "Availability not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to delete availability", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Availability deleted successfully", nil)
+}
+
+// GetSquad handles the squad availability of a team
+// @Summary Get Squad Availability
+// @Description Get the players of a team at a moment, ordered by jersey number, with the injuries and absences that keep them out
+// @Tags Teams
+// @Accept json
+// @Produce json
+// @Param id path string true "Team ID"
+// @Param at query string false "RFC 3339 timestamp or YYYY-MM-DD (UTC midnight); default: now"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} response.Response{data=[]dto.SquadAvailabilityResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/teams/{id}/availability [get]
+func (h *AvailabilityHandler) GetSquad(c *gin.Context) {
+	teamID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid team ID", nil)
+		return
+	}
+
+	at := time.Now()
+	if value := c.Query("at"); value != "" {
+		if at, err = parseTimeQuery(value, false); err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid at, use RFC 3339 or YYYY-MM-DD", nil)
+			return
+		}
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	squad, total, err := h.availabilityUseCase.GetSquadAvailability(c.Request.Context(), teamID, at, page, limit)
+	if err != nil {
+		if errors.Is(err, usecase.ErrTeamNotFound) {
+			response.Error(c, http.StatusNotFound, "Team not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get squad availability", err.Error())
+		return
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, "Squad availability retrieved successfully", dto.ToSquadAvailabilityResponseList(squad), response.NewMeta(page, limit, total))
+}
@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// DisciplinaryHandler handles disciplinary rule and suspension requests
+type DisciplinaryHandler struct {
+	disciplinaryUseCase usecase.DisciplinaryUseCase
+}
+
+// NewDisciplinaryHandler creates a new instance of DisciplinaryHandler
+func NewDisciplinaryHandler(disciplinaryUseCase usecase.DisciplinaryUseCase) *DisciplinaryHandler {
+	return &DisciplinaryHandler{disciplinaryUseCase: disciplinaryUseCase}
+}
+
+// GetRule handles getting the disciplinary rules of a season
+// @Summary Get Disciplinary Rules
+// @Description Get how cards turn into suspensions in a season
+// @Tags Seasons
+// @Accept json
+// @Produce json
+// @Param season path string true "Season, such as 2024 or 2024-2025"
+// @Success 200 {object} response.Response{data=dto.DisciplinaryRuleResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/seasons/{season}/disciplinary-rules [get]
+func (h *DisciplinaryHandler) GetRule(c *gin.Context) {
+	season, err := usecase.ParseSeason(c.Param("season"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	rule, err := h.disciplinaryUseCase.GetRule(c.Request.Context(), season)
+	if err != nil {
+		if errors.Is(err, usecase.ErrDisciplinaryRuleNotFound) {
+			response.Error(c, http.StatusNotFound, "Disciplinary rules not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get disciplinary rules", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Disciplinary rules retrieved successfully", dto.ToDisciplinaryRuleResponse(rule))
+}
+
+// SetRule handles creating or replacing the disciplinary rules of a season
+// @Summary Set Disciplinary Rules
+// @Description Create or replace the disciplinary rules of a season. Every yellow_card_limit yellow cards ban a player for one match and a red card bans them for red_card_ban matches. Seasons that overlap, such as 2024 and 2024-2025, cannot both have rules.
+// @Tags Seasons
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param season path string true "Season, such as 2024 or 2024-2025"
+// @Param request body dto.SetDisciplinaryRuleRequest true "Disciplinary rule details"
+// @Success 200 {object} response.Response{data=dto.DisciplinaryRuleResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/seasons/{season}/disciplinary-rules [put]
+func (h *DisciplinaryHandler) SetRule(c *gin.Context) {
+	season, err := usecase.ParseSeason(c.Param("season"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	var req dto.SetDisciplinaryRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	rule := req.ToDisciplinaryRuleEntity()
+	if err := h.disciplinaryUseCase.SetRule(c.Request.Context(), season, rule); err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidDisciplinaryRule):
+			response.Error(c, http.StatusBadRequest, err.Error(), nil)
+		case errors.Is(err, usecase.ErrDisciplinaryRuleOverlap):
+			response.Error(c, http.StatusConflict, err.Error(), nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to set disciplinary rules", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Disciplinary rules saved successfully", dto.ToDisciplinaryRuleResponse(rule))
+}
+
+// DeleteRule handles removing the disciplinary rules of a season
+// @Summary Delete Disciplinary Rules
+// @Description Delete the disciplinary rules of a season. The season no longer has suspensions.
+// @Tags Seasons
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param season path string true "Season, such as 2024 or 2024-2025"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/seasons/{season}/disciplinary-rules [delete]
+func (h *DisciplinaryHandler) DeleteRule(c *gin.Context) {
+	season, err := usecase.ParseSeason(c.Param("season"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	if err := h.disciplinaryUseCase.DeleteRule(c.Request.Context(), season); err != nil {
+		if errors.Is(err, usecase.ErrDisciplinaryRuleNotFound) {
+			response.Error(c, http.StatusNotFound, "Disciplinary rules not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to delete disciplinary rules", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Disciplinary rules deleted successfully", nil)
+}
+
+// GetSuspensions handles the suspension report of a season
+// @Summary Get Suspensions
+// @Description Get the suspensions of a season worked out from its cards and disciplinary rules, in the order the cards were shown. A season without rules has no suspensions.
+// @Tags Reports
+// @Accept json
+// @Produce json
+// @Param season query string true "Season, such as 2024 or 2024-2025"
+// @Param active query bool false "Only suspensions that have not been served yet"
+// @Success 200 {object} response.Response{data=[]dto.SuspensionResponse}
+// @Failure 400 {object} response.Response
+// @Router /api/v1/reports/suspensions [get]
+func (h *DisciplinaryHandler) GetSuspensions(c *gin.Context) {
+	season, err := usecase.ParseSeason(c.Query("season"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	suspensions, err := h.disciplinaryUseCase.GetSuspensions(c.Request.Context(), season, c.Query("active") == "true")
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to get suspensions", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Suspensions retrieved successfully", dto.ToSuspensionResponseList(suspensions))
+}
+
+// GetMatchSuspensions handles getting the players suspended for a match
+// @Summary Get Match Suspensions
+// @Description Get the suspensions that ban players from a match. Suspended players cannot score or be booked in it.
+// @Tags Matches
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Success 200 {object} response.Response{data=[]dto.SuspensionResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/matches/{id}/suspensions [get]
+func (h *DisciplinaryHandler) GetMatchSuspensions(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid match ID", nil)
+		return
+	}
+
+	suspensions, err := h.disciplinaryUseCase.GetMatchSuspensions(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrMatchNotFound) {
+			response.Error(c, http.StatusNotFound, "Match not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get match suspensions", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match suspensions retrieved successfully", dto.ToSuspensionResponseList(suspensions))
+}
@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/export"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// exportRecords maps the :entity path segment to the record type it exports
+var exportRecords = map[string]interface{}{
+	"teams":       dto.TeamExportRecord{},
+	"players":     dto.PlayerExportRecord{},
+	"matches":     dto.MatchExportRecord{},
+	"goals":       dto.GoalExportRecord{},
+	"standings":   dto.StandingExportRecord{},
+	"top-scorers": dto.TopScorerExportRecord{},
+}
+
+// ExportHandler handles bulk export related requests
+type ExportHandler struct {
+	exportUseCase usecase.ExportUseCase
+	reportUseCase usecase.ReportUseCase
+}
+
+// NewExportHandler creates a new instance of ExportHandler
+func NewExportHandler(exportUseCase usecase.ExportUseCase, reportUseCase usecase.ReportUseCase) *ExportHandler {
+	return &ExportHandler{
+		exportUseCase: exportUseCase,
+		reportUseCase: reportUseCase,
+	}
+}
+
+// Export handles exporting all records of one entity type or report
+// @Summary Export Data
+// @Description Download all active teams, players, matches or goals, the league standings or the top scorers as CSV or NDJSON. Records are streamed from the database in batches ordered by ID.
+// @Tags Export
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Security BearerAuth
+// @Param entity path string true "Entity type (teams, players, matches, goals, standings, top-scorers)"
+// @Param format query string false "File format (csv, ndjson)" default(csv)
+// @Success 200 {file} file
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /api/v1/export/{entity} [get]
+func (h *ExportHandler) Export(c *gin.Context) {
+	entityType := c.Param("entity")
+	prototype, ok := exportRecords[entityType]
+	if !ok {
+		response.Error(c, http.StatusBadRequest, "Invalid entity type, must be one of: teams, players, matches, goals, standings, top-scorers", nil)
+		return
+	}
+
+	format, err := export.ParseFormat(c.DefaultQuery("format", "csv"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid format, must be one of: csv, ndjson", nil)
+		return
+	}
+
+	// A whole table takes longer than the server's write timeout allows a
+	// response. Streaming still stops when the client goes away, through
+	// the request context.
+	if err := http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		log.Printf("Warning: Failed to clear the write deadline of the %s export: %v", entityType, err)
+	}
+
+	stream := &exportStream{c: c, entityType: entityType, format: format, prototype: prototype}
+	ctx := c.Request.Context()
+	switch entityType {
+	case "teams":
+		err = h.exportUseCase.ExportTeams(ctx, func(teams []entity.Team) error {
+			for i := range teams {
+				if err := stream.write(dto.ToTeamExportRecord(&teams[i])); err != nil {
+					return err
+				}
+			}
+			return stream.flush()
+		})
+	case "players":
+		err = h.exportUseCase.ExportPlayers(ctx, func(players []entity.Player) error {
+			for i := range players {
+				if err := stream.write(dto.ToPlayerExportRecord(&players[i])); err != nil {
+					return err
+				}
+			}
+			return stream.flush()
+		})
+	case "matches":
+		err = h.exportUseCase.ExportMatches(ctx, func(matches []entity.Match) error {
+			for i := range matches {
+				if err := stream.write(dto.ToMatchExportRecord(&matches[i])); err != nil {
+					return err
+				}
+			}
+			return stream.flush()
+		})
+	case "goals":
+		err = h.exportUseCase.ExportGoals(ctx, func(goals []entity.Goal) error {
+			for i := range goals {
+				if err := stream.write(dto.ToGoalExportRecord(&goals[i])); err != nil {
+					return err
+				}
+			}
+			return stream.flush()
+		})
+	case "standings":
+		// Standings are aggregated per team, so they are small enough to
+		// build before writing
+		var standings []usecase.LeaderboardEntry
+		if standings, err = h.reportUseCase.GetStandings(ctx); err == nil {
+			for i := range standings {
+				if err = stream.write(dto.ToStandingExportRecord(i+1, &standings[i])); err != nil {
+					break
+				}
+			}
+		}
+	case "top-scorers":
+		// A negative limit returns every scorer
+		var scorers []repository.TopScorerResult
+		if scorers, err = h.reportUseCase.GetTopScorers(ctx, -1); err == nil {
+			for i := range scorers {
+				if err = stream.write(dto.ToTopScorerExportRecord(i+1, &scorers[i])); err != nil {
+					break
+				}
+			}
+		}
+	}
+
+	stream.finish(err)
+}
+
+// exportStream writes export records to the response. Nothing is sent
+// before the first batch is flushed, so a failure until then can still be
+// reported as a JSON error; a failure later can only cut the download short.
+type exportStream struct {
+	c          *gin.Context
+	entityType string
+	format     export.Format
+	prototype  interface{}
+	writer     export.Writer
+}
+
+func (s *exportStream) start() error {
+	if s.writer != nil {
+		return nil
+	}
+
+	filename := fmt.Sprintf("%s-%s%s", s.entityType, time.Now().UTC().Format("20060102"), s.format.Extension())
+	header := s.c.Writer.Header()
+	header.Set("Content-Type", s.format.ContentType())
+	header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	header.Set("X-Content-Type-Options", "nosniff")
+	s.c.Status(http.StatusOK)
+
+	writer, err := export.NewWriter(s.format, s.c.Writer, s.prototype)
+	if err != nil {
+		return err
+	}
+	s.writer = writer
+	return nil
+}
+
+func (s *exportStream) write(record interface{}) error {
+	if err := s.start(); err != nil {
+		return err
+	}
+	return s.writer.Write(record)
+}
+
+// flush sends the buffered records to the client
+func (s *exportStream) flush() error {
+	if s.writer == nil {
+		return nil
+	}
+	if err := s.writer.Flush(); err != nil {
+		return err
+	}
+	s.c.Writer.Flush()
+	return nil
+}
+
+func (s *exportStream) finish(err error) {
+	if err == nil {
+		if err = s.start(); err == nil {
+			err = s.flush()
+		}
+	}
+	if err == nil {
+		return
+	}
+
+	if !s.c.Writer.Written() {
+		header := s.c.Writer.Header()
+		header.Del("Content-Type")
+		header.Del("Content-Disposition")
+		response.Error(s.c, http.StatusInternalServerError, "Failed to export "+s.entityType, err.Error())
+		return
+	}
+	log.Printf("Warning: Export of %s stopped early: %v", s.entityType, err)
+	s.c.Abort()
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/ical"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// FixtureHandler handles fixture calendar related requests
+type FixtureHandler struct {
+	fixtureUseCase usecase.FixtureUseCase
+}
+
+// NewFixtureHandler creates a new instance of FixtureHandler
+func NewFixtureHandler(fixtureUseCase usecase.FixtureUseCase) *FixtureHandler {
+	return &FixtureHandler{fixtureUseCase: fixtureUseCase}
+}
+
+// GetTeamFixtures handles getting the fixture calendar of a team
+// @Summary Team Fixture Calendar
+// @Description Get all matches of a team as an iCalendar feed for calendar apps. Subscribed calendars pick up reschedules, cancellations and results.
+// @Tags Fixtures
+// @Produce text/calendar
+// @Param id path string true "Team ID"
+// @Success 200 {file} file
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/teams/{id}/fixtures.ics [get]
+func (h *FixtureHandler) GetTeamFixtures(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid team ID format", nil)
+		return
+	}
+
+	team, matches, err := h.fixtureUseCase.GetTeamFixtures(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrTeamNotFound) {
+			response.Error(c, http.StatusNotFound, "Team not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get fixtures", err.Error())
+		return
+	}
+
+	writeCalendar(c, "fixtures-"+team.ID.String()+".ics", dto.ToFixtureCalendar(team.Name+" fixtures", matches))
+}
+
+// GetSeasonFixtures handles getting the fixture calendar of a season
+// @Summary Season Fixture Calendar
+// @Description Get all matches of a season as an iCalendar feed for calendar apps. A season is a year such as 2024 (January to December) or two consecutive years such as 2024-2025 (July to June).
+// @Tags Fixtures
+// @Produce text/calendar
+// @Param season path string true "Season, such as 2024 or 2024-2025"
+// @Success 200 {file} file
+// @Failure 400 {object} response.Response
+// @Router /api/v1/seasons/{season}/fixtures.ics [get]
+func (h *FixtureHandler) GetSeasonFixtures(c *gin.Context) {
+	season, err := usecase.ParseSeason(c.Param("season"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	matches, err := h.fixtureUseCase.GetSeasonFixtures(c.Request.Context(), season)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to get fixtures", err.Error())
+		return
+	}
+
+	writeCalendar(c, "fixtures-"+season.Name+".ics", dto.ToFixtureCalendar("AYO Football fixtures "+season.Name, matches))
+}
+
+// writeCalendar renders the calendar before sending it, so a failure can
+// still be reported as a JSON error
+func writeCalendar(c *gin.Context, filename string, cal *ical.Calendar) {
+	var buf bytes.Buffer
+	if err := ical.Write(&buf, cal); err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to write calendar", err.Error())
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("inline; filename=%q", filename))
+	c.Data(http.StatusOK, ical.ContentType, buf.Bytes())
+}
@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+	"github.com/zenkriztao/ayo-football-backend/pkg/spreadsheet"
+)
+
+const (
+	// maxImportFileSize caps the size of an uploaded import file
+	maxImportFileSize = 5 << 20
+	// maxImportRows caps the data rows of one import
+	maxImportRows = 1000
+)
+
+// ImportHandler handles bulk import related requests
+type ImportHandler struct {
+	importUseCase usecase.ImportUseCase
+}
+
+// NewImportHandler creates a new instance of ImportHandler
+func NewImportHandler(importUseCase usecase.ImportUseCase) *ImportHandler {
+	return &ImportHandler{importUseCase: importUseCase}
+}
+
+// Import handles importing teams or players from a spreadsheet
+// @Summary Import Teams or Players
+// @Description Import teams or players from a CSV or XLSX file. The first row names the columns. Rows are validated like single creates and rejected rows are reported by row number. By default valid rows are stored and invalid rows skipped; all_or_nothing=true stores nothing unless every row is valid, and dry_run=true only previews the result.
+// @Tags Import
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param entity path string true "Entity type (teams, players)"
+// @Param file formData file true "CSV or XLSX file"
+// @Param team_id query string false "Team for player rows without a team_id column"
+// @Param dry_run query bool false "Validate without storing anything"
+// @Param all_or_nothing query bool false "Store the rows only if every row is valid"
+// @Success 200 {object} response.Response{data=dto.ImportResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 422 {object} response.Response{error=dto.ImportResponse}
+// @Router /api/v1/import/{entity} [post]
+func (h *ImportHandler) Import(c *gin.Context) {
+	entityType := c.Param("entity")
+	if entityType != "teams" && entityType != "players" {
+		response.Error(c, http.StatusBadRequest, "Invalid entity type, must be one of: teams, players", nil)
+		return
+	}
+
+	teamID := c.Query("team_id")
+	if teamID != "" {
+		if _, err := uuid.Parse(teamID); err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid team ID format", nil)
+			return
+		}
+	}
+
+	opts := usecase.ImportOptions{
+		DryRun:       c.Query("dry_run") == "true",
+		AllOrNothing: c.Query("all_or_nothing") == "true",
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "File is required in the multipart field \"file\"", nil)
+		return
+	}
+	if fileHeader.Size > maxImportFileSize {
+		response.Error(c, http.StatusRequestEntityTooLarge, fmt.Sprintf("File must be at most %d MB", maxImportFileSize>>20), nil)
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Failed to read file", err.Error())
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.LimitReader(file, maxImportFileSize))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Failed to read file", err.Error())
+		return
+	}
+
+	rows, err := spreadsheet.Read(fileHeader.Filename, data)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid file", err.Error())
+		return
+	}
+	// The first row is the header
+	if len(rows) > maxImportRows+1 {
+		response.Error(c, http.StatusBadRequest, fmt.Sprintf("File must have at most %d rows", maxImportRows), nil)
+		return
+	}
+
+	ctx := c.Request.Context()
+	var result *usecase.ImportResult
+	switch entityType {
+	case "teams":
+		var teamRows []usecase.TeamImportRow
+		if teamRows, err = dto.ParseTeamImportRows(rows); err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid file", err.Error())
+			return
+		}
+		result, err = h.importUseCase.ImportTeams(ctx, teamRows, opts)
+	case "players":
+		var playerRows []usecase.PlayerImportRow
+		if playerRows, err = dto.ParsePlayerImportRows(rows, teamID); err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid file", err.Error())
+			return
+		}
+		result, err = h.importUseCase.ImportPlayers(ctx, playerRows, opts)
+	}
+
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrImportRejected):
+			response.Error(c, http.StatusUnprocessableEntity, "Import rejected because some rows are invalid, no rows were stored", dto.ToImportResponse(result, opts))
+		case errors.Is(err, usecase.ErrJerseyNumberTaken):
+			response.Error(c, http.StatusConflict, "A jersey number was taken while importing, no rows were stored", nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to import "+entityType, err.Error())
+		}
+		return
+	}
+
+	message := "Import completed"
+	if opts.DryRun {
+		message = "Import validated, no rows were stored"
+	}
+	response.Success(c, http.StatusOK, message, dto.ToImportResponse(result, opts))
+}
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zenkriztao/ayo-football-backend/pkg/fieldset"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// parseListQuery reads the search, filters and sort order of a list request
+// against the schema of the list. On invalid input it writes the error
+// response and returns false.
+func parseListQuery(c *gin.Context, schema listquery.Schema) (listquery.Query, bool) {
+	query, err := listquery.Parse(c.Request.URL.Query(), schema)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid filter or sort", err.Error())
+		return listquery.Query{}, false
+	}
+	return query, true
+}
+
+// parseWindow reads the cursor pagination of a list request sorted like
+// query: pagination=cursor for the first page, or a cursor from the meta of
+// an earlier one, with total=true to also count the list. scrolling is false
+// for numbered pages. On invalid input it writes the error response and
+// returns false.
+func parseWindow(c *gin.Context, schema listquery.Schema, query listquery.Query, limit int) (window listquery.Window, scrolling, ok bool) {
+	mode := c.DefaultQuery("pagination", "page")
+	token := c.Query("cursor")
+	if mode != "page" && mode != "cursor" {
+		response.Error(c, http.StatusBadRequest, "Invalid pagination, use page or cursor", nil)
+		return listquery.Window{}, false, false
+	}
+	if mode == "page" && token == "" {
+		return listquery.Window{}, false, true
+	}
+	if c.Query("page") != "" {
+		response.Error(c, http.StatusBadRequest, "page cannot be combined with cursor pagination", nil)
+		return listquery.Window{}, false, false
+	}
+
+	window = listquery.Window{Limit: limit, Count: c.Query("total") == "true"}
+	if token != "" {
+		cursor, err := listquery.DecodeCursor(token, schema, query.SortOrDefault(schema))
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid cursor", err.Error())
+			return listquery.Window{}, false, false
+		}
+		window.Cursor = cursor
+	}
+	return window, true, true
+}
+
+// cursorMeta returns the pagination metadata of a cursor page
+func cursorMeta[T any](slice listquery.Slice[T], limit int) *response.Meta {
+	var next, prev string
+	if slice.Next != nil {
+		next = slice.Next.Encode()
+	}
+	if slice.Prev != nil {
+		prev = slice.Prev.Encode()
+	}
+	return response.NewCursorMeta(limit, next, prev, slice.Total)
+}
+
+// parseInclude reads the related resources of schema a request includes, or
+// defaults when it has no include parameter: what the endpoint returned
+// before include existed. On invalid input it writes the error response and
+// returns false.
+func parseInclude(c *gin.Context, schema listquery.Schema, defaults ...string) (listquery.Include, bool) {
+	value, given := c.GetQuery("include")
+	if !given {
+		return listquery.NewInclude(defaults...), true
+	}
+	include, err := listquery.ParseInclude(value, schema)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid include", err.Error())
+		return nil, false
+	}
+	return include, true
+}
+
+// parseFields reads the fields a request selects of a response shaped like
+// sample. Included relations stay in the response unless some of their own
+// fields are selected, as in home_team.name. On invalid input it writes the
+// error response and returns false.
+func parseFields(c *gin.Context, sample interface{}, include listquery.Include) (*fieldset.Set, bool) {
+	var relations []string
+	for _, path := range include {
+		if !strings.Contains(path, ".") {
+			relations = append(relations, path)
+		}
+	}
+	fields, err := fieldset.Parse(c.Query("fields"), sample, relations...)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid fields", err.Error())
+		return nil, false
+	}
+	return fields, true
+}
@@ -10,7 +10,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
 	"github.com/zenkriztao/ayo-football-backend/pkg/response"
 )
 
@@ -35,6 +37,8 @@ func NewMatchHandler(matchUseCase usecase.MatchUseCase) *MatchHandler {
 // @Success 201 {object} response.Response{data=dto.MatchResponse}
 // @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response{error=dto.ScheduleConflictResponse}
 // @Router /api/v1/matches [post]
 func (h *MatchHandler) Create(c *gin.Context) {
 	var req dto.CreateMatchRequest
@@ -54,6 +58,23 @@ func (h *MatchHandler) Create(c *gin.Context) {
 			response.Error(c, http.StatusBadRequest, "Home team and away team cannot be the same", nil)
 			return
 		}
+		if errors.Is(err, usecase.ErrInvalidTimezone) || errors.Is(err, usecase.ErrKickoffRequired) {
+			response.Error(c, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+		if errors.Is(err, usecase.ErrTeamNotFound) {
+			response.Error(c, http.StatusNotFound, "Team not found", nil)
+			return
+		}
+		if errors.Is(err, usecase.ErrVenueNotFound) {
+			response.Error(c, http.StatusNotFound, "Venue not found", nil)
+			return
+		}
+		var conflictErr *usecase.ScheduleConflictError
+		if errors.As(err, &conflictErr) {
+			response.Error(c, http.StatusConflict, conflictMessage(conflictErr), dto.ToScheduleConflictResponse(conflictErr))
+			return
+		}
 		response.Error(c, http.StatusInternalServerError, "Failed to create match", err.Error())
 		return
 	}
@@ -63,11 +84,13 @@ func (h *MatchHandler) Create(c *gin.Context) {
 
 // GetByID handles getting a match by ID
 // @Summary Get Match
-// @Description Get a match by ID
+// @Description Get a match by ID with its teams, venue, goals and cards, or the related resources given by include
 // @Tags Matches
 // @Accept json
 // @Produce json
 // @Param id path string true "Match ID"
+// @Param include query string false "Comma-separated related resources to embed: home_team, away_team, venue, goals, goals.player, goals.team, cards, cards.player, cards.team" default(all)
+// @Param fields query string false "Comma-separated fields to return, e.g. id,status,home_score,away_score,goals.minute"
 // @Success 200 {object} response.Response{data=dto.MatchResponse}
 // @Failure 400 {object} response.Response
 // @Failure 404 {object} response.Response
@@ -79,7 +102,16 @@ func (h *MatchHandler) GetByID(c *gin.Context) {
 		return
 	}
 
-	match, err := h.matchUseCase.GetByIDWithDetails(c.Request.Context(), id)
+	include, ok := parseInclude(c, repository.MatchListSchema, repository.MatchListSchema.Relations...)
+	if !ok {
+		return
+	}
+	fields, ok := parseFields(c, dto.MatchResponse{}, include)
+	if !ok {
+		return
+	}
+
+	match, err := h.matchUseCase.GetByIDWith(c.Request.Context(), id, include)
 	if err != nil {
 		if errors.Is(err, usecase.ErrMatchNotFound) {
 			response.Error(c, http.StatusNotFound, "Match not found", nil)
@@ -89,7 +121,7 @@ func (h *MatchHandler) GetByID(c *gin.Context) {
 		return
 	}
 
-	response.Success(c, http.StatusOK, "Match retrieved successfully", dto.ToMatchResponse(match))
+	response.Success(c, http.StatusOK, "Match retrieved successfully", fields.Apply(dto.ToMatchResponse(match)))
 }
 
 // Update handles updating a match
@@ -105,6 +137,7 @@ func (h *MatchHandler) GetByID(c *gin.Context) {
 // @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
 // @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response{error=dto.ScheduleConflictResponse}
 // @Router /api/v1/matches/{id} [put]
 func (h *MatchHandler) Update(c *gin.Context) {
 	id, err := uuid.Parse(c.Param("id"))
@@ -139,6 +172,23 @@ func (h *MatchHandler) Update(c *gin.Context) {
 			response.Error(c, http.StatusBadRequest, "Home team and away team cannot be the same", nil)
 			return
 		}
+		if errors.Is(err, usecase.ErrInvalidTimezone) || errors.Is(err, usecase.ErrKickoffRequired) {
+			response.Error(c, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+		if errors.Is(err, usecase.ErrTeamNotFound) {
+			response.Error(c, http.StatusNotFound, "Team not found", nil)
+			return
+		}
+		if errors.Is(err, usecase.ErrVenueNotFound) {
+			response.Error(c, http.StatusNotFound, "Venue not found", nil)
+			return
+		}
+		var conflictErr *usecase.ScheduleConflictError
+		if errors.As(err, &conflictErr) {
+			response.Error(c, http.StatusConflict, conflictMessage(conflictErr), dto.ToScheduleConflictResponse(conflictErr))
+			return
+		}
 		response.Error(c, http.StatusInternalServerError, "Failed to update match", err.Error())
 		return
 	}
@@ -146,6 +196,17 @@ func (h *MatchHandler) Update(c *gin.Context) {
 	response.Success(c, http.StatusOK, "Match updated successfully", dto.ToMatchResponse(match))
 }
 
+// conflictMessage describes a schedule conflict for the response message
+func conflictMessage(err *usecase.ScheduleConflictError) string {
+	switch err.Conflict {
+	case usecase.ConflictVenueBooked:
+		return "Venue is already booked for another match around that time"
+	case usecase.ConflictOfficialBusy:
+		return "An official is already appointed to another match around that time"
+	}
+	return "A team already has a match on that day"
+}
+
 // Delete handles deleting a match
 // @Summary Delete Match
 // @Description Delete a match (soft delete)
@@ -180,25 +241,37 @@ func (h *MatchHandler) Delete(c *gin.Context) {
 
 // GetAll handles getting all matches with pagination
 // @Summary Get All Matches
-// @Description Get all matches with pagination
+// @Description Get all matches with pagination. Filters combine and take an operator as in kickoff_at[gte]=2024-07-01, like the team list.
 // @Tags Matches
 // @Accept json
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(10)
-// @Param team_id query string false "Filter by team ID"
+// @Param team_id query string false "Filter by home or away team ID"
+// @Param home_team_id query string false "Filter by home team ID"
+// @Param away_team_id query string false "Filter by away team ID"
+// @Param venue_id query string false "Filter by venue ID"
 // @Param status query string false "Filter by status (scheduled, ongoing, completed, cancelled)"
+// @Param kickoff_at query string false "Filter by kickoff (YYYY-MM-DD as midnight UTC, or RFC 3339), usually with an operator"
 // @Param start_date query string false "Start date filter (YYYY-MM-DD)"
-// @Param end_date query string false "End date filter (YYYY-MM-DD)"
+// @Param end_date query string false "End date filter (YYYY-MM-DD), inclusive"
+// @Param timezone query string false "IANA time zone of the date filter" default(Asia/Jakarta)
+// @Param sort query string false "Comma-separated sort fields, - for descending: kickoff_at, match_date, status, created_at, home_team.name, away_team.name" default(-kickoff_at)
+// @Param include query string false "Comma-separated related resources to embed: home_team, away_team, venue, goals, goals.player, goals.team, cards, cards.player, cards.team" default(home_team,away_team,venue)
+// @Param fields query string false "Comma-separated fields to return, e.g. id,kickoff_at,home_team.name,away_team.name"
+// @Param pagination query string false "page, or cursor for cursor pagination" default(page)
+// @Param cursor query string false "With cursor pagination, meta.next_cursor or meta.prev_cursor of an earlier page"
+// @Param total query bool false "With cursor pagination, also count the items" default(false)
 // @Success 200 {object} response.Response{data=[]dto.MatchResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
 // @Router /api/v1/matches [get]
 func (h *MatchHandler) GetAll(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
-	teamIDStr := c.Query("team_id")
-	status := c.Query("status")
 	startDateStr := c.Query("start_date")
 	endDateStr := c.Query("end_date")
+	timezone := c.DefaultQuery("timezone", entity.DefaultTimezone)
 
 	if page < 1 {
 		page = 1
@@ -207,72 +280,91 @@ func (h *MatchHandler) GetAll(c *gin.Context) {
 		limit = 10
 	}
 
-	var matches interface{}
-	var total int64
-	var err error
+	query, ok := parseListQuery(c, repository.MatchListSchema)
+	if !ok {
+		return
+	}
+	query.Include, ok = parseInclude(c, repository.MatchListSchema, "home_team", "away_team", "venue")
+	if !ok {
+		return
+	}
+	fields, ok := parseFields(c, dto.MatchResponse{}, query.Include)
+	if !ok {
+		return
+	}
 
-	if teamIDStr != "" {
-		teamID, parseErr := uuid.Parse(teamIDStr)
-		if parseErr != nil {
-			response.Error(c, http.StatusBadRequest, "Invalid team ID", nil)
+	// The date filters are calendar dates in a time zone, so they become
+	// kickoff bounds in UTC
+	if startDateStr != "" || endDateStr != "" {
+		loc, err := entity.LoadLocation(timezone)
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid timezone", nil)
 			return
 		}
-		m, totalCount, getErr := h.matchUseCase.GetByTeamID(c.Request.Context(), teamID, page, limit)
-		matches = dto.ToMatchResponseList(m)
-		total = totalCount
-		err = getErr
-		if errors.Is(err, usecase.ErrTeamNotFound) {
-			response.Error(c, http.StatusNotFound, "Team not found", nil)
-			return
+		if startDateStr != "" {
+			startDate, err := time.ParseInLocation("2006-01-02", startDateStr, loc)
+			if err != nil {
+				response.Error(c, http.StatusBadRequest, "Invalid start date format", nil)
+				return
+			}
+			query = query.Where("kickoff_at", listquery.Gte, startDate.UTC())
 		}
-	} else if status != "" {
-		m, totalCount, getErr := h.matchUseCase.GetByStatus(c.Request.Context(), entity.MatchStatus(status), page, limit)
-		matches = dto.ToMatchResponseList(m)
-		total = totalCount
-		err = getErr
-	} else if startDateStr != "" && endDateStr != "" {
-		startDate, parseErr := time.Parse("2006-01-02", startDateStr)
-		if parseErr != nil {
-			response.Error(c, http.StatusBadRequest, "Invalid start date format", nil)
-			return
+		if endDateStr != "" {
+			endDate, err := time.ParseInLocation("2006-01-02", endDateStr, loc)
+			if err != nil {
+				response.Error(c, http.StatusBadRequest, "Invalid end date format", nil)
+				return
+			}
+			// The end date is inclusive, so the range runs to the next midnight
+			query = query.Where("kickoff_at", listquery.Lt, endDate.AddDate(0, 0, 1).UTC())
 		}
-		endDate, parseErr := time.Parse("2006-01-02", endDateStr)
-		if parseErr != nil {
-			response.Error(c, http.StatusBadRequest, "Invalid end date format", nil)
+	}
+
+	window, scrolling, ok := parseWindow(c, repository.MatchListSchema, query, limit)
+	if !ok {
+		return
+	}
+	if scrolling {
+		slice, err := h.matchUseCase.Scroll(c.Request.Context(), query, window)
+		if err != nil {
+			if errors.Is(err, usecase.ErrTeamNotFound) {
+				response.Error(c, http.StatusNotFound, "Team not found", nil)
+				return
+			}
+			response.Error(c, http.StatusInternalServerError, "Failed to get matches", err.Error())
 			return
 		}
-		m, totalCount, getErr := h.matchUseCase.GetByDateRange(c.Request.Context(), startDate, endDate, page, limit)
-		matches = dto.ToMatchResponseList(m)
-		total = totalCount
-		err = getErr
-	} else {
-		m, totalCount, getAllErr := h.matchUseCase.GetAll(c.Request.Context(), page, limit)
-		matches = dto.ToMatchResponseList(m)
-		total = totalCount
-		err = getAllErr
+		response.SuccessWithMeta(c, http.StatusOK, "Matches retrieved successfully", fields.Apply(dto.ToMatchResponseList(slice.Items)), cursorMeta(slice, limit))
+		return
 	}
 
+	matches, total, err := h.matchUseCase.GetAll(c.Request.Context(), query, page, limit)
 	if err != nil {
+		if errors.Is(err, usecase.ErrTeamNotFound) {
+			response.Error(c, http.StatusNotFound, "Team not found", nil)
+			return
+		}
 		response.Error(c, http.StatusInternalServerError, "Failed to get matches", err.Error())
 		return
 	}
 
-	response.SuccessWithMeta(c, http.StatusOK, "Matches retrieved successfully", matches, response.NewMeta(page, limit, total))
+	response.SuccessWithMeta(c, http.StatusOK, "Matches retrieved successfully", fields.Apply(dto.ToMatchResponseList(matches)), response.NewMeta(page, limit, total))
 }
 
 // RecordResult handles recording a match result
 // @Summary Record Match Result
-// @Description Record the result of a completed match
+// @Description Record the result of a completed match with its goals and cards. Players suspended for the match cannot score or be booked. Scorers and booked players recorded as injured or unavailable at kickoff are returned as warnings.
 // @Tags Matches
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Match ID"
 // @Param request body dto.RecordMatchResultRequest true "Match result"
-// @Success 200 {object} response.Response{data=dto.MatchResponse}
+// @Success 200 {object} response.Response{data=dto.MatchResultResponse}
 // @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
 // @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
 // @Router /api/v1/matches/{id}/result [post]
 func (h *MatchHandler) RecordResult(c *gin.Context) {
 	id, err := uuid.Parse(c.Param("id"))
@@ -308,13 +400,35 @@ func (h *MatchHandler) RecordResult(c *gin.Context) {
 		}
 	}
 
+	// Convert cards
+	cards := make([]usecase.CardInput, len(req.Cards))
+	for i, card := range req.Cards {
+		playerID, parseErr := uuid.Parse(card.PlayerID)
+		if parseErr != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid player ID in cards", nil)
+			return
+		}
+		teamID, parseErr := uuid.Parse(card.TeamID)
+		if parseErr != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid team ID in cards", nil)
+			return
+		}
+		cards[i] = usecase.CardInput{
+			PlayerID: playerID,
+			TeamID:   teamID,
+			Minute:   card.Minute,
+			Type:     entity.CardType(card.Type),
+		}
+	}
+
 	input := usecase.MatchResultInput{
 		HomeScore: req.HomeScore,
 		AwayScore: req.AwayScore,
 		Goals:     goals,
+		Cards:     cards,
 	}
 
-	match, err := h.matchUseCase.RecordResult(c.Request.Context(), id, input)
+	match, warnings, err := h.matchUseCase.RecordResult(c.Request.Context(), id, input)
 	if err != nil {
 		if errors.Is(err, usecase.ErrMatchNotFound) {
 			response.Error(c, http.StatusNotFound, "Match not found", nil)
@@ -324,9 +438,25 @@ func (h *MatchHandler) RecordResult(c *gin.Context) {
 			response.Error(c, http.StatusNotFound, "One or more players not found", nil)
 			return
 		}
+		if errors.Is(err, usecase.ErrTeamNotFound) {
+			response.Error(c, http.StatusNotFound, "One or more teams not found", nil)
+			return
+		}
+		if errors.Is(err, usecase.ErrNegativeScore) {
+			response.Error(c, http.StatusBadRequest, "Score cannot be negative", nil)
+			return
+		}
+		if errors.Is(err, usecase.ErrInvalidCardType) || errors.Is(err, usecase.ErrPlayerNotInTeam) {
+			response.Error(c, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+		if errors.Is(err, usecase.ErrPlayerSuspended) {
+			response.Error(c, http.StatusConflict, err.Error(), nil)
+			return
+		}
 		response.Error(c, http.StatusInternalServerError, "Failed to record match result", err.Error())
 		return
 	}
 
-	response.Success(c, http.StatusOK, "Match result recorded successfully", dto.ToMatchResponse(match))
+	response.Success(c, http.StatusOK, "Match result recorded successfully", dto.ToMatchResultResponse(match, warnings))
 }
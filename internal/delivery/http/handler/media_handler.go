@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+const (
+	// maxImageFileSize caps the size of an uploaded image
+	maxImageFileSize = 5 << 20
+	// mediaCacheControl lets clients and CDNs cache images for good; a new
+	// upload gets a new URL
+	mediaCacheControl = "public, max-age=31536000, immutable"
+)
+
+// MediaHandler handles image upload related requests
+type MediaHandler struct {
+	mediaUseCase usecase.MediaUseCase
+}
+
+// NewMediaHandler creates a new instance of MediaHandler
+func NewMediaHandler(mediaUseCase usecase.MediaUseCase) *MediaHandler {
+	return &MediaHandler{mediaUseCase: mediaUseCase}
+}
+
+// UploadTeamLogo handles uploading a team logo
+// @Summary Upload Team Logo
+// @Description Upload a JPEG, PNG or GIF of at most 5 MB and 16 megapixels as the logo of a team. The type is judged from the content, not the file name. The image is scaled down to fit 1024 pixels, a thumbnail of 256 pixels is made, and the team's logo is set to the new URL.
+// @Tags Media
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Team ID"
+// @Param file formData file true "Image file"
+// @Success 200 {object} response.Response{data=dto.TeamLogoResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Router /api/v1/teams/{id}/logo [post]
+func (h *MediaHandler) UploadTeamLogo(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid team ID", nil)
+		return
+	}
+	data, ok := readImageFile(c)
+	if !ok {
+		return
+	}
+
+	team, upload, err := h.mediaUseCase.UploadTeamLogo(c.Request.Context(), id, data)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrTeamNotFound):
+			response.Error(c, http.StatusNotFound, "Team not found", nil)
+		default:
+			writeImageError(c, err, "Failed to upload team logo")
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Team logo uploaded successfully", dto.ToTeamLogoResponse(team, upload))
+}
+
+// UploadPlayerPhoto handles uploading a player photo
+// @Summary Upload Player Photo
+// @Description Upload a JPEG, PNG or GIF of at most 5 MB and 16 megapixels as the photo of a player. The type is judged from the content, not the file name. The image is scaled down to fit 1024 pixels, a thumbnail of 256 pixels is made, and the player's photo is set to the new URL.
+// @Tags Media
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Player ID"
+// @Param file formData file true "Image file"
+// @Success 200 {object} response.Response{data=dto.PlayerPhotoResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Router /api/v1/players/{id}/photo [post]
+func (h *MediaHandler) UploadPlayerPhoto(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid player ID", nil)
+		return
+	}
+	data, ok := readImageFile(c)
+	if !ok {
+		return
+	}
+
+	player, upload, err := h.mediaUseCase.UploadPlayerPhoto(c.Request.Context(), id, data)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrPlayerNotFound):
+			response.Error(c, http.StatusNotFound, "Player not found", nil)
+		default:
+			writeImageError(c, err, "Failed to upload player photo")
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Player photo uploaded successfully", dto.ToPlayerPhotoResponse(player, upload))
+}
+
+// Serve handles downloading an uploaded image
+// @Summary Get Uploaded Image
+// @Description Download an uploaded team logo or player photo, or its thumbnail. Image URLs never change content, so responses may be cached for a year; If-None-Match is answered with 304.
+// @Tags Media
+// @Produce image/jpeg,image/png
+// @Param key path string true "Image key, as in the uploaded URL"
+// @Success 200 {file} file
+// @Success 304
+// @Failure 404 {object} response.Response
+// @Router /api/v1/media/{key} [get]
+func (h *MediaHandler) Serve(c *gin.Context) {
+	key := strings.TrimPrefix(c.Param("key"), "/")
+
+	body, info, err := h.mediaUseCase.Open(c.Request.Context(), key)
+	if err != nil {
+		if errors.Is(err, usecase.ErrMediaNotFound) {
+			response.Error(c, http.StatusNotFound, "Media not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get media", err.Error())
+		return
+	}
+	defer body.Close()
+
+	headers := map[string]string{
+		"Cache-Control":          mediaCacheControl,
+		"X-Content-Type-Options": "nosniff",
+	}
+	if info.ETag != "" {
+		headers["ETag"] = info.ETag
+	}
+	if !info.LastModified.IsZero() {
+		headers["Last-Modified"] = info.LastModified.UTC().Format(http.TimeFormat)
+	}
+
+	if info.ETag != "" && c.GetHeader("If-None-Match") == info.ETag {
+		for name, value := range headers {
+			c.Header(name, value)
+		}
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.DataFromReader(http.StatusOK, info.Size, info.ContentType, body, headers)
+}
+
+// readImageFile reads the multipart field "file" of an image upload. On
+// invalid input it writes the error response and returns false.
+func readImageFile(c *gin.Context) ([]byte, bool) {
+	// Bound the whole body, so an oversized upload is not spooled to disk
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImageFileSize+1<<20)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			response.Error(c, http.StatusRequestEntityTooLarge, fmt.Sprintf("Image must be at most %d MB", maxImageFileSize>>20), nil)
+			return nil, false
+		}
+		response.Error(c, http.StatusBadRequest, "File is required in the multipart field \"file\"", nil)
+		return nil, false
+	}
+	if fileHeader.Size > maxImageFileSize {
+		response.Error(c, http.StatusRequestEntityTooLarge, fmt.Sprintf("Image must be at most %d MB", maxImageFileSize>>20), nil)
+		return nil, false
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Failed to read file", err.Error())
+		return nil, false
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.LimitReader(file, maxImageFileSize))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Failed to read file", err.Error())
+		return nil, false
+	}
+	return data, true
+}
+
+// writeImageError writes the response for an error of storing an image
+func writeImageError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, usecase.ErrUnsupportedImage):
+		response.Error(c, http.StatusUnsupportedMediaType, "Image must be a JPEG, PNG or GIF", nil)
+	case errors.Is(err, usecase.ErrImageTooLarge):
+		response.Error(c, http.StatusRequestEntityTooLarge, "Image must be at most 16 megapixels", nil)
+	default:
+		response.Error(c, http.StatusInternalServerError, message, err.Error())
+	}
+}
@@ -0,0 +1,389 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// OfficialHandler handles official related requests
+type OfficialHandler struct {
+	officialUseCase usecase.OfficialUseCase
+}
+
+// NewOfficialHandler creates a new instance of OfficialHandler
+func NewOfficialHandler(officialUseCase usecase.OfficialUseCase) *OfficialHandler {
+	return &OfficialHandler{officialUseCase: officialUseCase}
+}
+
+// Create handles official creation
+// @Summary Create Official
+// @Description Create a new referee or match official
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateOfficialRequest true "Official details"
+// @Success 201 {object} response.Response{data=dto.OfficialResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /api/v1/officials [post]
+func (h *OfficialHandler) Create(c *gin.Context) {
+	var req dto.CreateOfficialRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	official := req.ToOfficialEntity()
+	if err := h.officialUseCase.Create(c.Request.Context(), official); err != nil {
+		if errors.Is(err, usecase.ErrInvalidLicenseLevel) {
+			response.Error(c, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to create official", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Official created successfully", dto.ToOfficialResponse(official))
+}
+
+// GetByID handles getting a official by ID
+// @Summary Get Official
+// @Description Get an official by ID
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Param id path string true "Official ID"
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name"
+// @Success 200 {object} response.Response{data=dto.OfficialResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/officials/{id} [get]
+func (h *OfficialHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid official ID", nil)
+		return
+	}
+
+	fields, ok := parseFields(c, dto.OfficialResponse{}, nil)
+	if !ok {
+		return
+	}
+
+	official, err := h.officialUseCase.GetByID(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrOfficialNotFound) {
+			response.Error(c, http.StatusNotFound, "Official not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get official", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Official retrieved successfully", fields.Apply(dto.ToOfficialResponse(official)))
+}
+
+// Update handles updating a official
+// @Summary Update Official
+// @Description Update an existing official
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Official ID"
+// @Param request body dto.UpdateOfficialRequest true "Official details"
+// @Success 200 {object} response.Response{data=dto.OfficialResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/officials/{id} [put]
+func (h *OfficialHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid official ID", nil)
+		return
+	}
+
+	var req dto.UpdateOfficialRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	official, err := h.officialUseCase.GetByID(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrOfficialNotFound) {
+			response.Error(c, http.StatusNotFound, "Official not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get official", err.Error())
+		return
+	}
+
+	req.UpdateOfficialEntity(official)
+
+	if err := h.officialUseCase.Update(c.Request.Context(), official); err != nil {
+		if errors.Is(err, usecase.ErrInvalidLicenseLevel) {
+			response.Error(c, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to update official", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Official updated successfully", dto.ToOfficialResponse(official))
+}
+
+// Delete handles deleting a official
+// @Summary Delete Official
+// @Description Delete an official (soft delete). Past appointments keep their reference to them.
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Official ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/officials/{id} [delete]
+func (h *OfficialHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid official ID", nil)
+		return
+	}
+
+	if err := h.officialUseCase.Delete(c.Request.Context(), id); err != nil {
+		if errors.Is(err, usecase.ErrOfficialNotFound) {
+			response.Error(c, http.StatusNotFound, "Official not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to delete official", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Official deleted successfully", nil)
+}
+
+// GetAll handles getting all officials with pagination
+// @Summary Get All Officials
+// @Description Get all officials with pagination, optionally searching by name or city, or only those free to officiate a match. Filters combine and take an operator like the team list.
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param search query string false "Search by name or city"
+// @Param name query string false "Filter by name"
+// @Param city query string false "Filter by city"
+// @Param license_level query string false "Filter by license level (fifa, national or regional)"
+// @Param sort query string false "Comma-separated sort fields, - for descending: name, city, license_level, created_at" default(name)
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name"
+// @Param pagination query string false "page, or cursor for cursor pagination" default(page)
+// @Param cursor query string false "With cursor pagination, meta.next_cursor or meta.prev_cursor of an earlier page"
+// @Param total query bool false "With cursor pagination, also count the items" default(false)
+// @Param available_for query string false "Match ID; only officials without an overlapping appointment. Cannot be combined with search, filters, sort or cursor pagination."
+// @Success 200 {object} response.Response{data=[]dto.OfficialResponse}
+// @Failure 400 {object} response.Response
+// @Router /api/v1/officials [get]
+func (h *OfficialHandler) GetAll(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	availableFor := c.Query("available_for")
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	query, ok := parseListQuery(c, repository.OfficialListSchema)
+	if !ok {
+		return
+	}
+	fields, ok := parseFields(c, dto.OfficialResponse{}, nil)
+	if !ok {
+		return
+	}
+	window, scrolling, ok := parseWindow(c, repository.OfficialListSchema, query, limit)
+	if !ok {
+		return
+	}
+
+	var officials []entity.Official
+	var total int64
+	var err error
+
+	if availableFor != "" {
+		if query.Filtered() || len(query.Sort) > 0 || scrolling {
+			response.Error(c, http.StatusBadRequest, "available_for cannot be combined with search, filters, sort or cursor pagination", nil)
+			return
+		}
+		matchID, parseErr := uuid.Parse(availableFor)
+		if parseErr != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid match ID", nil)
+			return
+		}
+		officials, total, err = h.officialUseCase.GetAvailableFor(c.Request.Context(), matchID, page, limit)
+		if errors.Is(err, usecase.ErrMatchNotFound) {
+			response.Error(c, http.StatusNotFound, "Match not found", nil)
+			return
+		}
+	} else if scrolling {
+		slice, scrollErr := h.officialUseCase.Scroll(c.Request.Context(), query, window)
+		if scrollErr != nil {
+			response.Error(c, http.StatusInternalServerError, "Failed to get officials", scrollErr.Error())
+			return
+		}
+		response.SuccessWithMeta(c, http.StatusOK, "Officials retrieved successfully", fields.Apply(dto.ToOfficialResponseList(slice.Items)), cursorMeta(slice, limit))
+		return
+	} else {
+		officials, total, err = h.officialUseCase.GetAll(c.Request.Context(), query, page, limit)
+	}
+
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to get officials", err.Error())
+		return
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, "Officials retrieved successfully", fields.Apply(dto.ToOfficialResponseList(officials)), response.NewMeta(page, limit, total))
+}
+
+// GetStats handles getting the statistics of an official
+// @Summary Get Official Stats
+// @Description Get the number of matches an official was appointed to, by role, and the cards shown in the matches they refereed
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Param id path string true "Official ID"
+// @Success 200 {object} response.Response{data=dto.OfficialStatsResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/officials/{id}/stats [get]
+func (h *OfficialHandler) GetStats(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid official ID", nil)
+		return
+	}
+
+	official, err := h.officialUseCase.GetByID(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrOfficialNotFound) {
+			response.Error(c, http.StatusNotFound, "Official not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get official", err.Error())
+		return
+	}
+
+	stats, err := h.officialUseCase.GetStats(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to get official stats", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Official stats retrieved successfully", dto.ToOfficialStatsResponse(official, stats))
+}
+
+// GetMatchOfficials handles getting the officials of a match
+// @Summary Get Match Officials
+// @Description Get the officials appointed to a match
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Success 200 {object} response.Response{data=[]dto.MatchOfficialResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/matches/{id}/officials [get]
+func (h *OfficialHandler) GetMatchOfficials(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid match ID", nil)
+		return
+	}
+
+	appointments, err := h.officialUseCase.GetMatchOfficials(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrMatchNotFound) {
+			response.Error(c, http.StatusNotFound, "Match not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get match officials", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match officials retrieved successfully", dto.ToMatchOfficialResponseList(appointments))
+}
+
+// AssignMatchOfficials handles appointing the officials of a match
+// @Summary Assign Match Officials
+// @Description Replace the officials appointed to a match. An official cannot be appointed to two matches that overlap.
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Match ID"
+// @Param request body dto.AssignOfficialsRequest true "Appointments"
+// @Success 200 {object} response.Response{data=[]dto.MatchOfficialResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response{error=dto.ScheduleConflictResponse}
+// @Router /api/v1/matches/{id}/officials [put]
+func (h *OfficialHandler) AssignMatchOfficials(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid match ID", nil)
+		return
+	}
+
+	var req dto.AssignOfficialsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	assignments := make([]usecase.OfficialAssignment, len(req.Officials))
+	for i, a := range req.Officials {
+		assignments[i] = usecase.OfficialAssignment{
+			OfficialID: uuid.MustParse(a.OfficialID), // validated by binding
+			Role:       entity.OfficialRole(a.Role),
+		}
+	}
+
+	appointments, err := h.officialUseCase.AssignToMatch(c.Request.Context(), id, assignments)
+	if err != nil {
+		var conflict *usecase.ScheduleConflictError
+		switch {
+		case errors.Is(err, usecase.ErrMatchNotFound):
+			response.Error(c, http.StatusNotFound, "Match not found", nil)
+		case errors.Is(err, usecase.ErrOfficialNotFound):
+			response.Error(c, http.StatusNotFound, "One or more officials not found", nil)
+		case errors.Is(err, usecase.ErrInvalidOfficialRole),
+			errors.Is(err, usecase.ErrDuplicateOfficialRole),
+			errors.Is(err, usecase.ErrOfficialAssignedTwice):
+			response.Error(c, http.StatusBadRequest, err.Error(), nil)
+		case errors.As(err, &conflict):
+			response.Error(c, http.StatusConflict, conflictMessage(conflict), dto.ToScheduleConflictResponse(conflict))
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to assign match officials", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match officials assigned successfully", dto.ToMatchOfficialResponseList(appointments))
+}
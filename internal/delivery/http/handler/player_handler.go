@@ -4,11 +4,15 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
 	"github.com/zenkriztao/ayo-football-backend/pkg/response"
 )
 
@@ -44,7 +48,7 @@ func (h *PlayerHandler) Create(c *gin.Context) {
 
 	player, err := req.ToPlayerEntity()
 	if err != nil {
-		response.Error(c, http.StatusBadRequest, "Invalid team ID format", nil)
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
 		return
 	}
 
@@ -58,6 +62,11 @@ func (h *PlayerHandler) Create(c *gin.Context) {
 			response.Error(c, http.StatusBadRequest, "Invalid player position", nil)
 		case errors.Is(err, usecase.ErrInvalidJerseyNumber):
 			response.Error(c, http.StatusBadRequest, "Jersey number must be between 1 and 99", nil)
+		case errors.Is(err, usecase.ErrInvalidSecondaryPositions),
+			errors.Is(err, usecase.ErrInvalidNationality),
+			errors.Is(err, usecase.ErrInvalidPreferredFoot),
+			errors.Is(err, usecase.ErrInvalidDateOfBirth):
+			response.Error(c, http.StatusBadRequest, err.Error(), nil)
 		default:
 			response.Error(c, http.StatusInternalServerError, "Failed to create player", err.Error())
 		}
@@ -69,11 +78,13 @@ func (h *PlayerHandler) Create(c *gin.Context) {
 
 // GetByID handles getting a player by ID
 // @Summary Get Player
-// @Description Get a player by ID
+// @Description Get a player by ID with their team, or the related resources given by include
 // @Tags Players
 // @Accept json
 // @Produce json
 // @Param id path string true "Player ID"
+// @Param include query string false "Comma-separated related resources to embed: team" default(team)
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name,team.name"
 // @Success 200 {object} response.Response{data=dto.PlayerResponse}
 // @Failure 400 {object} response.Response
 // @Failure 404 {object} response.Response
@@ -85,7 +96,16 @@ func (h *PlayerHandler) GetByID(c *gin.Context) {
 		return
 	}
 
-	player, err := h.playerUseCase.GetByIDWithTeam(c.Request.Context(), id)
+	include, ok := parseInclude(c, repository.PlayerListSchema, "team")
+	if !ok {
+		return
+	}
+	fields, ok := parseFields(c, dto.PlayerResponse{}, include)
+	if !ok {
+		return
+	}
+
+	player, err := h.playerUseCase.GetByIDWith(c.Request.Context(), id, include)
 	if err != nil {
 		if errors.Is(err, usecase.ErrPlayerNotFound) {
 			response.Error(c, http.StatusNotFound, "Player not found", nil)
@@ -95,7 +115,7 @@ func (h *PlayerHandler) GetByID(c *gin.Context) {
 		return
 	}
 
-	response.Success(c, http.StatusOK, "Player retrieved successfully", dto.ToPlayerResponse(player))
+	response.Success(c, http.StatusOK, "Player retrieved successfully", fields.Apply(dto.ToPlayerResponse(player)))
 }
 
 // Update handles updating a player
@@ -151,6 +171,13 @@ func (h *PlayerHandler) Update(c *gin.Context) {
 			response.Error(c, http.StatusBadRequest, "Invalid player position", nil)
 		case errors.Is(err, usecase.ErrInvalidJerseyNumber):
 			response.Error(c, http.StatusBadRequest, "Jersey number must be between 1 and 99", nil)
+		case errors.Is(err, usecase.ErrInvalidSecondaryPositions),
+			errors.Is(err, usecase.ErrInvalidNationality),
+			errors.Is(err, usecase.ErrInvalidPreferredFoot),
+			errors.Is(err, usecase.ErrInvalidDateOfBirth):
+			response.Error(c, http.StatusBadRequest, err.Error(), nil)
+		case errors.Is(err, usecase.ErrTeamChangeNeedsTransfer):
+			response.Error(c, http.StatusBadRequest, "Use POST /api/v1/players/:id/transfer to move a player to another team", nil)
 		default:
 			response.Error(c, http.StatusInternalServerError, "Failed to update player", err.Error())
 		}
@@ -194,21 +221,39 @@ func (h *PlayerHandler) Delete(c *gin.Context) {
 
 // GetAll handles getting all players with pagination
 // @Summary Get All Players
-// @Description Get all players with pagination
+// @Description Get all players with pagination, newest first, or by jersey number when filtering by team_id. Filters combine and take an operator as in height[gte]=180, like the team list.
 // @Tags Players
 // @Accept json
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(10)
-// @Param search query string false "Search query"
+// @Param search query string false "Search by name"
 // @Param team_id query string false "Filter by team ID"
+// @Param as_of query string false "With team_id alone, the squad at this date (YYYY-MM-DD) or time (RFC 3339) instead of today"
+// @Param name query string false "Filter by name"
+// @Param position query string false "Filter by primary or secondary position"
+// @Param nationality query string false "Filter by nationality (ISO 3166-1 alpha-2)"
+// @Param preferred_foot query string false "Filter by preferred foot (left, right or both)"
+// @Param height query number false "Filter by height in cm"
+// @Param weight query number false "Filter by weight in kg"
+// @Param jersey_number query int false "Filter by jersey number"
+// @Param date_of_birth query string false "Filter by date of birth (YYYY-MM-DD)"
+// @Param age_group query string false "Filter by age group, e.g. U21"
+// @Param on query string false "With age_group, the cut-off date (YYYY-MM-DD) instead of today"
+// @Param sort query string false "Comma-separated sort fields, - for descending: name, nationality, height, weight, jersey_number, date_of_birth, created_at, team.name" default(-created_at)
+// @Param include query string false "Comma-separated related resources to embed: team" default(team)
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name,team.name"
+// @Param pagination query string false "page, or cursor for cursor pagination" default(page)
+// @Param cursor query string false "With cursor pagination, meta.next_cursor or meta.prev_cursor of an earlier page"
+// @Param total query bool false "With cursor pagination, also count the items" default(false)
 // @Success 200 {object} response.Response{data=[]dto.PlayerResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
 // @Router /api/v1/players [get]
 func (h *PlayerHandler) GetAll(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
-	search := c.Query("search")
-	teamIDStr := c.Query("team_id")
+	asOfStr := c.Query("as_of")
 
 	if page < 1 {
 		page = 1
@@ -217,40 +262,175 @@ func (h *PlayerHandler) GetAll(c *gin.Context) {
 		limit = 10
 	}
 
-	var players interface{}
+	query, ok := parseListQuery(c, repository.PlayerListSchema)
+	if !ok {
+		return
+	}
+	query.Include, ok = parseInclude(c, repository.PlayerListSchema, "team")
+	if !ok {
+		return
+	}
+	fields, ok := parseFields(c, dto.PlayerResponse{}, query.Include)
+	if !ok {
+		return
+	}
+	query, ok = parseAgeGroupFilter(c, query)
+	if !ok {
+		return
+	}
+
+	teamID, byTeam := query.Lookup("team_id")
+	if asOfStr != "" {
+		if !byTeam {
+			response.Error(c, http.StatusBadRequest, "as_of requires team_id", nil)
+			return
+		}
+		if query.Search != "" || len(query.Conditions) > 1 || len(query.Sort) > 0 {
+			response.Error(c, http.StatusBadRequest, "as_of cannot be combined with search, filters or sort", nil)
+			return
+		}
+		// The squad is read from contracts, which do not load the team
+		if c.Query("include") != "" {
+			response.Error(c, http.StatusBadRequest, "as_of cannot be combined with include", nil)
+			return
+		}
+	}
+	if byTeam && len(query.Sort) == 0 {
+		// A squad reads best by jersey number
+		query = query.OrderBy(listquery.Asc("jersey_number"))
+	}
+	window, scrolling, ok := parseWindow(c, repository.PlayerListSchema, query, limit)
+	if !ok {
+		return
+	}
+	if asOfStr != "" && scrolling {
+		response.Error(c, http.StatusBadRequest, "as_of cannot be combined with cursor pagination", nil)
+		return
+	}
+
+	if scrolling {
+		slice, err := h.playerUseCase.Scroll(c.Request.Context(), query, window)
+		if err != nil {
+			if errors.Is(err, usecase.ErrTeamNotFound) {
+				response.Error(c, http.StatusNotFound, "Team not found", nil)
+				return
+			}
+			response.Error(c, http.StatusInternalServerError, "Failed to get players", err.Error())
+			return
+		}
+		response.SuccessWithMeta(c, http.StatusOK, "Players retrieved successfully", fields.Apply(dto.ToPlayerResponseList(slice.Items)), cursorMeta(slice, limit))
+		return
+	}
+
+	var players []entity.Player
 	var total int64
 	var err error
 
-	if teamIDStr != "" {
-		teamID, parseErr := uuid.Parse(teamIDStr)
+	if asOfStr != "" {
+		// A bare date covers the whole day, like the audit log filters
+		asOf, parseErr := parseTimeQuery(asOfStr, true)
 		if parseErr != nil {
-			response.Error(c, http.StatusBadRequest, "Invalid team ID", nil)
+			response.Error(c, http.StatusBadRequest, "Invalid as_of, use YYYY-MM-DD or RFC 3339", nil)
 			return
 		}
-		p, totalCount, getErr := h.playerUseCase.GetByTeamID(c.Request.Context(), teamID, page, limit)
-		players = dto.ToPlayerResponseList(p)
-		total = totalCount
-		err = getErr
+		players, total, err = h.playerUseCase.GetByTeamIDAsOf(c.Request.Context(), teamID.(uuid.UUID), asOf, page, limit)
+	} else {
+		players, total, err = h.playerUseCase.GetAll(c.Request.Context(), query, page, limit)
+	}
+
+	if err != nil {
 		if errors.Is(err, usecase.ErrTeamNotFound) {
 			response.Error(c, http.StatusNotFound, "Team not found", nil)
 			return
 		}
-	} else if search != "" {
-		p, totalCount, searchErr := h.playerUseCase.Search(c.Request.Context(), search, page, limit)
-		players = dto.ToPlayerResponseList(p)
-		total = totalCount
-		err = searchErr
-	} else {
-		p, totalCount, getAllErr := h.playerUseCase.GetAll(c.Request.Context(), page, limit)
-		players = dto.ToPlayerResponseList(p)
-		total = totalCount
-		err = getAllErr
+		response.Error(c, http.StatusInternalServerError, "Failed to get players", err.Error())
+		return
 	}
 
+	response.SuccessWithMeta(c, http.StatusOK, "Players retrieved successfully", fields.Apply(dto.ToPlayerResponseList(players)), response.NewMeta(page, limit, total))
+}
+
+// GetEligibility handles checking a player against an age group
+// @Summary Check Player Eligibility
+// @Description Check whether a player is young enough for an age group on its cut-off date. Players without a date of birth are not eligible.
+// @Tags Players
+// @Accept json
+// @Produce json
+// @Param id path string true "Player ID"
+// @Param age_group query string true "Age group, e.g. U21"
+// @Param on query string false "Cut-off date (YYYY-MM-DD), default today"
+// @Success 200 {object} response.Response{data=dto.PlayerEligibilityResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/players/{id}/eligibility [get]
+func (h *PlayerHandler) GetEligibility(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		response.Error(c, http.StatusInternalServerError, "Failed to get players", err.Error())
+		response.Error(c, http.StatusBadRequest, "Invalid player ID", nil)
+		return
+	}
+
+	group, ok := entity.ParseAgeGroup(c.Query("age_group"))
+	if !ok {
+		response.Error(c, http.StatusBadRequest, "Invalid age_group, use U6 to U23, e.g. U21", nil)
+		return
+	}
+	on, ok := parseCutOffDate(c)
+	if !ok {
 		return
 	}
 
-	response.SuccessWithMeta(c, http.StatusOK, "Players retrieved successfully", players, response.NewMeta(page, limit, total))
+	eligibility, err := h.playerUseCase.CheckEligibility(c.Request.Context(), id, group, on)
+	if err != nil {
+		if errors.Is(err, usecase.ErrPlayerNotFound) {
+			response.Error(c, http.StatusNotFound, "Player not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to check eligibility", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Eligibility checked successfully", dto.ToPlayerEligibilityResponse(eligibility))
+}
+
+// parseAgeGroupFilter adds the age_group filter of a player list request to
+// the query: players born after the group's cut-off, on the date of the on
+// parameter or today. On invalid input it writes the error response and
+// returns false.
+func parseAgeGroupFilter(c *gin.Context, query listquery.Query) (listquery.Query, bool) {
+	ageGroup := c.Query("age_group")
+	if ageGroup == "" {
+		if c.Query("on") != "" {
+			response.Error(c, http.StatusBadRequest, "on requires age_group", nil)
+			return query, false
+		}
+		return query, true
+	}
+
+	group, ok := entity.ParseAgeGroup(ageGroup)
+	if !ok {
+		response.Error(c, http.StatusBadRequest, "Invalid age_group, use U6 to U23, e.g. U21", nil)
+		return query, false
+	}
+	on, ok := parseCutOffDate(c)
+	if !ok {
+		return query, false
+	}
+	return query.Where("date_of_birth", listquery.Gt, group.BornAfter(on)), true
+}
+
+// parseCutOffDate reads the on query parameter of an age group check as a
+// calendar date, defaulting to today. On invalid input it writes the error
+// response and returns false.
+func parseCutOffDate(c *gin.Context) (time.Time, bool) {
+	value := c.Query("on")
+	if value == "" {
+		return entity.Today(), true
+	}
+	on, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid on, use YYYY-MM-DD", nil)
+		return time.Time{}, false
+	}
+	return on, true
 }
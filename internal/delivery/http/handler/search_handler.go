@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// SearchHandler handles search related requests
+type SearchHandler struct {
+	searchUseCase usecase.SearchUseCase
+}
+
+// NewSearchHandler creates a new instance of SearchHandler
+func NewSearchHandler(searchUseCase usecase.SearchUseCase) *SearchHandler {
+	return &SearchHandler{searchUseCase: searchUseCase}
+}
+
+// Search handles searching teams, players and venues at once
+// @Summary Search
+// @Description Search teams (name and city), players (name) and venues (name and city) at once. Matching ignores case and accents, accepts the start of a word and tolerates typos; results are grouped by type, best match first.
+// @Tags Search
+// @Accept json
+// @Produce json
+// @Param q query string true "Search text, at least 2 letters or digits"
+// @Param type query string false "Comma-separated types to search: team, player, venue" default(team,player,venue)
+// @Param limit query int false "Maximum results per type" default(5)
+// @Success 200 {object} response.Response{data=dto.SearchResponse}
+// @Failure 400 {object} response.Response
+// @Router /api/v1/search [get]
+func (h *SearchHandler) Search(c *gin.Context) {
+	text := strings.TrimSpace(c.Query("q"))
+	if text == "" {
+		response.Error(c, http.StatusBadRequest, "Search query is required", nil)
+		return
+	}
+	types, err := usecase.ParseSearchTypes(c.Query("type"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "5"))
+	if limit < 1 || limit > 20 {
+		limit = 5
+	}
+
+	result, err := h.searchUseCase.Search(c.Request.Context(), text, types, limit)
+	if err != nil {
+		if errors.Is(err, usecase.ErrSearchQueryTooShort) {
+			response.Error(c, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to search", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Search results retrieved successfully", dto.ToSearchResponse(text, result))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
 	"github.com/zenkriztao/ayo-football-backend/pkg/response"
 )
@@ -33,6 +34,7 @@ func NewTeamHandler(teamUseCase usecase.TeamUseCase) *TeamHandler {
 // @Success 201 {object} response.Response{data=dto.TeamResponse}
 // @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
 // @Router /api/v1/teams [post]
 func (h *TeamHandler) Create(c *gin.Context) {
 	var req dto.CreateTeamRequest
@@ -43,6 +45,10 @@ func (h *TeamHandler) Create(c *gin.Context) {
 
 	team := req.ToTeamEntity()
 	if err := h.teamUseCase.Create(c.Request.Context(), team); err != nil {
+		if errors.Is(err, usecase.ErrVenueNotFound) {
+			response.Error(c, http.StatusNotFound, "Venue not found", nil)
+			return
+		}
 		response.Error(c, http.StatusInternalServerError, "Failed to create team", err.Error())
 		return
 	}
@@ -52,11 +58,14 @@ func (h *TeamHandler) Create(c *gin.Context) {
 
 // GetByID handles getting a team by ID
 // @Summary Get Team
-// @Description Get a team by ID
+// @Description Get a team by ID with its home venue, or the related resources given by include
 // @Tags Teams
 // @Accept json
 // @Produce json
 // @Param id path string true "Team ID"
+// @Param include query string false "Comma-separated related resources to embed: home_venue, players (by jersey number)" default(home_venue)
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name,home_venue.name"
+// @Param with_players query bool false "Deprecated, same as adding players to include"
 // @Success 200 {object} response.Response{data=dto.TeamResponse}
 // @Failure 400 {object} response.Response
 // @Failure 404 {object} response.Response
@@ -68,35 +77,29 @@ func (h *TeamHandler) GetByID(c *gin.Context) {
 		return
 	}
 
-	// Check if we should include players
-	withPlayers := c.Query("with_players") == "true"
+	include, ok := parseInclude(c, repository.TeamListSchema, "home_venue")
+	if !ok {
+		return
+	}
+	if c.Query("with_players") == "true" {
+		include = include.With("players")
+	}
+	fields, ok := parseFields(c, dto.TeamResponse{}, include)
+	if !ok {
+		return
+	}
 
-	var team interface{}
-	if withPlayers {
-		t, err := h.teamUseCase.GetByIDWithPlayers(c.Request.Context(), id)
-		if err != nil {
-			if errors.Is(err, usecase.ErrTeamNotFound) {
-				response.Error(c, http.StatusNotFound, "Team not found", nil)
-				return
-			}
-			response.Error(c, http.StatusInternalServerError, "Failed to get team", err.Error())
-			return
-		}
-		team = dto.ToTeamResponse(t)
-	} else {
-		t, err := h.teamUseCase.GetByID(c.Request.Context(), id)
-		if err != nil {
-			if errors.Is(err, usecase.ErrTeamNotFound) {
-				response.Error(c, http.StatusNotFound, "Team not found", nil)
-				return
-			}
-			response.Error(c, http.StatusInternalServerError, "Failed to get team", err.Error())
+	team, err := h.teamUseCase.GetByIDWith(c.Request.Context(), id, include)
+	if err != nil {
+		if errors.Is(err, usecase.ErrTeamNotFound) {
+			response.Error(c, http.StatusNotFound, "Team not found", nil)
 			return
 		}
-		team = dto.ToTeamResponse(t)
+		response.Error(c, http.StatusInternalServerError, "Failed to get team", err.Error())
+		return
 	}
 
-	response.Success(c, http.StatusOK, "Team retrieved successfully", team)
+	response.Success(c, http.StatusOK, "Team retrieved successfully", fields.Apply(dto.ToTeamResponse(team)))
 }
 
 // Update handles updating a team
@@ -139,6 +142,10 @@ func (h *TeamHandler) Update(c *gin.Context) {
 	req.UpdateTeamEntity(team)
 
 	if err := h.teamUseCase.Update(c.Request.Context(), team); err != nil {
+		if errors.Is(err, usecase.ErrVenueNotFound) {
+			response.Error(c, http.StatusNotFound, "Venue not found", nil)
+			return
+		}
 		response.Error(c, http.StatusInternalServerError, "Failed to update team", err.Error())
 		return
 	}
@@ -148,16 +155,18 @@ func (h *TeamHandler) Update(c *gin.Context) {
 
 // Delete handles deleting a team
 // @Summary Delete Team
-// @Description Delete a team (soft delete)
+// @Description Delete a team and its players (soft delete). Teams with matches require force=true, which also cancels their pending matches.
 // @Tags Teams
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Team ID"
+// @Param force query bool false "Delete even if the team has matches"
 // @Success 200 {object} response.Response
 // @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
 // @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response{error=dto.TeamDependenciesResponse}
 // @Router /api/v1/teams/{id} [delete]
 func (h *TeamHandler) Delete(c *gin.Context) {
 	id, err := uuid.Parse(c.Param("id"))
@@ -166,33 +175,82 @@ func (h *TeamHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	if err := h.teamUseCase.Delete(c.Request.Context(), id); err != nil {
+	force := c.Query("force") == "true"
+
+	if err := h.teamUseCase.Delete(c.Request.Context(), id, force); err != nil {
+		var blockedErr *usecase.TeamDeleteBlockedError
+		switch {
+		case errors.Is(err, usecase.ErrTeamNotFound):
+			response.Error(c, http.StatusNotFound, "Team not found", nil)
+		case errors.As(err, &blockedErr):
+			response.Error(c, http.StatusConflict, "Team has matches, use force=true to delete it anyway", dto.ToTeamDependenciesResponse(&blockedErr.Dependencies))
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to delete team", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Team deleted successfully", nil)
+}
+
+// GetDependencies handles listing the records that depend on a team
+// @Summary Get Team Dependencies
+// @Description Get the players and matches that depend on a team
+// @Tags Teams
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Team ID"
+// @Success 200 {object} response.Response{data=dto.TeamDependenciesResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/teams/{id}/dependencies [get]
+func (h *TeamHandler) GetDependencies(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid team ID", nil)
+		return
+	}
+
+	dependencies, err := h.teamUseCase.GetDependencies(c.Request.Context(), id)
+	if err != nil {
 		if errors.Is(err, usecase.ErrTeamNotFound) {
 			response.Error(c, http.StatusNotFound, "Team not found", nil)
 			return
 		}
-		response.Error(c, http.StatusInternalServerError, "Failed to delete team", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to get team dependencies", err.Error())
 		return
 	}
 
-	response.Success(c, http.StatusOK, "Team deleted successfully", nil)
+	response.Success(c, http.StatusOK, "Team dependencies retrieved successfully", dto.ToTeamDependenciesResponse(dependencies))
 }
 
 // GetAll handles getting all teams with pagination
 // @Summary Get All Teams
-// @Description Get all teams with pagination
+// @Description Get all teams with pagination. Filters combine, and take an operator as in founded_year[gte]=1950: eq, ne, in (comma-separated) and, for numbers and dates, gt, gte, lt and lte, or contains for text.
 // @Tags Teams
 // @Accept json
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(10)
-// @Param search query string false "Search query"
+// @Param search query string false "Search by name or city"
+// @Param name query string false "Filter by name"
+// @Param city query string false "Filter by city"
+// @Param founded_year query int false "Filter by founding year"
+// @Param home_venue_id query string false "Filter by home venue ID"
+// @Param sort query string false "Comma-separated sort fields, - for descending: name, city, founded_year, created_at" default(-created_at)
+// @Param include query string false "Comma-separated related resources to embed: home_venue, players"
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name,home_venue.name"
+// @Param pagination query string false "page, or cursor for cursor pagination" default(page)
+// @Param cursor query string false "With cursor pagination, meta.next_cursor or meta.prev_cursor of an earlier page"
+// @Param total query bool false "With cursor pagination, also count the items" default(false)
 // @Success 200 {object} response.Response{data=[]dto.TeamResponse}
+// @Failure 400 {object} response.Response
 // @Router /api/v1/teams [get]
 func (h *TeamHandler) GetAll(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
-	search := c.Query("search")
 
 	if page < 1 {
 		page = 1
@@ -201,26 +259,37 @@ func (h *TeamHandler) GetAll(c *gin.Context) {
 		limit = 10
 	}
 
-	var teams interface{}
-	var total int64
-	var err error
-
-	if search != "" {
-		t, totalCount, searchErr := h.teamUseCase.Search(c.Request.Context(), search, page, limit)
-		teams = dto.ToTeamResponseList(t)
-		total = totalCount
-		err = searchErr
-	} else {
-		t, totalCount, getAllErr := h.teamUseCase.GetAll(c.Request.Context(), page, limit)
-		teams = dto.ToTeamResponseList(t)
-		total = totalCount
-		err = getAllErr
+	query, ok := parseListQuery(c, repository.TeamListSchema)
+	if !ok {
+		return
+	}
+	query.Include, ok = parseInclude(c, repository.TeamListSchema)
+	if !ok {
+		return
+	}
+	fields, ok := parseFields(c, dto.TeamResponse{}, query.Include)
+	if !ok {
+		return
+	}
+	window, scrolling, ok := parseWindow(c, repository.TeamListSchema, query, limit)
+	if !ok {
+		return
+	}
+	if scrolling {
+		slice, err := h.teamUseCase.Scroll(c.Request.Context(), query, window)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, "Failed to get teams", err.Error())
+			return
+		}
+		response.SuccessWithMeta(c, http.StatusOK, "Teams retrieved successfully", fields.Apply(dto.ToTeamResponseList(slice.Items)), cursorMeta(slice, limit))
+		return
 	}
 
+	teams, total, err := h.teamUseCase.GetAll(c.Request.Context(), query, page, limit)
 	if err != nil {
 		response.Error(c, http.StatusInternalServerError, "Failed to get teams", err.Error())
 		return
 	}
 
-	response.SuccessWithMeta(c, http.StatusOK, "Teams retrieved successfully", teams, response.NewMeta(page, limit, total))
+	response.SuccessWithMeta(c, http.StatusOK, "Teams retrieved successfully", fields.Apply(dto.ToTeamResponseList(teams)), response.NewMeta(page, limit, total))
 }
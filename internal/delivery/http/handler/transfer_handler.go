@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// TransferHandler handles player transfer and transfer window requests
+type TransferHandler struct {
+	transferUseCase usecase.TransferUseCase
+}
+
+// NewTransferHandler creates a new instance of TransferHandler
+func NewTransferHandler(transferUseCase usecase.TransferUseCase) *TransferHandler {
+	return &TransferHandler{transferUseCase: transferUseCase}
+}
+
+// Transfer handles moving a player to another team
+// @Summary Transfer Player
+// @Description Move a player to another team. Their current contract ends and a new one starts on the transfer date, so past matches keep the team they played for. Within a season that has transfer windows, the date must fall in one of them.
+// @Tags Players
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Player ID"
+// @Param request body dto.TransferPlayerRequest true "Transfer details"
+// @Success 201 {object} response.Response{data=dto.PlayerContractResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/players/{id}/transfer [post]
+func (h *TransferHandler) Transfer(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid player ID", nil)
+		return
+	}
+
+	var req dto.TransferPlayerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	input, err := req.ToTransferInput()
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	contract, err := h.transferUseCase.Transfer(c.Request.Context(), id, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrPlayerNotFound):
+			response.Error(c, http.StatusNotFound, "Player not found", nil)
+		case errors.Is(err, usecase.ErrTeamNotFound):
+			response.Error(c, http.StatusNotFound, "Team not found", nil)
+		case errors.Is(err, usecase.ErrJerseyNumberTaken):
+			response.Error(c, http.StatusConflict, "Jersey number is already taken by another player in this team", nil)
+		case errors.Is(err, usecase.ErrTransferWindowClosed):
+			response.Error(c, http.StatusConflict, err.Error(), nil)
+		case errors.Is(err, usecase.ErrInvalidJerseyNumber):
+			response.Error(c, http.StatusBadRequest, "Jersey number must be between 1 and 99", nil)
+		case errors.Is(err, usecase.ErrSameTeamTransfer), errors.Is(err, usecase.ErrInvalidTransferDate):
+			response.Error(c, http.StatusBadRequest, err.Error(), nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to transfer player", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Player transferred successfully", dto.ToPlayerContractResponse(contract))
+}
+
+// GetContracts handles getting the contracts of a player
+// @Summary Get Player Contracts
+// @Description Get the teams a player has belonged to, oldest first. The first contract has no start and the current one has no end.
+// @Tags Players
+// @Accept json
+// @Produce json
+// @Param id path string true "Player ID"
+// @Success 200 {object} response.Response{data=[]dto.PlayerContractResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/players/{id}/contracts [get]
+func (h *TransferHandler) GetContracts(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid player ID", nil)
+		return
+	}
+
+	contracts, err := h.transferUseCase.GetContracts(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrPlayerNotFound) {
+			response.Error(c, http.StatusNotFound, "Player not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get contracts", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Contracts retrieved successfully", dto.ToPlayerContractResponseList(contracts))
+}
+
+// GetWindows handles getting the transfer windows of a season
+// @Summary Get Transfer Windows
+// @Description Get the transfer windows of a season. A season without windows has no transfer restrictions.
+// @Tags Seasons
+// @Accept json
+// @Produce json
+// @Param season path string true "Season, such as 2024 or 2024-2025"
+// @Success 200 {object} response.Response{data=[]dto.TransferWindowResponse}
+// @Failure 400 {object} response.Response
+// @Router /api/v1/seasons/{season}/transfer-windows [get]
+func (h *TransferHandler) GetWindows(c *gin.Context) {
+	season, err := usecase.ParseSeason(c.Param("season"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	windows, err := h.transferUseCase.GetWindows(c.Request.Context(), season)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to get transfer windows", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Transfer windows retrieved successfully", dto.ToTransferWindowResponseList(windows))
+}
+
+// CreateWindow handles opening a transfer window in a season
+// @Summary Create Transfer Window
+// @Description Add a transfer window to a season. It must lie within the season and not overlap its other windows.
+// @Tags Seasons
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param season path string true "Season, such as 2024 or 2024-2025"
+// @Param request body dto.CreateTransferWindowRequest true "Transfer window details"
+// @Success 201 {object} response.Response{data=dto.TransferWindowResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/seasons/{season}/transfer-windows [post]
+func (h *TransferHandler) CreateWindow(c *gin.Context) {
+	season, err := usecase.ParseSeason(c.Param("season"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	var req dto.CreateTransferWindowRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	window, err := req.ToTransferWindowEntity()
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	if err := h.transferUseCase.CreateWindow(c.Request.Context(), season, window); err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidTransferWindow):
+			response.Error(c, http.StatusBadRequest, err.Error(), nil)
+		case errors.Is(err, usecase.ErrTransferWindowOverlap):
+			response.Error(c, http.StatusConflict, err.Error(), nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to create transfer window", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Transfer window created successfully", dto.ToTransferWindowResponse(window))
+}
+
+// DeleteWindow handles removing a transfer window from a season
+// @Summary Delete Transfer Window
+// @Description Delete a transfer window of a season (soft delete)
+// @Tags Seasons
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param season path string true "Season, such as 2024 or 2024-2025"
+// @Param id path string true "Transfer window ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/seasons/{season}/transfer-windows/{id} [delete]
+func (h *TransferHandler) DeleteWindow(c *gin.Context) {
+	season, err := usecase.ParseSeason(c.Param("season"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid transfer window ID", nil)
+		return
+	}
+
+	if err := h.transferUseCase.DeleteWindow(c.Request.Context(), season, id); err != nil {
+		if errors.Is(err, usecase.ErrTransferWindowNotFound) {
+			response.Error(c, http.StatusNotFound, "Transfer window not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to delete transfer window", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Transfer window deleted successfully", nil)
+}
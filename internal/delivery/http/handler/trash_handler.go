@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// trashEntityTypes maps the :entity path segment to the audited entity type
+var trashEntityTypes = map[string]string{
+	"teams":   entity.AuditEntityTeam,
+	"players": entity.AuditEntityPlayer,
+	"matches": entity.AuditEntityMatch,
+}
+
+// TrashHandler handles soft-deleted record related requests
+type TrashHandler struct {
+	trashUseCase usecase.TrashUseCase
+}
+
+// NewTrashHandler creates a new instance of TrashHandler
+func NewTrashHandler(trashUseCase usecase.TrashUseCase) *TrashHandler {
+	return &TrashHandler{trashUseCase: trashUseCase}
+}
+
+// GetAll handles listing soft-deleted records of one entity type
+// @Summary Get Trash
+// @Description Get soft-deleted teams, players or matches, most recently deleted first
+// @Tags Trash
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param entity path string true "Entity type (teams, players, matches)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /api/v1/trash/{entity} [get]
+func (h *TrashHandler) GetAll(c *gin.Context) {
+	entityType, ok := trashEntityTypes[c.Param("entity")]
+	if !ok {
+		response.Error(c, http.StatusBadRequest, "Invalid entity type, must be one of: teams, players, matches", nil)
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	ctx := c.Request.Context()
+
+	var data interface{}
+	var total int64
+	var err error
+	switch entityType {
+	case entity.AuditEntityTeam:
+		var teams []entity.Team
+		teams, total, err = h.trashUseCase.ListTeams(ctx, page, limit)
+		data = dto.ToTrashedTeamResponseList(teams)
+	case entity.AuditEntityPlayer:
+		var players []entity.Player
+		players, total, err = h.trashUseCase.ListPlayers(ctx, page, limit)
+		data = dto.ToTrashedPlayerResponseList(players)
+	case entity.AuditEntityMatch:
+		var matches []entity.Match
+		matches, total, err = h.trashUseCase.ListMatches(ctx, page, limit)
+		data = dto.ToTrashedMatchResponseList(matches)
+	}
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to get trash", err.Error())
+		return
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, "Trash retrieved successfully", data, response.NewMeta(page, limit, total))
+}
+
+// Restore handles restoring a soft-deleted record
+// @Summary Restore Record
+// @Description Restore a soft-deleted team, player or match. Restoring a team also restores the players deleted with it.
+// @Tags Trash
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param entity path string true "Entity type (teams, players, matches)"
+// @Param id path string true "Record ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/trash/{entity}/{id}/restore [post]
+func (h *TrashHandler) Restore(c *gin.Context) {
+	entityType, id, ok := parseTrashParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.trashUseCase.Restore(c.Request.Context(), entityType, id); err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrNotInTrash):
+			response.Error(c, http.StatusNotFound, "Record not found in trash", nil)
+		case errors.Is(err, usecase.ErrTeamNotFound):
+			response.Error(c, http.StatusConflict, "Team is not active, restore the team first", nil)
+		case errors.Is(err, usecase.ErrJerseyNumberTaken):
+			response.Error(c, http.StatusConflict, err.Error(), nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to restore record", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Record restored successfully", nil)
+}
+
+// Purge handles permanently deleting a soft-deleted record
+// @Summary Purge Record
+// @Description Permanently delete a soft-deleted team, player or match. Purging a match also removes its goals.
+// @Tags Trash
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param entity path string true "Entity type (teams, players, matches)"
+// @Param id path string true "Record ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/trash/{entity}/{id} [delete]
+func (h *TrashHandler) Purge(c *gin.Context) {
+	entityType, id, ok := parseTrashParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.trashUseCase.Purge(c.Request.Context(), entityType, id); err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrNotInTrash):
+			response.Error(c, http.StatusNotFound, "Record not found in trash", nil)
+		case errors.Is(err, usecase.ErrStillReferenced):
+			response.Error(c, http.StatusConflict, err.Error(), nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to purge record", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Record purged successfully", nil)
+}
+
+// parseTrashParams reads the :entity and :id path parameters, writing a 400
+// response when either is invalid
+func parseTrashParams(c *gin.Context) (string, uuid.UUID, bool) {
+	entityType, ok := trashEntityTypes[c.Param("entity")]
+	if !ok {
+		response.Error(c, http.StatusBadRequest, "Invalid entity type, must be one of: teams, players, matches", nil)
+		return "", uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid record ID", nil)
+		return "", uuid.Nil, false
+	}
+
+	return entityType, id, true
+}
@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// VenueHandler handles venue related requests
+type VenueHandler struct {
+	venueUseCase usecase.VenueUseCase
+}
+
+// NewVenueHandler creates a new instance of VenueHandler
+func NewVenueHandler(venueUseCase usecase.VenueUseCase) *VenueHandler {
+	return &VenueHandler{venueUseCase: venueUseCase}
+}
+
+// Create handles venue creation
+// @Summary Create Venue
+// @Description Create a new venue (stadium)
+// @Tags Venues
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateVenueRequest true "Venue details"
+// @Success 201 {object} response.Response{data=dto.VenueResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /api/v1/venues [post]
+func (h *VenueHandler) Create(c *gin.Context) {
+	var req dto.CreateVenueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	venue := req.ToVenueEntity()
+	if err := h.venueUseCase.Create(c.Request.Context(), venue); err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to create venue", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Venue created successfully", dto.ToVenueResponse(venue))
+}
+
+// GetByID handles getting a venue by ID
+// @Summary Get Venue
+// @Description Get a venue by ID
+// @Tags Venues
+// @Accept json
+// @Produce json
+// @Param id path string true "Venue ID"
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name,city"
+// @Success 200 {object} response.Response{data=dto.VenueResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/venues/{id} [get]
+func (h *VenueHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid venue ID", nil)
+		return
+	}
+
+	fields, ok := parseFields(c, dto.VenueResponse{}, nil)
+	if !ok {
+		return
+	}
+
+	venue, err := h.venueUseCase.GetByID(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrVenueNotFound) {
+			response.Error(c, http.StatusNotFound, "Venue not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get venue", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Venue retrieved successfully", fields.Apply(dto.ToVenueResponse(venue)))
+}
+
+// Update handles updating a venue
+// @Summary Update Venue
+// @Description Update an existing venue
+// @Tags Venues
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Venue ID"
+// @Param request body dto.UpdateVenueRequest true "Venue details"
+// @Success 200 {object} response.Response{data=dto.VenueResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/venues/{id} [put]
+func (h *VenueHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid venue ID", nil)
+		return
+	}
+
+	var req dto.UpdateVenueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	venue, err := h.venueUseCase.GetByID(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrVenueNotFound) {
+			response.Error(c, http.StatusNotFound, "Venue not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get venue", err.Error())
+		return
+	}
+
+	req.UpdateVenueEntity(venue)
+
+	if err := h.venueUseCase.Update(c.Request.Context(), venue); err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to update venue", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Venue updated successfully", dto.ToVenueResponse(venue))
+}
+
+// Delete handles deleting a venue
+// @Summary Delete Venue
+// @Description Delete a venue (soft delete). Teams and matches keep their reference to it.
+// @Tags Venues
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Venue ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/venues/{id} [delete]
+func (h *VenueHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid venue ID", nil)
+		return
+	}
+
+	if err := h.venueUseCase.Delete(c.Request.Context(), id); err != nil {
+		if errors.Is(err, usecase.ErrVenueNotFound) {
+			response.Error(c, http.StatusNotFound, "Venue not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to delete venue", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Venue deleted successfully", nil)
+}
+
+// GetAll handles getting all venues with pagination
+// @Summary Get All Venues
+// @Description Get all venues with pagination, optionally searching by name or city. Filters combine and take an operator as in capacity[gte]=30000, like the team list.
+// @Tags Venues
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param search query string false "Search by name or city"
+// @Param name query string false "Filter by name"
+// @Param city query string false "Filter by city"
+// @Param capacity query int false "Filter by capacity"
+// @Param sort query string false "Comma-separated sort fields, - for descending: name, city, capacity, created_at" default(name)
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name,city"
+// @Param pagination query string false "page, or cursor for cursor pagination" default(page)
+// @Param cursor query string false "With cursor pagination, meta.next_cursor or meta.prev_cursor of an earlier page"
+// @Param total query bool false "With cursor pagination, also count the items" default(false)
+// @Success 200 {object} response.Response{data=[]dto.VenueResponse}
+// @Failure 400 {object} response.Response
+// @Router /api/v1/venues [get]
+func (h *VenueHandler) GetAll(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	query, ok := parseListQuery(c, repository.VenueListSchema)
+	if !ok {
+		return
+	}
+	fields, ok := parseFields(c, dto.VenueResponse{}, nil)
+	if !ok {
+		return
+	}
+	window, scrolling, ok := parseWindow(c, repository.VenueListSchema, query, limit)
+	if !ok {
+		return
+	}
+	if scrolling {
+		slice, err := h.venueUseCase.Scroll(c.Request.Context(), query, window)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, "Failed to get venues", err.Error())
+			return
+		}
+		response.SuccessWithMeta(c, http.StatusOK, "Venues retrieved successfully", fields.Apply(dto.ToVenueResponseList(slice.Items)), cursorMeta(slice, limit))
+		return
+	}
+
+	venues, total, err := h.venueUseCase.GetAll(c.Request.Context(), query, page, limit)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to get venues", err.Error())
+		return
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, "Venues retrieved successfully", fields.Apply(dto.ToVenueResponseList(venues)), response.NewMeta(page, limit, total))
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
 	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/security"
 	"github.com/zenkriztao/ayo-football-backend/pkg/response"
 )
@@ -47,6 +48,13 @@ func AuthMiddleware(jwtService security.JWTService) gin.HandlerFunc {
 		c.Set(UserEmailKey, claims.Email)
 		c.Set(UserRoleKey, claims.Role)
 
+		// Carry the actor into the request context for audit logging
+		c.Request = c.Request.WithContext(usecase.ContextWithAuditMetadata(c.Request.Context(), usecase.AuditMetadata{
+			ActorID:   &claims.UserID,
+			IPAddress: c.ClientIP(),
+			RequestID: c.GetString(RequestIDKey),
+		}))
+
 		c.Next()
 	}
 }
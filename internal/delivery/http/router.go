@@ -9,12 +9,25 @@ import (
 
 // Router holds all HTTP handlers
 type Router struct {
-	authHandler   *handler.AuthHandler
-	teamHandler   *handler.TeamHandler
-	playerHandler *handler.PlayerHandler
-	matchHandler  *handler.MatchHandler
-	reportHandler *handler.ReportHandler
-	jwtService    security.JWTService
+	authHandler     *handler.AuthHandler
+	teamHandler     *handler.TeamHandler
+	playerHandler   *handler.PlayerHandler
+	matchHandler    *handler.MatchHandler
+	reportHandler   *handler.ReportHandler
+	auditHandler    *handler.AuditHandler
+	trashHandler    *handler.TrashHandler
+	importHandler   *handler.ImportHandler
+	exportHandler   *handler.ExportHandler
+	fixtureHandler  *handler.FixtureHandler
+	venueHandler    *handler.VenueHandler
+	officialHandler *handler.OfficialHandler
+	transferHandler *handler.TransferHandler
+
+	disciplinaryHandler *handler.DisciplinaryHandler
+	availabilityHandler *handler.AvailabilityHandler
+	mediaHandler        *handler.MediaHandler
+	searchHandler       *handler.SearchHandler
+	jwtService          security.JWTService
 }
 
 // NewRouter creates a new Router instance
@@ -24,21 +37,47 @@ func NewRouter(
 	playerHandler *handler.PlayerHandler,
 	matchHandler *handler.MatchHandler,
 	reportHandler *handler.ReportHandler,
+	auditHandler *handler.AuditHandler,
+	trashHandler *handler.TrashHandler,
+	importHandler *handler.ImportHandler,
+	exportHandler *handler.ExportHandler,
+	fixtureHandler *handler.FixtureHandler,
+	venueHandler *handler.VenueHandler,
+	officialHandler *handler.OfficialHandler,
+	transferHandler *handler.TransferHandler,
+	disciplinaryHandler *handler.DisciplinaryHandler,
+	availabilityHandler *handler.AvailabilityHandler,
+	mediaHandler *handler.MediaHandler,
+	searchHandler *handler.SearchHandler,
 	jwtService security.JWTService,
 ) *Router {
 	return &Router{
-		authHandler:   authHandler,
-		teamHandler:   teamHandler,
-		playerHandler: playerHandler,
-		matchHandler:  matchHandler,
-		reportHandler: reportHandler,
-		jwtService:    jwtService,
+		authHandler:     authHandler,
+		teamHandler:     teamHandler,
+		playerHandler:   playerHandler,
+		matchHandler:    matchHandler,
+		reportHandler:   reportHandler,
+		auditHandler:    auditHandler,
+		trashHandler:    trashHandler,
+		importHandler:   importHandler,
+		exportHandler:   exportHandler,
+		fixtureHandler:  fixtureHandler,
+		venueHandler:    venueHandler,
+		officialHandler: officialHandler,
+		transferHandler: transferHandler,
+
+		disciplinaryHandler: disciplinaryHandler,
+		availabilityHandler: availabilityHandler,
+		mediaHandler:        mediaHandler,
+		searchHandler:       searchHandler,
+		jwtService:          jwtService,
 	}
 }
 
 // Setup configures all routes
 func (r *Router) Setup(engine *gin.Engine) {
 	// Global middlewares
+	engine.Use(middleware.RequestIDMiddleware())
 	engine.Use(middleware.CORSMiddleware())
 	engine.Use(middleware.RecoveryMiddleware())
 
@@ -73,6 +112,8 @@ func (r *Router) Setup(engine *gin.Engine) {
 			// Public routes
 			teams.GET("", r.teamHandler.GetAll)
 			teams.GET("/:id", r.teamHandler.GetByID)
+			teams.GET("/:id/fixtures.ics", r.fixtureHandler.GetTeamFixtures)
+			teams.GET("/:id/availability", r.availabilityHandler.GetSquad)
 
 			// Protected routes (Admin only)
 			teamsAdmin := teams.Group("")
@@ -82,6 +123,8 @@ func (r *Router) Setup(engine *gin.Engine) {
 				teamsAdmin.POST("", r.teamHandler.Create)
 				teamsAdmin.PUT("/:id", r.teamHandler.Update)
 				teamsAdmin.DELETE("/:id", r.teamHandler.Delete)
+				teamsAdmin.POST("/:id/logo", r.mediaHandler.UploadTeamLogo)
+				teamsAdmin.GET("/:id/dependencies", r.teamHandler.GetDependencies)
 			}
 		}
 
@@ -91,6 +134,9 @@ func (r *Router) Setup(engine *gin.Engine) {
 			// Public routes
 			players.GET("", r.playerHandler.GetAll)
 			players.GET("/:id", r.playerHandler.GetByID)
+			players.GET("/:id/eligibility", r.playerHandler.GetEligibility)
+			players.GET("/:id/contracts", r.transferHandler.GetContracts)
+			players.GET("/:id/availability", r.availabilityHandler.GetByPlayer)
 
 			// Protected routes (Admin only)
 			playersAdmin := players.Group("")
@@ -100,6 +146,11 @@ func (r *Router) Setup(engine *gin.Engine) {
 				playersAdmin.POST("", r.playerHandler.Create)
 				playersAdmin.PUT("/:id", r.playerHandler.Update)
 				playersAdmin.DELETE("/:id", r.playerHandler.Delete)
+				playersAdmin.POST("/:id/photo", r.mediaHandler.UploadPlayerPhoto)
+				playersAdmin.POST("/:id/transfer", r.transferHandler.Transfer)
+				playersAdmin.POST("/:id/availability", r.availabilityHandler.Create)
+				playersAdmin.PUT("/:id/availability/:availability_id", r.availabilityHandler.Update)
+				playersAdmin.DELETE("/:id/availability/:availability_id", r.availabilityHandler.Delete)
 			}
 		}
 
@@ -109,6 +160,8 @@ func (r *Router) Setup(engine *gin.Engine) {
 			// Public routes
 			matches.GET("", r.matchHandler.GetAll)
 			matches.GET("/:id", r.matchHandler.GetByID)
+			matches.GET("/:id/officials", r.officialHandler.GetMatchOfficials)
+			matches.GET("/:id/suspensions", r.disciplinaryHandler.GetMatchSuspensions)
 
 			// Protected routes (Admin only)
 			matchesAdmin := matches.Group("")
@@ -119,15 +172,113 @@ func (r *Router) Setup(engine *gin.Engine) {
 				matchesAdmin.PUT("/:id", r.matchHandler.Update)
 				matchesAdmin.DELETE("/:id", r.matchHandler.Delete)
 				matchesAdmin.POST("/:id/result", r.matchHandler.RecordResult)
+				matchesAdmin.PUT("/:id/officials", r.officialHandler.AssignMatchOfficials)
+			}
+		}
+
+		// Venue routes
+		venues := v1.Group("/venues")
+		{
+			// Public routes
+			venues.GET("", r.venueHandler.GetAll)
+			venues.GET("/:id", r.venueHandler.GetByID)
+
+			// Protected routes (Admin only)
+			venuesAdmin := venues.Group("")
+			venuesAdmin.Use(middleware.AuthMiddleware(r.jwtService))
+			venuesAdmin.Use(middleware.AdminMiddleware())
+			{
+				venuesAdmin.POST("", r.venueHandler.Create)
+				venuesAdmin.PUT("/:id", r.venueHandler.Update)
+				venuesAdmin.DELETE("/:id", r.venueHandler.Delete)
 			}
 		}
 
+		// Official routes
+		officials := v1.Group("/officials")
+		{
+			// Public routes
+			officials.GET("", r.officialHandler.GetAll)
+			officials.GET("/:id", r.officialHandler.GetByID)
+			officials.GET("/:id/stats", r.officialHandler.GetStats)
+
+			// Protected routes (Admin only)
+			officialsAdmin := officials.Group("")
+			officialsAdmin.Use(middleware.AuthMiddleware(r.jwtService))
+			officialsAdmin.Use(middleware.AdminMiddleware())
+			{
+				officialsAdmin.POST("", r.officialHandler.Create)
+				officialsAdmin.PUT("/:id", r.officialHandler.Update)
+				officialsAdmin.DELETE("/:id", r.officialHandler.Delete)
+			}
+		}
+
+		// Search route (public)
+		v1.GET("/search", r.searchHandler.Search)
+
+		// Media routes (public)
+		v1.GET("/media/*key", r.mediaHandler.Serve)
+
 		// Report routes (public)
 		reports := v1.Group("/reports")
 		{
 			reports.GET("/matches", r.reportHandler.GetAllMatchReports)
 			reports.GET("/matches/:id", r.reportHandler.GetMatchReport)
 			reports.GET("/top-scorers", r.reportHandler.GetTopScorers)
+			reports.GET("/suspensions", r.disciplinaryHandler.GetSuspensions)
+		}
+
+		// Season routes
+		seasons := v1.Group("/seasons")
+		{
+			// Public routes
+			seasons.GET("/:season/fixtures.ics", r.fixtureHandler.GetSeasonFixtures)
+			seasons.GET("/:season/transfer-windows", r.transferHandler.GetWindows)
+			seasons.GET("/:season/disciplinary-rules", r.disciplinaryHandler.GetRule)
+
+			// Protected routes (Admin only)
+			seasonsAdmin := seasons.Group("")
+			seasonsAdmin.Use(middleware.AuthMiddleware(r.jwtService))
+			seasonsAdmin.Use(middleware.AdminMiddleware())
+			{
+				seasonsAdmin.POST("/:season/transfer-windows", r.transferHandler.CreateWindow)
+				seasonsAdmin.DELETE("/:season/transfer-windows/:id", r.transferHandler.DeleteWindow)
+				seasonsAdmin.PUT("/:season/disciplinary-rules", r.disciplinaryHandler.SetRule)
+				seasonsAdmin.DELETE("/:season/disciplinary-rules", r.disciplinaryHandler.DeleteRule)
+			}
+		}
+
+		// Audit routes (Admin only)
+		audit := v1.Group("/audit")
+		audit.Use(middleware.AuthMiddleware(r.jwtService))
+		audit.Use(middleware.AdminMiddleware())
+		{
+			audit.GET("", r.auditHandler.GetAll)
+		}
+
+		// Trash routes (Admin only)
+		trash := v1.Group("/trash")
+		trash.Use(middleware.AuthMiddleware(r.jwtService))
+		trash.Use(middleware.AdminMiddleware())
+		{
+			trash.GET("/:entity", r.trashHandler.GetAll)
+			trash.POST("/:entity/:id/restore", r.trashHandler.Restore)
+			trash.DELETE("/:entity/:id", r.trashHandler.Purge)
+		}
+
+		// Import routes (Admin only)
+		imports := v1.Group("/import")
+		imports.Use(middleware.AuthMiddleware(r.jwtService))
+		imports.Use(middleware.AdminMiddleware())
+		{
+			imports.POST("/:entity", r.importHandler.Import)
+		}
+
+		// Export routes (Authenticated)
+		exports := v1.Group("/export")
+		exports.Use(middleware.AuthMiddleware(r.jwtService))
+		{
+			exports.GET("/:entity", r.exportHandler.Export)
 		}
 	}
 }
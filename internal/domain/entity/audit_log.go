@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AuditAction represents the kind of change recorded in the audit log
+type AuditAction string
+
+const (
+	AuditActionCreate       AuditAction = "create"
+	AuditActionUpdate       AuditAction = "update"
+	AuditActionDelete       AuditAction = "delete"
+	AuditActionRecordResult AuditAction = "record_result"
+	AuditActionRestore      AuditAction = "restore"
+	AuditActionPurge        AuditAction = "purge"
+	AuditActionAssign       AuditAction = "assign_officials"
+	AuditActionTransfer     AuditAction = "transfer"
+)
+
+// Audited entity types
+const (
+	AuditEntityTeam             = "team"
+	AuditEntityPlayer           = "player"
+	AuditEntityMatch            = "match"
+	AuditEntityVenue            = "venue"
+	AuditEntityOfficial         = "official"
+	AuditEntityTransferWindow   = "transfer_window"
+	AuditEntityDisciplinaryRule = "disciplinary_rule"
+	AuditEntityAvailability     = "player_availability"
+)
+
+// AuditLog represents a single administrative change. Entries are append-only,
+// so it does not embed BaseEntity (no updates, no soft delete).
+type AuditLog struct {
+	ID         uuid.UUID   `gorm:"type:uuid;primary_key" json:"id"`
+	ActorID    *uuid.UUID  `gorm:"type:uuid;index" json:"actor_id"`
+	Action     AuditAction `gorm:"type:varchar(30);not null;index" json:"action"`
+	EntityType string      `gorm:"size:50;not null;index:idx_audit_logs_entity" json:"entity_type"`
+	EntityID   uuid.UUID   `gorm:"type:uuid;not null;index:idx_audit_logs_entity" json:"entity_id"`
+	Before     string      `gorm:"type:text" json:"before"`  // JSON snapshot before the change
+	After      string      `gorm:"type:text" json:"after"`   // JSON snapshot after the change
+	Changes    string      `gorm:"type:text" json:"changes"` // JSON diff of changed fields
+	IPAddress  string      `gorm:"size:45" json:"ip_address"`
+	RequestID  string      `gorm:"size:64;index" json:"request_id"`
+	CreatedAt  time.Time   `gorm:"index" json:"created_at"`
+}
+
+// TableName returns the table name for AuditLog entity
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
+
+// BeforeCreate is a GORM hook to set UUID before creating a record
+func (a *AuditLog) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package entity
+
+import "github.com/google/uuid"
+
+// CardType represents the colour of a card shown to a player
+type CardType string
+
+const (
+	CardYellow CardType = "yellow"
+	CardRed    CardType = "red"
+)
+
+// IsValidCardType checks if a card type is valid
+func IsValidCardType(cardType CardType) bool {
+	return cardType == CardYellow || cardType == CardRed
+}
+
+// Card represents a card shown to a player in a match
+type Card struct {
+	BaseEntity
+	MatchID  uuid.UUID `gorm:"type:uuid;not null;index" json:"match_id"`
+	PlayerID uuid.UUID `gorm:"type:uuid;not null;index" json:"player_id"`
+	TeamID   uuid.UUID `gorm:"type:uuid;not null;index" json:"team_id"`
+	Minute   int       `gorm:"not null" json:"minute"`
+	Type     CardType  `gorm:"type:varchar(10);not null" json:"type"`
+	Match    *Match    `gorm:"foreignKey:MatchID" json:"match,omitempty"`
+	Player   *Player   `gorm:"foreignKey:PlayerID" json:"player,omitempty"`
+	Team     *Team     `gorm:"foreignKey:TeamID" json:"team,omitempty"`
+}
+
+// TableName returns the table name for Card entity
+func (Card) TableName() string {
+	return "cards"
+}
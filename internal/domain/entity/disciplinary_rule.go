@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DisciplinaryRule sets how cards turn into suspensions within a season.
+// Every YellowCardLimit yellow cards ban a player for one match, and a red
+// card bans them for RedCardBan matches. A season without a rule has no
+// suspensions. Rules are replaced rather than deleted softly, so it does not
+// embed BaseEntity.
+type DisciplinaryRule struct {
+	ID              uuid.UUID `gorm:"type:uuid;primary_key" json:"id"`
+	Season          string    `gorm:"not null;size:9;uniqueIndex:uq_disciplinary_rules_season" json:"season"` // Season name, such as 2024 or 2024-2025
+	YellowCardLimit int       `gorm:"not null" json:"yellow_card_limit"`
+	RedCardBan      int       `gorm:"not null" json:"red_card_ban"` // Matches
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// TableName returns the table name for DisciplinaryRule entity
+func (DisciplinaryRule) TableName() string {
+	return "disciplinary_rules"
+}
+
+// BeforeCreate is a GORM hook to set UUID before creating a record
+func (r *DisciplinaryRule) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // MatchStatus represents the status of a match
@@ -19,16 +20,19 @@ const (
 // Match represents a football match between two teams
 type Match struct {
 	BaseEntity
-	MatchDate    time.Time   `gorm:"not null;index" json:"match_date"`
-	MatchTime    string      `gorm:"not null;size:10" json:"match_time"` // Format: HH:MM
+	KickoffAt    time.Time   `gorm:"not null;index" json:"kickoff_at"` // Always UTC
+	Timezone     string      `gorm:"not null;size:64;default:'Asia/Jakarta'" json:"timezone"` // IANA name, such as Asia/Jakarta
 	HomeTeamID   uuid.UUID   `gorm:"type:uuid;not null;index" json:"home_team_id"`
 	AwayTeamID   uuid.UUID   `gorm:"type:uuid;not null;index" json:"away_team_id"`
+	VenueID      *uuid.UUID  `gorm:"type:uuid;index" json:"venue_id"`
 	HomeScore    *int        `gorm:"default:null" json:"home_score"`
 	AwayScore    *int        `gorm:"default:null" json:"away_score"`
 	Status       MatchStatus `gorm:"type:varchar(20);default:'scheduled'" json:"status"`
 	HomeTeam     *Team       `gorm:"foreignKey:HomeTeamID" json:"home_team,omitempty"`
 	AwayTeam     *Team       `gorm:"foreignKey:AwayTeamID" json:"away_team,omitempty"`
+	Venue        *Venue      `gorm:"foreignKey:VenueID" json:"venue,omitempty"`
 	Goals        []Goal      `gorm:"foreignKey:MatchID" json:"goals,omitempty"`
+	Cards        []Card      `gorm:"foreignKey:MatchID" json:"cards,omitempty"`
 }
 
 // TableName returns the table name for Match entity
@@ -36,6 +40,27 @@ func (Match) TableName() string {
 	return "matches"
 }
 
+// BeforeSave is a GORM hook that stores the kickoff in UTC, so that kickoff
+// times compare correctly in databases that store them as text
+func (m *Match) BeforeSave(tx *gorm.DB) error {
+	m.KickoffAt = m.KickoffAt.UTC()
+	return nil
+}
+
+// Location returns the time zone of the match. Timezone is validated when a
+// match is saved, so UTC is only a fallback for rows edited by hand.
+func (m *Match) Location() *time.Location {
+	if loc, err := LoadLocation(m.Timezone); err == nil {
+		return loc
+	}
+	return time.UTC
+}
+
+// LocalKickoff returns the kickoff in the time zone of the match
+func (m *Match) LocalKickoff() time.Time {
+	return m.KickoffAt.In(m.Location())
+}
+
 // MatchResult represents the result of a match
 type MatchResult string
 
@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// LicenseLevel represents the highest level an official is licensed for
+type LicenseLevel string
+
+const (
+	LicenseFIFA     LicenseLevel = "fifa"     // Wasit FIFA
+	LicenseNational LicenseLevel = "national" // Wasit Nasional
+	LicenseRegional LicenseLevel = "regional" // Wasit Daerah
+)
+
+// Official represents a referee who can be appointed to matches
+type Official struct {
+	BaseEntity
+	Name         string       `gorm:"not null;size:255" json:"name"`
+	LicenseLevel LicenseLevel `gorm:"type:varchar(20);not null" json:"license_level"`
+	City         string       `gorm:"size:100" json:"city"`
+}
+
+// TableName returns the table name for Official entity
+func (Official) TableName() string {
+	return "officials"
+}
+
+// ValidLicenseLevels returns all valid license levels
+func ValidLicenseLevels() []LicenseLevel {
+	return []LicenseLevel{
+		LicenseFIFA,
+		LicenseNational,
+		LicenseRegional,
+	}
+}
+
+// IsValidLicenseLevel checks if a license level is valid
+func IsValidLicenseLevel(level LicenseLevel) bool {
+	for _, l := range ValidLicenseLevels() {
+		if l == level {
+			return true
+		}
+	}
+	return false
+}
+
+// OfficialRole represents the role of an official in a match
+type OfficialRole string
+
+const (
+	OfficialRoleReferee    OfficialRole = "referee"
+	OfficialRoleAssistant1 OfficialRole = "assistant_1"
+	OfficialRoleAssistant2 OfficialRole = "assistant_2"
+	OfficialRoleFourth     OfficialRole = "fourth_official"
+)
+
+// ValidOfficialRoles returns all valid official roles
+func ValidOfficialRoles() []OfficialRole {
+	return []OfficialRole{
+		OfficialRoleReferee,
+		OfficialRoleAssistant1,
+		OfficialRoleAssistant2,
+		OfficialRoleFourth,
+	}
+}
+
+// IsValidOfficialRole checks if an official role is valid
+func IsValidOfficialRole(role OfficialRole) bool {
+	for _, r := range ValidOfficialRoles() {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchOfficial appoints an official to a match in one role. The officials
+// of a match are replaced as a whole, so it does not embed BaseEntity (no
+// updates, no soft delete).
+type MatchOfficial struct {
+	ID         uuid.UUID    `gorm:"type:uuid;primary_key" json:"id"`
+	MatchID    uuid.UUID    `gorm:"type:uuid;not null;uniqueIndex:uq_match_officials_role;uniqueIndex:uq_match_officials_official" json:"match_id"`
+	OfficialID uuid.UUID    `gorm:"type:uuid;not null;index;uniqueIndex:uq_match_officials_official" json:"official_id"`
+	Role       OfficialRole `gorm:"type:varchar(20);not null;uniqueIndex:uq_match_officials_role" json:"role"`
+	CreatedAt  time.Time    `json:"created_at"`
+	Match      *Match       `gorm:"foreignKey:MatchID" json:"match,omitempty"`
+	Official   *Official    `gorm:"foreignKey:OfficialID" json:"official,omitempty"`
+}
+
+// TableName returns the table name for MatchOfficial entity
+func (MatchOfficial) TableName() string {
+	return "match_officials"
+}
+
+// BeforeCreate is a GORM hook to set UUID before creating a record
+func (m *MatchOfficial) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
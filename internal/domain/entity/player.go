@@ -1,6 +1,15 @@
 package entity
 
-import "github.com/google/uuid"
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
 
 // PlayerPosition represents the position of a player
 type PlayerPosition string
@@ -12,16 +21,30 @@ const (
 	PositionGoalkeeper PlayerPosition = "goalkeeper"  // Penjaga Gawang
 )
 
+// PreferredFoot represents the foot a player prefers to kick with
+type PreferredFoot string
+
+const (
+	FootLeft  PreferredFoot = "left"
+	FootRight PreferredFoot = "right"
+	FootBoth  PreferredFoot = "both"
+)
+
 // Player represents a football player
 type Player struct {
 	BaseEntity
-	TeamID       uuid.UUID      `gorm:"type:uuid;not null;index" json:"team_id"`
-	Name         string         `gorm:"not null;size:255" json:"name"`
-	Height       float64        `gorm:"not null" json:"height"` // in cm
-	Weight       float64        `gorm:"not null" json:"weight"` // in kg
-	Position     PlayerPosition `gorm:"type:varchar(20);not null" json:"position"`
-	JerseyNumber int            `gorm:"not null" json:"jersey_number"`
-	Team         *Team          `gorm:"foreignKey:TeamID" json:"team,omitempty"`
+	TeamID             uuid.UUID       `gorm:"type:uuid;not null;index" json:"team_id"`
+	Name               string          `gorm:"not null;size:255" json:"name"`
+	Height             float64         `gorm:"not null" json:"height"` // in cm
+	Weight             float64         `gorm:"not null" json:"weight"` // in kg
+	Position           PlayerPosition  `gorm:"type:varchar(20);not null" json:"position"`
+	SecondaryPositions PlayerPositions `gorm:"type:varchar(100);not null;default:''" json:"secondary_positions"`
+	JerseyNumber       int             `gorm:"not null" json:"jersey_number"`
+	DateOfBirth        *time.Time      `gorm:"type:date" json:"date_of_birth"`                // Calendar date, midnight UTC
+	Nationality        string          `gorm:"size:2;not null;default:''" json:"nationality"` // ISO 3166-1 alpha-2
+	PreferredFoot      PreferredFoot   `gorm:"type:varchar(5);not null;default:''" json:"preferred_foot"`
+	Photo              string          `gorm:"size:500;not null;default:''" json:"photo"`
+	Team               *Team           `gorm:"foreignKey:TeamID" json:"team,omitempty"`
 }
 
 // TableName returns the table name for Player entity
@@ -29,6 +52,43 @@ func (Player) TableName() string {
 	return "players"
 }
 
+// BeforeSave is a GORM hook that stores the date of birth as midnight UTC,
+// so it compares as a calendar date whatever zone it was parsed in
+func (p *Player) BeforeSave(tx *gorm.DB) error {
+	p.DateOfBirth = CalendarDate(p.DateOfBirth)
+	return nil
+}
+
+// AgeOn returns the age of the player in whole years on the calendar date
+// of on. It reports false when the date of birth is unknown.
+func (p *Player) AgeOn(on time.Time) (int, bool) {
+	if p.DateOfBirth == nil {
+		return 0, false
+	}
+	born := *p.DateOfBirth
+	age := on.Year() - born.Year()
+	if on.Month() < born.Month() || (on.Month() == born.Month() && on.Day() < born.Day()) {
+		age--
+	}
+	return age, true
+}
+
+// IsEligibleFor reports whether the player may play in an age group whose
+// cut-off date is on. Players without a date of birth are not eligible.
+func (p *Player) IsEligibleFor(group AgeGroup, on time.Time) bool {
+	age, ok := p.AgeOn(on)
+	return ok && age < group.MaxAge
+}
+
+// CalendarDate returns midnight UTC of the calendar date of t, or nil
+func CalendarDate(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return &date
+}
+
 // ValidPositions returns all valid player positions
 func ValidPositions() []PlayerPosition {
 	return []PlayerPosition{
@@ -48,3 +108,92 @@ func IsValidPosition(position PlayerPosition) bool {
 	}
 	return false
 }
+
+// IsValidPreferredFoot checks if a preferred foot is valid. Empty means unknown.
+func IsValidPreferredFoot(foot PreferredFoot) bool {
+	switch foot {
+	case "", FootLeft, FootRight, FootBoth:
+		return true
+	}
+	return false
+}
+
+// PlayerPositions is a list of positions, stored as comma-separated text
+type PlayerPositions []PlayerPosition
+
+// Contains reports whether the list holds position
+func (p PlayerPositions) Contains(position PlayerPosition) bool {
+	for _, candidate := range p {
+		if candidate == position {
+			return true
+		}
+	}
+	return false
+}
+
+// Value implements driver.Valuer
+func (p PlayerPositions) Value() (driver.Value, error) {
+	names := make([]string, len(p))
+	for i, position := range p {
+		names[i] = string(position)
+	}
+	return strings.Join(names, ","), nil
+}
+
+// Scan implements sql.Scanner
+func (p *PlayerPositions) Scan(value interface{}) error {
+	var text string
+	switch v := value.(type) {
+	case nil:
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into PlayerPositions", value)
+	}
+
+	*p = PlayerPositions{}
+	for _, name := range strings.Split(text, ",") {
+		if name != "" {
+			*p = append(*p, PlayerPosition(name))
+		}
+	}
+	return nil
+}
+
+// AgeGroup is an age-restricted category such as U-21: players must be
+// younger than MaxAge on the cut-off date of the competition
+type AgeGroup struct {
+	MaxAge int
+}
+
+// Age groups range from U-6 to U-23
+const (
+	minAgeGroup = 6
+	maxAgeGroup = 23
+)
+
+// ParseAgeGroup parses an age group written as U21, U-21 or u21
+func ParseAgeGroup(name string) (AgeGroup, bool) {
+	upper := strings.ToUpper(strings.TrimSpace(name))
+	if !strings.HasPrefix(upper, "U") {
+		return AgeGroup{}, false
+	}
+	maxAge, err := strconv.Atoi(strings.TrimPrefix(upper[1:], "-"))
+	if err != nil || maxAge < minAgeGroup || maxAge > maxAgeGroup {
+		return AgeGroup{}, false
+	}
+	return AgeGroup{MaxAge: maxAge}, true
+}
+
+// String returns the age group as U-21
+func (g AgeGroup) String() string {
+	return "U-" + strconv.Itoa(g.MaxAge)
+}
+
+// BornAfter returns the date players of the age group must be born after to
+// be eligible on the calendar date of on
+func (g AgeGroup) BornAfter(on time.Time) time.Time {
+	return CalendarDate(&on).AddDate(-g.MaxAge, 0, 0)
+}
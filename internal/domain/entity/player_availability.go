@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AvailabilityStatus represents why a player is unavailable
+type AvailabilityStatus string
+
+const (
+	AvailabilityInjured           AvailabilityStatus = "injured"
+	AvailabilityIll               AvailabilityStatus = "ill"
+	AvailabilityInternationalDuty AvailabilityStatus = "international_duty"
+	AvailabilityPersonal          AvailabilityStatus = "personal"
+	AvailabilityOther             AvailabilityStatus = "other"
+)
+
+// IsValidAvailabilityStatus checks if an availability status is valid
+func IsValidAvailabilityStatus(status AvailabilityStatus) bool {
+	switch status {
+	case AvailabilityInjured, AvailabilityIll, AvailabilityInternationalDuty, AvailabilityPersonal, AvailabilityOther:
+		return true
+	}
+	return false
+}
+
+// PlayerAvailability is a period in which a player cannot play. It lasts
+// until the player returns or, while they have not, until the expected
+// return; without either it is open-ended.
+type PlayerAvailability struct {
+	BaseEntity
+	PlayerID         uuid.UUID          `gorm:"type:uuid;not null;index" json:"player_id"`
+	Status           AvailabilityStatus `gorm:"type:varchar(20);not null" json:"status"`
+	Note             string             `gorm:"size:255" json:"note"`
+	StartsAt         time.Time          `gorm:"not null" json:"starts_at"`              // Always UTC
+	ExpectedReturnAt *time.Time         `gorm:"default:null" json:"expected_return_at"` // Always UTC
+	ReturnedAt       *time.Time         `gorm:"default:null" json:"returned_at"`        // Always UTC
+	Player           *Player            `gorm:"foreignKey:PlayerID" json:"player,omitempty"`
+}
+
+// TableName returns the table name for PlayerAvailability entity
+func (PlayerAvailability) TableName() string {
+	return "player_availabilities"
+}
+
+// BeforeSave is a GORM hook that stores the period in UTC, like
+// Match.BeforeSave
+func (a *PlayerAvailability) BeforeSave(tx *gorm.DB) error {
+	a.StartsAt = a.StartsAt.UTC()
+	if a.ExpectedReturnAt != nil {
+		expected := a.ExpectedReturnAt.UTC()
+		a.ExpectedReturnAt = &expected
+	}
+	if a.ReturnedAt != nil {
+		returned := a.ReturnedAt.UTC()
+		a.ReturnedAt = &returned
+	}
+	return nil
+}
+
+// EndsAt returns when the player is available again: when they returned,
+// otherwise when they are expected back, or nil when that is unknown
+func (a *PlayerAvailability) EndsAt() *time.Time {
+	if a.ReturnedAt != nil {
+		return a.ReturnedAt
+	}
+	return a.ExpectedReturnAt
+}
+
+// CoversTime reports whether the player is unavailable at the given time
+func (a *PlayerAvailability) CoversTime(at time.Time) bool {
+	if at.Before(a.StartsAt) {
+		return false
+	}
+	end := a.EndsAt()
+	return end == nil || at.Before(*end)
+}
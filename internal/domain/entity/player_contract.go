@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PlayerContract is a spell of a player at a team. Contracts are the
+// membership history of a player: a transfer ends the current contract and
+// starts a new one, so it does not embed BaseEntity (no soft delete).
+//
+// The first contract of a player has no start and covers every match before
+// their first transfer. The current contract has no end.
+type PlayerContract struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primary_key" json:"id"`
+	PlayerID     uuid.UUID  `gorm:"type:uuid;not null;index" json:"player_id"`
+	TeamID       uuid.UUID  `gorm:"type:uuid;not null;index" json:"team_id"`
+	JerseyNumber int        `gorm:"not null" json:"jersey_number"`
+	StartsAt     *time.Time `gorm:"default:null" json:"starts_at"` // Always UTC
+	EndsAt       *time.Time `gorm:"default:null" json:"ends_at"`   // Always UTC, exclusive
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	Player       *Player    `gorm:"foreignKey:PlayerID" json:"player,omitempty"`
+	Team         *Team      `gorm:"foreignKey:TeamID" json:"team,omitempty"`
+}
+
+// TableName returns the table name for PlayerContract entity
+func (PlayerContract) TableName() string {
+	return "player_contracts"
+}
+
+// BeforeCreate is a GORM hook to set UUID before creating a record
+func (c *PlayerContract) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
+
+// BeforeSave is a GORM hook that stores the start and end in UTC, like
+// Match.BeforeSave
+func (c *PlayerContract) BeforeSave(tx *gorm.DB) error {
+	if c.StartsAt != nil {
+		start := c.StartsAt.UTC()
+		c.StartsAt = &start
+	}
+	if c.EndsAt != nil {
+		end := c.EndsAt.UTC()
+		c.EndsAt = &end
+	}
+	return nil
+}
+
+// IsCurrent reports whether the contract has not ended
+func (c *PlayerContract) IsCurrent() bool {
+	return c.EndsAt == nil
+}
+
+// Covers reports whether the player belonged to the team at the given time
+func (c *PlayerContract) Covers(at time.Time) bool {
+	if c.StartsAt != nil && at.Before(*c.StartsAt) {
+		return false
+	}
+	return c.EndsAt == nil || at.Before(*c.EndsAt)
+}
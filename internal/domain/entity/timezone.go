@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"errors"
+	"sync"
+	"time"
+	// Embed the time zone database so that zones resolve on hosts and
+	// containers without one
+	_ "time/tzdata"
+)
+
+// DefaultTimezone is the time zone of matches created without one. Kickoff
+// times stored before time zones were recorded are read in this zone too.
+const DefaultTimezone = "Asia/Jakarta"
+
+var errServerTimezone = errors.New(`time zone must be an IANA name, not empty or "Local"`)
+
+// locations caches loaded time zones by name
+var locations sync.Map
+
+// LoadLocation returns the time zone with the given IANA name, such as
+// Asia/Jakarta or UTC. The empty name and "Local" are rejected because they
+// mean the server's zone.
+func LoadLocation(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	if name == "" || name == "Local" {
+		return nil, errServerTimezone
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// Today returns the current calendar date in the default time zone, as
+// midnight UTC like the other calendar dates
+func Today() time.Time {
+	now := time.Now()
+	if loc, err := LoadLocation(DefaultTimezone); err == nil {
+		now = now.In(loc)
+	}
+	return *CalendarDate(&now)
+}
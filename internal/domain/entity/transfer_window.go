@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// TransferWindow is a period of a season in which players may change teams.
+// A season without windows has no transfer restrictions.
+type TransferWindow struct {
+	BaseEntity
+	Season   string    `gorm:"not null;size:9;index" json:"season"` // Season name, such as 2024 or 2024-2025
+	Name     string    `gorm:"size:100" json:"name"`
+	OpensAt  time.Time `gorm:"not null" json:"opens_at"`  // Always UTC
+	ClosesAt time.Time `gorm:"not null" json:"closes_at"` // Always UTC, exclusive
+}
+
+// TableName returns the table name for TransferWindow entity
+func (TransferWindow) TableName() string {
+	return "transfer_windows"
+}
+
+// BeforeSave is a GORM hook that stores the window in UTC, like
+// Match.BeforeSave
+func (w *TransferWindow) BeforeSave(tx *gorm.DB) error {
+	w.OpensAt = w.OpensAt.UTC()
+	w.ClosesAt = w.ClosesAt.UTC()
+	return nil
+}
+
+// IsOpenAt reports whether the window is open at the given time
+func (w *TransferWindow) IsOpenAt(at time.Time) bool {
+	return !at.Before(w.OpensAt) && at.Before(w.ClosesAt)
+}
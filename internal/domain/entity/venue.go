@@ -0,0 +1,17 @@
+package entity
+
+// Venue represents a stadium or ground where matches are played
+type Venue struct {
+	BaseEntity
+	Name      string   `gorm:"not null;size:255" json:"name"`
+	Address   string   `gorm:"size:500" json:"address"`
+	City      string   `gorm:"not null;size:100" json:"city"`
+	Capacity  int      `gorm:"not null" json:"capacity"`
+	Latitude  *float64 `gorm:"default:null" json:"latitude"`
+	Longitude *float64 `gorm:"default:null" json:"longitude"`
+}
+
+// TableName returns the table name for Venue entity
+func (Venue) TableName() string {
+	return "venues"
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// AuditLogFilter holds the optional criteria for listing audit entries
+type AuditLogFilter struct {
+	EntityType string
+	EntityID   *uuid.UUID
+	ActorID    *uuid.UUID
+	From       *time.Time
+	To         *time.Time
+}
+
+// AuditRepository defines the interface for audit log data operations
+type AuditRepository interface {
+	Create(ctx context.Context, log *entity.AuditLog) error
+	FindAll(ctx context.Context, filter AuditLogFilter, page, limit int) ([]entity.AuditLog, int64, error)
+}
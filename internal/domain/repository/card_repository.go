@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// CardRepository defines the interface for card data operations
+type CardRepository interface {
+	CreateBatch(ctx context.Context, cards []entity.Card) error
+	FindByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.Card, error)
+	// FindCompletedBetween returns the cards of the completed matches kicking
+	// off at or after from and before to, with their match, player and team,
+	// in the order they were shown
+	FindCompletedBetween(ctx context.Context, from, to time.Time) ([]entity.Card, error)
+	DeleteByMatchID(ctx context.Context, matchID uuid.UUID) error
+	PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error)
+}
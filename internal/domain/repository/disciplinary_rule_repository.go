@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// DisciplinaryRuleRepository defines the interface for disciplinary rule data operations
+type DisciplinaryRuleRepository interface {
+	Create(ctx context.Context, rule *entity.DisciplinaryRule) error
+	Update(ctx context.Context, rule *entity.DisciplinaryRule) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindBySeason(ctx context.Context, season string) (*entity.DisciplinaryRule, error)
+	// FindBySeasons returns the rules of any of the seasons
+	FindBySeasons(ctx context.Context, seasons []string) ([]entity.DisciplinaryRule, error)
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+)
+
+// Errors returned by repository implementations when a write violates a
+// database constraint. Use cases translate them into their domain errors.
+var (
+	ErrDuplicateJerseyNumber = errors.New("jersey number is already used by an active player of this team")
+	ErrDuplicateEmail        = errors.New("email is already registered")
+	ErrSameTeams             = errors.New("home team and away team must differ")
+	ErrNegativeScore         = errors.New("score cannot be negative")
+	ErrDuplicateAppointment  = errors.New("official or role is already appointed to this match")
+	ErrDuplicateContract     = errors.New("player already has a current contract")
+	ErrDuplicateKey          = errors.New("duplicate key")
+	ErrCheckViolation        = errors.New("check constraint violated")
+	ErrReferenceNotFound     = errors.New("referenced record does not exist")
+	ErrStillReferenced       = errors.New("record is still referenced by other records")
+)
+
+// Check constraints whose violation use cases report as distinct errors
+const (
+	ConstraintPlayersJerseyNumber  = "chk_players_jersey_number"
+	ConstraintPlayersPreferredFoot = "chk_players_preferred_foot"
+)
+
+// IsCheckViolation reports whether err is an ErrCheckViolation of the named
+// constraint. Implementations append the constraint name to the error.
+func IsCheckViolation(err error, constraint string) bool {
+	return errors.Is(err, ErrCheckViolation) && strings.HasSuffix(err.Error(), ": "+constraint)
+}
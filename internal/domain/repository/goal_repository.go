@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
@@ -16,7 +17,13 @@ type GoalRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	FindByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.Goal, error)
 	FindByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.Goal, error)
+	// FindInBatches streams the active goals of active matches with their
+	// match, player and team, like TeamRepository.FindInBatches
+	FindInBatches(ctx context.Context, batchSize int, fn func(goals []entity.Goal) error) error
 	DeleteByMatchID(ctx context.Context, matchID uuid.UUID) error
+	PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error)
+	// GetTopScorers returns the players with the most goals, excluding own
+	// goals. A negative limit returns every scorer.
 	GetTopScorers(ctx context.Context, limit int) ([]TopScorerResult, error)
 }
 
@@ -6,20 +6,105 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
 )
 
 // MatchRepository defines the interface for match data operations
 type MatchRepository interface {
 	Create(ctx context.Context, match *entity.Match) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Match, error)
+	// FindByIDWithDetails finds an active match with every related resource
+	// of MatchListSchema. Goals and cards are ordered by minute.
 	FindByIDWithDetails(ctx context.Context, id uuid.UUID) (*entity.Match, error)
+	// FindByIDWith is FindByIDWithDetails with only the related resources of
+	// include
+	FindByIDWith(ctx context.Context, id uuid.UUID, include listquery.Include) (*entity.Match, error)
 	Update(ctx context.Context, match *entity.Match) error
 	Delete(ctx context.Context, id uuid.UUID) error
-	FindAll(ctx context.Context, page, limit int) ([]entity.Match, int64, error)
-	FindByDateRange(ctx context.Context, startDate, endDate time.Time, page, limit int) ([]entity.Match, int64, error)
+	// List returns a page of the active matches matching the query with the
+	// related resources it includes, see MatchListSchema
+	List(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Match, int64, error)
+	// Scroll returns the slice of the same list selected by a cursor window
+	Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Match], error)
+	// FindByDateRange returns the matches kicking off at or after from and
+	// before to, earliest first
+	FindByDateRange(ctx context.Context, from, to time.Time, page, limit int) ([]entity.Match, int64, error)
 	FindByTeamID(ctx context.Context, teamID uuid.UUID, page, limit int) ([]entity.Match, int64, error)
-	FindByStatus(ctx context.Context, status entity.MatchStatus, page, limit int) ([]entity.Match, int64, error)
+	// FindScheduledAtVenue returns the matches at the venue that are not
+	// cancelled and kick off at or after from and before to, earliest first
+	FindScheduledAtVenue(ctx context.Context, venueID uuid.UUID, from, to time.Time) ([]entity.Match, error)
+	// FindScheduledForTeams is FindScheduledAtVenue for the matches that
+	// involve any of the teams, home or away
+	FindScheduledForTeams(ctx context.Context, teamIDs []uuid.UUID, from, to time.Time) ([]entity.Match, error)
+	// FindScheduled is FindScheduledAtVenue for the matches at any venue
+	FindScheduled(ctx context.Context, from, to time.Time) ([]entity.Match, error)
 	Exists(ctx context.Context, id uuid.UUID) (bool, error)
+	// FindInBatches streams active matches with both teams, like TeamRepository.FindInBatches
+	FindInBatches(ctx context.Context, batchSize int, fn func(matches []entity.Match) error) error
+	FindDeleted(ctx context.Context, page, limit int) ([]entity.Match, int64, error)
+	Restore(ctx context.Context, id uuid.UUID) error
+	Purge(ctx context.Context, id uuid.UUID) error
+	PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error)
 	GetTeamWinCount(ctx context.Context, teamID uuid.UUID, isHome bool) (int64, error)
 	GetCompletedMatches(ctx context.Context, page, limit int) ([]entity.Match, int64, error)
 }
+
+// MatchListSchema lists the fields matches can be filtered and sorted by,
+// and the related resources they can be loaded with. team_id matches the
+// home or the away team, and match_date sorts like kickoff_at.
+var MatchListSchema = listquery.Schema{
+	Fields: map[string]listquery.Field{
+		"status": {
+			Type:      listquery.String,
+			Operators: listquery.Equality,
+			Sortable:  true,
+			Values:    []string{string(entity.MatchStatusScheduled), string(entity.MatchStatusOngoing), string(entity.MatchStatusCompleted), string(entity.MatchStatusCancelled)},
+		},
+		"team_id":        {Type: listquery.UUID, Operators: []listquery.Operator{listquery.Eq, listquery.In}},
+		"home_team_id":   {Type: listquery.UUID, Operators: listquery.Equality},
+		"away_team_id":   {Type: listquery.UUID, Operators: listquery.Equality},
+		"venue_id":       {Type: listquery.UUID, Operators: listquery.Equality},
+		"kickoff_at":     {Type: listquery.Time, Operators: listquery.Range, Sortable: true},
+		"match_date":     {Type: listquery.Time, Sortable: true},
+		"created_at":     {Type: listquery.Time, Operators: listquery.Range, Sortable: true},
+		"home_team.name": {Type: listquery.String, Sortable: true},
+		"away_team.name": {Type: listquery.String, Sortable: true},
+	},
+	DefaultSort: []listquery.Order{listquery.Desc("kickoff_at")},
+	Relations: []string{
+		"home_team", "away_team", "venue",
+		"goals", "goals.player", "goals.team",
+		"cards", "cards.player", "cards.team",
+	},
+}
+
+// MatchListReader reads the fields of MatchListSchema from a match loaded with its teams
+var MatchListReader = listquery.Reader[entity.Match]{
+	Fields: map[string]func(entity.Match) interface{}{
+		"status":       func(m entity.Match) interface{} { return string(m.Status) },
+		"home_team_id": func(m entity.Match) interface{} { return m.HomeTeamID },
+		"away_team_id": func(m entity.Match) interface{} { return m.AwayTeamID },
+		"venue_id": func(m entity.Match) interface{} {
+			if m.VenueID == nil {
+				return nil
+			}
+			return *m.VenueID
+		},
+		"kickoff_at": func(m entity.Match) interface{} { return m.KickoffAt },
+		"match_date": func(m entity.Match) interface{} { return m.KickoffAt },
+		"created_at": func(m entity.Match) interface{} { return m.CreatedAt },
+		"home_team.name": func(m entity.Match) interface{} {
+			if m.HomeTeam == nil {
+				return nil
+			}
+			return m.HomeTeam.Name
+		},
+		"away_team.name": func(m entity.Match) interface{} {
+			if m.AwayTeam == nil {
+				return nil
+			}
+			return m.AwayTeam.Name
+		},
+	},
+	ID: func(m entity.Match) uuid.UUID { return m.ID },
+}
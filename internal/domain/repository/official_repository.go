@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+)
+
+// OfficialRepository defines the interface for official data operations
+type OfficialRepository interface {
+	Create(ctx context.Context, official *entity.Official) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Official, error)
+	Update(ctx context.Context, official *entity.Official) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// List returns a page of the active officials matching the query, see
+	// OfficialListSchema
+	List(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Official, int64, error)
+	// Scroll returns the slice of the same list selected by a cursor window
+	Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Official], error)
+	// FindAvailable returns the officials that are not appointed to an
+	// active, non-cancelled match kicking off strictly between from and to.
+	// Appointments to exceptMatchID are ignored.
+	FindAvailable(ctx context.Context, from, to time.Time, exceptMatchID uuid.UUID, page, limit int) ([]entity.Official, int64, error)
+	Exists(ctx context.Context, id uuid.UUID) (bool, error)
+}
+
+// OfficialListSchema lists the fields officials can be filtered and sorted
+// by. The search matches the name and city.
+var OfficialListSchema = listquery.Schema{
+	Fields: map[string]listquery.Field{
+		"name": {Type: listquery.String, Operators: listquery.Text, Sortable: true},
+		"city": {Type: listquery.String, Operators: listquery.Text, Sortable: true},
+		"license_level": {
+			Type:      listquery.String,
+			Operators: listquery.Equality,
+			Sortable:  true,
+			Values:    []string{string(entity.LicenseFIFA), string(entity.LicenseNational), string(entity.LicenseRegional)},
+		},
+		"created_at": {Type: listquery.Time, Operators: listquery.Range, Sortable: true},
+	},
+	Search:      []string{"name", "city"},
+	DefaultSort: []listquery.Order{listquery.Asc("name")},
+}
+
+// OfficialListReader reads the fields of OfficialListSchema from an official
+var OfficialListReader = listquery.Reader[entity.Official]{
+	Fields: map[string]func(entity.Official) interface{}{
+		"name":          func(o entity.Official) interface{} { return o.Name },
+		"city":          func(o entity.Official) interface{} { return o.City },
+		"license_level": func(o entity.Official) interface{} { return string(o.LicenseLevel) },
+		"created_at":    func(o entity.Official) interface{} { return o.CreatedAt },
+	},
+	ID: func(o entity.Official) uuid.UUID { return o.ID },
+}
+
+// MatchOfficialRepository defines the interface for match appointments
+type MatchOfficialRepository interface {
+	// FindByMatchID returns the appointments of a match with their official,
+	// including officials that have since been deleted
+	FindByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.MatchOfficial, error)
+	// ReplaceForMatch atomically replaces every appointment of a match
+	ReplaceForMatch(ctx context.Context, matchID uuid.UUID, officials []entity.MatchOfficial) error
+	// FindScheduledForOfficials returns the appointments of the given
+	// officials to active, non-cancelled matches kicking off strictly between
+	// from and to, with their match
+	FindScheduledForOfficials(ctx context.Context, officialIDs []uuid.UUID, from, to time.Time) ([]entity.MatchOfficial, error)
+	// GetOfficialStats counts the active matches an official was appointed
+	// to, and the cards shown in the matches they refereed
+	GetOfficialStats(ctx context.Context, officialID uuid.UUID) (*OfficialStats, error)
+}
+
+// OfficialStats represents the appointment and card statistics of an official
+type OfficialStats struct {
+	Matches     int64
+	MatchesBy   map[entity.OfficialRole]int64
+	YellowCards int64
+	RedCards    int64
+}
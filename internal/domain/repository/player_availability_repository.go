@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// PlayerAvailabilityRepository defines the interface for player availability data operations
+type PlayerAvailabilityRepository interface {
+	Create(ctx context.Context, availability *entity.PlayerAvailability) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.PlayerAvailability, error)
+	Update(ctx context.Context, availability *entity.PlayerAvailability) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// FindByPlayerID returns the records of a player, latest first
+	FindByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.PlayerAvailability, error)
+	// FindUnavailableAt returns the records that make any of the players
+	// unavailable at the given time, with their player, ordered by start
+	FindUnavailableAt(ctx context.Context, playerIDs []uuid.UUID, at time.Time) ([]entity.PlayerAvailability, error)
+}
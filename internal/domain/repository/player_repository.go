@@ -2,26 +2,121 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/pkg/iso3166"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+	"github.com/zenkriztao/ayo-football-backend/pkg/textsearch"
 )
 
 // PlayerRepository defines the interface for player data operations
 type PlayerRepository interface {
 	Create(ctx context.Context, player *entity.Player) error
+	// CreateBatch stores all players or, if any of them violates a constraint, none
+	CreateBatch(ctx context.Context, players []entity.Player) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Player, error)
 	FindByIDWithTeam(ctx context.Context, id uuid.UUID) (*entity.Player, error)
+	// FindByIDWith finds an active player with the related resources of
+	// include, see PlayerListSchema
+	FindByIDWith(ctx context.Context, id uuid.UUID, include listquery.Include) (*entity.Player, error)
 	Update(ctx context.Context, player *entity.Player) error
 	Delete(ctx context.Context, id uuid.UUID) error
-	FindAll(ctx context.Context, page, limit int) ([]entity.Player, int64, error)
+	// List returns a page of the active players matching the query with the
+	// related resources it includes, see PlayerListSchema
+	List(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Player, int64, error)
+	// Scroll returns the slice of the same list selected by a cursor window
+	Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Player], error)
+	// Search returns up to limit active players whose name best match text,
+	// best first. It folds case and accents and tolerates typos.
+	Search(ctx context.Context, text string, limit int) ([]textsearch.Hit[entity.Player], error)
 	FindByTeamID(ctx context.Context, teamID uuid.UUID, page, limit int) ([]entity.Player, int64, error)
 	IsJerseyNumberTaken(ctx context.Context, teamID uuid.UUID, jerseyNumber int, excludePlayerID *uuid.UUID) (bool, error)
-	Search(ctx context.Context, query string, page, limit int) ([]entity.Player, int64, error)
 	Exists(ctx context.Context, id uuid.UUID) (bool, error)
+	// FindInBatches streams active players with their team, like TeamRepository.FindInBatches
+	FindInBatches(ctx context.Context, batchSize int, fn func(players []entity.Player) error) error
+	FindDeleted(ctx context.Context, page, limit int) ([]entity.Player, int64, error)
+	Restore(ctx context.Context, id uuid.UUID) error
+	Purge(ctx context.Context, id uuid.UUID) error
+	PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error)
 	GetTopScorers(ctx context.Context, limit int) ([]PlayerGoalCount, error)
 }
 
+// PlayerListSchema lists the fields players can be filtered and sorted by.
+// A position matches the primary or a secondary position, and the date of
+// birth is compared as a calendar date. The search matches the name.
+var PlayerListSchema = listquery.Schema{
+	Fields: map[string]listquery.Field{
+		"name":    {Type: listquery.String, Operators: listquery.Text, Sortable: true},
+		"team_id": {Type: listquery.UUID, Operators: listquery.Equality},
+		"position": {
+			Type:      listquery.String,
+			Operators: []listquery.Operator{listquery.Eq, listquery.In},
+			Values:    []string{string(entity.PositionForward), string(entity.PositionMidfielder), string(entity.PositionDefender), string(entity.PositionGoalkeeper)},
+		},
+		"nationality": {Type: listquery.String, Operators: listquery.Equality, Sortable: true, Parse: parseNationality},
+		"preferred_foot": {
+			Type:      listquery.String,
+			Operators: listquery.Equality,
+			Values:    []string{string(entity.FootLeft), string(entity.FootRight), string(entity.FootBoth)},
+		},
+		"height":        {Type: listquery.Float, Operators: listquery.Range, Sortable: true},
+		"weight":        {Type: listquery.Float, Operators: listquery.Range, Sortable: true},
+		"jersey_number": {Type: listquery.Int, Operators: listquery.Range, Sortable: true},
+		"date_of_birth": {Type: listquery.Time, Operators: listquery.Range, Sortable: true, Parse: parseCalendarDate},
+		"created_at":    {Type: listquery.Time, Operators: listquery.Range, Sortable: true},
+		"team.name":     {Type: listquery.String, Sortable: true},
+	},
+	Search:      []string{"name"},
+	DefaultSort: []listquery.Order{listquery.Desc("created_at")},
+	Relations:   []string{"team"},
+}
+
+// PlayerListReader reads the fields of PlayerListSchema from a player loaded with its team
+var PlayerListReader = listquery.Reader[entity.Player]{
+	Fields: map[string]func(entity.Player) interface{}{
+		"name":           func(p entity.Player) interface{} { return p.Name },
+		"team_id":        func(p entity.Player) interface{} { return p.TeamID },
+		"nationality":    func(p entity.Player) interface{} { return p.Nationality },
+		"preferred_foot": func(p entity.Player) interface{} { return string(p.PreferredFoot) },
+		"height":         func(p entity.Player) interface{} { return p.Height },
+		"weight":         func(p entity.Player) interface{} { return p.Weight },
+		"jersey_number":  func(p entity.Player) interface{} { return p.JerseyNumber },
+		"date_of_birth": func(p entity.Player) interface{} {
+			if p.DateOfBirth == nil {
+				return nil
+			}
+			return *p.DateOfBirth
+		},
+		"created_at": func(p entity.Player) interface{} { return p.CreatedAt },
+		"team.name": func(p entity.Player) interface{} {
+			if p.Team == nil {
+				return nil
+			}
+			return p.Team.Name
+		},
+	},
+	ID: func(p entity.Player) uuid.UUID { return p.ID },
+}
+
+func parseNationality(value string) (interface{}, error) {
+	code := iso3166.Normalize(value)
+	if !iso3166.IsAlpha2(code) {
+		return nil, fmt.Errorf("%q is not an ISO 3166-1 alpha-2 code", value)
+	}
+	return code, nil
+}
+
+func parseCalendarDate(value string) (interface{}, error) {
+	t, err := listquery.ParseTime(value)
+	if err != nil {
+		return nil, err
+	}
+	return *entity.CalendarDate(&t), nil
+}
+
 // PlayerGoalCount represents a player with their goal count
 type PlayerGoalCount struct {
 	Player    entity.Player
@@ -0,0 +1,1711 @@
+// Package repositorytest provides a conformance test suite that every
+// implementation of the repository interfaces must pass, so that all
+// supported backends behave identically.
+package repositorytest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+	"gorm.io/gorm"
+)
+
+// Repositories groups the repositories under test. All of them must share
+// the same underlying store.
+type Repositories struct {
+	Users   repository.UserRepository
+	Teams   repository.TeamRepository
+	Players repository.PlayerRepository
+	Matches repository.MatchRepository
+	Goals   repository.GoalRepository
+	Audit   repository.AuditRepository
+	Venues  repository.VenueRepository
+	Cards   repository.CardRepository
+
+	Officials      repository.OfficialRepository
+	MatchOfficials repository.MatchOfficialRepository
+	Contracts      repository.PlayerContractRepository
+	Windows        repository.TransferWindowRepository
+	Rules          repository.DisciplinaryRuleRepository
+	Availability   repository.PlayerAvailabilityRepository
+}
+
+// Factory returns repositories backed by an empty store
+type Factory func(t *testing.T) Repositories
+
+// Run runs the conformance suite. newRepos is called once per test case.
+func Run(t *testing.T, newRepos Factory) {
+	cases := []struct {
+		name string
+		run  func(t *testing.T, r Repositories)
+	}{
+		{"TeamCRUDAndSoftDelete", testTeamCRUDAndSoftDelete},
+		{"Pagination", testPagination},
+		{"SearchIgnoresCaseAndEscapesWildcards", testSearch},
+		{"FuzzySearchRanksMatches", testFuzzySearch},
+		{"JerseyNumberUniqueAmongActivePlayers", testJerseyNumberUnique},
+		{"PlayerConstraints", testPlayerConstraints},
+		{"CreateBatchIsAllOrNothing", testCreateBatch},
+		{"FindInBatches", testFindInBatches},
+		{"MatchConstraints", testMatchConstraints},
+		{"MatchQueries", testMatchQueries},
+		{"TopScorersExcludeOwnGoals", testTopScorers},
+		{"UserEmailUnique", testUserEmailUnique},
+		{"TeamDeleteCascadeAndRestore", testTeamDeleteCascadeAndRestore},
+		{"PurgeRespectsReferences", testPurge},
+		{"PurgeDeletedBefore", testPurgeDeletedBefore},
+		{"AuditFilters", testAuditFilters},
+		{"Venues", testVenues},
+		{"ScheduledMatches", testScheduledMatches},
+		{"Officials", testOfficials},
+		{"MatchOfficials", testMatchOfficials},
+		{"CardsAndPurge", testCardsAndPurge},
+		{"PlayerContracts", testPlayerContracts},
+		{"TransferWindows", testTransferWindows},
+		{"CompletedMatchCards", testCompletedMatchCards},
+		{"DisciplinaryRules", testDisciplinaryRules},
+		{"PlayerAvailability", testPlayerAvailability},
+		{"PlayerProfileFilters", testPlayerProfileFilters},
+		{"ListFiltersAndSorts", testListFiltersAndSorts},
+		{"ScrollWithCursors", testScroll},
+		{"LoadsOnlyIncludedRelations", testIncludedRelations},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.run(t, newRepos(t))
+		})
+	}
+}
+
+func testTeamCRUDAndSoftDelete(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	team := createTeam(t, r, "Persija Jakarta", "Jakarta")
+
+	found, err := r.Teams.FindByID(ctx, team.ID)
+	mustNoError(t, err)
+	if found.ID != team.ID || found.Name != "Persija Jakarta" {
+		t.Fatalf("FindByID returned %+v", found)
+	}
+
+	found.City = "Jakarta Pusat"
+	mustNoError(t, r.Teams.Update(ctx, found))
+	found, err = r.Teams.FindByID(ctx, team.ID)
+	mustNoError(t, err)
+	if found.City != "Jakarta Pusat" {
+		t.Fatalf("Update not persisted, city = %q", found.City)
+	}
+
+	mustNoError(t, r.Teams.Delete(ctx, team.ID))
+
+	if _, err := r.Teams.FindByID(ctx, team.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("FindByID after delete: got %v, want gorm.ErrRecordNotFound", err)
+	}
+	exists, err := r.Teams.Exists(ctx, team.ID)
+	mustNoError(t, err)
+	if exists {
+		t.Fatal("Exists reports a soft-deleted team")
+	}
+	_, total, err := r.Teams.List(ctx, listquery.Query{}, 1, 10)
+	mustNoError(t, err)
+	if total != 0 {
+		t.Fatalf("List total = %d after delete, want 0", total)
+	}
+
+	deleted, total, err := r.Teams.FindDeleted(ctx, 1, 10)
+	mustNoError(t, err)
+	if total != 1 || len(deleted) != 1 || deleted[0].ID != team.ID || !deleted[0].DeletedAt.Valid {
+		t.Fatalf("FindDeleted = %d items (total %d), want the deleted team", len(deleted), total)
+	}
+}
+
+func testPagination(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	for i := 0; i < 5; i++ {
+		createTeam(t, r, "Team "+string(rune('A'+i)), "City")
+	}
+
+	page1, total, err := r.Teams.List(ctx, listquery.Query{}, 1, 2)
+	mustNoError(t, err)
+	page3, _, err := r.Teams.List(ctx, listquery.Query{}, 3, 2)
+	mustNoError(t, err)
+	page4, _, err := r.Teams.List(ctx, listquery.Query{}, 4, 2)
+	mustNoError(t, err)
+
+	if total != 5 || len(page1) != 2 || len(page3) != 1 || len(page4) != 0 {
+		t.Fatalf("pages = %d/%d/%d items, total %d; want 2/1/0 of 5", len(page1), len(page3), len(page4), total)
+	}
+}
+
+func testSearch(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	persija := createTeam(t, r, "Persija Jakarta", "Jakarta")
+	createTeam(t, r, "PERSIB Bandung", "Bandung")
+	createTeam(t, r, "Arema", "Malang")
+	createTeam(t, r, "Bali United_FC", "Gianyar")
+	createPlayer(t, r, persija.ID, "Marko Simic", 9)
+
+	searches := []struct {
+		query string
+		want  int64
+	}{
+		{"persi", 2},
+		{"MALANG", 1},
+		{"%", 0},
+		{"_", 1},
+		{"zzz", 0},
+	}
+	for _, s := range searches {
+		_, total, err := r.Teams.List(ctx, listquery.Query{Search: s.query}, 1, 10)
+		mustNoError(t, err)
+		if total != s.want {
+			t.Errorf("Teams.List(search %q) total = %d, want %d", s.query, total, s.want)
+		}
+	}
+
+	players, total, err := r.Players.List(ctx, listquery.Query{Search: "SIMIC"}.Including("team"), 1, 10)
+	mustNoError(t, err)
+	if total != 1 || len(players) != 1 || players[0].Team == nil || players[0].Team.ID != persija.ID {
+		t.Fatalf("Players.List(search) returned %d players (total %d), want Simic with team", len(players), total)
+	}
+}
+
+func testFuzzySearch(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	persija := createTeam(t, r, "Persija Jakarta", "Jakarta")
+	createTeam(t, r, "São Paulo FC", "São Paulo")
+	createTeam(t, r, "Persib Bandung", "Bandung")
+	deleted := createTeam(t, r, "Persijap Jepara", "Jepara")
+	mustNoError(t, r.Teams.Delete(ctx, deleted.ID))
+	createPlayer(t, r, persija.ID, "João Pedro", 10)
+	createPlayer(t, r, persija.ID, "Marko Šimić", 9)
+	mustNoError(t, r.Venues.Create(ctx, &entity.Venue{Name: "Estádio do Maracanã", City: "Rio de Janeiro", Capacity: 78838}))
+	mustNoError(t, r.Venues.Create(ctx, &entity.Venue{Name: "Gelora Bung Karno", City: "Jakarta", Capacity: 77193}))
+
+	teamSearches := []struct {
+		query string
+		want  string
+	}{
+		{"sao paulo", "São Paulo FC"},
+		{"PERSIJA", "Persija Jakarta"},
+		{"persja", "Persija Jakarta"},
+		{"bandung", "Persib Bandung"},
+	}
+	for _, s := range teamSearches {
+		hits, err := r.Teams.Search(ctx, s.query, 10)
+		mustNoError(t, err)
+		if len(hits) == 0 || hits[0].Item.Name != s.want {
+			t.Errorf("Teams.Search(%q) = %d hits, want %s first", s.query, len(hits), s.want)
+			continue
+		}
+		for i, hit := range hits {
+			if hit.Item.ID == deleted.ID {
+				t.Errorf("Teams.Search(%q) found a deleted team", s.query)
+			}
+			if hit.Score <= 0 || hit.Score > 1 || (i > 0 && hit.Score > hits[i-1].Score) {
+				t.Errorf("Teams.Search(%q) scores are not ranked in (0, 1]: %v at %d", s.query, hit.Score, i)
+			}
+		}
+	}
+	if hits, err := r.Teams.Search(ctx, "persija", 1); err != nil || len(hits) != 1 {
+		t.Errorf("Teams.Search(limit 1) = %d hits (%v), want 1", len(hits), err)
+	}
+	if hits, err := r.Teams.Search(ctx, "arema", 10); err != nil || len(hits) != 0 {
+		t.Errorf("Teams.Search(arema) = %d hits (%v), want none", len(hits), err)
+	}
+
+	players, err := r.Players.Search(ctx, "joao", 10)
+	mustNoError(t, err)
+	if len(players) != 1 || players[0].Item.Name != "João Pedro" || players[0].Item.Team == nil || players[0].Item.Team.ID != persija.ID {
+		t.Fatalf("Players.Search(joao) = %d hits, want João Pedro with team", len(players))
+	}
+	players, err = r.Players.Search(ctx, "simic", 10)
+	mustNoError(t, err)
+	if len(players) != 1 || players[0].Item.Name != "Marko Šimić" {
+		t.Fatalf("Players.Search(simic) = %d hits, want Marko Šimić", len(players))
+	}
+
+	venues, err := r.Venues.Search(ctx, "maracana", 10)
+	mustNoError(t, err)
+	if len(venues) != 1 || venues[0].Item.Name != "Estádio do Maracanã" {
+		t.Fatalf("Venues.Search(maracana) = %d hits, want the Maracanã", len(venues))
+	}
+}
+
+func testPlayerProfileFilters(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	persija := createTeam(t, r, "Persija", "Jakarta")
+	persib := createTeam(t, r, "Persib", "Bandung")
+
+	// Parsed in Jakarta, the date of birth is still stored as a calendar date
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	born := time.Date(2004, 3, 15, 0, 0, 0, 0, jakarta)
+	young := newPlayer(persija.ID, "Rizky Ridho", 5)
+	young.Position = entity.PositionDefender
+	young.DateOfBirth = &born
+	young.Nationality = "ID"
+	young.PreferredFoot = entity.FootRight
+	young.Photo = "https://example.com/ridho.png"
+	young.SecondaryPositions = entity.PlayerPositions{entity.PositionMidfielder, entity.PositionForward}
+	mustNoError(t, r.Players.Create(ctx, young))
+
+	bornEarlier := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+	veteran := newPlayer(persib.ID, "Marko Simic", 9)
+	veteran.DateOfBirth = &bornEarlier
+	veteran.Nationality = "HR"
+	veteran.PreferredFoot = entity.FootLeft
+	mustNoError(t, r.Players.Create(ctx, veteran))
+	unknown := newPlayer(persib.ID, "Unknown", 1)
+	unknown.Position = entity.PositionGoalkeeper
+	mustNoError(t, r.Players.Create(ctx, unknown))
+
+	found, err := r.Players.FindByID(ctx, young.ID)
+	mustNoError(t, err)
+	want := time.Date(2004, 3, 15, 0, 0, 0, 0, time.UTC)
+	if found.DateOfBirth == nil || !found.DateOfBirth.Equal(want) {
+		t.Fatalf("DateOfBirth = %v, want %v", found.DateOfBirth, want)
+	}
+	if found.Nationality != "ID" || found.PreferredFoot != entity.FootRight || found.Photo != young.Photo ||
+		len(found.SecondaryPositions) != 2 || found.SecondaryPositions[1] != entity.PositionForward {
+		t.Fatalf("profile not persisted: %+v", found)
+	}
+
+	bornAfter := time.Date(2003, 3, 15, 0, 0, 0, 0, time.UTC)
+	bornBefore := time.Date(2004, 3, 15, 0, 0, 0, 0, time.UTC)
+	all := listquery.Query{}
+	filters := []struct {
+		name  string
+		query listquery.Query
+		want  int64
+	}{
+		{"no filter", all, 3},
+		{"team", all.Where("team_id", listquery.Eq, persib.ID), 2},
+		{"primary or secondary position", all.Where("position", listquery.Eq, "forward"), 2},
+		{"secondary position only", all.Where("position", listquery.Eq, "midfielder"), 1},
+		{"position prefix of no item", all.Where("position", listquery.Eq, "mid"), 0},
+		{"any of the positions", all.Where("position", listquery.In, []interface{}{"goalkeeper", "midfielder"}), 2},
+		{"nationality", all.Where("nationality", listquery.Eq, "HR"), 1},
+		{"preferred foot", all.Where("preferred_foot", listquery.Eq, "right"), 1},
+		{"born after", all.Where("date_of_birth", listquery.Gt, bornAfter), 1},
+		{"born after is exclusive", all.Where("date_of_birth", listquery.Gt, want), 0},
+		{"born before is exclusive", all.Where("date_of_birth", listquery.Lt, bornBefore), 1},
+		{"unknown dates of birth match no bound", all.Where("date_of_birth", listquery.Ne, bornBefore), 1},
+		{"combined", listquery.Query{Search: "ridho"}.Where("position", listquery.Eq, "forward").Where("date_of_birth", listquery.Gt, bornAfter), 1},
+	}
+	for _, f := range filters {
+		_, total, err := r.Players.List(ctx, f.query, 1, 10)
+		mustNoError(t, err)
+		if total != f.want {
+			t.Errorf("Players.List(%s) total = %d, want %d", f.name, total, f.want)
+		}
+	}
+
+	found.PreferredFoot = "middle"
+	if err := r.Players.Update(ctx, found); !errors.Is(err, repository.ErrCheckViolation) {
+		t.Fatalf("update with an unknown preferred foot: got %v, want ErrCheckViolation", err)
+	}
+}
+
+func testJerseyNumberUnique(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	team := createTeam(t, r, "Persija", "Jakarta")
+	other := createTeam(t, r, "Persib", "Bandung")
+	first := createPlayer(t, r, team.ID, "First", 10)
+
+	err := r.Players.Create(ctx, newPlayer(team.ID, "Second", 10))
+	if !errors.Is(err, repository.ErrDuplicateJerseyNumber) {
+		t.Fatalf("duplicate jersey: got %v, want ErrDuplicateJerseyNumber", err)
+	}
+
+	// The same number is free in another team
+	createPlayer(t, r, other.ID, "Other", 10)
+
+	taken, err := r.Players.IsJerseyNumberTaken(ctx, team.ID, 10, nil)
+	mustNoError(t, err)
+	if !taken {
+		t.Fatal("IsJerseyNumberTaken = false for a used number")
+	}
+	taken, err = r.Players.IsJerseyNumberTaken(ctx, team.ID, 10, &first.ID)
+	mustNoError(t, err)
+	if taken {
+		t.Fatal("IsJerseyNumberTaken = true when excluding the only holder")
+	}
+
+	// Soft-deleted players release their number
+	mustNoError(t, r.Players.Delete(ctx, first.ID))
+	createPlayer(t, r, team.ID, "Second", 10)
+
+	// ...so restoring the old holder conflicts
+	if err := r.Players.Restore(ctx, first.ID); !errors.Is(err, repository.ErrDuplicateJerseyNumber) {
+		t.Fatalf("restore into taken number: got %v, want ErrDuplicateJerseyNumber", err)
+	}
+}
+
+func testPlayerConstraints(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	team := createTeam(t, r, "Persija", "Jakarta")
+
+	if err := r.Players.Create(ctx, newPlayer(team.ID, "Zero", 0)); !errors.Is(err, repository.ErrCheckViolation) {
+		t.Fatalf("jersey 0: got %v, want ErrCheckViolation", err)
+	}
+	if err := r.Players.Create(ctx, newPlayer(uuid.New(), "Orphan", 7)); !errors.Is(err, repository.ErrReferenceNotFound) {
+		t.Fatalf("unknown team: got %v, want ErrReferenceNotFound", err)
+	}
+
+	player := createPlayer(t, r, team.ID, "Simic", 9)
+	found, err := r.Players.FindByIDWithTeam(ctx, player.ID)
+	mustNoError(t, err)
+	if found.TeamID != team.ID || found.Team == nil || found.Team.Name != "Persija" {
+		t.Fatalf("FindByIDWithTeam returned %+v", found)
+	}
+}
+
+func testCreateBatch(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	teams := []entity.Team{
+		{Name: "Persija", FoundedYear: 1928, City: "Jakarta"},
+		{Name: "Persib", FoundedYear: 1933, City: "Bandung"},
+	}
+	mustNoError(t, r.Teams.CreateBatch(ctx, teams))
+	for _, team := range teams {
+		if team.ID == uuid.Nil {
+			t.Fatal("CreateBatch did not assign team IDs")
+		}
+	}
+	createPlayer(t, r, teams[0].ID, "Simic", 9)
+
+	rejected := [][]entity.Player{
+		// Clashes with an existing player
+		{*newPlayer(teams[0].ID, "Riko", 7), *newPlayer(teams[0].ID, "Marko", 9)},
+		// Clashes within the batch
+		{*newPlayer(teams[1].ID, "Ciro", 10), *newPlayer(teams[1].ID, "David", 10)},
+	}
+	for i, players := range rejected {
+		if err := r.Players.CreateBatch(ctx, players); !errors.Is(err, repository.ErrDuplicateJerseyNumber) {
+			t.Fatalf("batch %d: got %v, want ErrDuplicateJerseyNumber", i, err)
+		}
+	}
+	_, total, err := r.Players.List(ctx, listquery.Query{}, 1, 10)
+	mustNoError(t, err)
+	if total != 1 {
+		t.Fatalf("rejected batches stored players: got %d players, want 1", total)
+	}
+
+	players := []entity.Player{*newPlayer(teams[1].ID, "Ciro", 10), *newPlayer(teams[1].ID, "David", 11)}
+	mustNoError(t, r.Players.CreateBatch(ctx, players))
+	if _, err := r.Players.FindByID(ctx, players[1].ID); err != nil {
+		t.Fatalf("batch player not found: %v", err)
+	}
+}
+
+func testFindInBatches(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	var teams []*entity.Team
+	for _, name := range []string{"Persija", "Persib", "Arema", "PSM", "Bali United"} {
+		teams = append(teams, createTeam(t, r, name, "Jakarta"))
+	}
+	mustNoError(t, r.Teams.Delete(ctx, teams[4].ID))
+
+	var sizes []int
+	var ids []uuid.UUID
+	mustNoError(t, r.Teams.FindInBatches(ctx, 2, func(batch []entity.Team) error {
+		sizes = append(sizes, len(batch))
+		for _, team := range batch {
+			ids = append(ids, team.ID)
+		}
+		return nil
+	}))
+	if !reflect.DeepEqual(sizes, []int{2, 2}) {
+		t.Fatalf("expected batches of 2 and 2 active teams, got %v", sizes)
+	}
+	for i := 1; i < len(ids); i++ {
+		if bytes.Compare(ids[i-1][:], ids[i][:]) >= 0 {
+			t.Fatalf("teams are not ordered by ID: %v", ids)
+		}
+	}
+
+	// An error from fn stops the iteration
+	stop := errors.New("stop")
+	calls := 0
+	err := r.Teams.FindInBatches(ctx, 1, func([]entity.Team) error {
+		calls++
+		return stop
+	})
+	if !errors.Is(err, stop) || calls != 1 {
+		t.Fatalf("expected the callback error after one batch, got %v after %d batches", err, calls)
+	}
+
+	player := createPlayer(t, r, teams[0].ID, "Simic", 9)
+	mustNoError(t, r.Players.FindInBatches(ctx, 10, func(players []entity.Player) error {
+		if len(players) != 1 || players[0].Team == nil || players[0].Team.Name != "Persija" {
+			t.Fatalf("expected the player with its team, got %+v", players)
+		}
+		return nil
+	}))
+
+	// Goals of deleted matches are left out
+	date := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	kept := createMatch(t, r, teams[0].ID, teams[1].ID, date)
+	deleted := createMatch(t, r, teams[0].ID, teams[2].ID, date.AddDate(0, 0, 7))
+	for _, match := range []*entity.Match{kept, deleted} {
+		mustNoError(t, r.Goals.Create(ctx, &entity.Goal{MatchID: match.ID, PlayerID: player.ID, TeamID: teams[0].ID, Minute: 10}))
+	}
+	mustNoError(t, r.Matches.Delete(ctx, deleted.ID))
+
+	var matches []entity.Match
+	mustNoError(t, r.Matches.FindInBatches(ctx, 10, func(batch []entity.Match) error {
+		matches = append(matches, batch...)
+		return nil
+	}))
+	if len(matches) != 1 || matches[0].HomeTeam == nil || matches[0].AwayTeam.Name != "Persib" {
+		t.Fatalf("expected the kept match with both teams, got %+v", matches)
+	}
+
+	var goals []entity.Goal
+	mustNoError(t, r.Goals.FindInBatches(ctx, 10, func(batch []entity.Goal) error {
+		goals = append(goals, batch...)
+		return nil
+	}))
+	if len(goals) != 1 || goals[0].MatchID != kept.ID || goals[0].Player == nil || goals[0].Team == nil || goals[0].Match == nil {
+		t.Fatalf("expected the goal of the kept match with its relations, got %+v", goals)
+	}
+}
+
+func testMatchConstraints(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	home := createTeam(t, r, "Persija", "Jakarta")
+	away := createTeam(t, r, "Persib", "Bandung")
+
+	if err := r.Matches.Create(ctx, newMatch(home.ID, home.ID, time.Now())); !errors.Is(err, repository.ErrSameTeams) {
+		t.Fatalf("same teams: got %v, want ErrSameTeams", err)
+	}
+	if err := r.Matches.Create(ctx, newMatch(home.ID, uuid.New(), time.Now())); !errors.Is(err, repository.ErrReferenceNotFound) {
+		t.Fatalf("unknown team: got %v, want ErrReferenceNotFound", err)
+	}
+
+	match := createMatch(t, r, home.ID, away.ID, time.Now())
+	negative := -1
+	match.HomeScore = &negative
+	if err := r.Matches.Update(ctx, match); !errors.Is(err, repository.ErrNegativeScore) {
+		t.Fatalf("negative score: got %v, want ErrNegativeScore", err)
+	}
+}
+
+func testMatchQueries(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	home := createTeam(t, r, "Persija", "Jakarta")
+	away := createTeam(t, r, "Persib", "Bandung")
+	third := createTeam(t, r, "Arema", "Malang")
+
+	// Kickoffs in another zone are stored as the same instant in UTC
+	wib := time.FixedZone("WIB", 7*60*60)
+	day := time.Date(2024, 3, 10, 19, 30, 0, 0, wib)
+	won := createMatch(t, r, home.ID, away.ID, day)
+	createMatch(t, r, away.ID, third.ID, day.AddDate(0, 0, 7))
+	createMatch(t, r, third.ID, home.ID, day.AddDate(0, 1, 0))
+
+	two, one := 2, 1
+	won.HomeScore, won.AwayScore, won.Status = &two, &one, entity.MatchStatusCompleted
+	mustNoError(t, r.Matches.Update(ctx, won))
+
+	found, err := r.Matches.FindByIDWithDetails(ctx, won.ID)
+	mustNoError(t, err)
+	if found.HomeTeam == nil || found.AwayTeam == nil || found.HomeTeam.ID != home.ID {
+		t.Fatalf("FindByIDWithDetails did not load teams: %+v", found)
+	}
+	if !found.KickoffAt.Equal(day) || found.KickoffAt.Location() != time.UTC || found.Timezone != entity.DefaultTimezone {
+		t.Fatalf("kickoff not persisted in UTC: %v (%s)", found.KickoffAt, found.Timezone)
+	}
+
+	_, total, err := r.Matches.FindByTeamID(ctx, home.ID, 1, 10)
+	mustNoError(t, err)
+	if total != 2 {
+		t.Errorf("FindByTeamID total = %d, want 2", total)
+	}
+
+	inRange, total, err := r.Matches.FindByDateRange(ctx, day, day.AddDate(0, 0, 8), 1, 10)
+	mustNoError(t, err)
+	if total != 2 || len(inRange) != 2 || inRange[0].ID != won.ID {
+		t.Errorf("FindByDateRange total = %d, want 2 starting with the earliest", total)
+	}
+	// The end of the range is exclusive
+	_, total, err = r.Matches.FindByDateRange(ctx, day, day.AddDate(0, 0, 7), 1, 10)
+	mustNoError(t, err)
+	if total != 1 {
+		t.Errorf("FindByDateRange up to a kickoff total = %d, want 1", total)
+	}
+
+	_, total, err = r.Matches.List(ctx, listquery.Query{}.Where("status", listquery.Eq, "scheduled"), 1, 10)
+	mustNoError(t, err)
+	if total != 2 {
+		t.Errorf("List(status scheduled) total = %d, want 2", total)
+	}
+
+	_, total, err = r.Matches.GetCompletedMatches(ctx, 1, 10)
+	mustNoError(t, err)
+	if total != 1 {
+		t.Errorf("GetCompletedMatches total = %d, want 1", total)
+	}
+
+	wins, err := r.Matches.GetTeamWinCount(ctx, home.ID, true)
+	mustNoError(t, err)
+	if wins != 1 {
+		t.Errorf("home wins = %d, want 1", wins)
+	}
+	wins, err = r.Matches.GetTeamWinCount(ctx, away.ID, false)
+	mustNoError(t, err)
+	if wins != 0 {
+		t.Errorf("away wins = %d, want 0", wins)
+	}
+}
+
+func testTopScorers(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	home := createTeam(t, r, "Persija", "Jakarta")
+	away := createTeam(t, r, "Persib", "Bandung")
+	striker := createPlayer(t, r, home.ID, "Striker", 9)
+	defender := createPlayer(t, r, away.ID, "Defender", 4)
+	match := createMatch(t, r, home.ID, away.ID, time.Now())
+
+	goals := []entity.Goal{
+		{MatchID: match.ID, PlayerID: striker.ID, TeamID: home.ID, Minute: 10},
+		{MatchID: match.ID, PlayerID: striker.ID, TeamID: home.ID, Minute: 50},
+		{MatchID: match.ID, PlayerID: defender.ID, TeamID: home.ID, Minute: 70, IsOwnGoal: true},
+	}
+	mustNoError(t, r.Goals.CreateBatch(ctx, goals))
+
+	matchGoals, err := r.Goals.FindByMatchID(ctx, match.ID)
+	mustNoError(t, err)
+	if len(matchGoals) != 3 {
+		t.Fatalf("FindByMatchID = %d goals, want 3", len(matchGoals))
+	}
+	ownGoals := 0
+	for _, goal := range matchGoals {
+		if goal.IsOwnGoal {
+			ownGoals++
+		}
+	}
+	if ownGoals != 1 {
+		t.Fatalf("own goals read back = %d, want 1", ownGoals)
+	}
+
+	scorers, err := r.Goals.GetTopScorers(ctx, 10)
+	mustNoError(t, err)
+	if len(scorers) != 1 || scorers[0].PlayerID != striker.ID || scorers[0].GoalCount != 2 ||
+		scorers[0].PlayerName != "Striker" || scorers[0].TeamName != "Persija" {
+		t.Fatalf("GetTopScorers = %+v, want Striker with 2 goals", scorers)
+	}
+
+	mustNoError(t, r.Goals.DeleteByMatchID(ctx, match.ID))
+	scorers, err = r.Goals.GetTopScorers(ctx, 10)
+	mustNoError(t, err)
+	if len(scorers) != 0 {
+		t.Fatalf("GetTopScorers after DeleteByMatchID = %+v, want none", scorers)
+	}
+}
+
+func testUserEmailUnique(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	user := &entity.User{Email: "admin@example.com", Password: "hash", Name: "Admin", Role: entity.RoleAdmin}
+	mustNoError(t, r.Users.Create(ctx, user))
+
+	dup := &entity.User{Email: "admin@example.com", Password: "hash", Name: "Other", Role: entity.RoleUser}
+	if err := r.Users.Create(ctx, dup); !errors.Is(err, repository.ErrDuplicateEmail) {
+		t.Fatalf("duplicate email: got %v, want ErrDuplicateEmail", err)
+	}
+
+	found, err := r.Users.FindByEmail(ctx, "admin@example.com")
+	mustNoError(t, err)
+	if found.ID != user.ID || !found.IsAdmin() {
+		t.Fatalf("FindByEmail returned %+v", found)
+	}
+	if _, err := r.Users.FindByEmail(ctx, "missing@example.com"); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("FindByEmail(missing): got %v, want gorm.ErrRecordNotFound", err)
+	}
+}
+
+func testTeamDeleteCascadeAndRestore(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	team := createTeam(t, r, "Persija", "Jakarta")
+	rival := createTeam(t, r, "Persib", "Bandung")
+	kept := createPlayer(t, r, team.ID, "Kept", 9)
+	earlier := createPlayer(t, r, team.ID, "Earlier", 10)
+	mustNoError(t, r.Players.Delete(ctx, earlier.ID))
+	scheduled := createMatch(t, r, team.ID, rival.ID, time.Now())
+
+	// Make sure the cascade gets a different deletion timestamp
+	time.Sleep(5 * time.Millisecond)
+	mustNoError(t, r.Teams.DeleteCascade(ctx, team.ID))
+
+	if _, err := r.Players.FindByID(ctx, kept.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("player after cascade: got %v, want gorm.ErrRecordNotFound", err)
+	}
+	match, err := r.Matches.FindByID(ctx, scheduled.ID)
+	mustNoError(t, err)
+	if match.Status != entity.MatchStatusCancelled {
+		t.Fatalf("match status after cascade = %s, want cancelled", match.Status)
+	}
+
+	// Players cannot come back while their team is in the trash
+	if err := r.Players.Restore(ctx, kept.ID); !errors.Is(err, repository.ErrReferenceNotFound) {
+		t.Fatalf("restore player of deleted team: got %v, want ErrReferenceNotFound", err)
+	}
+
+	mustNoError(t, r.Teams.Restore(ctx, team.ID))
+	if _, err := r.Players.FindByID(ctx, kept.ID); err != nil {
+		t.Fatalf("player deleted with the team was not restored: %v", err)
+	}
+	if _, err := r.Players.FindByID(ctx, earlier.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("player deleted before the team was restored too: %v", err)
+	}
+
+	if err := r.Teams.Restore(ctx, team.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("restore of an active team: got %v, want gorm.ErrRecordNotFound", err)
+	}
+}
+
+func testPurge(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	home := createTeam(t, r, "Persija", "Jakarta")
+	away := createTeam(t, r, "Persib", "Bandung")
+	scorer := createPlayer(t, r, home.ID, "Scorer", 9)
+	match := createMatch(t, r, home.ID, away.ID, time.Now())
+	mustNoError(t, r.Goals.Create(ctx, &entity.Goal{MatchID: match.ID, PlayerID: scorer.ID, TeamID: home.ID, Minute: 5}))
+
+	if err := r.Teams.Purge(ctx, home.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("purge of an active team: got %v, want gorm.ErrRecordNotFound", err)
+	}
+
+	mustNoError(t, r.Players.Delete(ctx, scorer.ID))
+	if err := r.Players.Purge(ctx, scorer.ID); !errors.Is(err, repository.ErrStillReferenced) {
+		t.Fatalf("purge of a scorer: got %v, want ErrStillReferenced", err)
+	}
+
+	mustNoError(t, r.Matches.Delete(ctx, match.ID))
+	mustNoError(t, r.Matches.Purge(ctx, match.ID))
+	if goals, err := r.Goals.FindByMatchID(ctx, match.ID); err != nil || len(goals) != 0 {
+		t.Fatalf("goals of a purged match = %d (%v), want none", len(goals), err)
+	}
+
+	// With its goals gone the player can be purged, and then the team
+	mustNoError(t, r.Players.Purge(ctx, scorer.ID))
+	mustNoError(t, r.Teams.Delete(ctx, home.ID))
+	mustNoError(t, r.Teams.Purge(ctx, home.ID))
+
+	deleted, _, err := r.Teams.FindDeleted(ctx, 1, 10)
+	mustNoError(t, err)
+	if len(deleted) != 0 {
+		t.Fatalf("trash still holds %d teams after purge", len(deleted))
+	}
+}
+
+func testPurgeDeletedBefore(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	home := createTeam(t, r, "Persija", "Jakarta")
+	away := createTeam(t, r, "Persib", "Bandung")
+	free := createPlayer(t, r, home.ID, "Free", 7)
+	scorer := createPlayer(t, r, home.ID, "Scorer", 9)
+	match := createMatch(t, r, home.ID, away.ID, time.Now())
+	mustNoError(t, r.Goals.Create(ctx, &entity.Goal{MatchID: match.ID, PlayerID: scorer.ID, TeamID: home.ID, Minute: 5}))
+
+	mustNoError(t, r.Players.Delete(ctx, free.ID))
+	mustNoError(t, r.Players.Delete(ctx, scorer.ID))
+	mustNoError(t, r.Teams.Delete(ctx, away.ID))
+
+	// Nothing is old enough yet
+	purged, err := r.Players.PurgeDeletedBefore(ctx, time.Now().Add(-time.Hour))
+	mustNoError(t, err)
+	if purged != 0 {
+		t.Fatalf("purged %d players deleted within the retention period", purged)
+	}
+
+	cutoff := time.Now().Add(time.Hour)
+	purged, err = r.Players.PurgeDeletedBefore(ctx, cutoff)
+	mustNoError(t, err)
+	if purged != 1 {
+		t.Fatalf("purged %d players, want only the one without goals", purged)
+	}
+	purged, err = r.Teams.PurgeDeletedBefore(ctx, cutoff)
+	mustNoError(t, err)
+	if purged != 0 {
+		t.Fatalf("purged %d teams still referenced by a match", purged)
+	}
+
+	mustNoError(t, r.Matches.Delete(ctx, match.ID))
+	purged, err = r.Matches.PurgeDeletedBefore(ctx, cutoff)
+	mustNoError(t, err)
+	if purged != 1 {
+		t.Fatalf("purged %d matches, want 1", purged)
+	}
+	for _, purge := range []func(context.Context, time.Time) (int64, error){
+		r.Goals.PurgeDeletedBefore, r.Players.PurgeDeletedBefore, r.Teams.PurgeDeletedBefore,
+	} {
+		_, err := purge(ctx, cutoff)
+		mustNoError(t, err)
+	}
+
+	players, _, err := r.Players.FindDeleted(ctx, 1, 10)
+	mustNoError(t, err)
+	teams, _, err := r.Teams.FindDeleted(ctx, 1, 10)
+	mustNoError(t, err)
+	if len(players) != 0 || len(teams) != 0 {
+		t.Fatalf("trash holds %d players and %d teams after purging dependents first", len(players), len(teams))
+	}
+}
+
+func testAuditFilters(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	actor := uuid.New()
+	teamID := uuid.New()
+
+	logs := []*entity.AuditLog{
+		{ActorID: &actor, Action: entity.AuditActionCreate, EntityType: entity.AuditEntityTeam, EntityID: teamID},
+		{ActorID: &actor, Action: entity.AuditActionUpdate, EntityType: entity.AuditEntityTeam, EntityID: teamID},
+		{Action: entity.AuditActionCreate, EntityType: entity.AuditEntityPlayer, EntityID: uuid.New()},
+	}
+	for _, log := range logs {
+		mustNoError(t, r.Audit.Create(ctx, log))
+	}
+
+	filters := []struct {
+		name   string
+		filter repository.AuditLogFilter
+		want   int64
+	}{
+		{"all", repository.AuditLogFilter{}, 3},
+		{"entity type", repository.AuditLogFilter{EntityType: entity.AuditEntityTeam}, 2},
+		{"entity id", repository.AuditLogFilter{EntityID: &teamID}, 2},
+		{"actor", repository.AuditLogFilter{ActorID: &actor}, 2},
+	}
+	for _, f := range filters {
+		_, total, err := r.Audit.FindAll(ctx, f.filter, 1, 10)
+		mustNoError(t, err)
+		if total != f.want {
+			t.Errorf("audit filter %s total = %d, want %d", f.name, total, f.want)
+		}
+	}
+
+	future := time.Now().Add(time.Hour)
+	_, total, err := r.Audit.FindAll(ctx, repository.AuditLogFilter{From: &future}, 1, 10)
+	mustNoError(t, err)
+	if total != 0 {
+		t.Errorf("audit entries after %s = %d, want 0", future, total)
+	}
+}
+
+func testVenues(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	lat, lng := -6.218335, 106.802216
+	gbk := &entity.Venue{Name: "Gelora Bung Karno", City: "Jakarta", Capacity: 77193, Latitude: &lat, Longitude: &lng}
+	mustNoError(t, r.Venues.Create(ctx, gbk))
+	mustNoError(t, r.Venues.Create(ctx, &entity.Venue{Name: "Si Jalak Harupat", City: "Bandung", Capacity: 27000}))
+
+	found, err := r.Venues.FindByID(ctx, gbk.ID)
+	mustNoError(t, err)
+	if found.Latitude == nil || *found.Latitude != lat || found.Capacity != 77193 {
+		t.Fatalf("venue not persisted: %+v", found)
+	}
+
+	venues, total, err := r.Venues.List(ctx, listquery.Query{}, 1, 10)
+	mustNoError(t, err)
+	if total != 2 || venues[0].ID != gbk.ID {
+		t.Fatalf("List total = %d, want 2 ordered by name", total)
+	}
+	venues, total, err = r.Venues.List(ctx, listquery.Query{Search: "bandung"}, 1, 10)
+	mustNoError(t, err)
+	if total != 1 || venues[0].Name != "Si Jalak Harupat" {
+		t.Fatalf("List(search bandung) total = %d, want the venue in Bandung", total)
+	}
+	venues, total, err = r.Venues.List(ctx, listquery.Query{}.Where("capacity", listquery.Lt, 50000), 1, 10)
+	mustNoError(t, err)
+	if total != 1 || venues[0].Name != "Si Jalak Harupat" {
+		t.Fatalf("List(capacity < 50000) total = %d, want the smaller venue", total)
+	}
+
+	team := &entity.Team{Name: "Persija", FoundedYear: 1928, City: "Jakarta", HomeVenueID: &gbk.ID}
+	mustNoError(t, r.Teams.Create(ctx, team))
+	mustNoError(t, r.Venues.Delete(ctx, gbk.ID))
+	if exists, err := r.Venues.Exists(ctx, gbk.ID); err != nil || exists {
+		t.Fatalf("Exists after delete = %v (%v), want false", exists, err)
+	}
+
+	// Teams keep showing a home venue that has since been deleted
+	loaded, err := r.Teams.FindByID(ctx, team.ID)
+	mustNoError(t, err)
+	if loaded.HomeVenue == nil || loaded.HomeVenue.ID != gbk.ID || !loaded.HomeVenue.DeletedAt.Valid {
+		t.Fatalf("FindByID did not load the deleted home venue: %+v", loaded.HomeVenue)
+	}
+}
+
+func testScheduledMatches(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	home := createTeam(t, r, "Persija", "Jakarta")
+	away := createTeam(t, r, "Persib", "Bandung")
+	third := createTeam(t, r, "Arema", "Malang")
+	venue := &entity.Venue{Name: "Gelora Bung Karno", City: "Jakarta", Capacity: 77193}
+	mustNoError(t, r.Venues.Create(ctx, venue))
+
+	kickoff := time.Date(2024, 3, 10, 12, 30, 0, 0, time.UTC)
+	first := newMatch(home.ID, away.ID, kickoff)
+	first.VenueID = &venue.ID
+	mustNoError(t, r.Matches.Create(ctx, first))
+	later := newMatch(third.ID, away.ID, kickoff.Add(4*time.Hour))
+	later.VenueID = &venue.ID
+	mustNoError(t, r.Matches.Create(ctx, later))
+	cancelled := newMatch(home.ID, third.ID, kickoff.Add(time.Hour))
+	cancelled.VenueID = &venue.ID
+	cancelled.Status = entity.MatchStatusCancelled
+	mustNoError(t, r.Matches.Create(ctx, cancelled))
+	createMatch(t, r, away.ID, third.ID, kickoff.AddDate(0, 0, 1))
+
+	booked, err := r.Matches.FindScheduledAtVenue(ctx, venue.ID, kickoff, kickoff.Add(4*time.Hour))
+	mustNoError(t, err)
+	if len(booked) != 1 || booked[0].ID != first.ID || booked[0].Venue == nil {
+		t.Fatalf("FindScheduledAtVenue = %d matches, want only the first with its venue", len(booked))
+	}
+
+	busy, err := r.Matches.FindScheduledForTeams(ctx, []uuid.UUID{home.ID, third.ID}, kickoff, kickoff.AddDate(0, 0, 2))
+	mustNoError(t, err)
+	if len(busy) != 3 || busy[0].ID != first.ID || busy[1].ID != later.ID {
+		t.Fatalf("FindScheduledForTeams = %d matches, want 3 earliest first", len(busy))
+	}
+	none, err := r.Matches.FindScheduledForTeams(ctx, nil, kickoff, kickoff.AddDate(0, 0, 2))
+	mustNoError(t, err)
+	if len(none) != 0 {
+		t.Fatalf("FindScheduledForTeams without teams = %d matches, want 0", len(none))
+	}
+
+	all, err := r.Matches.FindScheduled(ctx, kickoff, kickoff.AddDate(0, 0, 1))
+	mustNoError(t, err)
+	if len(all) != 2 || all[0].ID != first.ID || all[1].ID != later.ID {
+		t.Fatalf("FindScheduled = %d matches, want the first and the later one", len(all))
+	}
+}
+
+func testOfficials(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	thoriq := &entity.Official{Name: "Thoriq Alkatiri", LicenseLevel: entity.LicenseFIFA, City: "Jakarta"}
+	mustNoError(t, r.Officials.Create(ctx, thoriq))
+	mustNoError(t, r.Officials.Create(ctx, &entity.Official{Name: "Yudi Nurcahya", LicenseLevel: entity.LicenseNational, City: "Bandung"}))
+
+	invalid := &entity.Official{Name: "Nobody", LicenseLevel: "continental"}
+	if err := r.Officials.Create(ctx, invalid); !errors.Is(err, repository.ErrCheckViolation) {
+		t.Fatalf("create with an unknown license level: got %v, want ErrCheckViolation", err)
+	}
+
+	officials, total, err := r.Officials.List(ctx, listquery.Query{Search: "BANDUNG"}, 1, 10)
+	mustNoError(t, err)
+	if total != 1 || len(officials) != 1 || officials[0].Name != "Yudi Nurcahya" {
+		t.Fatalf("List(search BANDUNG) = %d officials, want Yudi Nurcahya", total)
+	}
+
+	thoriq.LicenseLevel = entity.LicenseNational
+	mustNoError(t, r.Officials.Update(ctx, thoriq))
+	found, err := r.Officials.FindByID(ctx, thoriq.ID)
+	mustNoError(t, err)
+	if found.LicenseLevel != entity.LicenseNational {
+		t.Fatalf("license level = %q after update, want national", found.LicenseLevel)
+	}
+
+	mustNoError(t, r.Officials.Delete(ctx, thoriq.ID))
+	if exists, err := r.Officials.Exists(ctx, thoriq.ID); err != nil || exists {
+		t.Fatalf("Exists after delete = %v (%v), want false", exists, err)
+	}
+	if _, total, err = r.Officials.List(ctx, listquery.Query{}, 1, 10); err != nil || total != 1 {
+		t.Fatalf("List after delete = %d (%v), want 1", total, err)
+	}
+}
+
+func testMatchOfficials(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	home := createTeam(t, r, "Persija", "Jakarta")
+	away := createTeam(t, r, "Persib", "Bandung")
+	third := createTeam(t, r, "Arema", "Malang")
+	kickoff := time.Date(2024, 3, 10, 12, 30, 0, 0, time.UTC)
+	first := createMatch(t, r, home.ID, away.ID, kickoff)
+	second := createMatch(t, r, third.ID, away.ID, kickoff.Add(time.Hour))
+	cancelled := newMatch(home.ID, third.ID, kickoff.Add(-time.Hour))
+	cancelled.Status = entity.MatchStatusCancelled
+	mustNoError(t, r.Matches.Create(ctx, cancelled))
+
+	referee := &entity.Official{Name: "Thoriq Alkatiri", LicenseLevel: entity.LicenseFIFA}
+	assistant := &entity.Official{Name: "Yudi Nurcahya", LicenseLevel: entity.LicenseNational}
+	free := &entity.Official{Name: "Fariq Hitaba", LicenseLevel: entity.LicenseRegional}
+	for _, official := range []*entity.Official{referee, assistant, free} {
+		mustNoError(t, r.Officials.Create(ctx, official))
+	}
+
+	mustNoError(t, r.MatchOfficials.ReplaceForMatch(ctx, first.ID, []entity.MatchOfficial{
+		{OfficialID: referee.ID, Role: entity.OfficialRoleReferee},
+		{OfficialID: assistant.ID, Role: entity.OfficialRoleAssistant1},
+	}))
+	mustNoError(t, r.MatchOfficials.ReplaceForMatch(ctx, cancelled.ID, []entity.MatchOfficial{
+		{OfficialID: free.ID, Role: entity.OfficialRoleReferee},
+	}))
+
+	err := r.MatchOfficials.ReplaceForMatch(ctx, first.ID, []entity.MatchOfficial{
+		{OfficialID: free.ID, Role: entity.OfficialRoleReferee},
+		{OfficialID: assistant.ID, Role: entity.OfficialRoleReferee},
+	})
+	if !errors.Is(err, repository.ErrDuplicateAppointment) {
+		t.Fatalf("replace with a role filled twice: got %v, want ErrDuplicateAppointment", err)
+	}
+
+	appointed, err := r.MatchOfficials.FindByMatchID(ctx, first.ID)
+	mustNoError(t, err)
+	if len(appointed) != 2 || appointed[0].Official == nil {
+		t.Fatalf("FindByMatchID = %d appointments, want the 2 kept after the failed replace with their official", len(appointed))
+	}
+
+	busy, err := r.MatchOfficials.FindScheduledForOfficials(ctx, []uuid.UUID{referee.ID, free.ID},
+		kickoff.Add(-2*time.Hour), kickoff.Add(2*time.Hour))
+	mustNoError(t, err)
+	if len(busy) != 1 || busy[0].MatchID != first.ID || busy[0].Match == nil {
+		t.Fatalf("FindScheduledForOfficials = %d appointments, want the first match only", len(busy))
+	}
+
+	available, total, err := r.Officials.FindAvailable(ctx, second.KickoffAt.Add(-2*time.Hour), second.KickoffAt.Add(2*time.Hour), second.ID, 1, 10)
+	mustNoError(t, err)
+	if total != 1 || len(available) != 1 || available[0].ID != free.ID {
+		t.Fatalf("FindAvailable = %d officials, want only the official of the cancelled match", total)
+	}
+	_, total, err = r.Officials.FindAvailable(ctx, kickoff.Add(-2*time.Hour), kickoff.Add(2*time.Hour), first.ID, 1, 10)
+	mustNoError(t, err)
+	if total != 3 {
+		t.Fatalf("FindAvailable excluding the first match = %d officials, want 3", total)
+	}
+
+	player := createPlayer(t, r, home.ID, "Booked", 4)
+	mustNoError(t, r.Cards.CreateBatch(ctx, []entity.Card{
+		{MatchID: first.ID, PlayerID: player.ID, TeamID: home.ID, Minute: 10, Type: entity.CardYellow},
+		{MatchID: first.ID, PlayerID: player.ID, TeamID: home.ID, Minute: 80, Type: entity.CardRed},
+	}))
+
+	stats, err := r.MatchOfficials.GetOfficialStats(ctx, referee.ID)
+	mustNoError(t, err)
+	if stats.Matches != 1 || stats.MatchesBy[entity.OfficialRoleReferee] != 1 || stats.YellowCards != 1 || stats.RedCards != 1 {
+		t.Fatalf("referee stats = %+v, want 1 match as referee with 1 yellow and 1 red", stats)
+	}
+	stats, err = r.MatchOfficials.GetOfficialStats(ctx, assistant.ID)
+	mustNoError(t, err)
+	if stats.Matches != 1 || stats.MatchesBy[entity.OfficialRoleAssistant1] != 1 || stats.YellowCards != 0 {
+		t.Fatalf("assistant stats = %+v, want 1 match as assistant_1 without cards", stats)
+	}
+
+	mustNoError(t, r.MatchOfficials.ReplaceForMatch(ctx, first.ID, nil))
+	if appointed, err = r.MatchOfficials.FindByMatchID(ctx, first.ID); err != nil || len(appointed) != 0 {
+		t.Fatalf("FindByMatchID after clearing = %d (%v), want none", len(appointed), err)
+	}
+}
+
+func testCardsAndPurge(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	home := createTeam(t, r, "Persija", "Jakarta")
+	away := createTeam(t, r, "Persib", "Bandung")
+	booked := createPlayer(t, r, home.ID, "Booked", 4)
+	match := createMatch(t, r, home.ID, away.ID, time.Now())
+	referee := &entity.Official{Name: "Thoriq Alkatiri", LicenseLevel: entity.LicenseFIFA}
+	mustNoError(t, r.Officials.Create(ctx, referee))
+	mustNoError(t, r.MatchOfficials.ReplaceForMatch(ctx, match.ID, []entity.MatchOfficial{
+		{OfficialID: referee.ID, Role: entity.OfficialRoleReferee},
+	}))
+
+	err := r.Cards.CreateBatch(ctx, []entity.Card{
+		{MatchID: match.ID, PlayerID: booked.ID, TeamID: home.ID, Minute: 10, Type: entity.CardYellow},
+		{MatchID: match.ID, PlayerID: booked.ID, TeamID: home.ID, Minute: 20, Type: "green"},
+	})
+	if !errors.Is(err, repository.ErrCheckViolation) {
+		t.Fatalf("create with an unknown card type: got %v, want ErrCheckViolation", err)
+	}
+	mustNoError(t, r.Cards.CreateBatch(ctx, []entity.Card{
+		{MatchID: match.ID, PlayerID: booked.ID, TeamID: home.ID, Minute: 70, Type: entity.CardRed},
+		{MatchID: match.ID, PlayerID: booked.ID, TeamID: home.ID, Minute: 10, Type: entity.CardYellow},
+	}))
+
+	cards, err := r.Cards.FindByMatchID(ctx, match.ID)
+	mustNoError(t, err)
+	if len(cards) != 2 || cards[0].Minute != 10 || cards[0].Player == nil {
+		t.Fatalf("FindByMatchID = %d cards, want 2 by minute with their player", len(cards))
+	}
+	details, err := r.Matches.FindByIDWithDetails(ctx, match.ID)
+	mustNoError(t, err)
+	if len(details.Cards) != 2 {
+		t.Fatalf("match details hold %d cards, want 2", len(details.Cards))
+	}
+
+	mustNoError(t, r.Players.Delete(ctx, booked.ID))
+	if err := r.Players.Purge(ctx, booked.ID); !errors.Is(err, repository.ErrStillReferenced) {
+		t.Fatalf("purge of a booked player: got %v, want ErrStillReferenced", err)
+	}
+
+	mustNoError(t, r.Matches.Delete(ctx, match.ID))
+	mustNoError(t, r.Matches.Purge(ctx, match.ID))
+	if cards, err := r.Cards.FindByMatchID(ctx, match.ID); err != nil || len(cards) != 0 {
+		t.Fatalf("cards of a purged match = %d (%v), want none", len(cards), err)
+	}
+	if appointed, err := r.MatchOfficials.FindByMatchID(ctx, match.ID); err != nil || len(appointed) != 0 {
+		t.Fatalf("officials of a purged match = %d (%v), want none", len(appointed), err)
+	}
+	mustNoError(t, r.Players.Purge(ctx, booked.ID))
+
+	// Soft-deleted cards are purged once they are past the cutoff
+	other := createMatch(t, r, away.ID, home.ID, time.Now())
+	player := createPlayer(t, r, away.ID, "Other", 8)
+	mustNoError(t, r.Cards.CreateBatch(ctx, []entity.Card{
+		{MatchID: other.ID, PlayerID: player.ID, TeamID: away.ID, Minute: 30, Type: entity.CardYellow},
+	}))
+	mustNoError(t, r.Cards.DeleteByMatchID(ctx, other.ID))
+	purged, err := r.Cards.PurgeDeletedBefore(ctx, time.Now().Add(time.Minute))
+	mustNoError(t, err)
+	if purged != 1 {
+		t.Fatalf("PurgeDeletedBefore purged %d cards, want 1", purged)
+	}
+}
+
+func testPlayerContracts(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	persija := createTeam(t, r, "Persija", "Jakarta")
+	persib := createTeam(t, r, "Persib", "Bandung")
+	player := createPlayer(t, r, persija.ID, "Witan Sulaeman", 8)
+	createPlayer(t, r, persib.ID, "Marc Klok", 23)
+
+	// A new player starts with a current contract without a start
+	current, err := r.Contracts.FindCurrent(ctx, player.ID)
+	mustNoError(t, err)
+	if current.TeamID != persija.ID || current.JerseyNumber != 8 || current.StartsAt != nil {
+		t.Fatalf("first contract = %+v, want a current contract at Persija with number 8", current)
+	}
+	player.JerseyNumber = 11
+	mustNoError(t, r.Players.Update(ctx, player))
+	if current, err = r.Contracts.FindCurrent(ctx, player.ID); err != nil || current.JerseyNumber != 11 {
+		t.Fatalf("current contract after a jersey change = %+v (%v), want number 11", current, err)
+	}
+
+	transferAt := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	taken := &entity.PlayerContract{PlayerID: player.ID, TeamID: persib.ID, JerseyNumber: 23, StartsAt: &transferAt}
+	if err := r.Contracts.Transfer(ctx, taken); !errors.Is(err, repository.ErrDuplicateJerseyNumber) {
+		t.Fatalf("transfer onto a taken jersey number: got %v, want ErrDuplicateJerseyNumber", err)
+	}
+	if current, err = r.Contracts.FindCurrent(ctx, player.ID); err != nil || current.TeamID != persija.ID {
+		t.Fatalf("a failed transfer must change nothing, current contract = %+v (%v)", current, err)
+	}
+
+	mustNoError(t, r.Contracts.Transfer(ctx, &entity.PlayerContract{
+		PlayerID: player.ID, TeamID: persib.ID, JerseyNumber: 7, StartsAt: &transferAt,
+	}))
+	moved, err := r.Players.FindByID(ctx, player.ID)
+	mustNoError(t, err)
+	if moved.TeamID != persib.ID || moved.JerseyNumber != 7 {
+		t.Fatalf("player after the transfer = %+v, want Persib with number 7", moved)
+	}
+
+	contracts, err := r.Contracts.FindByPlayerID(ctx, player.ID)
+	mustNoError(t, err)
+	if len(contracts) != 2 || contracts[0].TeamID != persija.ID || contracts[0].EndsAt == nil ||
+		!contracts[0].EndsAt.Equal(transferAt) || contracts[1].Team == nil || !contracts[1].IsCurrent() {
+		t.Fatalf("contracts = %+v, want the ended Persija contract then the current Persib one", contracts)
+	}
+
+	before, after := transferAt.Add(-time.Hour), transferAt
+	if c, err := r.Contracts.FindAsOf(ctx, player.ID, before); err != nil || c.TeamID != persija.ID {
+		t.Fatalf("contract before the transfer = %+v (%v), want Persija", c, err)
+	}
+	if c, err := r.Contracts.FindAsOf(ctx, player.ID, after); err != nil || c.TeamID != persib.ID {
+		t.Fatalf("contract at the transfer = %+v (%v), want Persib", c, err)
+	}
+
+	roster, total, err := r.Contracts.FindByTeamAsOf(ctx, persib.ID, after, 1, 10)
+	mustNoError(t, err)
+	if total != 2 || roster[0].JerseyNumber != 7 || roster[0].Player == nil {
+		t.Fatalf("Persib roster at the transfer = %d, want 2 by jersey number with their player", total)
+	}
+	if _, total, _ := r.Contracts.FindByTeamAsOf(ctx, persib.ID, before, 1, 10); total != 1 {
+		t.Fatalf("Persib roster before the transfer = %d, want 1", total)
+	}
+
+	// The former team keeps the history, so it cannot be purged
+	mustNoError(t, r.Teams.Delete(ctx, persija.ID))
+	if err := r.Teams.Purge(ctx, persija.ID); !errors.Is(err, repository.ErrStillReferenced) {
+		t.Fatalf("purge of a former team: got %v, want ErrStillReferenced", err)
+	}
+
+	// Contracts go with their player
+	mustNoError(t, r.Players.Delete(ctx, player.ID))
+	mustNoError(t, r.Players.Purge(ctx, player.ID))
+	if contracts, err := r.Contracts.FindByPlayerID(ctx, player.ID); err != nil || len(contracts) != 0 {
+		t.Fatalf("contracts of a purged player = %d (%v), want none", len(contracts), err)
+	}
+	mustNoError(t, r.Teams.Purge(ctx, persija.ID))
+}
+
+func testTransferWindows(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	opens := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	inverted := &entity.TransferWindow{Season: "2024-2025", OpensAt: opens, ClosesAt: opens.Add(-time.Hour)}
+	if err := r.Windows.Create(ctx, inverted); !errors.Is(err, repository.ErrCheckViolation) {
+		t.Fatalf("create a window closing before it opens: got %v, want ErrCheckViolation", err)
+	}
+
+	winter := &entity.TransferWindow{Season: "2024-2025", Name: "Winter", OpensAt: opens, ClosesAt: opens.AddDate(0, 1, 0)}
+	summer := &entity.TransferWindow{Season: "2024-2025", Name: "Summer", OpensAt: opens.AddDate(0, -6, 0), ClosesAt: opens.AddDate(0, -4, 0)}
+	other := &entity.TransferWindow{Season: "2025", OpensAt: opens, ClosesAt: opens.AddDate(0, 2, 0)}
+	for _, window := range []*entity.TransferWindow{winter, summer, other} {
+		mustNoError(t, r.Windows.Create(ctx, window))
+	}
+
+	windows, err := r.Windows.FindBySeason(ctx, "2024-2025")
+	mustNoError(t, err)
+	if len(windows) != 2 || windows[0].ID != summer.ID || !windows[1].OpensAt.Equal(opens) {
+		t.Fatalf("FindBySeason = %+v, want summer then winter", windows)
+	}
+
+	mustNoError(t, r.Windows.Delete(ctx, summer.ID))
+	if _, err := r.Windows.FindByID(ctx, summer.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("FindByID of a deleted window: got %v, want gorm.ErrRecordNotFound", err)
+	}
+	if windows, _ := r.Windows.FindBySeason(ctx, "2024-2025"); len(windows) != 1 {
+		t.Fatalf("FindBySeason after a delete = %d windows, want 1", len(windows))
+	}
+}
+
+func testCompletedMatchCards(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	home := createTeam(t, r, "Persija", "Jakarta")
+	away := createTeam(t, r, "Persib", "Bandung")
+	booked := createPlayer(t, r, home.ID, "Booked", 4)
+	kickoff := time.Date(2024, 3, 10, 12, 30, 0, 0, time.UTC)
+
+	later := newMatch(home.ID, away.ID, kickoff.AddDate(0, 0, 7))
+	later.Status = entity.MatchStatusCompleted
+	mustNoError(t, r.Matches.Create(ctx, later))
+	earlier := newMatch(away.ID, home.ID, kickoff)
+	earlier.Status = entity.MatchStatusCompleted
+	mustNoError(t, r.Matches.Create(ctx, earlier))
+	pending := createMatch(t, r, home.ID, away.ID, kickoff.AddDate(0, 0, 3))
+	outside := newMatch(home.ID, away.ID, kickoff.AddDate(0, 1, 0))
+	outside.Status = entity.MatchStatusCompleted
+	mustNoError(t, r.Matches.Create(ctx, outside))
+
+	for _, match := range []*entity.Match{later, earlier, pending, outside} {
+		mustNoError(t, r.Cards.CreateBatch(ctx, []entity.Card{
+			{MatchID: match.ID, PlayerID: booked.ID, TeamID: home.ID, Minute: 80, Type: entity.CardRed},
+			{MatchID: match.ID, PlayerID: booked.ID, TeamID: home.ID, Minute: 20, Type: entity.CardYellow},
+		}))
+	}
+
+	cards, err := r.Cards.FindCompletedBetween(ctx, kickoff, kickoff.AddDate(0, 0, 14))
+	mustNoError(t, err)
+	if len(cards) != 4 || cards[0].MatchID != earlier.ID || cards[0].Minute != 20 || cards[2].MatchID != later.ID {
+		t.Fatalf("FindCompletedBetween = %d cards, want 4 of the completed matches in order", len(cards))
+	}
+	if cards[0].Match == nil || cards[0].Player == nil || cards[0].Team == nil {
+		t.Fatalf("FindCompletedBetween should preload the match, player and team")
+	}
+
+	mustNoError(t, r.Matches.Delete(ctx, earlier.ID))
+	if cards, _ := r.Cards.FindCompletedBetween(ctx, kickoff, kickoff.AddDate(0, 0, 14)); len(cards) != 2 {
+		t.Fatalf("FindCompletedBetween after deleting a match = %d cards, want 2", len(cards))
+	}
+}
+
+func testDisciplinaryRules(t *testing.T, r Repositories) {
+	ctx := context.Background()
+
+	invalid := &entity.DisciplinaryRule{Season: "2024-2025", YellowCardLimit: 0, RedCardBan: 1}
+	if err := r.Rules.Create(ctx, invalid); !errors.Is(err, repository.ErrCheckViolation) {
+		t.Fatalf("create with a yellow card limit of 0: got %v, want ErrCheckViolation", err)
+	}
+
+	rule := &entity.DisciplinaryRule{Season: "2024-2025", YellowCardLimit: 5, RedCardBan: 1}
+	mustNoError(t, r.Rules.Create(ctx, rule))
+	duplicate := &entity.DisciplinaryRule{Season: "2024-2025", YellowCardLimit: 3, RedCardBan: 2}
+	if err := r.Rules.Create(ctx, duplicate); !errors.Is(err, repository.ErrDuplicateKey) {
+		t.Fatalf("create a second rule for the season: got %v, want ErrDuplicateKey", err)
+	}
+	mustNoError(t, r.Rules.Create(ctx, &entity.DisciplinaryRule{Season: "2026", YellowCardLimit: 3, RedCardBan: 2}))
+
+	rule.RedCardBan = 3
+	mustNoError(t, r.Rules.Update(ctx, rule))
+	found, err := r.Rules.FindBySeason(ctx, "2024-2025")
+	mustNoError(t, err)
+	if found.ID != rule.ID || found.RedCardBan != 3 {
+		t.Fatalf("FindBySeason = %+v, want the updated rule", found)
+	}
+
+	rules, err := r.Rules.FindBySeasons(ctx, []string{"2024", "2024-2025", "2026"})
+	mustNoError(t, err)
+	if len(rules) != 2 || rules[0].Season != "2024-2025" {
+		t.Fatalf("FindBySeasons = %+v, want 2024-2025 and 2026", rules)
+	}
+
+	mustNoError(t, r.Rules.Delete(ctx, rule.ID))
+	if _, err := r.Rules.FindBySeason(ctx, "2024-2025"); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("FindBySeason of a deleted rule: got %v, want gorm.ErrRecordNotFound", err)
+	}
+}
+
+func testPlayerAvailability(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	team := createTeam(t, r, "Persija", "Jakarta")
+	injured := createPlayer(t, r, team.ID, "Injured", 4)
+	fit := createPlayer(t, r, team.ID, "Fit", 5)
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	expected := start.AddDate(0, 0, 14)
+
+	invalid := []entity.PlayerAvailability{
+		{PlayerID: injured.ID, Status: "tired", StartsAt: start},
+		{PlayerID: injured.ID, Status: entity.AvailabilityInjured, StartsAt: start, ExpectedReturnAt: &start},
+	}
+	for _, availability := range invalid {
+		if err := r.Availability.Create(ctx, &availability); !errors.Is(err, repository.ErrCheckViolation) {
+			t.Fatalf("create %+v: got %v, want ErrCheckViolation", availability, err)
+		}
+	}
+	orphan := &entity.PlayerAvailability{PlayerID: uuid.New(), Status: entity.AvailabilityIll, StartsAt: start}
+	if err := r.Availability.Create(ctx, orphan); !errors.Is(err, repository.ErrReferenceNotFound) {
+		t.Fatalf("create for a missing player: got %v, want ErrReferenceNotFound", err)
+	}
+
+	earlier := &entity.PlayerAvailability{PlayerID: injured.ID, Status: entity.AvailabilityIll, StartsAt: start.AddDate(0, -1, 0), ExpectedReturnAt: &start}
+	mustNoError(t, r.Availability.Create(ctx, earlier))
+	injury := &entity.PlayerAvailability{PlayerID: injured.ID, Status: entity.AvailabilityInjured, StartsAt: start, ExpectedReturnAt: &expected}
+	mustNoError(t, r.Availability.Create(ctx, injury))
+
+	records, err := r.Availability.FindByPlayerID(ctx, injured.ID)
+	mustNoError(t, err)
+	if len(records) != 2 || records[0].ID != injury.ID {
+		t.Fatalf("FindByPlayerID = %d records, want 2 with the injury first", len(records))
+	}
+
+	players := []uuid.UUID{injured.ID, fit.ID}
+	unavailable, err := r.Availability.FindUnavailableAt(ctx, players, start.AddDate(0, 0, 7))
+	mustNoError(t, err)
+	if len(unavailable) != 1 || unavailable[0].ID != injury.ID || unavailable[0].Player == nil {
+		t.Fatalf("FindUnavailableAt during the injury = %+v, want the injury with its player", unavailable)
+	}
+	if unavailable, _ := r.Availability.FindUnavailableAt(ctx, players, expected); len(unavailable) != 0 {
+		t.Fatalf("FindUnavailableAt on the expected return = %d records, want 0", len(unavailable))
+	}
+
+	// The actual return replaces the expected one
+	returned := expected.AddDate(0, 0, 7)
+	injury.ReturnedAt = &returned
+	mustNoError(t, r.Availability.Update(ctx, injury))
+	if unavailable, _ := r.Availability.FindUnavailableAt(ctx, players, expected); len(unavailable) != 1 {
+		t.Fatalf("FindUnavailableAt before the return = %d records, want 1", len(unavailable))
+	}
+
+	mustNoError(t, r.Availability.Delete(ctx, injury.ID))
+	if _, err := r.Availability.FindByID(ctx, injury.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("FindByID of a deleted record: got %v, want gorm.ErrRecordNotFound", err)
+	}
+	if unavailable, _ := r.Availability.FindUnavailableAt(ctx, players, expected); len(unavailable) != 0 {
+		t.Fatalf("FindUnavailableAt after deleting the injury = %d records, want 0", len(unavailable))
+	}
+}
+
+func testListFiltersAndSorts(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	teams := map[string]*entity.Team{}
+	for _, team := range []entity.Team{
+		{Name: "Persija", City: "Jakarta", FoundedYear: 1928},
+		{Name: "Persib", City: "Bandung", FoundedYear: 1933},
+		{Name: "Persikabo", City: "Bandung", FoundedYear: 1973},
+		{Name: "Arema", City: "Malang", FoundedYear: 1987},
+	} {
+		team := team
+		mustNoError(t, r.Teams.Create(ctx, &team))
+		teams[team.Name] = &team
+	}
+	all := listquery.Query{}
+
+	teamLists := []struct {
+		name  string
+		query listquery.Query
+		want  []string
+	}{
+		{"field and range", all.Where("city", listquery.Eq, "Bandung").Where("founded_year", listquery.Gte, 1950), []string{"Persikabo"}},
+		{"bounded range, descending", all.Where("founded_year", listquery.Gte, 1930).Where("founded_year", listquery.Lt, 1980).OrderBy(listquery.Desc("founded_year")), []string{"Persikabo", "Persib"}},
+		{"in", all.Where("city", listquery.In, []interface{}{"Jakarta", "Malang"}).OrderBy(listquery.Asc("name")), []string{"Arema", "Persija"}},
+		{"not equal", all.Where("city", listquery.Ne, "Bandung").OrderBy(listquery.Asc("name")), []string{"Arema", "Persija"}},
+		{"contains ignores case", all.Where("name", listquery.Contains, "SIK"), []string{"Persikabo"}},
+		{"two sort fields", all.OrderBy(listquery.Asc("city"), listquery.Desc("name")), []string{"Persikabo", "Persib", "Persija", "Arema"}},
+		{"search and filter", listquery.Query{Search: "bandung"}.Where("founded_year", listquery.Lt, 1950), []string{"Persib"}},
+	}
+	for _, l := range teamLists {
+		found, total, err := r.Teams.List(ctx, l.query, 1, 10)
+		mustNoError(t, err)
+		names := make([]string, len(found))
+		for i, team := range found {
+			names[i] = team.Name
+		}
+		if total != int64(len(l.want)) || !reflect.DeepEqual(names, l.want) {
+			t.Errorf("Teams.List(%s) = %v (total %d), want %v", l.name, names, total, l.want)
+		}
+	}
+
+	persija, persib, arema := teams["Persija"], teams["Persib"], teams["Arema"]
+	day := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	completed := createMatch(t, r, persija.ID, persib.ID, day)
+	two, one := 2, 1
+	completed.HomeScore, completed.AwayScore, completed.Status = &two, &one, entity.MatchStatusCompleted
+	mustNoError(t, r.Matches.Update(ctx, completed))
+	aremaHome := createMatch(t, r, arema.ID, persija.ID, day.AddDate(0, 0, 7))
+	persibHome := createMatch(t, r, persib.ID, arema.ID, day.AddDate(0, 0, 7))
+	cancelled := createMatch(t, r, persija.ID, arema.ID, day.AddDate(0, 1, 0))
+	cancelled.Status = entity.MatchStatusCancelled
+	mustNoError(t, r.Matches.Update(ctx, cancelled))
+
+	matchLists := []struct {
+		name  string
+		query listquery.Query
+		want  []uuid.UUID
+	}{
+		{"status and home or away team", all.Where("status", listquery.Eq, "scheduled").Where("team_id", listquery.Eq, persija.ID), []uuid.UUID{aremaHome.ID}},
+		{"team and kickoff", all.Where("team_id", listquery.Eq, persija.ID).Where("kickoff_at", listquery.Gte, day.AddDate(0, 0, 1)), []uuid.UUID{cancelled.ID, aremaHome.ID}},
+		{"any of the teams", all.Where("team_id", listquery.In, []interface{}{persib.ID, arema.ID}).OrderBy(listquery.Desc("kickoff_at"), listquery.Asc("home_team.name")), []uuid.UUID{cancelled.ID, aremaHome.ID, persibHome.ID, completed.ID}},
+		{"home team", all.Where("home_team_id", listquery.Eq, persib.ID), []uuid.UUID{persibHome.ID}},
+		{"any of the statuses", all.Where("status", listquery.In, []interface{}{"scheduled", "completed"}).Where("kickoff_at", listquery.Lt, day.AddDate(0, 1, 0)).OrderBy(listquery.Asc("kickoff_at"), listquery.Asc("home_team.name")), []uuid.UUID{completed.ID, aremaHome.ID, persibHome.ID}},
+		{"date, then home team name", all.OrderBy(listquery.Desc("match_date"), listquery.Asc("home_team.name")), []uuid.UUID{cancelled.ID, aremaHome.ID, persibHome.ID, completed.ID}},
+		{"home team name descending", all.OrderBy(listquery.Desc("home_team.name"), listquery.Asc("kickoff_at")), []uuid.UUID{completed.ID, cancelled.ID, persibHome.ID, aremaHome.ID}},
+	}
+	for _, l := range matchLists {
+		found, total, err := r.Matches.List(ctx, l.query.Including("home_team", "away_team"), 1, 10)
+		mustNoError(t, err)
+		ids := make([]uuid.UUID, len(found))
+		for i, match := range found {
+			ids[i] = match.ID
+			if match.HomeTeam == nil || match.AwayTeam == nil {
+				t.Fatalf("Matches.List(%s) did not load the teams of %s", l.name, match.ID)
+			}
+		}
+		if total != int64(len(l.want)) || !reflect.DeepEqual(ids, l.want) {
+			t.Errorf("Matches.List(%s) = %v (total %d), want %v", l.name, ids, total, l.want)
+		}
+	}
+
+	// Ties on the sort fields are broken by ID, so pages neither repeat nor
+	// skip matches
+	seen := map[uuid.UUID]bool{}
+	for page := 1; page <= 4; page++ {
+		found, _, err := r.Matches.List(ctx, all.OrderBy(listquery.Asc("status")), page, 1)
+		mustNoError(t, err)
+		if len(found) != 1 || seen[found[0].ID] {
+			t.Fatalf("page %d of matches by status repeats or skips a match", page)
+		}
+		seen[found[0].ID] = true
+	}
+
+	born := func(year int) *time.Time {
+		date := time.Date(year, 6, 1, 0, 0, 0, 0, time.UTC)
+		return &date
+	}
+	players := []*entity.Player{
+		{TeamID: persija.ID, Name: "Andritany", Height: 185, Weight: 80, Position: entity.PositionGoalkeeper, JerseyNumber: 1, DateOfBirth: born(1992)},
+		{TeamID: persija.ID, Name: "Rizky", Height: 178.5, Weight: 70, Position: entity.PositionDefender, JerseyNumber: 5},
+		{TeamID: persib.ID, Name: "Ciro", Height: 187, Weight: 82, Position: entity.PositionForward, JerseyNumber: 10, DateOfBirth: born(1988)},
+		{TeamID: persib.ID, Name: "David", Height: 172, Weight: 68, Position: entity.PositionForward, JerseyNumber: 19, DateOfBirth: born(1995)},
+	}
+	for _, player := range players {
+		mustNoError(t, r.Players.Create(ctx, player))
+	}
+
+	playerLists := []struct {
+		name  string
+		query listquery.Query
+		want  []string
+	}{
+		{"height range", all.Where("height", listquery.Gte, 180.0).Where("height", listquery.Lt, 187.0), []string{"Andritany"}},
+		{"height descending", all.Where("height", listquery.Gt, 175.0).OrderBy(listquery.Desc("height")), []string{"Ciro", "Andritany", "Rizky"}},
+		{"team name, then height", all.OrderBy(listquery.Asc("team.name"), listquery.Desc("height")), []string{"Ciro", "David", "Andritany", "Rizky"}},
+		{"unknown dates of birth last", all.OrderBy(listquery.Asc("date_of_birth")), []string{"Ciro", "Andritany", "David", "Rizky"}},
+		{"unknown dates of birth last, descending", all.OrderBy(listquery.Desc("date_of_birth")), []string{"David", "Andritany", "Ciro", "Rizky"}},
+		{"team and position", all.Where("team_id", listquery.Eq, persib.ID).Where("position", listquery.Eq, "forward").OrderBy(listquery.Desc("jersey_number")), []string{"David", "Ciro"}},
+		{"jersey numbers", all.Where("jersey_number", listquery.In, []interface{}{1, 19}).OrderBy(listquery.Asc("name")), []string{"Andritany", "David"}},
+	}
+	for _, l := range playerLists {
+		found, total, err := r.Players.List(ctx, l.query, 1, 10)
+		mustNoError(t, err)
+		names := make([]string, len(found))
+		for i, player := range found {
+			names[i] = player.Name
+		}
+		if total != int64(len(l.want)) || !reflect.DeepEqual(names, l.want) {
+			t.Errorf("Players.List(%s) = %v (total %d), want %v", l.name, names, total, l.want)
+		}
+	}
+}
+
+func testScroll(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	persija := createTeam(t, r, "Persija", "Jakarta")
+	persib := createTeam(t, r, "Persib", "Bandung")
+	born := func(year int) *time.Time {
+		date := time.Date(year, 6, 1, 0, 0, 0, 0, time.UTC)
+		return &date
+	}
+	for i, p := range []struct {
+		team   uuid.UUID
+		name   string
+		height float64
+		born   *time.Time
+	}{
+		{persija.ID, "Andritany", 185, born(1992)},
+		{persija.ID, "Rizky", 178.5, nil},
+		{persija.ID, "Hansamu", 185, born(1994)},
+		{persija.ID, "Marko", 185, nil},
+		{persib.ID, "Ciro", 187, born(1988)},
+		{persib.ID, "David", 172, born(1992)},
+		{persib.ID, "Febri", 172, born(1995)},
+	} {
+		player := newPlayer(p.team, p.name, i+1)
+		player.Height, player.DateOfBirth = p.height, p.born
+		mustNoError(t, r.Players.Create(ctx, player))
+	}
+
+	names := func(players []entity.Player) []string {
+		out := make([]string, len(players))
+		for i, p := range players {
+			out[i] = p.Name
+		}
+		return out
+	}
+	// through encodes and decodes a cursor, as a client passes it back
+	through := func(schema listquery.Schema, query listquery.Query, c *listquery.Cursor) *listquery.Cursor {
+		decoded, err := listquery.DecodeCursor(c.Encode(), schema, query.SortOrDefault(schema))
+		mustNoError(t, err)
+		return decoded
+	}
+
+	all := listquery.Query{}
+	for _, query := range []listquery.Query{
+		all,
+		all.OrderBy(listquery.Asc("date_of_birth")),
+		all.OrderBy(listquery.Desc("date_of_birth"), listquery.Asc("name")),
+		all.OrderBy(listquery.Asc("team.name"), listquery.Desc("height")),
+		all.OrderBy(listquery.Desc("height")),
+		all.Where("height", listquery.Gte, 180.0).OrderBy(listquery.Asc("height")),
+	} {
+		sort := listquery.FormatSort(query.SortOrDefault(repository.PlayerListSchema))
+		list, total, err := r.Players.List(ctx, query, 1, 100)
+		mustNoError(t, err)
+		want := names(list)
+
+		// Forwards through the whole list, two at a time
+		var got []string
+		var last listquery.Slice[entity.Player]
+		window := listquery.Window{Limit: 2, Count: true}
+		for pages := 0; ; pages++ {
+			if pages > len(want) {
+				t.Fatalf("Scroll(%s) does not end", sort)
+			}
+			slice, err := r.Players.Scroll(ctx, query, window)
+			mustNoError(t, err)
+			// Only the first window asks for the total
+			if (pages == 0) != (slice.Total != nil) || (slice.Total != nil && *slice.Total != total) {
+				t.Fatalf("Scroll(%s) page %d total = %v, want %d", sort, pages, slice.Total, total)
+			}
+			if (slice.Prev == nil) != (pages == 0) {
+				t.Fatalf("Scroll(%s) page %d has prev cursor %v", sort, pages, slice.Prev)
+			}
+			got = append(got, names(slice.Items)...)
+			last = slice
+			if slice.Next == nil {
+				break
+			}
+			window = listquery.Window{Cursor: through(repository.PlayerListSchema, query, slice.Next), Limit: 2}
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Scroll(%s) forwards = %v, want %v", sort, got, want)
+		}
+
+		// And back again from the last page
+		got = names(last.Items)
+		for cursor := last.Prev; cursor != nil; {
+			slice, err := r.Players.Scroll(ctx, query, listquery.Window{Cursor: through(repository.PlayerListSchema, query, cursor), Limit: 2})
+			mustNoError(t, err)
+			if slice.Total != nil || slice.Next == nil {
+				t.Fatalf("Scroll(%s) backwards = %+v, want a next cursor and no total", sort, slice)
+			}
+			got = append(names(slice.Items), got...)
+			cursor = slice.Prev
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Scroll(%s) backwards = %v, want %v", sort, got, want)
+		}
+	}
+
+	// A player added before the cursor does not shift the next page
+	byName := all.OrderBy(listquery.Asc("name"))
+	first, err := r.Players.Scroll(ctx, byName, listquery.Window{Limit: 3})
+	mustNoError(t, err)
+	createPlayer(t, r, persib.ID, "Abdul", 30)
+	next, err := r.Players.Scroll(ctx, byName, listquery.Window{Cursor: first.Next, Limit: 3})
+	mustNoError(t, err)
+	if got := names(next.Items); !reflect.DeepEqual(got, []string{"Febri", "Hansamu", "Marko"}) {
+		t.Errorf("Scroll after an insert = %v, want the players after David", got)
+	}
+
+	// Teams and matches scroll the same way
+	teams, err := r.Teams.Scroll(ctx, all.OrderBy(listquery.Asc("name")), listquery.Window{Limit: 1})
+	mustNoError(t, err)
+	if len(teams.Items) != 1 || teams.Items[0].Name != "Persib" || teams.Next == nil || teams.Prev != nil {
+		t.Errorf("Teams.Scroll = %+v, want Persib and a next cursor", teams)
+	}
+	teams, err = r.Teams.Scroll(ctx, all.OrderBy(listquery.Asc("name")), listquery.Window{Cursor: teams.Next, Limit: 1})
+	mustNoError(t, err)
+	if len(teams.Items) != 1 || teams.Items[0].Name != "Persija" || teams.Next != nil || teams.Prev == nil {
+		t.Errorf("Teams.Scroll = %+v, want Persija as the last page", teams)
+	}
+	day := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	early := createMatch(t, r, persija.ID, persib.ID, day)
+	late := createMatch(t, r, persib.ID, persija.ID, day.AddDate(0, 0, 7))
+	matches, err := r.Matches.Scroll(ctx, all.Including("home_team", "away_team"), listquery.Window{Limit: 1})
+	mustNoError(t, err)
+	if len(matches.Items) != 1 || matches.Items[0].ID != late.ID || matches.Items[0].HomeTeam == nil {
+		t.Fatalf("Matches.Scroll = %+v, want the latest match with its teams", matches)
+	}
+	matches, err = r.Matches.Scroll(ctx, all, listquery.Window{Cursor: through(repository.MatchListSchema, all, matches.Next), Limit: 1})
+	mustNoError(t, err)
+	if len(matches.Items) != 1 || matches.Items[0].ID != early.ID || matches.Next != nil {
+		t.Errorf("Matches.Scroll = %+v, want the earlier match last", matches)
+	}
+}
+
+func testIncludedRelations(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	stadium := &entity.Venue{Name: "Gelora Bung Karno", City: "Jakarta", Capacity: 77193}
+	mustNoError(t, r.Venues.Create(ctx, stadium))
+	home := &entity.Team{Name: "Persija", City: "Jakarta", HomeVenueID: &stadium.ID}
+	mustNoError(t, r.Teams.Create(ctx, home))
+	away := createTeam(t, r, "Persib", "Bandung")
+	striker := createPlayer(t, r, home.ID, "Striker", 9)
+	createPlayer(t, r, home.ID, "Keeper", 1)
+	match := createMatch(t, r, home.ID, away.ID, time.Now())
+	mustNoError(t, r.Goals.Create(ctx, &entity.Goal{MatchID: match.ID, PlayerID: striker.ID, TeamID: home.ID, Minute: 70}))
+	mustNoError(t, r.Goals.Create(ctx, &entity.Goal{MatchID: match.ID, PlayerID: striker.ID, TeamID: home.ID, Minute: 15}))
+	mustNoError(t, r.Cards.CreateBatch(ctx, []entity.Card{{MatchID: match.ID, PlayerID: striker.ID, TeamID: home.ID, Minute: 30, Type: entity.CardYellow}}))
+
+	// Nothing is loaded unless included
+	team, err := r.Teams.FindByIDWith(ctx, home.ID, nil)
+	mustNoError(t, err)
+	if team.HomeVenue != nil || team.Players != nil {
+		t.Fatalf("Teams.FindByIDWith(nothing) loaded %+v", team)
+	}
+	teams, _, err := r.Teams.List(ctx, listquery.Query{}.Where("name", listquery.Eq, "Persija"), 1, 10)
+	mustNoError(t, err)
+	if len(teams) != 1 || teams[0].HomeVenue != nil || teams[0].Players != nil {
+		t.Fatalf("Teams.List(nothing) = %+v, want Persija alone", teams)
+	}
+	players, _, err := r.Players.List(ctx, listquery.Query{}, 1, 10)
+	mustNoError(t, err)
+	if len(players) != 2 || players[0].Team != nil {
+		t.Fatalf("Players.List(nothing) = %+v, want 2 players without their team", players)
+	}
+	found, err := r.Matches.FindByIDWith(ctx, match.ID, nil)
+	mustNoError(t, err)
+	if found.HomeTeam != nil || found.AwayTeam != nil || found.Venue != nil || found.Goals != nil || found.Cards != nil {
+		t.Fatalf("Matches.FindByIDWith(nothing) loaded %+v", found)
+	}
+
+	// Included relations are loaded, players by jersey number and goals by minute
+	team, err = r.Teams.FindByIDWith(ctx, home.ID, listquery.NewInclude("home_venue", "players"))
+	mustNoError(t, err)
+	if team.HomeVenue == nil || team.HomeVenue.ID != stadium.ID || len(team.Players) != 2 || team.Players[0].JerseyNumber != 1 {
+		t.Fatalf("Teams.FindByIDWith(home_venue, players) = %+v", team)
+	}
+	teams, _, err = r.Teams.List(ctx, listquery.Query{}.Where("name", listquery.Eq, "Persija").Including("players"), 1, 10)
+	mustNoError(t, err)
+	if len(teams) != 1 || teams[0].HomeVenue != nil || len(teams[0].Players) != 2 {
+		t.Fatalf("Teams.List(players) = %+v, want Persija with 2 players", teams)
+	}
+	player, err := r.Players.FindByIDWith(ctx, striker.ID, listquery.NewInclude("team"))
+	mustNoError(t, err)
+	if player.Team == nil || player.Team.ID != home.ID {
+		t.Fatalf("Players.FindByIDWith(team) = %+v", player)
+	}
+	found, err = r.Matches.FindByIDWith(ctx, match.ID, listquery.NewInclude("away_team", "goals.player"))
+	mustNoError(t, err)
+	if found.HomeTeam != nil || found.AwayTeam == nil || found.Cards != nil || len(found.Goals) != 2 {
+		t.Fatalf("Matches.FindByIDWith(away_team, goals.player) = %+v", found)
+	}
+	if goal := found.Goals[0]; goal.Minute != 15 || goal.Player == nil || goal.Player.ID != striker.ID || goal.Team != nil {
+		t.Fatalf("first goal = %+v, want the 15th minute with its player only", goal)
+	}
+
+	// A list sorted by a relation reads it for its cursors without
+	// returning it
+	matches, err := r.Matches.Scroll(ctx, listquery.Query{}.OrderBy(listquery.Asc("home_team.name")), listquery.Window{Limit: 1})
+	mustNoError(t, err)
+	if len(matches.Items) != 1 || matches.Items[0].HomeTeam != nil || matches.Items[0].AwayTeam != nil {
+		t.Fatalf("Matches.Scroll(by home team) = %+v, want the match without its teams", matches)
+	}
+	sorted, err := r.Players.Scroll(ctx, listquery.Query{}.OrderBy(listquery.Asc("team.name"), listquery.Asc("jersey_number")), listquery.Window{Limit: 1})
+	mustNoError(t, err)
+	if len(sorted.Items) != 1 || sorted.Items[0].Team != nil || sorted.Next == nil {
+		t.Fatalf("Players.Scroll(by team) = %+v, want a player without their team and a next cursor", sorted)
+	}
+	sorted, err = r.Players.Scroll(ctx, listquery.Query{}.OrderBy(listquery.Asc("team.name"), listquery.Asc("jersey_number")), listquery.Window{Cursor: sorted.Next, Limit: 1})
+	mustNoError(t, err)
+	if len(sorted.Items) != 1 || sorted.Items[0].ID != striker.ID {
+		t.Fatalf("Players.Scroll(by team) second page = %+v, want the striker", sorted)
+	}
+}
+
+func createTeam(t *testing.T, r Repositories, name, city string) *entity.Team {
+	t.Helper()
+	team := &entity.Team{Name: name, FoundedYear: 1928, City: city}
+	mustNoError(t, r.Teams.Create(context.Background(), team))
+	return team
+}
+
+func newPlayer(teamID uuid.UUID, name string, jerseyNumber int) *entity.Player {
+	return &entity.Player{
+		TeamID:       teamID,
+		Name:         name,
+		Height:       180,
+		Weight:       75,
+		Position:     entity.PositionForward,
+		JerseyNumber: jerseyNumber,
+	}
+}
+
+func createPlayer(t *testing.T, r Repositories, teamID uuid.UUID, name string, jerseyNumber int) *entity.Player {
+	t.Helper()
+	player := newPlayer(teamID, name, jerseyNumber)
+	mustNoError(t, r.Players.Create(context.Background(), player))
+	return player
+}
+
+func newMatch(homeID, awayID uuid.UUID, kickoff time.Time) *entity.Match {
+	return &entity.Match{
+		KickoffAt:  kickoff,
+		Timezone:   entity.DefaultTimezone,
+		HomeTeamID: homeID,
+		AwayTeamID: awayID,
+		Status:     entity.MatchStatusScheduled,
+	}
+}
+
+func createMatch(t *testing.T, r Repositories, homeID, awayID uuid.UUID, kickoff time.Time) *entity.Match {
+	t.Helper()
+	match := newMatch(homeID, awayID, kickoff)
+	mustNoError(t, r.Matches.Create(context.Background(), match))
+	return match
+}
+
+func mustNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -2,19 +2,77 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+	"github.com/zenkriztao/ayo-football-backend/pkg/textsearch"
 )
 
 // TeamRepository defines the interface for team data operations
 type TeamRepository interface {
 	Create(ctx context.Context, team *entity.Team) error
+	// CreateBatch stores all teams in a single transaction
+	CreateBatch(ctx context.Context, teams []entity.Team) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Team, error)
-	FindByIDWithPlayers(ctx context.Context, id uuid.UUID) (*entity.Team, error)
+	// FindByIDWith finds an active team with the related resources of
+	// include, see TeamListSchema. Players are ordered by jersey number.
+	FindByIDWith(ctx context.Context, id uuid.UUID, include listquery.Include) (*entity.Team, error)
 	Update(ctx context.Context, team *entity.Team) error
 	Delete(ctx context.Context, id uuid.UUID) error
-	FindAll(ctx context.Context, page, limit int) ([]entity.Team, int64, error)
-	Search(ctx context.Context, query string, page, limit int) ([]entity.Team, int64, error)
+	// DeleteCascade soft deletes the team together with its players and
+	// cancels its scheduled or ongoing matches in a single transaction.
+	// Completed matches and goals are kept for historical statistics.
+	DeleteCascade(ctx context.Context, id uuid.UUID) error
+	// List returns a page of the active teams matching the query with the
+	// related resources it includes, see TeamListSchema
+	List(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Team, int64, error)
+	// Scroll returns the slice of the same list selected by a cursor window
+	Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Team], error)
+	// Search returns up to limit active teams whose name or city best match text,
+	// best first. It folds case and accents and tolerates typos.
+	Search(ctx context.Context, text string, limit int) ([]textsearch.Hit[entity.Team], error)
 	Exists(ctx context.Context, id uuid.UUID) (bool, error)
+	// FindInBatches calls fn with consecutive batches of active teams,
+	// ordered by ID. Batches are read one at a time with a keyset cursor, so
+	// memory use does not grow with the table. fn must not keep the slice.
+	FindInBatches(ctx context.Context, batchSize int, fn func(teams []entity.Team) error) error
+	FindDeleted(ctx context.Context, page, limit int) ([]entity.Team, int64, error)
+	Restore(ctx context.Context, id uuid.UUID) error
+	Purge(ctx context.Context, id uuid.UUID) error
+	PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
+// TeamListSchema lists the fields teams can be filtered and sorted by, and
+// the related resources they can be loaded with. The search matches the
+// name and city.
+var TeamListSchema = listquery.Schema{
+	Fields: map[string]listquery.Field{
+		"name":          {Type: listquery.String, Operators: listquery.Text, Sortable: true},
+		"city":          {Type: listquery.String, Operators: listquery.Text, Sortable: true},
+		"founded_year":  {Type: listquery.Int, Operators: listquery.Range, Sortable: true},
+		"home_venue_id": {Type: listquery.UUID, Operators: listquery.Equality},
+		"created_at":    {Type: listquery.Time, Operators: listquery.Range, Sortable: true},
+	},
+	Search:      []string{"name", "city"},
+	DefaultSort: []listquery.Order{listquery.Desc("created_at")},
+	Relations:   []string{"home_venue", "players"},
+}
+
+// TeamListReader reads the fields of TeamListSchema from a team
+var TeamListReader = listquery.Reader[entity.Team]{
+	Fields: map[string]func(entity.Team) interface{}{
+		"name":         func(t entity.Team) interface{} { return t.Name },
+		"city":         func(t entity.Team) interface{} { return t.City },
+		"founded_year": func(t entity.Team) interface{} { return t.FoundedYear },
+		"home_venue_id": func(t entity.Team) interface{} {
+			if t.HomeVenueID == nil {
+				return nil
+			}
+			return *t.HomeVenueID
+		},
+		"created_at": func(t entity.Team) interface{} { return t.CreatedAt },
+	},
+	ID: func(t entity.Team) uuid.UUID { return t.ID },
 }
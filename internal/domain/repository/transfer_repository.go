@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// PlayerContractRepository defines the interface for the membership history
+// of players. PlayerRepository.Create and CreateBatch start the first
+// contract of a player, and PlayerRepository.Update keeps the jersey number
+// of the current contract in step with the player.
+type PlayerContractRepository interface {
+	// FindByPlayerID returns the contracts of a player, oldest first, with
+	// their team, including teams that have since been deleted
+	FindByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.PlayerContract, error)
+	// FindCurrent returns the contract of a player that has not ended
+	FindCurrent(ctx context.Context, playerID uuid.UUID) (*entity.PlayerContract, error)
+	// FindAsOf returns the contract of a player that covers the given time
+	FindAsOf(ctx context.Context, playerID uuid.UUID, at time.Time) (*entity.PlayerContract, error)
+	// FindByTeamAsOf returns the contracts of the active players that
+	// belonged to a team at the given time, with their player, ordered by
+	// jersey number
+	FindByTeamAsOf(ctx context.Context, teamID uuid.UUID, at time.Time, page, limit int) ([]entity.PlayerContract, int64, error)
+	// Transfer atomically ends the current contract of the player when
+	// the new contract starts, stores the new contract and moves the player
+	// to its team and jersey number
+	Transfer(ctx context.Context, contract *entity.PlayerContract) error
+}
+
+// TransferWindowRepository defines the interface for transfer window data operations
+type TransferWindowRepository interface {
+	Create(ctx context.Context, window *entity.TransferWindow) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.TransferWindow, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// FindBySeason returns the windows of a season in the order they open
+	FindBySeason(ctx context.Context, season string) ([]entity.TransferWindow, error)
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+	"github.com/zenkriztao/ayo-football-backend/pkg/textsearch"
+)
+
+// VenueRepository defines the interface for venue data operations
+type VenueRepository interface {
+	Create(ctx context.Context, venue *entity.Venue) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Venue, error)
+	Update(ctx context.Context, venue *entity.Venue) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// List returns a page of the active venues matching the query, see
+	// VenueListSchema
+	List(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Venue, int64, error)
+	// Scroll returns the slice of the same list selected by a cursor window
+	Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Venue], error)
+	// Search returns up to limit active venues whose name or city best match text,
+	// best first. It folds case and accents and tolerates typos.
+	Search(ctx context.Context, text string, limit int) ([]textsearch.Hit[entity.Venue], error)
+	Exists(ctx context.Context, id uuid.UUID) (bool, error)
+}
+
+// VenueListSchema lists the fields venues can be filtered and sorted by. The
+// search matches the name and city.
+var VenueListSchema = listquery.Schema{
+	Fields: map[string]listquery.Field{
+		"name":       {Type: listquery.String, Operators: listquery.Text, Sortable: true},
+		"city":       {Type: listquery.String, Operators: listquery.Text, Sortable: true},
+		"capacity":   {Type: listquery.Int, Operators: listquery.Range, Sortable: true},
+		"created_at": {Type: listquery.Time, Operators: listquery.Range, Sortable: true},
+	},
+	Search:      []string{"name", "city"},
+	DefaultSort: []listquery.Order{listquery.Asc("name")},
+}
+
+// VenueListReader reads the fields of VenueListSchema from a venue
+var VenueListReader = listquery.Reader[entity.Venue]{
+	Fields: map[string]func(entity.Venue) interface{}{
+		"name":       func(v entity.Venue) interface{} { return v.Name },
+		"city":       func(v entity.Venue) interface{} { return v.City },
+		"capacity":   func(v entity.Venue) interface{} { return v.Capacity },
+		"created_at": func(v entity.Venue) interface{} { return v.CreatedAt },
+	},
+	ID: func(v entity.Venue) uuid.UUID { return v.ID },
+}
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"reflect"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+)
+
+// AuditMetadata identifies who made a change and from where
+type AuditMetadata struct {
+	ActorID   *uuid.UUID
+	IPAddress string
+	RequestID string
+}
+
+type auditMetadataKey struct{}
+
+// ContextWithAuditMetadata returns a copy of ctx carrying the audit metadata
+func ContextWithAuditMetadata(ctx context.Context, meta AuditMetadata) context.Context {
+	return context.WithValue(ctx, auditMetadataKey{}, meta)
+}
+
+// AuditMetadataFromContext returns the audit metadata stored in ctx, if any
+func AuditMetadataFromContext(ctx context.Context) AuditMetadata {
+	meta, _ := ctx.Value(auditMetadataKey{}).(AuditMetadata)
+	return meta
+}
+
+// FieldChange represents the old and new value of a changed field
+type FieldChange struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// AuditUseCase defines the interface for audit log operations
+type AuditUseCase interface {
+	Record(ctx context.Context, action entity.AuditAction, entityType string, entityID uuid.UUID, before, after interface{})
+	GetAll(ctx context.Context, filter repository.AuditLogFilter, page, limit int) ([]entity.AuditLog, int64, error)
+}
+
+type auditUseCaseImpl struct {
+	auditRepo repository.AuditRepository
+}
+
+// NewAuditUseCase creates a new instance of AuditUseCase
+func NewAuditUseCase(auditRepo repository.AuditRepository) AuditUseCase {
+	return &auditUseCaseImpl{auditRepo: auditRepo}
+}
+
+// Record appends an audit entry for a change. The change has already been
+// persisted at this point, so failures are logged rather than returned.
+func (uc *auditUseCaseImpl) Record(ctx context.Context, action entity.AuditAction, entityType string, entityID uuid.UUID, before, after interface{}) {
+	beforeFields, err := toFieldMap(before)
+	if err != nil {
+		log.Printf("Warning: Failed to snapshot %s %s for audit: %v", entityType, entityID, err)
+		return
+	}
+	afterFields, err := toFieldMap(after)
+	if err != nil {
+		log.Printf("Warning: Failed to snapshot %s %s for audit: %v", entityType, entityID, err)
+		return
+	}
+
+	meta := AuditMetadataFromContext(ctx)
+	entry := &entity.AuditLog{
+		ActorID:    meta.ActorID,
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Before:     marshalOrEmpty(beforeFields),
+		After:      marshalOrEmpty(afterFields),
+		Changes:    marshalOrEmpty(diffFields(beforeFields, afterFields)),
+		IPAddress:  meta.IPAddress,
+		RequestID:  meta.RequestID,
+	}
+
+	if err := uc.auditRepo.Create(ctx, entry); err != nil {
+		log.Printf("Warning: Failed to record audit entry for %s %s: %v", entityType, entityID, err)
+	}
+}
+
+func (uc *auditUseCaseImpl) GetAll(ctx context.Context, filter repository.AuditLogFilter, page, limit int) ([]entity.AuditLog, int64, error) {
+	return uc.auditRepo.FindAll(ctx, filter, page, limit)
+}
+
+// toFieldMap converts an entity snapshot into a generic JSON field map
+func toFieldMap(v interface{}) (map[string]interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return nil, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// diffFields returns the fields whose value differs between two snapshots
+func diffFields(before, after map[string]interface{}) map[string]FieldChange {
+	changes := make(map[string]FieldChange)
+	for key, oldValue := range before {
+		if newValue, ok := after[key]; !ok || !reflect.DeepEqual(oldValue, newValue) {
+			changes[key] = FieldChange{From: oldValue, To: after[key]}
+		}
+	}
+	for key, newValue := range after {
+		if _, ok := before[key]; !ok {
+			changes[key] = FieldChange{From: nil, To: newValue}
+		}
+	}
+	// updated_at always moves on save and only adds noise to the diff
+	delete(changes, "updated_at")
+	return changes
+}
+
+// marshalOrEmpty encodes v as JSON, returning an empty string for empty values
+func marshalOrEmpty(v interface{}) string {
+	if reflect.ValueOf(v).Len() == 0 {
+		return ""
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// matchSnapshot returns a copy of match without its preloaded relations,
+// keeping the recorded goals and cards so result changes can be traced.
+func matchSnapshot(match *entity.Match) *entity.Match {
+	if match == nil {
+		return nil
+	}
+	snapshot := *match
+	snapshot.HomeTeam = nil
+	snapshot.AwayTeam = nil
+	snapshot.Venue = nil
+	if match.Goals != nil {
+		snapshot.Goals = make([]entity.Goal, len(match.Goals))
+		for i, goal := range match.Goals {
+			goal.Match = nil
+			goal.Player = nil
+			goal.Team = nil
+			snapshot.Goals[i] = goal
+		}
+	}
+	if match.Cards != nil {
+		snapshot.Cards = make([]entity.Card, len(match.Cards))
+		for i, card := range match.Cards {
+			card.Match = nil
+			card.Player = nil
+			card.Team = nil
+			snapshot.Cards[i] = card
+		}
+	}
+	return &snapshot
+}
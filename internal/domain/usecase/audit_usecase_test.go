@@ -0,0 +1,57 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+func TestAuditUseCase_RecordsMetadataAndChanges(t *testing.T) {
+	f := newFixture(t)
+	actorID := uuid.New()
+	ctx := usecase.ContextWithAuditMetadata(context.Background(), usecase.AuditMetadata{
+		ActorID:   &actorID,
+		IPAddress: "10.0.0.1",
+		RequestID: "req-1",
+	})
+
+	team := &entity.Team{Name: "Persija", FoundedYear: 1928, City: "Jakarta"}
+	if err := f.teamUseCase.Create(ctx, team); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	team.City = "Bekasi"
+	if err := f.teamUseCase.Update(ctx, team); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	logs, total, err := f.auditUseCase.GetAll(ctx, repository.AuditLogFilter{ActorID: &actorID}, 1, 10)
+	if err != nil {
+		t.Fatalf("get all: %v", err)
+	}
+	if total != 2 {
+		t.Fatalf("expected 2 entries for the actor, got %d", total)
+	}
+
+	update := logs[0]
+	if update.Action != entity.AuditActionUpdate || update.IPAddress != "10.0.0.1" || update.RequestID != "req-1" {
+		t.Fatalf("unexpected update entry: %+v", update)
+	}
+
+	var changes map[string]usecase.FieldChange
+	if err := json.Unmarshal([]byte(update.Changes), &changes); err != nil {
+		t.Fatalf("decode changes: %v", err)
+	}
+	if len(changes) != 1 || changes["city"].From != "Jakarta" || changes["city"].To != "Bekasi" {
+		t.Fatalf("expected only the city to change, got %+v", changes)
+	}
+
+	_, total, err = f.auditUseCase.GetAll(ctx, repository.AuditLogFilter{EntityType: entity.AuditEntityPlayer}, 1, 10)
+	if err != nil || total != 0 {
+		t.Fatalf("expected no player entries, got %d (%v)", total, err)
+	}
+}
@@ -84,6 +84,9 @@ func (uc *authUseCaseImpl) Register(ctx context.Context, name, email, password s
 	}
 
 	if err := uc.userRepo.Create(ctx, user); err != nil {
+		if errors.Is(err, repository.ErrDuplicateEmail) {
+			return nil, ErrUserAlreadyExists
+		}
 		return nil, err
 	}
 
@@ -0,0 +1,87 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/config"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/memory"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/security"
+)
+
+func newAuthUseCase() (usecase.AuthUseCase, security.JWTService) {
+	cfg := &config.Config{JWT: config.JWTConfig{Secret: "test-secret", ExpirationHours: 1}}
+	jwtService := security.NewJWTService(cfg)
+	return usecase.NewAuthUseCase(memory.NewUserRepository(memory.NewStore()), jwtService), jwtService
+}
+
+func TestAuthUseCase_RegisterAndLogin(t *testing.T) {
+	ctx := context.Background()
+	auth, jwtService := newAuthUseCase()
+
+	user, err := auth.Register(ctx, "Budi", "budi@example.com", "secret123", entity.RoleUser)
+	if err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	if user.Password == "secret123" {
+		t.Fatalf("expected the password to be hashed")
+	}
+
+	if _, err := auth.Register(ctx, "Budi Again", "budi@example.com", "other", entity.RoleUser); !errors.Is(err, usecase.ErrUserAlreadyExists) {
+		t.Fatalf("expected ErrUserAlreadyExists, got %v", err)
+	}
+
+	token, loggedIn, err := auth.Login(ctx, "budi@example.com", "secret123")
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if loggedIn.ID != user.ID {
+		t.Fatalf("expected to log in as the registered user")
+	}
+	claims, err := jwtService.ValidateToken(token)
+	if err != nil {
+		t.Fatalf("validate token: %v", err)
+	}
+	if claims.UserID != user.ID || claims.Role != string(entity.RoleUser) {
+		t.Fatalf("unexpected claims: %+v", claims)
+	}
+
+	if _, _, err := auth.Login(ctx, "budi@example.com", "wrong"); !errors.Is(err, usecase.ErrInvalidCredentials) {
+		t.Fatalf("expected ErrInvalidCredentials for a wrong password, got %v", err)
+	}
+	if _, _, err := auth.Login(ctx, "nobody@example.com", "secret123"); !errors.Is(err, usecase.ErrInvalidCredentials) {
+		t.Fatalf("expected ErrInvalidCredentials for an unknown email, got %v", err)
+	}
+}
+
+func TestAuthUseCase_GetUserByID(t *testing.T) {
+	ctx := context.Background()
+	auth, _ := newAuthUseCase()
+
+	if _, err := auth.GetUserByID(ctx, uuid.New()); !errors.Is(err, usecase.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestAuthUseCase_CreateDefaultAdminIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	auth, _ := newAuthUseCase()
+
+	for i := 0; i < 2; i++ {
+		if err := auth.CreateDefaultAdmin(ctx, "admin@example.com", "admin123"); err != nil {
+			t.Fatalf("create default admin (run %d): %v", i+1, err)
+		}
+	}
+
+	_, admin, err := auth.Login(ctx, "admin@example.com", "admin123")
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if !admin.IsAdmin() {
+		t.Fatalf("expected the default admin to have the admin role")
+	}
+}
@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrAvailabilityNotFound      = errors.New("availability record not found")
+	ErrInvalidAvailabilityStatus = errors.New("invalid availability status, must be injured, ill, international_duty, personal or other")
+	ErrInvalidAvailabilityPeriod = errors.New("expected return and return must be after the player became unavailable")
+)
+
+// SquadMember is a player of a squad with the records that make them
+// unavailable at the time the squad was taken. The jersey number of Player
+// is the one they wore then.
+type SquadMember struct {
+	Player      entity.Player
+	Unavailable []entity.PlayerAvailability
+}
+
+// IsAvailable reports whether nothing kept the player out of the squad
+func (m *SquadMember) IsAvailable() bool {
+	return len(m.Unavailable) == 0
+}
+
+// AvailabilityWarning flags a player used in a match while an availability
+// record says they could not play. Records are kept by the clubs and may be
+// out of date, so this warns rather than rejects.
+type AvailabilityWarning struct {
+	PlayerID     uuid.UUID
+	Availability entity.PlayerAvailability
+}
+
+// AvailabilityUseCase defines the interface for player availability operations
+type AvailabilityUseCase interface {
+	Create(ctx context.Context, availability *entity.PlayerAvailability) error
+	// Update replaces a record of a player. The player of a record cannot change.
+	Update(ctx context.Context, availability *entity.PlayerAvailability) error
+	Delete(ctx context.Context, playerID, id uuid.UUID) error
+	GetByID(ctx context.Context, playerID, id uuid.UUID) (*entity.PlayerAvailability, error)
+	GetByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.PlayerAvailability, error)
+	// GetSquadAvailability returns the players of a team at the given time,
+	// ordered by jersey number, with the records that make them unavailable
+	GetSquadAvailability(ctx context.Context, teamID uuid.UUID, at time.Time, page, limit int) ([]SquadMember, int64, error)
+}
+
+type availabilityUseCaseImpl struct {
+	availabilityRepo repository.PlayerAvailabilityRepository
+	playerRepo       repository.PlayerRepository
+	teamRepo         repository.TeamRepository
+	contractRepo     repository.PlayerContractRepository
+	auditUseCase     AuditUseCase
+}
+
+// NewAvailabilityUseCase creates a new instance of AvailabilityUseCase
+func NewAvailabilityUseCase(
+	availabilityRepo repository.PlayerAvailabilityRepository,
+	playerRepo repository.PlayerRepository,
+	teamRepo repository.TeamRepository,
+	contractRepo repository.PlayerContractRepository,
+	auditUseCase AuditUseCase,
+) AvailabilityUseCase {
+	return &availabilityUseCaseImpl{
+		availabilityRepo: availabilityRepo,
+		playerRepo:       playerRepo,
+		teamRepo:         teamRepo,
+		contractRepo:     contractRepo,
+		auditUseCase:     auditUseCase,
+	}
+}
+
+func (uc *availabilityUseCaseImpl) Create(ctx context.Context, availability *entity.PlayerAvailability) error {
+	if err := uc.checkPlayer(ctx, availability.PlayerID); err != nil {
+		return err
+	}
+	if err := validateAvailability(availability); err != nil {
+		return err
+	}
+
+	if err := uc.availabilityRepo.Create(ctx, availability); err != nil {
+		return err
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionCreate, entity.AuditEntityAvailability, availability.ID, nil, availability)
+	return nil
+}
+
+func (uc *availabilityUseCaseImpl) Update(ctx context.Context, availability *entity.PlayerAvailability) error {
+	before, err := uc.GetByID(ctx, availability.PlayerID, availability.ID)
+	if err != nil {
+		return err
+	}
+	if err := validateAvailability(availability); err != nil {
+		return err
+	}
+
+	availability.CreatedAt = before.CreatedAt
+	if err := uc.availabilityRepo.Update(ctx, availability); err != nil {
+		return err
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionUpdate, entity.AuditEntityAvailability, availability.ID, before, availability)
+	return nil
+}
+
+func (uc *availabilityUseCaseImpl) Delete(ctx context.Context, playerID, id uuid.UUID) error {
+	availability, err := uc.GetByID(ctx, playerID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.availabilityRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionDelete, entity.AuditEntityAvailability, id, availability, nil)
+	return nil
+}
+
+func (uc *availabilityUseCaseImpl) GetByID(ctx context.Context, playerID, id uuid.UUID) (*entity.PlayerAvailability, error) {
+	availability, err := uc.availabilityRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAvailabilityNotFound
+		}
+		return nil, err
+	}
+	if availability.PlayerID != playerID {
+		return nil, ErrAvailabilityNotFound
+	}
+	return availability, nil
+}
+
+func (uc *availabilityUseCaseImpl) GetByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.PlayerAvailability, error) {
+	if err := uc.checkPlayer(ctx, playerID); err != nil {
+		return nil, err
+	}
+	return uc.availabilityRepo.FindByPlayerID(ctx, playerID)
+}
+
+func (uc *availabilityUseCaseImpl) GetSquadAvailability(ctx context.Context, teamID uuid.UUID, at time.Time, page, limit int) ([]SquadMember, int64, error) {
+	if _, err := uc.teamRepo.FindByID(ctx, teamID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, 0, ErrTeamNotFound
+		}
+		return nil, 0, err
+	}
+
+	contracts, total, err := uc.contractRepo.FindByTeamAsOf(ctx, teamID, at, page, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	squad := make([]SquadMember, 0, len(contracts))
+	ids := make([]uuid.UUID, 0, len(contracts))
+	index := make(map[uuid.UUID]int, len(contracts))
+	for _, contract := range contracts {
+		if contract.Player == nil {
+			continue
+		}
+		player := *contract.Player
+		player.TeamID = contract.TeamID
+		player.JerseyNumber = contract.JerseyNumber
+		index[player.ID] = len(squad)
+		ids = append(ids, player.ID)
+		squad = append(squad, SquadMember{Player: player, Unavailable: []entity.PlayerAvailability{}})
+	}
+
+	records, err := uc.availabilityRepo.FindUnavailableAt(ctx, ids, at)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, record := range records {
+		record.Player = nil
+		member := &squad[index[record.PlayerID]]
+		member.Unavailable = append(member.Unavailable, record)
+	}
+	return squad, total, nil
+}
+
+func (uc *availabilityUseCaseImpl) checkPlayer(ctx context.Context, playerID uuid.UUID) error {
+	exists, err := uc.playerRepo.Exists(ctx, playerID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrPlayerNotFound
+	}
+	return nil
+}
+
+func validateAvailability(availability *entity.PlayerAvailability) error {
+	if !entity.IsValidAvailabilityStatus(availability.Status) {
+		return ErrInvalidAvailabilityStatus
+	}
+	if availability.ExpectedReturnAt != nil && !availability.StartsAt.Before(*availability.ExpectedReturnAt) {
+		return ErrInvalidAvailabilityPeriod
+	}
+	if availability.ReturnedAt != nil && !availability.StartsAt.Before(*availability.ReturnedAt) {
+		return ErrInvalidAvailabilityPeriod
+	}
+	return nil
+}
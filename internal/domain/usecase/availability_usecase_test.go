@@ -0,0 +1,131 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+func TestAvailabilityUseCase_CRUD(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	persija := f.createTeam(t, "Persija")
+	player := f.createPlayer(t, persija.ID, "Rizky Ridho", 5)
+	other := f.createPlayer(t, persija.ID, "Marko Simic", 9)
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	before := start.AddDate(0, 0, -1)
+
+	invalid := []struct {
+		name         string
+		availability entity.PlayerAvailability
+		want         error
+	}{
+		{"unknown player", entity.PlayerAvailability{PlayerID: uuid.New(), Status: entity.AvailabilityInjured, StartsAt: start}, usecase.ErrPlayerNotFound},
+		{"unknown status", entity.PlayerAvailability{PlayerID: player.ID, Status: "tired", StartsAt: start}, usecase.ErrInvalidAvailabilityStatus},
+		{"expected before start", entity.PlayerAvailability{PlayerID: player.ID, Status: entity.AvailabilityInjured, StartsAt: start, ExpectedReturnAt: &before}, usecase.ErrInvalidAvailabilityPeriod},
+		{"returned at start", entity.PlayerAvailability{PlayerID: player.ID, Status: entity.AvailabilityIll, StartsAt: start, ReturnedAt: &start}, usecase.ErrInvalidAvailabilityPeriod},
+	}
+	for _, tc := range invalid {
+		if err := f.availabilityUseCase.Create(ctx, &tc.availability); !errors.Is(err, tc.want) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.want, err)
+		}
+	}
+
+	injury := &entity.PlayerAvailability{PlayerID: player.ID, Status: entity.AvailabilityInjured, StartsAt: start}
+	if err := f.availabilityUseCase.Create(ctx, injury); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	returned := start.AddDate(0, 0, 10)
+	update := *injury
+	update.ReturnedAt = &returned
+	if err := f.availabilityUseCase.Update(ctx, &update); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	update.PlayerID = other.ID
+	if err := f.availabilityUseCase.Update(ctx, &update); !errors.Is(err, usecase.ErrAvailabilityNotFound) {
+		t.Fatalf("expected ErrAvailabilityNotFound for another player, got %v", err)
+	}
+
+	records, err := f.availabilityUseCase.GetByPlayerID(ctx, player.ID)
+	if err != nil {
+		t.Fatalf("get by player: %v", err)
+	}
+	if len(records) != 1 || records[0].ReturnedAt == nil || !records[0].ReturnedAt.Equal(returned) {
+		t.Fatalf("expected the updated injury, got %+v", records)
+	}
+
+	if err := f.availabilityUseCase.Delete(ctx, player.ID, injury.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := f.availabilityUseCase.Delete(ctx, player.ID, injury.ID); !errors.Is(err, usecase.ErrAvailabilityNotFound) {
+		t.Fatalf("expected ErrAvailabilityNotFound, got %v", err)
+	}
+	want := []entity.AuditAction{entity.AuditActionCreate, entity.AuditActionUpdate, entity.AuditActionDelete}
+	if got := f.auditActions(t, injury.ID); len(got) != len(want) || got[0] != want[0] || got[1] != want[1] || got[2] != want[2] {
+		t.Fatalf("expected audit actions %v, got %v", want, got)
+	}
+}
+
+func TestAvailabilityUseCase_Squad(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	persija := f.createTeam(t, "Persija")
+	persib := f.createTeam(t, "Persib")
+	injured := f.createPlayer(t, persija.ID, "Rizky Ridho", 5)
+	fit := f.createPlayer(t, persija.ID, "Marko Simic", 9)
+	match := f.createMatch(t, persija.ID, persib.ID)
+
+	expected := match.KickoffAt.AddDate(0, 0, 7)
+	injury := &entity.PlayerAvailability{
+		PlayerID:         injured.ID,
+		Status:           entity.AvailabilityInjured,
+		StartsAt:         match.KickoffAt.AddDate(0, 0, -7),
+		ExpectedReturnAt: &expected,
+	}
+	if err := f.availabilityUseCase.Create(ctx, injury); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	squad, total, err := f.availabilityUseCase.GetSquadAvailability(ctx, persija.ID, match.KickoffAt, 1, 10)
+	if err != nil {
+		t.Fatalf("squad: %v", err)
+	}
+	if total != 2 || len(squad) != 2 || squad[0].Player.ID != injured.ID || squad[0].IsAvailable() || !squad[1].IsAvailable() {
+		t.Fatalf("expected only the injured player to be unavailable, got %+v", squad)
+	}
+	squad, _, err = f.availabilityUseCase.GetSquadAvailability(ctx, persija.ID, expected, 1, 10)
+	if err != nil {
+		t.Fatalf("squad: %v", err)
+	}
+	if !squad[0].IsAvailable() {
+		t.Fatalf("expected the player back on the expected return, got %+v", squad[0].Unavailable)
+	}
+	if _, _, err := f.availabilityUseCase.GetSquadAvailability(ctx, uuid.New(), expected, 1, 10); !errors.Is(err, usecase.ErrTeamNotFound) {
+		t.Fatalf("expected ErrTeamNotFound, got %v", err)
+	}
+
+	// Using an unavailable player warns but still records the result
+	updated, warnings, err := f.matchUseCase.RecordResult(ctx, match.ID, usecase.MatchResultInput{
+		HomeScore: 1,
+		Goals:     []usecase.GoalInput{{PlayerID: injured.ID, TeamID: persija.ID, Minute: 30}},
+		Cards: []usecase.CardInput{
+			{PlayerID: injured.ID, TeamID: persija.ID, Minute: 40, Type: entity.CardYellow},
+			{PlayerID: fit.ID, TeamID: persija.ID, Minute: 50, Type: entity.CardYellow},
+		},
+	})
+	if err != nil {
+		t.Fatalf("record result: %v", err)
+	}
+	if updated.Status != entity.MatchStatusCompleted {
+		t.Fatalf("expected the match to be completed, got %s", updated.Status)
+	}
+	if len(warnings) != 1 || warnings[0].PlayerID != injured.ID || warnings[0].Availability.ID != injury.ID {
+		t.Fatalf("expected one warning for the injured player, got %+v", warnings)
+	}
+}
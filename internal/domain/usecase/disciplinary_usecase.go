@@ -0,0 +1,352 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrDisciplinaryRuleNotFound = errors.New("season has no disciplinary rules")
+	ErrInvalidDisciplinaryRule  = errors.New("yellow card limit and red card ban must be at least 1")
+	ErrDisciplinaryRuleOverlap  = errors.New("an overlapping season already has disciplinary rules")
+	ErrPlayerSuspended          = errors.New("player is suspended for this match")
+)
+
+// SuspensionReason is why a player was suspended
+type SuspensionReason string
+
+const (
+	// SuspensionYellowCards means the player reached the yellow card limit
+	SuspensionYellowCards SuspensionReason = "yellow_cards"
+	// SuspensionRedCard means the player was sent off
+	SuspensionRedCard SuspensionReason = "red_card"
+)
+
+// Suspension is a ban earned by a card. Matches are the matches the player
+// misses, in order: the next matches of their team in the season after the
+// match the card was shown in. Bans are served one after another, so a
+// match never counts towards two suspensions of the same player.
+type Suspension struct {
+	PlayerID uuid.UUID
+	Player   *entity.Player
+	Team     *entity.Team // the team the card was shown for
+	Reason   SuspensionReason
+	Match    *entity.Match // the match the card was shown in
+	Length   int           // matches
+	Matches  []entity.Match
+}
+
+// Served reports whether the player has missed every match of the ban.
+// Until the team has enough fixtures, Matches is shorter than Length.
+func (s *Suspension) Served() bool {
+	if len(s.Matches) < s.Length {
+		return false
+	}
+	for _, match := range s.Matches {
+		if match.Status != entity.MatchStatusCompleted {
+			return false
+		}
+	}
+	return true
+}
+
+// IsActive reports whether the player still has matches of the ban to miss
+func (s *Suspension) IsActive() bool {
+	return !s.Served()
+}
+
+// Covers reports whether the suspension bans the player from a match
+func (s *Suspension) Covers(matchID uuid.UUID) bool {
+	for _, match := range s.Matches {
+		if match.ID == matchID {
+			return true
+		}
+	}
+	return false
+}
+
+// DisciplinaryUseCase defines the interface for disciplinary rules and the
+// suspensions they produce. Rules belong to a season, and no two rules may
+// cover the same day, so every match falls under at most one rule.
+type DisciplinaryUseCase interface {
+	GetRule(ctx context.Context, season *Season) (*entity.DisciplinaryRule, error)
+	// SetRule creates or replaces the rule of a season
+	SetRule(ctx context.Context, season *Season, rule *entity.DisciplinaryRule) error
+	DeleteRule(ctx context.Context, season *Season) error
+	// GetSuspensions returns the suspensions of a season in the order the
+	// cards were shown, optionally only those that are still active
+	GetSuspensions(ctx context.Context, season *Season, activeOnly bool) ([]Suspension, error)
+	// GetMatchSuspensions returns the suspensions that ban players from a match
+	GetMatchSuspensions(ctx context.Context, matchID uuid.UUID) ([]Suspension, error)
+}
+
+type disciplinaryUseCaseImpl struct {
+	ruleRepo     repository.DisciplinaryRuleRepository
+	matchRepo    repository.MatchRepository
+	tracker      *suspensionTracker
+	auditUseCase AuditUseCase
+}
+
+// NewDisciplinaryUseCase creates a new instance of DisciplinaryUseCase
+func NewDisciplinaryUseCase(
+	ruleRepo repository.DisciplinaryRuleRepository,
+	matchRepo repository.MatchRepository,
+	cardRepo repository.CardRepository,
+	contractRepo repository.PlayerContractRepository,
+	auditUseCase AuditUseCase,
+) DisciplinaryUseCase {
+	return &disciplinaryUseCaseImpl{
+		ruleRepo:     ruleRepo,
+		matchRepo:    matchRepo,
+		tracker:      newSuspensionTracker(ruleRepo, matchRepo, cardRepo, contractRepo),
+		auditUseCase: auditUseCase,
+	}
+}
+
+func (uc *disciplinaryUseCaseImpl) GetRule(ctx context.Context, season *Season) (*entity.DisciplinaryRule, error) {
+	rule, err := uc.ruleRepo.FindBySeason(ctx, season.Name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrDisciplinaryRuleNotFound
+		}
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (uc *disciplinaryUseCaseImpl) SetRule(ctx context.Context, season *Season, rule *entity.DisciplinaryRule) error {
+	if rule.YellowCardLimit < 1 || rule.RedCardBan < 1 {
+		return ErrInvalidDisciplinaryRule
+	}
+
+	existing, err := uc.ruleRepo.FindBySeasons(ctx, overlappingSeasonNames(season))
+	if err != nil {
+		return err
+	}
+
+	var before *entity.DisciplinaryRule
+	for i := range existing {
+		if existing[i].Season != season.Name {
+			return ErrDisciplinaryRuleOverlap
+		}
+		before = &existing[i]
+	}
+
+	rule.Season = season.Name
+	if before == nil {
+		if err := uc.ruleRepo.Create(ctx, rule); err != nil {
+			return err
+		}
+		uc.auditUseCase.Record(ctx, entity.AuditActionCreate, entity.AuditEntityDisciplinaryRule, rule.ID, nil, rule)
+		return nil
+	}
+
+	rule.ID = before.ID
+	rule.CreatedAt = before.CreatedAt
+	if err := uc.ruleRepo.Update(ctx, rule); err != nil {
+		return err
+	}
+	uc.auditUseCase.Record(ctx, entity.AuditActionUpdate, entity.AuditEntityDisciplinaryRule, rule.ID, before, rule)
+	return nil
+}
+
+func (uc *disciplinaryUseCaseImpl) DeleteRule(ctx context.Context, season *Season) error {
+	rule, err := uc.GetRule(ctx, season)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.ruleRepo.Delete(ctx, rule.ID); err != nil {
+		return err
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionDelete, entity.AuditEntityDisciplinaryRule, rule.ID, rule, nil)
+	return nil
+}
+
+func (uc *disciplinaryUseCaseImpl) GetSuspensions(ctx context.Context, season *Season, activeOnly bool) ([]Suspension, error) {
+	rule, err := uc.ruleRepo.FindBySeason(ctx, season.Name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return []Suspension{}, nil
+		}
+		return nil, err
+	}
+
+	suspensions, err := uc.tracker.suspensions(ctx, season, rule)
+	if err != nil || !activeOnly {
+		return suspensions, err
+	}
+	active := []Suspension{}
+	for _, suspension := range suspensions {
+		if suspension.IsActive() {
+			active = append(active, suspension)
+		}
+	}
+	return active, nil
+}
+
+func (uc *disciplinaryUseCaseImpl) GetMatchSuspensions(ctx context.Context, matchID uuid.UUID) ([]Suspension, error) {
+	match, err := uc.matchRepo.FindByID(ctx, matchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMatchNotFound
+		}
+		return nil, err
+	}
+	return uc.tracker.forMatch(ctx, match)
+}
+
+// overlappingSeasonNames returns the names of the seasons that share a day
+// with a season, including the season itself. A calendar year overlaps the
+// July to June seasons on either side of it and vice versa.
+func overlappingSeasonNames(season *Season) []string {
+	year := season.Start.Year()
+	if strings.Contains(season.Name, "-") {
+		return []string{season.Name, strconv.Itoa(year), strconv.Itoa(year + 1)}
+	}
+	return []string{season.Name, fmt.Sprintf("%d-%d", year-1, year), fmt.Sprintf("%d-%d", year, year+1)}
+}
+
+// suspensionTracker works out the suspensions of a season from its cards.
+// Suspensions are not stored: recording or correcting a result changes the
+// bans that follow it, so they are computed from the cards each time.
+type suspensionTracker struct {
+	ruleRepo     repository.DisciplinaryRuleRepository
+	matchRepo    repository.MatchRepository
+	cardRepo     repository.CardRepository
+	contractRepo repository.PlayerContractRepository
+}
+
+func newSuspensionTracker(
+	ruleRepo repository.DisciplinaryRuleRepository,
+	matchRepo repository.MatchRepository,
+	cardRepo repository.CardRepository,
+	contractRepo repository.PlayerContractRepository,
+) *suspensionTracker {
+	return &suspensionTracker{
+		ruleRepo:     ruleRepo,
+		matchRepo:    matchRepo,
+		cardRepo:     cardRepo,
+		contractRepo: contractRepo,
+	}
+}
+
+// forMatch returns the suspensions that ban players from a match
+func (t *suspensionTracker) forMatch(ctx context.Context, match *entity.Match) ([]Suspension, error) {
+	names, err := seasonNamesAt(match.KickoffAt)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := t.ruleRepo.FindBySeasons(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return []Suspension{}, nil
+	}
+	season, err := ParseSeason(rules[0].Season)
+	if err != nil {
+		return nil, err
+	}
+
+	all, err := t.suspensions(ctx, season, &rules[0])
+	if err != nil {
+		return nil, err
+	}
+	banned := []Suspension{}
+	for _, suspension := range all {
+		if suspension.Covers(match.ID) {
+			banned = append(banned, suspension)
+		}
+	}
+	return banned, nil
+}
+
+// suspensions applies a rule to the cards of its season. Every
+// YellowCardLimit-th yellow card of a player bans them for one match and
+// every red card for RedCardBan matches.
+func (t *suspensionTracker) suspensions(ctx context.Context, season *Season, rule *entity.DisciplinaryRule) ([]Suspension, error) {
+	cards, err := t.cardRepo.FindCompletedBetween(ctx, season.Start, season.End)
+	if err != nil {
+		return nil, err
+	}
+	fixtures, err := t.matchRepo.FindScheduled(ctx, season.Start, season.End)
+	if err != nil {
+		return nil, err
+	}
+
+	yellows := make(map[uuid.UUID]int)
+	banned := make(map[uuid.UUID]map[uuid.UUID]bool)
+	contracts := make(map[uuid.UUID][]entity.PlayerContract)
+	suspensions := []Suspension{}
+	for _, card := range cards {
+		suspension := Suspension{
+			PlayerID: card.PlayerID,
+			Player:   card.Player,
+			Team:     card.Team,
+			Match:    card.Match,
+			Matches:  []entity.Match{},
+		}
+		switch card.Type {
+		case entity.CardYellow:
+			yellows[card.PlayerID]++
+			if yellows[card.PlayerID]%rule.YellowCardLimit != 0 {
+				continue
+			}
+			suspension.Reason = SuspensionYellowCards
+			suspension.Length = 1
+		case entity.CardRed:
+			suspension.Reason = SuspensionRedCard
+			suspension.Length = rule.RedCardBan
+		default:
+			continue
+		}
+
+		history, ok := contracts[card.PlayerID]
+		if !ok {
+			if history, err = t.contractRepo.FindByPlayerID(ctx, card.PlayerID); err != nil {
+				return nil, err
+			}
+			contracts[card.PlayerID] = history
+		}
+		if banned[card.PlayerID] == nil {
+			banned[card.PlayerID] = make(map[uuid.UUID]bool)
+		}
+
+		for _, fixture := range fixtures {
+			if len(suspension.Matches) == suspension.Length {
+				break
+			}
+			if !fixture.KickoffAt.After(card.Match.KickoffAt) || banned[card.PlayerID][fixture.ID] {
+				continue
+			}
+			if !playsFor(history, fixture) {
+				continue
+			}
+			banned[card.PlayerID][fixture.ID] = true
+			suspension.Matches = append(suspension.Matches, fixture)
+		}
+		suspensions = append(suspensions, suspension)
+	}
+	return suspensions, nil
+}
+
+// playsFor reports whether a player belonged to either team of a match at
+// kickoff, going by their contracts
+func playsFor(contracts []entity.PlayerContract, match entity.Match) bool {
+	for _, contract := range contracts {
+		if contract.Covers(match.KickoffAt) {
+			return contract.TeamID == match.HomeTeamID || contract.TeamID == match.AwayTeamID
+		}
+	}
+	return false
+}
@@ -0,0 +1,153 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+func TestDisciplinaryUseCase_Rules(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+
+	season, err := usecase.ParseSeason("2024")
+	if err != nil {
+		t.Fatalf("parse season: %v", err)
+	}
+	if err := f.disciplinaryUseCase.SetRule(ctx, season, &entity.DisciplinaryRule{YellowCardLimit: 0, RedCardBan: 1}); !errors.Is(err, usecase.ErrInvalidDisciplinaryRule) {
+		t.Fatalf("expected ErrInvalidDisciplinaryRule, got %v", err)
+	}
+	if _, err := f.disciplinaryUseCase.GetRule(ctx, season); !errors.Is(err, usecase.ErrDisciplinaryRuleNotFound) {
+		t.Fatalf("expected ErrDisciplinaryRuleNotFound, got %v", err)
+	}
+
+	rule := &entity.DisciplinaryRule{YellowCardLimit: 5, RedCardBan: 1}
+	if err := f.disciplinaryUseCase.SetRule(ctx, season, rule); err != nil {
+		t.Fatalf("set rule: %v", err)
+	}
+	replaced := &entity.DisciplinaryRule{YellowCardLimit: 3, RedCardBan: 2}
+	if err := f.disciplinaryUseCase.SetRule(ctx, season, replaced); err != nil {
+		t.Fatalf("replace rule: %v", err)
+	}
+	if replaced.ID != rule.ID {
+		t.Fatalf("expected the rule to be replaced in place, got a new ID")
+	}
+	got, err := f.disciplinaryUseCase.GetRule(ctx, season)
+	if err != nil || got.YellowCardLimit != 3 || got.RedCardBan != 2 {
+		t.Fatalf("expected the replaced rule, got %+v (%v)", got, err)
+	}
+
+	// 2024-2025 shares July to December 2024 with 2024
+	overlapping, err := usecase.ParseSeason("2024-2025")
+	if err != nil {
+		t.Fatalf("parse season: %v", err)
+	}
+	if err := f.disciplinaryUseCase.SetRule(ctx, overlapping, &entity.DisciplinaryRule{YellowCardLimit: 3, RedCardBan: 1}); !errors.Is(err, usecase.ErrDisciplinaryRuleOverlap) {
+		t.Fatalf("expected ErrDisciplinaryRuleOverlap, got %v", err)
+	}
+
+	if err := f.disciplinaryUseCase.DeleteRule(ctx, season); err != nil {
+		t.Fatalf("delete rule: %v", err)
+	}
+	want := []entity.AuditAction{entity.AuditActionCreate, entity.AuditActionUpdate, entity.AuditActionDelete}
+	if got := f.auditActions(t, rule.ID); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected audit actions %v, got %v", want, got)
+	}
+	if err := f.disciplinaryUseCase.DeleteRule(ctx, season); !errors.Is(err, usecase.ErrDisciplinaryRuleNotFound) {
+		t.Fatalf("expected ErrDisciplinaryRuleNotFound, got %v", err)
+	}
+}
+
+func TestDisciplinaryUseCase_Suspensions(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	persija := f.createTeam(t, "Persija")
+	persib := f.createTeam(t, "Persib")
+	arema := f.createTeam(t, "Arema")
+	player := f.createPlayer(t, persija.ID, "Rizky Ridho", 5)
+
+	season, err := usecase.ParseSeason("2024")
+	if err != nil {
+		t.Fatalf("parse season: %v", err)
+	}
+	if err := f.disciplinaryUseCase.SetRule(ctx, season, &entity.DisciplinaryRule{YellowCardLimit: 2, RedCardBan: 2}); err != nil {
+		t.Fatalf("set rule: %v", err)
+	}
+
+	first := f.createMatch(t, persija.ID, persib.ID)
+	second := f.createMatch(t, persija.ID, arema.ID)
+	elsewhere := f.createMatch(t, persib.ID, arema.ID)
+	banned := f.createMatch(t, persija.ID, persib.ID)
+	sentOff := f.createMatch(t, arema.ID, persija.ID)
+	last := f.createMatch(t, persija.ID, persib.ID)
+
+	book := func(match *entity.Match, cardType entity.CardType) {
+		t.Helper()
+		_, _, err := f.matchUseCase.RecordResult(ctx, match.ID, usecase.MatchResultInput{Cards: []usecase.CardInput{
+			{PlayerID: player.ID, TeamID: persija.ID, Minute: 30, Type: cardType},
+		}})
+		if err != nil {
+			t.Fatalf("record result: %v", err)
+		}
+	}
+	book(first, entity.CardYellow)
+	book(second, entity.CardYellow)
+
+	// The second yellow bans the player from the next Persija match, not
+	// the next match of the season
+	suspensions, err := f.disciplinaryUseCase.GetMatchSuspensions(ctx, banned.ID)
+	if err != nil {
+		t.Fatalf("match suspensions: %v", err)
+	}
+	if len(suspensions) != 1 || suspensions[0].PlayerID != player.ID || suspensions[0].Reason != usecase.SuspensionYellowCards || suspensions[0].Match.ID != second.ID {
+		t.Fatalf("expected a yellow card suspension from the second match, got %+v", suspensions)
+	}
+	if none, _ := f.disciplinaryUseCase.GetMatchSuspensions(ctx, elsewhere.ID); len(none) != 0 {
+		t.Fatalf("expected no suspensions in a match without Persija, got %d", len(none))
+	}
+
+	goal := usecase.MatchResultInput{HomeScore: 1, Goals: []usecase.GoalInput{{PlayerID: player.ID, TeamID: persija.ID, Minute: 10}}}
+	if _, _, err := f.matchUseCase.RecordResult(ctx, banned.ID, goal); !errors.Is(err, usecase.ErrPlayerSuspended) {
+		t.Fatalf("expected ErrPlayerSuspended, got %v", err)
+	}
+	if _, _, err := f.matchUseCase.RecordResult(ctx, banned.ID, usecase.MatchResultInput{}); err != nil {
+		t.Fatalf("record result: %v", err)
+	}
+	if _, _, err := f.matchUseCase.RecordResult(ctx, sentOff.ID, usecase.MatchResultInput{AwayScore: 1, Goals: goal.Goals}); err != nil {
+		t.Fatalf("expected the player to be available after serving the ban, got %v", err)
+	}
+	book(sentOff, entity.CardRed)
+
+	all, err := f.disciplinaryUseCase.GetSuspensions(ctx, season, false)
+	if err != nil {
+		t.Fatalf("suspensions: %v", err)
+	}
+	if len(all) != 2 || !all[0].Served() || all[1].Reason != usecase.SuspensionRedCard || all[1].Length != 2 {
+		t.Fatalf("expected a served yellow card ban and a red card ban, got %+v", all)
+	}
+	// Only one Persija match is left, so the red card ban carries on
+	if len(all[1].Matches) != 1 || all[1].Matches[0].ID != last.ID || !all[1].IsActive() {
+		t.Fatalf("expected the red card ban to cover the last match and stay active, got %+v", all[1])
+	}
+
+	active, err := f.disciplinaryUseCase.GetSuspensions(ctx, season, true)
+	if err != nil || len(active) != 1 || active[0].Reason != usecase.SuspensionRedCard {
+		t.Fatalf("expected only the red card ban to be active, got %+v (%v)", active, err)
+	}
+
+	other, err := usecase.ParseSeason("2025")
+	if err != nil {
+		t.Fatalf("parse season: %v", err)
+	}
+	if none, err := f.disciplinaryUseCase.GetSuspensions(ctx, other, false); err != nil || len(none) != 0 {
+		t.Fatalf("expected no suspensions in a season without rules, got %d (%v)", len(none), err)
+	}
+	if _, err := f.disciplinaryUseCase.GetMatchSuspensions(ctx, uuid.New()); !errors.Is(err, usecase.ErrMatchNotFound) {
+		t.Fatalf("expected ErrMatchNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+)
+
+// exportBatchSize is the number of records read from the database at a time
+const exportBatchSize = 500
+
+// ExportUseCase defines the interface for bulk export operations. Every
+// method streams the active records to fn in batches ordered by ID; fn must
+// not keep the slice it is given.
+type ExportUseCase interface {
+	ExportTeams(ctx context.Context, fn func(teams []entity.Team) error) error
+	ExportPlayers(ctx context.Context, fn func(players []entity.Player) error) error
+	ExportMatches(ctx context.Context, fn func(matches []entity.Match) error) error
+	ExportGoals(ctx context.Context, fn func(goals []entity.Goal) error) error
+}
+
+type exportUseCaseImpl struct {
+	teamRepo   repository.TeamRepository
+	playerRepo repository.PlayerRepository
+	matchRepo  repository.MatchRepository
+	goalRepo   repository.GoalRepository
+}
+
+// NewExportUseCase creates a new instance of ExportUseCase
+func NewExportUseCase(
+	teamRepo repository.TeamRepository,
+	playerRepo repository.PlayerRepository,
+	matchRepo repository.MatchRepository,
+	goalRepo repository.GoalRepository,
+) ExportUseCase {
+	return &exportUseCaseImpl{
+		teamRepo:   teamRepo,
+		playerRepo: playerRepo,
+		matchRepo:  matchRepo,
+		goalRepo:   goalRepo,
+	}
+}
+
+func (uc *exportUseCaseImpl) ExportTeams(ctx context.Context, fn func(teams []entity.Team) error) error {
+	return uc.teamRepo.FindInBatches(ctx, exportBatchSize, fn)
+}
+
+func (uc *exportUseCaseImpl) ExportPlayers(ctx context.Context, fn func(players []entity.Player) error) error {
+	return uc.playerRepo.FindInBatches(ctx, exportBatchSize, fn)
+}
+
+func (uc *exportUseCaseImpl) ExportMatches(ctx context.Context, fn func(matches []entity.Match) error) error {
+	return uc.matchRepo.FindInBatches(ctx, exportBatchSize, fn)
+}
+
+func (uc *exportUseCaseImpl) ExportGoals(ctx context.Context, fn func(goals []entity.Goal) error) error {
+	return uc.goalRepo.FindInBatches(ctx, exportBatchSize, fn)
+}
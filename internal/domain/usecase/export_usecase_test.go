@@ -0,0 +1,47 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+func TestExportUseCase_ExportPlayers(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	team := f.createTeam(t, "Persija")
+	kept := f.createPlayer(t, team.ID, "Marko Simic", 9)
+	removed := f.createPlayer(t, team.ID, "Riko", 8)
+	if err := f.playerUseCase.Delete(ctx, removed.ID); err != nil {
+		t.Fatalf("delete player: %v", err)
+	}
+
+	var exported []entity.Player
+	err := f.exportUseCase.ExportPlayers(ctx, func(players []entity.Player) error {
+		exported = append(exported, players...)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("export players: %v", err)
+	}
+	if len(exported) != 1 || exported[0].ID != kept.ID {
+		t.Fatalf("expected only the active player, got %+v", exported)
+	}
+	if exported[0].Team == nil || exported[0].Team.Name != "Persija" {
+		t.Fatalf("expected the team to be preloaded, got %+v", exported[0].Team)
+	}
+}
+
+func TestExportUseCase_StopsOnCallbackError(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	f.createTeam(t, "Persija")
+
+	errStop := errors.New("client went away")
+	err := f.exportUseCase.ExportTeams(ctx, func([]entity.Team) error { return errStop })
+	if !errors.Is(err, errStop) {
+		t.Fatalf("expected the callback error, got %v", err)
+	}
+}
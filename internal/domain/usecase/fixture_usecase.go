@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+var ErrInvalidSeason = errors.New("invalid season, must be a year such as 2024 or two consecutive years such as 2024-2025")
+
+// Season is the kickoff range of a season. End is exclusive: the first
+// moment after the season.
+type Season struct {
+	Name  string
+	Start time.Time
+	End   time.Time
+}
+
+// ParseSeason parses a season name. A single year is a calendar year; two
+// consecutive years, such as 2024-2025, run from July 1 to June 30. Seasons
+// turn over at midnight in the default time zone.
+func ParseSeason(name string) (*Season, error) {
+	loc, err := entity.LoadLocation(entity.DefaultTimezone)
+	if err != nil {
+		return nil, err
+	}
+	first, second, split := strings.Cut(name, "-")
+	start, err := parseSeasonYear(first)
+	if err != nil {
+		return nil, err
+	}
+	if !split {
+		return &Season{
+			Name:  name,
+			Start: time.Date(start, time.January, 1, 0, 0, 0, 0, loc),
+			End:   time.Date(start+1, time.January, 1, 0, 0, 0, 0, loc),
+		}, nil
+	}
+
+	end, err := parseSeasonYear(second)
+	if err != nil || end != start+1 {
+		return nil, ErrInvalidSeason
+	}
+	return &Season{
+		Name:  name,
+		Start: time.Date(start, time.July, 1, 0, 0, 0, 0, loc),
+		End:   time.Date(end, time.July, 1, 0, 0, 0, 0, loc),
+	}, nil
+}
+
+func parseSeasonYear(text string) (int, error) {
+	year, err := strconv.Atoi(text)
+	if err != nil || len(text) != 4 {
+		return 0, ErrInvalidSeason
+	}
+	return year, nil
+}
+
+// FixtureUseCase defines the interface for fixture list operations. Fixtures
+// include every active match, whatever its status.
+type FixtureUseCase interface {
+	GetTeamFixtures(ctx context.Context, teamID uuid.UUID) (*entity.Team, []entity.Match, error)
+	GetSeasonFixtures(ctx context.Context, season *Season) ([]entity.Match, error)
+}
+
+type fixtureUseCaseImpl struct {
+	matchRepo repository.MatchRepository
+	teamRepo  repository.TeamRepository
+}
+
+// NewFixtureUseCase creates a new instance of FixtureUseCase
+func NewFixtureUseCase(matchRepo repository.MatchRepository, teamRepo repository.TeamRepository) FixtureUseCase {
+	return &fixtureUseCaseImpl{
+		matchRepo: matchRepo,
+		teamRepo:  teamRepo,
+	}
+}
+
+func (uc *fixtureUseCaseImpl) GetTeamFixtures(ctx context.Context, teamID uuid.UUID) (*entity.Team, []entity.Match, error) {
+	team, err := uc.teamRepo.FindByID(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrTeamNotFound
+		}
+		return nil, nil, err
+	}
+
+	// A negative limit returns every match
+	matches, _, err := uc.matchRepo.FindByTeamID(ctx, teamID, 1, -1)
+	if err != nil {
+		return nil, nil, err
+	}
+	return team, matches, nil
+}
+
+func (uc *fixtureUseCaseImpl) GetSeasonFixtures(ctx context.Context, season *Season) ([]entity.Match, error) {
+	matches, _, err := uc.matchRepo.FindByDateRange(ctx, season.Start, season.End, 1, -1)
+	return matches, err
+}
@@ -0,0 +1,62 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+func TestParseSeason(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end string
+	}{
+		{"2024", "2024-01-01", "2025-01-01"},
+		{"2024-2025", "2024-07-01", "2025-07-01"},
+	}
+	for _, tt := range tests {
+		season, err := usecase.ParseSeason(tt.name)
+		if err != nil {
+			t.Fatalf("ParseSeason(%q): %v", tt.name, err)
+		}
+		if got := season.Start.Format(time.DateOnly); got != tt.start {
+			t.Fatalf("ParseSeason(%q) starts %s, expected %s", tt.name, got, tt.start)
+		}
+		if got := season.End.Format(time.DateOnly); got != tt.end {
+			t.Fatalf("ParseSeason(%q) ends %s, expected %s", tt.name, got, tt.end)
+		}
+	}
+
+	for _, name := range []string{"", "24", "2024-2023", "2024-2026", "2024-", "abcd"} {
+		if _, err := usecase.ParseSeason(name); !errors.Is(err, usecase.ErrInvalidSeason) {
+			t.Fatalf("ParseSeason(%q): expected ErrInvalidSeason, got %v", name, err)
+		}
+	}
+}
+
+func TestFixtureUseCase_GetTeamFixtures(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	home := f.createTeam(t, "Persija")
+	away := f.createTeam(t, "Persib")
+	other := f.createTeam(t, "Arema")
+	f.createMatch(t, home.ID, away.ID)
+	f.createMatch(t, away.ID, home.ID)
+	f.createMatch(t, away.ID, other.ID)
+
+	team, matches, err := f.fixtureUseCase.GetTeamFixtures(ctx, home.ID)
+	if err != nil {
+		t.Fatalf("get fixtures: %v", err)
+	}
+	if team.ID != home.ID || len(matches) != 2 {
+		t.Fatalf("expected 2 fixtures of %s, got %d of %s", home.Name, len(matches), team.Name)
+	}
+
+	if _, _, err := f.fixtureUseCase.GetTeamFixtures(ctx, uuid.New()); !errors.Is(err, usecase.ErrTeamNotFound) {
+		t.Fatalf("expected ErrTeamNotFound, got %v", err)
+	}
+}
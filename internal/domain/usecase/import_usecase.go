@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+)
+
+var ErrImportRejected = errors.New("import rejected because some rows are invalid")
+
+// ImportOptions controls how the rows of an import are stored
+type ImportOptions struct {
+	// DryRun validates the rows without storing anything
+	DryRun bool
+	// AllOrNothing stores the rows in one transaction, and only if every row is valid
+	AllOrNothing bool
+}
+
+// ImportRowError explains why a row of an import was rejected
+type ImportRowError struct {
+	Row     int
+	Message string
+}
+
+// TeamImportRow is a team read from row Row of an import file. ParseError
+// is set when the row could not be turned into a team.
+type TeamImportRow struct {
+	Row        int
+	Team       entity.Team
+	ParseError string
+}
+
+// PlayerImportRow is a player read from row Row of an import file.
+// ParseError is set when the row could not be turned into a player.
+type PlayerImportRow struct {
+	Row        int
+	Player     entity.Player
+	ParseError string
+}
+
+// ImportResult summarizes an import. Teams and Players hold the records that
+// were created or, on a dry run, the records that would be created.
+type ImportResult struct {
+	Total   int
+	Valid   int
+	Created int
+	Errors  []ImportRowError
+	Teams   []entity.Team
+	Players []entity.Player
+}
+
+// ImportUseCase defines the interface for bulk import operations
+type ImportUseCase interface {
+	ImportTeams(ctx context.Context, rows []TeamImportRow, opts ImportOptions) (*ImportResult, error)
+	ImportPlayers(ctx context.Context, rows []PlayerImportRow, opts ImportOptions) (*ImportResult, error)
+}
+
+type importUseCaseImpl struct {
+	teamRepo     repository.TeamRepository
+	playerRepo   repository.PlayerRepository
+	auditUseCase AuditUseCase
+}
+
+// NewImportUseCase creates a new instance of ImportUseCase
+func NewImportUseCase(
+	teamRepo repository.TeamRepository,
+	playerRepo repository.PlayerRepository,
+	auditUseCase AuditUseCase,
+) ImportUseCase {
+	return &importUseCaseImpl{
+		teamRepo:     teamRepo,
+		playerRepo:   playerRepo,
+		auditUseCase: auditUseCase,
+	}
+}
+
+func (uc *importUseCaseImpl) ImportTeams(ctx context.Context, rows []TeamImportRow, opts ImportOptions) (*ImportResult, error) {
+	result := &ImportResult{Total: len(rows)}
+	var valid []TeamImportRow
+	for _, row := range rows {
+		if row.ParseError != "" {
+			result.Errors = append(result.Errors, ImportRowError{Row: row.Row, Message: row.ParseError})
+			continue
+		}
+		valid = append(valid, row)
+	}
+	result.Valid = len(valid)
+
+	if opts.AllOrNothing && len(result.Errors) > 0 {
+		return result, ErrImportRejected
+	}
+	if opts.DryRun {
+		for _, row := range valid {
+			result.Teams = append(result.Teams, row.Team)
+		}
+		return result, nil
+	}
+
+	if opts.AllOrNothing {
+		teams := make([]entity.Team, len(valid))
+		for i, row := range valid {
+			teams[i] = row.Team
+		}
+		if err := uc.teamRepo.CreateBatch(ctx, teams); err != nil {
+			return nil, err
+		}
+		result.Teams = teams
+	} else {
+		for _, row := range valid {
+			team := row.Team
+			if err := uc.teamRepo.Create(ctx, &team); err != nil {
+				return nil, err
+			}
+			result.Teams = append(result.Teams, team)
+		}
+	}
+
+	for i := range result.Teams {
+		team := &result.Teams[i]
+		uc.auditUseCase.Record(ctx, entity.AuditActionCreate, entity.AuditEntityTeam, team.ID, nil, team)
+	}
+	result.Created = len(result.Teams)
+	return result, nil
+}
+
+func (uc *importUseCaseImpl) ImportPlayers(ctx context.Context, rows []PlayerImportRow, opts ImportOptions) (*ImportResult, error) {
+	result := &ImportResult{Total: len(rows)}
+	rowError := func(row int, message string) {
+		result.Errors = append(result.Errors, ImportRowError{Row: row, Message: message})
+	}
+
+	// Rows are checked against the database and against the rows before
+	// them, so two rows claiming the same jersey number are caught too
+	type jersey struct {
+		teamID uuid.UUID
+		number int
+	}
+	claimedBy := make(map[jersey]int)
+	var valid []PlayerImportRow
+	for _, row := range rows {
+		if row.ParseError != "" {
+			rowError(row.Row, row.ParseError)
+			continue
+		}
+		if err := validatePlayer(ctx, uc.playerRepo, uc.teamRepo, &row.Player, nil); err != nil {
+			if !isPlayerRuleError(err) {
+				return nil, err
+			}
+			rowError(row.Row, err.Error())
+			continue
+		}
+		key := jersey{row.Player.TeamID, row.Player.JerseyNumber}
+		if earlier, ok := claimedBy[key]; ok {
+			rowError(row.Row, fmt.Sprintf("jersey number %d is already used by row %d", key.number, earlier))
+			continue
+		}
+		claimedBy[key] = row.Row
+		valid = append(valid, row)
+	}
+	result.Valid = len(valid)
+
+	if opts.AllOrNothing && len(result.Errors) > 0 {
+		return result, ErrImportRejected
+	}
+	if opts.DryRun {
+		for _, row := range valid {
+			result.Players = append(result.Players, row.Player)
+		}
+		return result, nil
+	}
+
+	if opts.AllOrNothing {
+		players := make([]entity.Player, len(valid))
+		for i, row := range valid {
+			players[i] = row.Player
+		}
+		if err := uc.playerRepo.CreateBatch(ctx, players); err != nil {
+			return nil, translatePlayerError(err)
+		}
+		result.Players = players
+	} else {
+		// A player created concurrently may still claim a number between
+		// validation and insert; that row is reported instead of failing the import
+		for _, row := range valid {
+			player := row.Player
+			if err := uc.playerRepo.Create(ctx, &player); err != nil {
+				err = translatePlayerError(err)
+				if !isPlayerRuleError(err) {
+					return nil, err
+				}
+				rowError(row.Row, err.Error())
+				continue
+			}
+			result.Players = append(result.Players, player)
+		}
+		sort.SliceStable(result.Errors, func(i, j int) bool {
+			return result.Errors[i].Row < result.Errors[j].Row
+		})
+	}
+
+	for i := range result.Players {
+		player := &result.Players[i]
+		uc.auditUseCase.Record(ctx, entity.AuditActionCreate, entity.AuditEntityPlayer, player.ID, nil, player)
+	}
+	result.Created = len(result.Players)
+	return result, nil
+}
+
+// isPlayerRuleError reports whether err is a broken business rule of a
+// player rather than a failure of the repository
+func isPlayerRuleError(err error) bool {
+	return errors.Is(err, ErrTeamNotFound) ||
+		errors.Is(err, ErrInvalidPosition) ||
+		errors.Is(err, ErrInvalidJerseyNumber) ||
+		errors.Is(err, ErrJerseyNumberTaken) ||
+		errors.Is(err, ErrInvalidSecondaryPositions) ||
+		errors.Is(err, ErrInvalidNationality) ||
+		errors.Is(err, ErrInvalidPreferredFoot) ||
+		errors.Is(err, ErrInvalidDateOfBirth)
+}
@@ -0,0 +1,159 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+)
+
+// rosterRows returns a roster with one valid row and four broken ones
+func rosterRows(teamID uuid.UUID) []usecase.PlayerImportRow {
+	unknownPosition := newPlayer(teamID, "Unknown", 7)
+	unknownPosition.Position = "striker"
+	return []usecase.PlayerImportRow{
+		{Row: 2, Player: *newPlayer(teamID, "Riko", 8)},
+		{Row: 3, Player: *newPlayer(teamID, "Marko", 9)},    // taken by Simic
+		{Row: 4, Player: *newPlayer(teamID, "Ciro", 8)},     // taken by row 2
+		{Row: 5, Player: *unknownPosition},                  // invalid position
+		{Row: 6, ParseError: "height must be a number"},     // rejected by the parser
+		{Row: 7, Player: *newPlayer(uuid.New(), "Lost", 5)}, // unknown team
+	}
+}
+
+func rowNumbers(errs []usecase.ImportRowError) []int {
+	rows := make([]int, len(errs))
+	for i, rowErr := range errs {
+		rows[i] = rowErr.Row
+	}
+	return rows
+}
+
+func TestImportPlayersReportsRowErrors(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	team := f.createTeam(t, "Persija")
+	f.createPlayer(t, team.ID, "Simic", 9)
+
+	result, err := f.importUseCase.ImportPlayers(ctx, rosterRows(team.ID), usecase.ImportOptions{})
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if result.Total != 6 || result.Valid != 1 || result.Created != 1 {
+		t.Fatalf("expected 6 rows, 1 valid and 1 created, got %+v", result)
+	}
+	if rows := rowNumbers(result.Errors); !reflect.DeepEqual(rows, []int{3, 4, 5, 6, 7}) {
+		t.Fatalf("expected errors for rows 3-7, got %v", rows)
+	}
+	want := []string{
+		usecase.ErrJerseyNumberTaken.Error(),
+		"jersey number 8 is already used by row 2",
+		usecase.ErrInvalidPosition.Error(),
+		"height must be a number",
+		usecase.ErrTeamNotFound.Error(),
+	}
+	for i, rowErr := range result.Errors {
+		if rowErr.Message != want[i] {
+			t.Fatalf("row %d: expected %q, got %q", rowErr.Row, want[i], rowErr.Message)
+		}
+	}
+
+	created := result.Players[0]
+	if _, err := f.playerUseCase.GetByID(ctx, created.ID); err != nil {
+		t.Fatalf("imported player not stored: %v", err)
+	}
+	if actions := f.auditActions(t, created.ID); !reflect.DeepEqual(actions, []entity.AuditAction{entity.AuditActionCreate}) {
+		t.Fatalf("expected a create audit entry, got %v", actions)
+	}
+}
+
+func TestImportPlayersAllOrNothing(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	team := f.createTeam(t, "Persija")
+	f.createPlayer(t, team.ID, "Simic", 9)
+
+	result, err := f.importUseCase.ImportPlayers(ctx, rosterRows(team.ID), usecase.ImportOptions{AllOrNothing: true})
+	if !errors.Is(err, usecase.ErrImportRejected) {
+		t.Fatalf("expected ErrImportRejected, got %v", err)
+	}
+	if result.Created != 0 || len(result.Errors) != 5 {
+		t.Fatalf("expected 5 errors and nothing created, got %+v", result)
+	}
+	if _, total, _ := f.playerUseCase.GetByTeamID(ctx, team.ID, 1, 10); total != 1 {
+		t.Fatalf("expected a rejected import to store nothing, team has %d players", total)
+	}
+
+	rows := []usecase.PlayerImportRow{
+		{Row: 2, Player: *newPlayer(team.ID, "Riko", 8)},
+		{Row: 3, Player: *newPlayer(team.ID, "Ciro", 10)},
+	}
+	result, err = f.importUseCase.ImportPlayers(ctx, rows, usecase.ImportOptions{AllOrNothing: true})
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if result.Created != 2 {
+		t.Fatalf("expected 2 players created, got %+v", result)
+	}
+}
+
+func TestImportDryRunStoresNothing(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	team := f.createTeam(t, "Persija")
+	f.createPlayer(t, team.ID, "Simic", 9)
+
+	result, err := f.importUseCase.ImportPlayers(ctx, rosterRows(team.ID), usecase.ImportOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if result.Valid != 1 || result.Created != 0 || len(result.Players) != 1 || len(result.Errors) != 5 {
+		t.Fatalf("expected a preview of 1 player and 5 errors, got %+v", result)
+	}
+	if _, total, _ := f.playerUseCase.GetByTeamID(ctx, team.ID, 1, 10); total != 1 {
+		t.Fatalf("expected a dry run to store nothing, team has %d players", total)
+	}
+
+	teams := []usecase.TeamImportRow{
+		{Row: 2, Team: entity.Team{Name: "Persib", FoundedYear: 1933, City: "Bandung"}},
+		{Row: 3, ParseError: "city is required"},
+	}
+	result, err = f.importUseCase.ImportTeams(ctx, teams, usecase.ImportOptions{DryRun: true, AllOrNothing: true})
+	if !errors.Is(err, usecase.ErrImportRejected) {
+		t.Fatalf("expected ErrImportRejected, got %v", err)
+	}
+	if result.Valid != 1 || len(result.Errors) != 1 {
+		t.Fatalf("expected 1 valid team and 1 error, got %+v", result)
+	}
+}
+
+func TestImportTeams(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+
+	rows := []usecase.TeamImportRow{
+		{Row: 2, Team: entity.Team{Name: "Persib", FoundedYear: 1933, City: "Bandung"}},
+		{Row: 3, Team: entity.Team{Name: "Arema", FoundedYear: 1987, City: "Malang"}},
+	}
+	for _, opts := range []usecase.ImportOptions{{}, {AllOrNothing: true}} {
+		result, err := f.importUseCase.ImportTeams(ctx, rows, opts)
+		if err != nil {
+			t.Fatalf("import %+v: %v", opts, err)
+		}
+		if result.Created != 2 || result.Teams[1].ID == uuid.Nil {
+			t.Fatalf("import %+v: expected 2 teams created, got %+v", opts, result)
+		}
+		if actions := f.auditActions(t, result.Teams[1].ID); len(actions) != 1 {
+			t.Fatalf("import %+v: expected a create audit entry, got %v", opts, actions)
+		}
+	}
+
+	if _, total, _ := f.teamUseCase.GetAll(ctx, listquery.Query{}, 1, 10); total != 4 {
+		t.Fatalf("expected 4 teams, got %d", total)
+	}
+}
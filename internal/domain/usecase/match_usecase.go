@@ -8,22 +8,67 @@ import (
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
 	"gorm.io/gorm"
 )
 
 var (
-	ErrMatchNotFound       = errors.New("match not found")
-	ErrSameTeamMatch       = errors.New("home team and away team cannot be the same")
-	ErrMatchAlreadyPlayed  = errors.New("match has already been played")
-	ErrMatchNotCompleted   = errors.New("match has not been completed yet")
-	ErrInvalidMatchStatus  = errors.New("invalid match status")
+	ErrMatchNotFound      = errors.New("match not found")
+	ErrSameTeamMatch      = errors.New("home team and away team cannot be the same")
+	ErrMatchAlreadyPlayed = errors.New("match has already been played")
+	ErrMatchNotCompleted  = errors.New("match has not been completed yet")
+	ErrInvalidMatchStatus = errors.New("invalid match status")
+	ErrNegativeScore      = errors.New("score cannot be negative")
+	ErrInvalidTimezone    = errors.New("invalid timezone, must be an IANA name such as Asia/Jakarta")
+	ErrKickoffRequired    = errors.New("kickoff time is required")
+	ErrScheduleConflict   = errors.New("match clashes with another scheduled match")
+	ErrInvalidCardType    = errors.New("invalid card type, must be yellow or red")
+	ErrPlayerNotInTeam    = errors.New("player did not belong to the team at kickoff")
 )
 
+// VenueBookingWindow is how far apart two kickoffs at one venue must be.
+// It covers the match itself plus time to clear and prepare the pitch.
+const VenueBookingWindow = 3 * time.Hour
+
+// ScheduleConflict is the reason a match cannot be scheduled
+type ScheduleConflict string
+
+const (
+	// ConflictVenueBooked means the venue hosts another match within VenueBookingWindow
+	ConflictVenueBooked ScheduleConflict = "venue_booked"
+	// ConflictTeamBusy means one of the teams already plays on the same day
+	ConflictTeamBusy ScheduleConflict = "team_busy"
+	// ConflictOfficialBusy means an appointed official officiates another
+	// match kicking off within MatchDuration
+	ConflictOfficialBusy ScheduleConflict = "official_busy"
+)
+
+// ScheduleConflictError is returned when a match clashes with an existing one
+type ScheduleConflictError struct {
+	Conflict ScheduleConflict
+	Match    entity.Match // the existing match
+}
+
+func (e *ScheduleConflictError) Error() string {
+	switch e.Conflict {
+	case ConflictVenueBooked:
+		return "venue is already booked for another match"
+	case ConflictOfficialBusy:
+		return "an official is already appointed to an overlapping match"
+	}
+	return "a team already has a match on that day"
+}
+
+func (e *ScheduleConflictError) Unwrap() error {
+	return ErrScheduleConflict
+}
+
 // MatchResultInput represents the input for recording a match result
 type MatchResultInput struct {
 	HomeScore int
 	AwayScore int
 	Goals     []GoalInput
+	Cards     []CardInput
 }
 
 // GoalInput represents a goal input
@@ -34,26 +79,48 @@ type GoalInput struct {
 	IsOwnGoal bool
 }
 
+// CardInput represents a card input
+type CardInput struct {
+	PlayerID uuid.UUID
+	TeamID   uuid.UUID
+	Minute   int
+	Type     entity.CardType
+}
+
 // MatchUseCase defines the interface for match operations
 type MatchUseCase interface {
 	Create(ctx context.Context, match *entity.Match) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Match, error)
-	GetByIDWithDetails(ctx context.Context, id uuid.UUID) (*entity.Match, error)
+	// GetByIDWith returns a match with the related resources of include, see
+	// repository.MatchListSchema
+	GetByIDWith(ctx context.Context, id uuid.UUID, include listquery.Include) (*entity.Match, error)
 	Update(ctx context.Context, match *entity.Match) error
 	Delete(ctx context.Context, id uuid.UUID) error
-	GetAll(ctx context.Context, page, limit int) ([]entity.Match, int64, error)
-	GetByDateRange(ctx context.Context, startDate, endDate time.Time, page, limit int) ([]entity.Match, int64, error)
-	GetByTeamID(ctx context.Context, teamID uuid.UUID, page, limit int) ([]entity.Match, int64, error)
-	GetByStatus(ctx context.Context, status entity.MatchStatus, page, limit int) ([]entity.Match, int64, error)
-	RecordResult(ctx context.Context, matchID uuid.UUID, input MatchResultInput) (*entity.Match, error)
+	// GetAll returns a page of the matches matching the query, see
+	// repository.MatchListSchema. It fails with ErrTeamNotFound when the
+	// query filters by a team that does not exist.
+	GetAll(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Match, int64, error)
+	// Scroll is GetAll with a cursor window instead of a page number
+	Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Match], error)
+	// RecordResult completes a match with its score, goals and cards. It
+	// also returns a warning for every scorer or booked player that an
+	// availability record says could not play.
+	RecordResult(ctx context.Context, matchID uuid.UUID, input MatchResultInput) (*entity.Match, []AvailabilityWarning, error)
 	GetCompletedMatches(ctx context.Context, page, limit int) ([]entity.Match, int64, error)
 }
 
 type matchUseCaseImpl struct {
-	matchRepo  repository.MatchRepository
-	teamRepo   repository.TeamRepository
-	playerRepo repository.PlayerRepository
-	goalRepo   repository.GoalRepository
+	matchRepo         repository.MatchRepository
+	teamRepo          repository.TeamRepository
+	playerRepo        repository.PlayerRepository
+	contractRepo      repository.PlayerContractRepository
+	goalRepo          repository.GoalRepository
+	cardRepo          repository.CardRepository
+	suspensions       *suspensionTracker
+	availabilityRepo  repository.PlayerAvailabilityRepository
+	venueRepo         repository.VenueRepository
+	matchOfficialRepo repository.MatchOfficialRepository
+	auditUseCase      AuditUseCase
 }
 
 // NewMatchUseCase creates a new instance of MatchUseCase
@@ -61,13 +128,27 @@ func NewMatchUseCase(
 	matchRepo repository.MatchRepository,
 	teamRepo repository.TeamRepository,
 	playerRepo repository.PlayerRepository,
+	contractRepo repository.PlayerContractRepository,
 	goalRepo repository.GoalRepository,
+	cardRepo repository.CardRepository,
+	ruleRepo repository.DisciplinaryRuleRepository,
+	availabilityRepo repository.PlayerAvailabilityRepository,
+	venueRepo repository.VenueRepository,
+	matchOfficialRepo repository.MatchOfficialRepository,
+	auditUseCase AuditUseCase,
 ) MatchUseCase {
 	return &matchUseCaseImpl{
-		matchRepo:  matchRepo,
-		teamRepo:   teamRepo,
-		playerRepo: playerRepo,
-		goalRepo:   goalRepo,
+		matchRepo:         matchRepo,
+		teamRepo:          teamRepo,
+		playerRepo:        playerRepo,
+		contractRepo:      contractRepo,
+		goalRepo:          goalRepo,
+		cardRepo:          cardRepo,
+		suspensions:       newSuspensionTracker(ruleRepo, matchRepo, cardRepo, contractRepo),
+		availabilityRepo:  availabilityRepo,
+		venueRepo:         venueRepo,
+		matchOfficialRepo: matchOfficialRepo,
+		auditUseCase:      auditUseCase,
 	}
 }
 
@@ -94,12 +175,131 @@ func (uc *matchUseCaseImpl) Create(ctx context.Context, match *entity.Match) err
 		return ErrSameTeamMatch
 	}
 
+	if err := normalizeKickoff(match); err != nil {
+		return err
+	}
+
 	// Set default status
 	if match.Status == "" {
 		match.Status = entity.MatchStatusScheduled
 	}
 
-	return uc.matchRepo.Create(ctx, match)
+	// Matches are played at the home ground unless a venue is given
+	if match.VenueID == nil {
+		home, err := uc.teamRepo.FindByID(ctx, match.HomeTeamID)
+		if err != nil {
+			return err
+		}
+		if home.HomeVenue != nil && !home.HomeVenue.DeletedAt.Valid {
+			match.VenueID = home.HomeVenueID
+		}
+	} else if err := uc.checkVenue(ctx, *match.VenueID); err != nil {
+		return err
+	}
+
+	if err := uc.checkSchedule(ctx, match); err != nil {
+		return err
+	}
+
+	if err := uc.matchRepo.Create(ctx, match); err != nil {
+		return translateMatchError(err)
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionCreate, entity.AuditEntityMatch, match.ID, nil, matchSnapshot(match))
+	return nil
+}
+
+// normalizeKickoff defaults the time zone of a match and checks it is known,
+// then stores the kickoff in UTC
+func normalizeKickoff(match *entity.Match) error {
+	if match.Timezone == "" {
+		match.Timezone = entity.DefaultTimezone
+	}
+	if _, err := entity.LoadLocation(match.Timezone); err != nil {
+		return ErrInvalidTimezone
+	}
+	if match.KickoffAt.IsZero() {
+		return ErrKickoffRequired
+	}
+	match.KickoffAt = match.KickoffAt.UTC()
+	return nil
+}
+
+func (uc *matchUseCaseImpl) checkVenue(ctx context.Context, id uuid.UUID) error {
+	exists, err := uc.venueRepo.Exists(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrVenueNotFound
+	}
+	return nil
+}
+
+// checkSchedule returns a ScheduleConflictError when the venue of the match
+// is booked within VenueBookingWindow of its kickoff, when either team
+// already plays on the same day in the match's time zone, or when one of its
+// officials is appointed to another match within MatchDuration. Cancelled
+// matches neither conflict nor block others.
+func (uc *matchUseCaseImpl) checkSchedule(ctx context.Context, match *entity.Match) error {
+	if match.Status == entity.MatchStatusCancelled {
+		return nil
+	}
+
+	if match.VenueID != nil {
+		booked, err := uc.matchRepo.FindScheduledAtVenue(ctx, *match.VenueID,
+			match.KickoffAt.Add(-VenueBookingWindow), match.KickoffAt.Add(VenueBookingWindow))
+		if err != nil {
+			return err
+		}
+		for _, other := range booked {
+			apart := match.KickoffAt.Sub(other.KickoffAt)
+			if apart < 0 {
+				apart = -apart
+			}
+			if other.ID != match.ID && apart < VenueBookingWindow {
+				return &ScheduleConflictError{Conflict: ConflictVenueBooked, Match: other}
+			}
+		}
+	}
+
+	local := match.LocalKickoff()
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, local.Location())
+	busy, err := uc.matchRepo.FindScheduledForTeams(ctx, []uuid.UUID{match.HomeTeamID, match.AwayTeamID},
+		day, day.AddDate(0, 0, 1))
+	if err != nil {
+		return err
+	}
+	for _, other := range busy {
+		if other.ID != match.ID {
+			return &ScheduleConflictError{Conflict: ConflictTeamBusy, Match: other}
+		}
+	}
+
+	// A new match has no officials yet
+	if match.ID == uuid.Nil {
+		return nil
+	}
+	appointed, err := uc.matchOfficialRepo.FindByMatchID(ctx, match.ID)
+	if err != nil || len(appointed) == 0 {
+		return err
+	}
+	officialIDs := make([]uuid.UUID, len(appointed))
+	for i, a := range appointed {
+		officialIDs[i] = a.OfficialID
+	}
+	return checkOfficialsFree(ctx, uc.matchOfficialRepo, match, officialIDs)
+}
+
+// scheduleChanged reports whether an update moves a match in time or space,
+// or brings a cancelled match back, so its schedule has to be checked again
+func scheduleChanged(before, after *entity.Match) bool {
+	return !before.KickoffAt.Equal(after.KickoffAt) ||
+		before.Timezone != after.Timezone ||
+		!sameID(before.VenueID, after.VenueID) ||
+		before.HomeTeamID != after.HomeTeamID ||
+		before.AwayTeamID != after.AwayTeamID ||
+		(before.Status == entity.MatchStatusCancelled && after.Status != entity.MatchStatusCancelled)
 }
 
 func (uc *matchUseCaseImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Match, error) {
@@ -113,8 +313,8 @@ func (uc *matchUseCaseImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.
 	return match, nil
 }
 
-func (uc *matchUseCaseImpl) GetByIDWithDetails(ctx context.Context, id uuid.UUID) (*entity.Match, error) {
-	match, err := uc.matchRepo.FindByIDWithDetails(ctx, id)
+func (uc *matchUseCaseImpl) GetByIDWith(ctx context.Context, id uuid.UUID, include listquery.Include) (*entity.Match, error) {
+	match, err := uc.matchRepo.FindByIDWith(ctx, id, include)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrMatchNotFound
@@ -126,13 +326,13 @@ func (uc *matchUseCaseImpl) GetByIDWithDetails(ctx context.Context, id uuid.UUID
 
 func (uc *matchUseCaseImpl) Update(ctx context.Context, match *entity.Match) error {
 	// Check match exists
-	exists, err := uc.matchRepo.Exists(ctx, match.ID)
+	before, err := uc.matchRepo.FindByID(ctx, match.ID)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrMatchNotFound
+		}
 		return err
 	}
-	if !exists {
-		return ErrMatchNotFound
-	}
 
 	// Validate teams
 	if match.HomeTeamID == match.AwayTeamID {
@@ -155,58 +355,102 @@ func (uc *matchUseCaseImpl) Update(ctx context.Context, match *entity.Match) err
 		return errors.New("away team not found")
 	}
 
-	return uc.matchRepo.Update(ctx, match)
+	if err := normalizeKickoff(match); err != nil {
+		return err
+	}
+
+	if match.VenueID != nil && !sameID(before.VenueID, match.VenueID) {
+		if err := uc.checkVenue(ctx, *match.VenueID); err != nil {
+			return err
+		}
+	}
+
+	if scheduleChanged(before, match) {
+		if err := uc.checkSchedule(ctx, match); err != nil {
+			return err
+		}
+	}
+
+	if err := uc.matchRepo.Update(ctx, match); err != nil {
+		return translateMatchError(err)
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionUpdate, entity.AuditEntityMatch, match.ID, matchSnapshot(before), matchSnapshot(match))
+	return nil
 }
 
 func (uc *matchUseCaseImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	exists, err := uc.matchRepo.Exists(ctx, id)
+	before, err := uc.matchRepo.FindByID(ctx, id)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrMatchNotFound
+		}
 		return err
 	}
-	if !exists {
-		return ErrMatchNotFound
-	}
-	return uc.matchRepo.Delete(ctx, id)
-}
 
-func (uc *matchUseCaseImpl) GetAll(ctx context.Context, page, limit int) ([]entity.Match, int64, error) {
-	return uc.matchRepo.FindAll(ctx, page, limit)
-}
+	if err := uc.matchRepo.Delete(ctx, id); err != nil {
+		return err
+	}
 
-func (uc *matchUseCaseImpl) GetByDateRange(ctx context.Context, startDate, endDate time.Time, page, limit int) ([]entity.Match, int64, error) {
-	return uc.matchRepo.FindByDateRange(ctx, startDate, endDate, page, limit)
+	uc.auditUseCase.Record(ctx, entity.AuditActionDelete, entity.AuditEntityMatch, id, matchSnapshot(before), nil)
+	return nil
 }
 
-func (uc *matchUseCaseImpl) GetByTeamID(ctx context.Context, teamID uuid.UUID, page, limit int) ([]entity.Match, int64, error) {
-	exists, err := uc.teamRepo.Exists(ctx, teamID)
-	if err != nil {
+func (uc *matchUseCaseImpl) GetAll(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Match, int64, error) {
+	if err := checkTeamFilter(ctx, uc.teamRepo, query); err != nil {
 		return nil, 0, err
 	}
-	if !exists {
-		return nil, 0, ErrTeamNotFound
-	}
-	return uc.matchRepo.FindByTeamID(ctx, teamID, page, limit)
+	return uc.matchRepo.List(ctx, query, page, limit)
 }
 
-func (uc *matchUseCaseImpl) GetByStatus(ctx context.Context, status entity.MatchStatus, page, limit int) ([]entity.Match, int64, error) {
-	return uc.matchRepo.FindByStatus(ctx, status, page, limit)
+func (uc *matchUseCaseImpl) Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Match], error) {
+	if err := checkTeamFilter(ctx, uc.teamRepo, query); err != nil {
+		return listquery.Slice[entity.Match]{}, err
+	}
+	return uc.matchRepo.Scroll(ctx, query, window)
 }
 
-func (uc *matchUseCaseImpl) RecordResult(ctx context.Context, matchID uuid.UUID, input MatchResultInput) (*entity.Match, error) {
+func (uc *matchUseCaseImpl) RecordResult(ctx context.Context, matchID uuid.UUID, input MatchResultInput) (*entity.Match, []AvailabilityWarning, error) {
 	// Get existing match
 	match, err := uc.matchRepo.FindByIDWithDetails(ctx, matchID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, ErrMatchNotFound
+			return nil, nil, ErrMatchNotFound
+		}
+		return nil, nil, err
+	}
+	before := matchSnapshot(match)
+
+	// Validate scorers and booked players before changing anything
+	for _, g := range input.Goals {
+		if err := uc.checkPlayedFor(ctx, match, g.PlayerID, g.TeamID, g.IsOwnGoal); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, c := range input.Cards {
+		if !entity.IsValidCardType(c.Type) {
+			return nil, nil, ErrInvalidCardType
+		}
+		if err := uc.checkPlayedFor(ctx, match, c.PlayerID, c.TeamID, false); err != nil {
+			return nil, nil, err
 		}
-		return nil, err
+	}
+	if err := uc.checkNotSuspended(ctx, match, input); err != nil {
+		return nil, nil, err
+	}
+	warnings, err := uc.availabilityWarnings(ctx, match, input)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// Check if match is already completed
 	if match.Status == entity.MatchStatusCompleted {
-		// Delete existing goals and record new ones
+		// Delete existing goals and cards and record new ones
 		if err := uc.goalRepo.DeleteByMatchID(ctx, matchID); err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		if err := uc.cardRepo.DeleteByMatchID(ctx, matchID); err != nil {
+			return nil, nil, err
 		}
 	}
 
@@ -216,21 +460,12 @@ func (uc *matchUseCaseImpl) RecordResult(ctx context.Context, matchID uuid.UUID,
 	match.Status = entity.MatchStatusCompleted
 
 	if err := uc.matchRepo.Update(ctx, match); err != nil {
-		return nil, err
+		return nil, nil, translateMatchError(err)
 	}
 
 	// Record goals
 	goals := make([]entity.Goal, len(input.Goals))
 	for i, g := range input.Goals {
-		// Validate player exists
-		exists, err := uc.playerRepo.Exists(ctx, g.PlayerID)
-		if err != nil {
-			return nil, err
-		}
-		if !exists {
-			return nil, ErrPlayerNotFound
-		}
-
 		goals[i] = entity.Goal{
 			MatchID:   matchID,
 			PlayerID:  g.PlayerID,
@@ -242,14 +477,128 @@ func (uc *matchUseCaseImpl) RecordResult(ctx context.Context, matchID uuid.UUID,
 
 	if len(goals) > 0 {
 		if err := uc.goalRepo.CreateBatch(ctx, goals); err != nil {
-			return nil, err
+			return nil, nil, translateMatchError(err)
 		}
 	}
 
+	// Record cards
+	cards := make([]entity.Card, len(input.Cards))
+	for i, c := range input.Cards {
+		cards[i] = entity.Card{
+			MatchID:  matchID,
+			PlayerID: c.PlayerID,
+			TeamID:   c.TeamID,
+			Minute:   c.Minute,
+			Type:     c.Type,
+		}
+	}
+
+	if err := uc.cardRepo.CreateBatch(ctx, cards); err != nil {
+		return nil, nil, translateMatchError(err)
+	}
+
 	// Fetch updated match with all details
-	return uc.matchRepo.FindByIDWithDetails(ctx, matchID)
+	updated, err := uc.matchRepo.FindByIDWithDetails(ctx, matchID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionRecordResult, entity.AuditEntityMatch, matchID, before, matchSnapshot(updated))
+	return updated, warnings, nil
+}
+
+// checkPlayedFor checks that a player exists and belonged to the team at
+// kickoff or, for an own goal, did not
+func (uc *matchUseCaseImpl) checkPlayedFor(ctx context.Context, match *entity.Match, playerID, teamID uuid.UUID, ownGoal bool) error {
+	exists, err := uc.playerRepo.Exists(ctx, playerID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrPlayerNotFound
+	}
+
+	contract, err := uc.contractRepo.FindAsOf(ctx, playerID, match.KickoffAt)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrPlayerNotInTeam
+		}
+		return err
+	}
+	if (contract.TeamID == teamID) == ownGoal {
+		return ErrPlayerNotInTeam
+	}
+	return nil
+}
+
+// checkNotSuspended returns ErrPlayerSuspended when a scorer or booked
+// player was banned from the match by an earlier card
+func (uc *matchUseCaseImpl) checkNotSuspended(ctx context.Context, match *entity.Match, input MatchResultInput) error {
+	suspensions, err := uc.suspensions.forMatch(ctx, match)
+	if err != nil {
+		return err
+	}
+	banned := make(map[uuid.UUID]bool, len(suspensions))
+	for _, suspension := range suspensions {
+		banned[suspension.PlayerID] = true
+	}
+	for _, g := range input.Goals {
+		if banned[g.PlayerID] {
+			return ErrPlayerSuspended
+		}
+	}
+	for _, c := range input.Cards {
+		if banned[c.PlayerID] {
+			return ErrPlayerSuspended
+		}
+	}
+	return nil
+}
+
+// availabilityWarnings returns a warning for every availability record that
+// covers the kickoff of a scorer or booked player
+func (uc *matchUseCaseImpl) availabilityWarnings(ctx context.Context, match *entity.Match, input MatchResultInput) ([]AvailabilityWarning, error) {
+	seen := make(map[uuid.UUID]bool)
+	var players []uuid.UUID
+	for _, g := range input.Goals {
+		if !seen[g.PlayerID] {
+			seen[g.PlayerID] = true
+			players = append(players, g.PlayerID)
+		}
+	}
+	for _, c := range input.Cards {
+		if !seen[c.PlayerID] {
+			seen[c.PlayerID] = true
+			players = append(players, c.PlayerID)
+		}
+	}
+
+	records, err := uc.availabilityRepo.FindUnavailableAt(ctx, players, match.KickoffAt)
+	if err != nil {
+		return nil, err
+	}
+	warnings := make([]AvailabilityWarning, len(records))
+	for i, record := range records {
+		warnings[i] = AvailabilityWarning{PlayerID: record.PlayerID, Availability: record}
+	}
+	return warnings, nil
 }
 
 func (uc *matchUseCaseImpl) GetCompletedMatches(ctx context.Context, page, limit int) ([]entity.Match, int64, error) {
 	return uc.matchRepo.GetCompletedMatches(ctx, page, limit)
 }
+
+// translateMatchError maps constraint violations caught by the database to domain errors
+func translateMatchError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrSameTeams):
+		return ErrSameTeamMatch
+	case errors.Is(err, repository.ErrNegativeScore):
+		return ErrNegativeScore
+	case errors.Is(err, repository.ErrReferenceNotFound):
+		// Players and the match itself are validated up front, so a dangling
+		// reference here is a team that does not exist
+		return ErrTeamNotFound
+	}
+	return err
+}
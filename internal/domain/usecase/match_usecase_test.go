@@ -0,0 +1,248 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+)
+
+func TestMatchUseCase_Create(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	home := f.createTeam(t, "Persija")
+	away := f.createTeam(t, "Persib")
+
+	match := f.createMatch(t, home.ID, away.ID)
+	if match.Status != entity.MatchStatusScheduled {
+		t.Fatalf("expected default status scheduled, got %q", match.Status)
+	}
+
+	same := &entity.Match{HomeTeamID: home.ID, AwayTeamID: home.ID, KickoffAt: time.Now()}
+	if err := f.matchUseCase.Create(ctx, same); !errors.Is(err, usecase.ErrSameTeamMatch) {
+		t.Fatalf("expected ErrSameTeamMatch, got %v", err)
+	}
+
+	unknown := &entity.Match{HomeTeamID: home.ID, AwayTeamID: uuid.New(), KickoffAt: time.Now()}
+	if err := f.matchUseCase.Create(ctx, unknown); err == nil {
+		t.Fatalf("expected an error for an unknown away team")
+	}
+
+	if match.Timezone != entity.DefaultTimezone || match.KickoffAt.Location() != time.UTC {
+		t.Fatalf("expected the default time zone and a UTC kickoff, got %q %v", match.Timezone, match.KickoffAt)
+	}
+	other := f.createTeam(t, "Arema")
+	unzoned := &entity.Match{HomeTeamID: home.ID, AwayTeamID: other.ID, KickoffAt: time.Now(), Timezone: "Mars/Olympus"}
+	if err := f.matchUseCase.Create(ctx, unzoned); !errors.Is(err, usecase.ErrInvalidTimezone) {
+		t.Fatalf("expected ErrInvalidTimezone, got %v", err)
+	}
+	missing := &entity.Match{HomeTeamID: home.ID, AwayTeamID: other.ID}
+	if err := f.matchUseCase.Create(ctx, missing); !errors.Is(err, usecase.ErrKickoffRequired) {
+		t.Fatalf("expected ErrKickoffRequired, got %v", err)
+	}
+}
+
+func TestMatchUseCase_RecordResult(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	home := f.createTeam(t, "Arema")
+	away := f.createTeam(t, "Persebaya")
+	striker := f.createPlayer(t, home.ID, "Striker", 9)
+	defender := f.createPlayer(t, away.ID, "Defender", 4)
+	match := f.createMatch(t, home.ID, away.ID)
+
+	input := usecase.MatchResultInput{
+		HomeScore: 2,
+		AwayScore: 0,
+		Goals: []usecase.GoalInput{
+			{PlayerID: striker.ID, TeamID: home.ID, Minute: 12},
+			{PlayerID: defender.ID, TeamID: home.ID, Minute: 80, IsOwnGoal: true},
+		},
+	}
+	updated, _, err := f.matchUseCase.RecordResult(ctx, match.ID, input)
+	if err != nil {
+		t.Fatalf("record result: %v", err)
+	}
+	if updated.Status != entity.MatchStatusCompleted || *updated.HomeScore != 2 || *updated.AwayScore != 0 {
+		t.Fatalf("unexpected match after result: %+v", updated)
+	}
+	if len(updated.Goals) != 2 {
+		t.Fatalf("expected 2 goals, got %d", len(updated.Goals))
+	}
+
+	// Recording again replaces the previous goals
+	input = usecase.MatchResultInput{
+		HomeScore: 1,
+		AwayScore: 0,
+		Goals:     []usecase.GoalInput{{PlayerID: striker.ID, TeamID: home.ID, Minute: 45}},
+	}
+	updated, _, err = f.matchUseCase.RecordResult(ctx, match.ID, input)
+	if err != nil {
+		t.Fatalf("re-record result: %v", err)
+	}
+	if len(updated.Goals) != 1 || updated.Goals[0].Minute != 45 {
+		t.Fatalf("expected goals to be replaced, got %+v", updated.Goals)
+	}
+
+	actions := f.auditActions(t, match.ID)
+	want := []entity.AuditAction{entity.AuditActionCreate, entity.AuditActionRecordResult, entity.AuditActionRecordResult}
+	if len(actions) != len(want) {
+		t.Fatalf("expected audit actions %v, got %v", want, actions)
+	}
+	for i := range want {
+		if actions[i] != want[i] {
+			t.Fatalf("expected audit actions %v, got %v", want, actions)
+		}
+	}
+}
+
+func TestMatchUseCase_RecordResultErrors(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	home := f.createTeam(t, "Bali United")
+	away := f.createTeam(t, "Borneo FC")
+	match := f.createMatch(t, home.ID, away.ID)
+
+	if _, _, err := f.matchUseCase.RecordResult(ctx, uuid.New(), usecase.MatchResultInput{}); !errors.Is(err, usecase.ErrMatchNotFound) {
+		t.Fatalf("expected ErrMatchNotFound, got %v", err)
+	}
+
+	negative := usecase.MatchResultInput{HomeScore: -1}
+	if _, _, err := f.matchUseCase.RecordResult(ctx, match.ID, negative); !errors.Is(err, usecase.ErrNegativeScore) {
+		t.Fatalf("expected ErrNegativeScore, got %v", err)
+	}
+
+	unknownScorer := usecase.MatchResultInput{
+		HomeScore: 1,
+		Goals:     []usecase.GoalInput{{PlayerID: uuid.New(), TeamID: home.ID, Minute: 10}},
+	}
+	if _, _, err := f.matchUseCase.RecordResult(ctx, match.ID, unknownScorer); !errors.Is(err, usecase.ErrPlayerNotFound) {
+		t.Fatalf("expected ErrPlayerNotFound, got %v", err)
+	}
+}
+
+func TestMatchUseCase_Queries(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	home := f.createTeam(t, "PSIS")
+	away := f.createTeam(t, "Persita")
+	other := f.createTeam(t, "PSS Sleman")
+	played := f.createMatch(t, home.ID, away.ID)
+	f.createMatch(t, away.ID, other.ID)
+
+	if _, _, err := f.matchUseCase.RecordResult(ctx, played.ID, usecase.MatchResultInput{HomeScore: 1}); err != nil {
+		t.Fatalf("record result: %v", err)
+	}
+
+	byTeam := listquery.Query{}.Where("team_id", listquery.Eq, away.ID)
+	_, total, err := f.matchUseCase.GetAll(ctx, byTeam, 1, 10)
+	if err != nil || total != 2 {
+		t.Fatalf("expected 2 matches for away team, got %d (%v)", total, err)
+	}
+	_, total, err = f.matchUseCase.GetAll(ctx, byTeam.Where("status", listquery.Eq, "completed"), 1, 10)
+	if err != nil || total != 1 {
+		t.Fatalf("expected 1 completed match for away team, got %d (%v)", total, err)
+	}
+	missing := listquery.Query{}.Where("team_id", listquery.Eq, uuid.New())
+	if _, _, err := f.matchUseCase.GetAll(ctx, missing, 1, 10); !errors.Is(err, usecase.ErrTeamNotFound) {
+		t.Fatalf("expected ErrTeamNotFound, got %v", err)
+	}
+
+	completed, total, err := f.matchUseCase.GetCompletedMatches(ctx, 1, 10)
+	if err != nil || total != 1 || completed[0].ID != played.ID {
+		t.Fatalf("expected only the played match to be completed, got %d (%v)", total, err)
+	}
+
+	_, total, err = f.matchUseCase.GetAll(ctx, listquery.Query{}.Where("status", listquery.Eq, "scheduled"), 1, 10)
+	if err != nil || total != 1 {
+		t.Fatalf("expected 1 scheduled match, got %d (%v)", total, err)
+	}
+
+	if err := f.matchUseCase.Delete(ctx, played.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := f.matchUseCase.GetByID(ctx, played.ID); !errors.Is(err, usecase.ErrMatchNotFound) {
+		t.Fatalf("expected ErrMatchNotFound after delete, got %v", err)
+	}
+}
+
+func TestMatchUseCase_ScheduleConflicts(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	persija := f.createTeam(t, "Persija")
+	persib := f.createTeam(t, "Persib")
+	arema := f.createTeam(t, "Arema")
+	bali := f.createTeam(t, "Bali United")
+	borneo := f.createTeam(t, "Borneo FC")
+	madura := f.createTeam(t, "Madura United")
+	psis := f.createTeam(t, "PSIS Semarang")
+	gbk := f.createVenue(t, "Gelora Bung Karno")
+
+	persija.HomeVenueID = &gbk.ID
+	if err := f.teamUseCase.Update(ctx, persija); err != nil {
+		t.Fatalf("set home venue: %v", err)
+	}
+
+	kickoff := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	first := &entity.Match{HomeTeamID: persija.ID, AwayTeamID: persib.ID, KickoffAt: kickoff}
+	if err := f.matchUseCase.Create(ctx, first); err != nil {
+		t.Fatalf("create match: %v", err)
+	}
+	if first.VenueID == nil || *first.VenueID != gbk.ID {
+		t.Fatalf("expected the home venue by default, got %v", first.VenueID)
+	}
+
+	var conflict *usecase.ScheduleConflictError
+	booked := &entity.Match{HomeTeamID: arema.ID, AwayTeamID: bali.ID, KickoffAt: kickoff.Add(2 * time.Hour), VenueID: &gbk.ID}
+	if err := f.matchUseCase.Create(ctx, booked); !errors.As(err, &conflict) || conflict.Conflict != usecase.ConflictVenueBooked || conflict.Match.ID != first.ID {
+		t.Fatalf("expected the venue to be booked by the first match, got %v", err)
+	}
+	booked.KickoffAt = kickoff.Add(usecase.VenueBookingWindow)
+	if err := f.matchUseCase.Create(ctx, booked); err != nil {
+		t.Fatalf("expected the venue to be free after the booking window, got %v", err)
+	}
+	earlier := &entity.Match{HomeTeamID: madura.ID, AwayTeamID: psis.ID, KickoffAt: kickoff.Add(-time.Hour), VenueID: &gbk.ID}
+	if err := f.matchUseCase.Create(ctx, earlier); !errors.As(err, &conflict) || conflict.Conflict != usecase.ConflictVenueBooked || conflict.Match.ID != first.ID {
+		t.Fatalf("expected the venue to be booked by the first match, got %v", err)
+	}
+	earlier.KickoffAt = kickoff.Add(-usecase.VenueBookingWindow)
+	if err := f.matchUseCase.Create(ctx, earlier); err != nil {
+		t.Fatalf("expected the venue to be free before the booking window, got %v", err)
+	}
+
+	// 23:00 in Jakarta is the same local day as the first match
+	busy := &entity.Match{HomeTeamID: persib.ID, AwayTeamID: arema.ID, KickoffAt: time.Date(2024, 5, 1, 16, 0, 0, 0, time.UTC)}
+	if err := f.matchUseCase.Create(ctx, busy); !errors.As(err, &conflict) || conflict.Conflict != usecase.ConflictTeamBusy {
+		t.Fatalf("expected ErrScheduleConflict for a team playing twice a day, got %v", err)
+	}
+	busy.KickoffAt = time.Date(2024, 5, 1, 17, 30, 0, 0, time.UTC) // 00:30 the next day in Jakarta
+	busy.AwayTeamID = persija.ID
+	if err := f.matchUseCase.Create(ctx, busy); err != nil {
+		t.Fatalf("expected the next local day to be free, got %v", err)
+	}
+
+	// Cancelling a match frees its slot, and bringing it back checks again
+	first.Status = entity.MatchStatusCancelled
+	if err := f.matchUseCase.Update(ctx, first); err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+	clash := &entity.Match{HomeTeamID: persib.ID, AwayTeamID: borneo.ID, KickoffAt: kickoff.Add(time.Hour)}
+	if err := f.matchUseCase.Create(ctx, clash); err != nil {
+		t.Fatalf("expected a cancelled match not to block the day, got %v", err)
+	}
+	first.Status = entity.MatchStatusScheduled
+	if err := f.matchUseCase.Update(ctx, first); !errors.Is(err, usecase.ErrScheduleConflict) {
+		t.Fatalf("expected ErrScheduleConflict when restoring the cancelled match, got %v", err)
+	}
+
+	unknown := uuid.New()
+	elsewhere := &entity.Match{HomeTeamID: arema.ID, AwayTeamID: bali.ID, KickoffAt: kickoff.AddDate(0, 0, 7), VenueID: &unknown}
+	if err := f.matchUseCase.Create(ctx, elsewhere); !errors.Is(err, usecase.ErrVenueNotFound) {
+		t.Fatalf("expected ErrVenueNotFound, got %v", err)
+	}
+}
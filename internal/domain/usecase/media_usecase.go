@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/storage"
+	"github.com/zenkriztao/ayo-football-backend/pkg/imaging"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrUnsupportedImage = errors.New("image must be a JPEG, PNG or GIF")
+	ErrImageTooLarge    = errors.New("image must be at most 16 megapixels")
+	ErrMediaNotFound    = errors.New("media not found")
+)
+
+const (
+	// maxImagePixels caps the pixels of an upload, checked before decoding
+	maxImagePixels = 16_000_000
+	// imageMaxSide bounds the stored image, thumbnailMaxSide its thumbnail
+	imageMaxSide     = 1024
+	thumbnailMaxSide = 256
+
+	// MediaPath is the path uploaded images are served under
+	MediaPath = "/api/v1/media/"
+)
+
+// ImageUpload describes a stored image and its thumbnail
+type ImageUpload struct {
+	// Key identifies the image in the blob store; it is served at
+	// MediaPath + Key
+	Key          string
+	URL          string
+	ThumbnailURL string
+	ContentType  string
+	Width        int
+	Height       int
+	Size         int
+}
+
+// MediaUseCase defines the interface for image upload operations
+type MediaUseCase interface {
+	// UploadTeamLogo stores an image as the logo of a team, replacing
+	// the previous upload
+	UploadTeamLogo(ctx context.Context, teamID uuid.UUID, data []byte) (*entity.Team, *ImageUpload, error)
+	// UploadPlayerPhoto stores an image as the photo of a player,
+	// replacing the previous upload
+	UploadPlayerPhoto(ctx context.Context, playerID uuid.UUID, data []byte) (*entity.Player, *ImageUpload, error)
+	// Open returns a stored image, which the caller must close
+	Open(ctx context.Context, key string) (io.ReadCloser, *storage.BlobInfo, error)
+}
+
+type mediaUseCaseImpl struct {
+	blobStore    storage.BlobStore
+	teamRepo     repository.TeamRepository
+	playerRepo   repository.PlayerRepository
+	auditUseCase AuditUseCase
+	baseURL      string
+}
+
+// NewMediaUseCase creates a new instance of MediaUseCase. Image URLs are
+// publicURL followed by MediaPath and the blob key.
+func NewMediaUseCase(
+	blobStore storage.BlobStore,
+	teamRepo repository.TeamRepository,
+	playerRepo repository.PlayerRepository,
+	auditUseCase AuditUseCase,
+	publicURL string,
+) MediaUseCase {
+	return &mediaUseCaseImpl{
+		blobStore:    blobStore,
+		teamRepo:     teamRepo,
+		playerRepo:   playerRepo,
+		auditUseCase: auditUseCase,
+		baseURL:      strings.TrimSuffix(publicURL, "/") + MediaPath,
+	}
+}
+
+func (uc *mediaUseCaseImpl) UploadTeamLogo(ctx context.Context, teamID uuid.UUID, data []byte) (*entity.Team, *ImageUpload, error) {
+	before, err := uc.teamRepo.FindByID(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrTeamNotFound
+		}
+		return nil, nil, err
+	}
+
+	upload, key, err := uc.store(ctx, "teams/"+teamID.String()+"/logo", data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	team := *before
+	team.Logo = upload.URL
+	if err := uc.teamRepo.Update(ctx, &team); err != nil {
+		uc.remove(ctx, key)
+		return nil, nil, err
+	}
+	uc.replace(ctx, before.Logo, key)
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionUpdate, entity.AuditEntityTeam, team.ID, before, &team)
+	return &team, upload, nil
+}
+
+func (uc *mediaUseCaseImpl) UploadPlayerPhoto(ctx context.Context, playerID uuid.UUID, data []byte) (*entity.Player, *ImageUpload, error) {
+	before, err := uc.playerRepo.FindByID(ctx, playerID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrPlayerNotFound
+		}
+		return nil, nil, err
+	}
+
+	upload, key, err := uc.store(ctx, "players/"+playerID.String()+"/photo", data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	player := *before
+	player.Photo = upload.URL
+	if err := uc.playerRepo.Update(ctx, &player); err != nil {
+		uc.remove(ctx, key)
+		return nil, nil, err
+	}
+	uc.replace(ctx, before.Photo, key)
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionUpdate, entity.AuditEntityPlayer, player.ID, before, &player)
+	return &player, upload, nil
+}
+
+func (uc *mediaUseCaseImpl) Open(ctx context.Context, key string) (io.ReadCloser, *storage.BlobInfo, error) {
+	body, info, err := uc.blobStore.Get(ctx, key)
+	if errors.Is(err, storage.ErrBlobNotFound) || errors.Is(err, storage.ErrInvalidKey) {
+		return nil, nil, ErrMediaNotFound
+	}
+	return body, info, err
+}
+
+// store decodes an uploaded image and stores it, scaled down to
+// imageMaxSide, together with a thumbnail. The keys start with prefix and
+// end with a hash of the upload, so a stored image never changes and can be
+// cached for good. It returns the key of the image.
+func (uc *mediaUseCaseImpl) store(ctx context.Context, prefix string, data []byte) (*ImageUpload, string, error) {
+	img, contentType, err := imaging.Decode(data, maxImagePixels)
+	switch {
+	case errors.Is(err, imaging.ErrUnsupportedFormat):
+		return nil, "", ErrUnsupportedImage
+	case errors.Is(err, imaging.ErrTooLarge):
+		return nil, "", ErrImageTooLarge
+	case err != nil:
+		return nil, "", err
+	}
+
+	// Re-encoding also drops metadata such as the GPS position of a photo
+	scaled := imaging.Fit(img, imageMaxSide)
+	encoded, contentType, err := imaging.Encode(scaled, contentType)
+	if err != nil {
+		return nil, "", err
+	}
+	thumbnail, _, err := imaging.Encode(imaging.Fit(scaled, thumbnailMaxSide), contentType)
+	if err != nil {
+		return nil, "", err
+	}
+
+	sum := sha256.Sum256(data)
+	key := fmt.Sprintf("%s-%s%s", prefix, hex.EncodeToString(sum[:8]), imaging.Extension(contentType))
+	if err := uc.blobStore.Put(ctx, key, encoded, contentType); err != nil {
+		return nil, "", err
+	}
+	if err := uc.blobStore.Put(ctx, thumbnailKey(key), thumbnail, contentType); err != nil {
+		uc.remove(ctx, key)
+		return nil, "", err
+	}
+
+	return &ImageUpload{
+		Key:          key,
+		URL:          uc.baseURL + key,
+		ThumbnailURL: uc.baseURL + thumbnailKey(key),
+		ContentType:  contentType,
+		Width:        scaled.Bounds().Dx(),
+		Height:       scaled.Bounds().Dy(),
+		Size:         len(encoded),
+	}, key, nil
+}
+
+// replace removes the previously uploaded image at oldURL, unless it is
+// the image just stored at key or was not uploaded here
+func (uc *mediaUseCaseImpl) replace(ctx context.Context, oldURL, key string) {
+	oldKey, ok := strings.CutPrefix(oldURL, uc.baseURL)
+	if !ok || oldKey == key {
+		return
+	}
+	uc.remove(ctx, oldKey)
+}
+
+// remove deletes an image and its thumbnail. Failures leave orphaned blobs
+// behind but do not fail the request.
+func (uc *mediaUseCaseImpl) remove(ctx context.Context, key string) {
+	for _, k := range []string{key, thumbnailKey(key)} {
+		if err := uc.blobStore.Delete(ctx, k); err != nil {
+			log.Printf("Warning: Failed to delete media %s: %v", k, err)
+		}
+	}
+}
+
+// thumbnailKey returns the key of the thumbnail of the image at key
+func thumbnailKey(key string) string {
+	ext := path.Ext(key)
+	return strings.TrimSuffix(key, ext) + "-thumb" + ext
+}
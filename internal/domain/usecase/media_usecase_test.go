@@ -0,0 +1,161 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// testImage returns a w×h image encoded with encode
+func testImage(t *testing.T, w, h int, encode func(io.Writer, image.Image) error) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for i := range img.Pix {
+		img.Pix[i] = uint8(i)
+	}
+	var buf bytes.Buffer
+	if err := encode(&buf, img); err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// hugePNG returns a PNG that claims to be w×h pixels but has no pixel data,
+// enough to be rejected before decoding
+func hugePNG(t *testing.T, w, h uint32) []byte {
+	t.Helper()
+	data := testImage(t, 1, 1, png.Encode)
+	// The IHDR chunk follows the 8 byte signature: length, type, width,
+	// height, five more bytes, then the CRC of type and data
+	binary.BigEndian.PutUint32(data[16:], w)
+	binary.BigEndian.PutUint32(data[20:], h)
+	binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+	return data[:33]
+}
+
+func encodeJPEG(w io.Writer, img image.Image) error { return jpeg.Encode(w, img, nil) }
+
+// mediaKey returns the blob key of an uploaded image URL
+func mediaKey(t *testing.T, url string) string {
+	t.Helper()
+	key, ok := strings.CutPrefix(url, "https://api.example.com"+usecase.MediaPath)
+	if !ok {
+		t.Fatalf("unexpected media URL %q", url)
+	}
+	return key
+}
+
+func TestMediaUseCase_UploadTeamLogo(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	team := f.createTeam(t, "Persija")
+
+	team.Logo = "https://cdn.example.com/persija.png"
+	if err := f.teamUseCase.Update(ctx, team); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	updated, upload, err := f.mediaUseCase.UploadTeamLogo(ctx, team.ID, testImage(t, 2048, 1024, png.Encode))
+	if err != nil {
+		t.Fatalf("upload: %v", err)
+	}
+	if updated.Logo != upload.URL || upload.ContentType != "image/png" || upload.Width != 1024 || upload.Height != 512 {
+		t.Fatalf("unexpected upload %+v for logo %q", upload, updated.Logo)
+	}
+	stored, err := f.teamUseCase.GetByID(ctx, team.ID)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if stored.Logo != upload.URL {
+		t.Fatalf("expected the logo to be stored, got %q", stored.Logo)
+	}
+
+	thumbnail, info, err := f.mediaUseCase.Open(ctx, mediaKey(t, upload.ThumbnailURL))
+	if err != nil {
+		t.Fatalf("open thumbnail: %v", err)
+	}
+	config, err := png.DecodeConfig(thumbnail)
+	thumbnail.Close()
+	if err != nil || config.Width != 256 || config.Height != 128 || info.ContentType != "image/png" {
+		t.Fatalf("expected a 256x128 PNG thumbnail, got %+v (%v) %+v", config, err, info)
+	}
+
+	// A new upload replaces the previous one, which is removed
+	_, replaced, err := f.mediaUseCase.UploadTeamLogo(ctx, team.ID, testImage(t, 64, 64, png.Encode))
+	if err != nil {
+		t.Fatalf("replace: %v", err)
+	}
+	if replaced.URL == upload.URL {
+		t.Fatal("expected a different image to get a different URL")
+	}
+	for _, url := range []string{upload.URL, upload.ThumbnailURL} {
+		if _, _, err := f.mediaUseCase.Open(ctx, mediaKey(t, url)); !errors.Is(err, usecase.ErrMediaNotFound) {
+			t.Fatalf("expected the replaced image %s to be removed, got %v", url, err)
+		}
+	}
+
+	want := []entity.AuditAction{entity.AuditActionCreate, entity.AuditActionUpdate, entity.AuditActionUpdate, entity.AuditActionUpdate}
+	got := f.auditActions(t, team.ID)
+	if len(got) != len(want) {
+		t.Fatalf("expected audit actions %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected audit actions %v, got %v", want, got)
+		}
+	}
+}
+
+func TestMediaUseCase_UploadPlayerPhoto(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	team := f.createTeam(t, "Persija")
+	player := f.createPlayer(t, team.ID, "Rizky Ridho", 5)
+
+	updated, upload, err := f.mediaUseCase.UploadPlayerPhoto(ctx, player.ID, testImage(t, 300, 400, encodeJPEG))
+	if err != nil {
+		t.Fatalf("upload: %v", err)
+	}
+	if updated.Photo != upload.URL || upload.ContentType != "image/jpeg" || !strings.HasSuffix(upload.URL, ".jpg") ||
+		upload.Width != 300 || upload.Height != 400 {
+		t.Fatalf("unexpected upload %+v for photo %q", upload, updated.Photo)
+	}
+
+	invalid := []struct {
+		name string
+		id   uuid.UUID
+		data []byte
+		want error
+	}{
+		{"unknown player", uuid.New(), testImage(t, 8, 8, png.Encode), usecase.ErrPlayerNotFound},
+		{"not an image", player.ID, []byte("<svg onload=alert(1)>"), usecase.ErrUnsupportedImage},
+		{"truncated image", player.ID, testImage(t, 8, 8, png.Encode)[:40], usecase.ErrUnsupportedImage},
+		{"too many pixels", player.ID, hugePNG(t, 4001, 4000), usecase.ErrImageTooLarge},
+	}
+	for _, tc := range invalid {
+		if _, _, err := f.mediaUseCase.UploadPlayerPhoto(ctx, tc.id, tc.data); !errors.Is(err, tc.want) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.want, err)
+		}
+	}
+
+	if _, _, err := f.mediaUseCase.Open(ctx, "../secret"); !errors.Is(err, usecase.ErrMediaNotFound) {
+		t.Fatalf("expected ErrMediaNotFound for an invalid key, got %v", err)
+	}
+	body, _, err := f.mediaUseCase.Open(ctx, mediaKey(t, upload.URL))
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	body.Close()
+}
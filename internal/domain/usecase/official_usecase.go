@@ -0,0 +1,262 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrOfficialNotFound      = errors.New("official not found")
+	ErrInvalidLicenseLevel   = errors.New("invalid license level")
+	ErrInvalidOfficialRole   = errors.New("invalid official role")
+	ErrDuplicateOfficialRole = errors.New("each role can only be assigned once per match")
+	ErrOfficialAssignedTwice = errors.New("an official can only hold one role per match")
+)
+
+// MatchDuration is how long a match keeps its officials busy. Officials
+// cannot be appointed to two matches that kick off less than this apart.
+const MatchDuration = 2 * time.Hour
+
+// OfficialAssignment represents the input for appointing an official
+type OfficialAssignment struct {
+	OfficialID uuid.UUID
+	Role       entity.OfficialRole
+}
+
+// OfficialUseCase defines the interface for official operations
+type OfficialUseCase interface {
+	Create(ctx context.Context, official *entity.Official) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Official, error)
+	Update(ctx context.Context, official *entity.Official) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// GetAll returns a page of the officials matching the query, see
+	// repository.OfficialListSchema
+	GetAll(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Official, int64, error)
+	// Scroll is GetAll with a cursor window instead of a page number
+	Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Official], error)
+	// GetAvailableFor returns the officials who could be appointed to a match
+	// without clashing with another appointment
+	GetAvailableFor(ctx context.Context, matchID uuid.UUID, page, limit int) ([]entity.Official, int64, error)
+	GetStats(ctx context.Context, id uuid.UUID) (*repository.OfficialStats, error)
+	GetMatchOfficials(ctx context.Context, matchID uuid.UUID) ([]entity.MatchOfficial, error)
+	// AssignToMatch replaces the officials of a match
+	AssignToMatch(ctx context.Context, matchID uuid.UUID, assignments []OfficialAssignment) ([]entity.MatchOfficial, error)
+}
+
+type officialUseCaseImpl struct {
+	officialRepo      repository.OfficialRepository
+	matchOfficialRepo repository.MatchOfficialRepository
+	matchRepo         repository.MatchRepository
+	auditUseCase      AuditUseCase
+}
+
+// NewOfficialUseCase creates a new instance of OfficialUseCase
+func NewOfficialUseCase(
+	officialRepo repository.OfficialRepository,
+	matchOfficialRepo repository.MatchOfficialRepository,
+	matchRepo repository.MatchRepository,
+	auditUseCase AuditUseCase,
+) OfficialUseCase {
+	return &officialUseCaseImpl{
+		officialRepo:      officialRepo,
+		matchOfficialRepo: matchOfficialRepo,
+		matchRepo:         matchRepo,
+		auditUseCase:      auditUseCase,
+	}
+}
+
+func (uc *officialUseCaseImpl) Create(ctx context.Context, official *entity.Official) error {
+	if !entity.IsValidLicenseLevel(official.LicenseLevel) {
+		return ErrInvalidLicenseLevel
+	}
+
+	if err := uc.officialRepo.Create(ctx, official); err != nil {
+		return err
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionCreate, entity.AuditEntityOfficial, official.ID, nil, official)
+	return nil
+}
+
+func (uc *officialUseCaseImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Official, error) {
+	official, err := uc.officialRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrOfficialNotFound
+		}
+		return nil, err
+	}
+	return official, nil
+}
+
+func (uc *officialUseCaseImpl) Update(ctx context.Context, official *entity.Official) error {
+	before, err := uc.GetByID(ctx, official.ID)
+	if err != nil {
+		return err
+	}
+
+	if !entity.IsValidLicenseLevel(official.LicenseLevel) {
+		return ErrInvalidLicenseLevel
+	}
+
+	if err := uc.officialRepo.Update(ctx, official); err != nil {
+		return err
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionUpdate, entity.AuditEntityOfficial, official.ID, before, official)
+	return nil
+}
+
+// Delete soft deletes an official. Their appointments are kept, so past
+// matches still show who officiated them.
+func (uc *officialUseCaseImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	before, err := uc.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.officialRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionDelete, entity.AuditEntityOfficial, id, before, nil)
+	return nil
+}
+
+func (uc *officialUseCaseImpl) GetAll(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Official, int64, error) {
+	return uc.officialRepo.List(ctx, query, page, limit)
+}
+
+func (uc *officialUseCaseImpl) Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Official], error) {
+	return uc.officialRepo.Scroll(ctx, query, window)
+}
+
+func (uc *officialUseCaseImpl) GetAvailableFor(ctx context.Context, matchID uuid.UUID, page, limit int) ([]entity.Official, int64, error) {
+	match, err := uc.findMatch(ctx, matchID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return uc.officialRepo.FindAvailable(ctx,
+		match.KickoffAt.Add(-MatchDuration), match.KickoffAt.Add(MatchDuration), match.ID, page, limit)
+}
+
+func (uc *officialUseCaseImpl) GetStats(ctx context.Context, id uuid.UUID) (*repository.OfficialStats, error) {
+	if _, err := uc.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return uc.matchOfficialRepo.GetOfficialStats(ctx, id)
+}
+
+func (uc *officialUseCaseImpl) GetMatchOfficials(ctx context.Context, matchID uuid.UUID) ([]entity.MatchOfficial, error) {
+	if _, err := uc.findMatch(ctx, matchID); err != nil {
+		return nil, err
+	}
+	return uc.matchOfficialRepo.FindByMatchID(ctx, matchID)
+}
+
+func (uc *officialUseCaseImpl) AssignToMatch(ctx context.Context, matchID uuid.UUID, assignments []OfficialAssignment) ([]entity.MatchOfficial, error) {
+	match, err := uc.findMatch(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make(map[entity.OfficialRole]bool, len(assignments))
+	officials := make(map[uuid.UUID]bool, len(assignments))
+	appointments := make([]entity.MatchOfficial, len(assignments))
+	officialIDs := make([]uuid.UUID, len(assignments))
+	for i, a := range assignments {
+		if !entity.IsValidOfficialRole(a.Role) {
+			return nil, ErrInvalidOfficialRole
+		}
+		if roles[a.Role] {
+			return nil, ErrDuplicateOfficialRole
+		}
+		if officials[a.OfficialID] {
+			return nil, ErrOfficialAssignedTwice
+		}
+		roles[a.Role] = true
+		officials[a.OfficialID] = true
+
+		exists, err := uc.officialRepo.Exists(ctx, a.OfficialID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, ErrOfficialNotFound
+		}
+
+		appointments[i] = entity.MatchOfficial{MatchID: matchID, OfficialID: a.OfficialID, Role: a.Role}
+		officialIDs[i] = a.OfficialID
+	}
+
+	if match.Status != entity.MatchStatusCancelled {
+		if err := checkOfficialsFree(ctx, uc.matchOfficialRepo, match, officialIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	previous, err := uc.matchOfficialRepo.FindByMatchID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.matchOfficialRepo.ReplaceForMatch(ctx, matchID, appointments); err != nil {
+		if errors.Is(err, repository.ErrDuplicateAppointment) {
+			return nil, ErrDuplicateOfficialRole
+		}
+		return nil, err
+	}
+
+	updated, err := uc.matchOfficialRepo.FindByMatchID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionAssign, entity.AuditEntityMatch, matchID,
+		appointmentsSnapshot(previous), appointmentsSnapshot(updated))
+	return updated, nil
+}
+
+func (uc *officialUseCaseImpl) findMatch(ctx context.Context, id uuid.UUID) (*entity.Match, error) {
+	match, err := uc.matchRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMatchNotFound
+		}
+		return nil, err
+	}
+	return match, nil
+}
+
+// checkOfficialsFree returns a ScheduleConflictError when one of the
+// officials is appointed to another match kicking off less than
+// MatchDuration before or after the match
+func checkOfficialsFree(ctx context.Context, repo repository.MatchOfficialRepository, match *entity.Match, officialIDs []uuid.UUID) error {
+	busy, err := repo.FindScheduledForOfficials(ctx, officialIDs,
+		match.KickoffAt.Add(-MatchDuration), match.KickoffAt.Add(MatchDuration))
+	if err != nil {
+		return err
+	}
+	for _, other := range busy {
+		if other.MatchID != match.ID && other.Match != nil {
+			return &ScheduleConflictError{Conflict: ConflictOfficialBusy, Match: *other.Match}
+		}
+	}
+	return nil
+}
+
+// appointmentsSnapshot keys the appointed officials by role for the audit log
+func appointmentsSnapshot(appointments []entity.MatchOfficial) map[string]interface{} {
+	snapshot := make(map[string]interface{}, len(appointments))
+	for _, a := range appointments {
+		snapshot[string(a.Role)] = a.OfficialID
+	}
+	return snapshot
+}
@@ -0,0 +1,159 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+func TestOfficialUseCase_Create(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+
+	invalid := &entity.Official{Name: "Nobody", LicenseLevel: "continental"}
+	if err := f.officialUseCase.Create(ctx, invalid); !errors.Is(err, usecase.ErrInvalidLicenseLevel) {
+		t.Fatalf("expected ErrInvalidLicenseLevel, got %v", err)
+	}
+
+	official := f.createOfficial(t, "Thoriq Alkatiri")
+	if got := f.auditActions(t, official.ID); len(got) != 1 || got[0] != entity.AuditActionCreate {
+		t.Fatalf("expected a create audit entry, got %v", got)
+	}
+
+	if _, err := f.officialUseCase.GetByID(ctx, uuid.New()); !errors.Is(err, usecase.ErrOfficialNotFound) {
+		t.Fatalf("expected ErrOfficialNotFound, got %v", err)
+	}
+}
+
+func TestOfficialUseCase_AssignToMatch(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	persija := f.createTeam(t, "Persija")
+	persib := f.createTeam(t, "Persib")
+	arema := f.createTeam(t, "Arema")
+	bali := f.createTeam(t, "Bali United")
+	first := f.createMatch(t, persija.ID, persib.ID)
+	referee := f.createOfficial(t, "Thoriq Alkatiri")
+	assistant := f.createOfficial(t, "Yudi Nurcahya")
+
+	invalid := []struct {
+		name        string
+		assignments []usecase.OfficialAssignment
+		want        error
+	}{
+		{"unknown role", []usecase.OfficialAssignment{{OfficialID: referee.ID, Role: "linesman"}}, usecase.ErrInvalidOfficialRole},
+		{"role twice", []usecase.OfficialAssignment{
+			{OfficialID: referee.ID, Role: entity.OfficialRoleReferee},
+			{OfficialID: assistant.ID, Role: entity.OfficialRoleReferee},
+		}, usecase.ErrDuplicateOfficialRole},
+		{"official twice", []usecase.OfficialAssignment{
+			{OfficialID: referee.ID, Role: entity.OfficialRoleReferee},
+			{OfficialID: referee.ID, Role: entity.OfficialRoleFourth},
+		}, usecase.ErrOfficialAssignedTwice},
+		{"unknown official", []usecase.OfficialAssignment{{OfficialID: uuid.New(), Role: entity.OfficialRoleReferee}}, usecase.ErrOfficialNotFound},
+	}
+	for _, tc := range invalid {
+		if _, err := f.officialUseCase.AssignToMatch(ctx, first.ID, tc.assignments); !errors.Is(err, tc.want) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.want, err)
+		}
+	}
+
+	appointed, err := f.officialUseCase.AssignToMatch(ctx, first.ID, []usecase.OfficialAssignment{
+		{OfficialID: referee.ID, Role: entity.OfficialRoleReferee},
+		{OfficialID: assistant.ID, Role: entity.OfficialRoleAssistant1},
+	})
+	if err != nil {
+		t.Fatalf("assign: %v", err)
+	}
+	if len(appointed) != 2 || appointed[0].Official == nil {
+		t.Fatalf("expected 2 appointments with their official, got %d", len(appointed))
+	}
+	if got := f.auditActions(t, first.ID); got[len(got)-1] != entity.AuditActionAssign {
+		t.Fatalf("expected an assign_officials audit entry, got %v", got)
+	}
+
+	// A match an hour later overlaps, one MatchDuration later does not
+	second := &entity.Match{HomeTeamID: arema.ID, AwayTeamID: bali.ID, KickoffAt: first.KickoffAt.Add(time.Hour)}
+	if err := f.matchUseCase.Create(ctx, second); err != nil {
+		t.Fatalf("create match: %v", err)
+	}
+	available, total, err := f.officialUseCase.GetAvailableFor(ctx, second.ID, 1, 10)
+	if err != nil || total != 0 || len(available) != 0 {
+		t.Fatalf("expected no official to be available, got %d (%v)", total, err)
+	}
+
+	var conflict *usecase.ScheduleConflictError
+	_, err = f.officialUseCase.AssignToMatch(ctx, second.ID, []usecase.OfficialAssignment{{OfficialID: referee.ID, Role: entity.OfficialRoleReferee}})
+	if !errors.As(err, &conflict) || conflict.Conflict != usecase.ConflictOfficialBusy || conflict.Match.ID != first.ID {
+		t.Fatalf("expected the referee to be busy with the first match, got %v", err)
+	}
+
+	second.KickoffAt = first.KickoffAt.Add(usecase.MatchDuration)
+	if err := f.matchUseCase.Update(ctx, second); err != nil {
+		t.Fatalf("reschedule: %v", err)
+	}
+	if _, err := f.officialUseCase.AssignToMatch(ctx, second.ID, []usecase.OfficialAssignment{{OfficialID: referee.ID, Role: entity.OfficialRoleReferee}}); err != nil {
+		t.Fatalf("expected the referee to be free after the match, got %v", err)
+	}
+
+	// Moving a match onto its officials' other appointment is rejected too
+	second.KickoffAt = first.KickoffAt.Add(30 * time.Minute)
+	if err := f.matchUseCase.Update(ctx, second); !errors.As(err, &conflict) || conflict.Conflict != usecase.ConflictOfficialBusy {
+		t.Fatalf("expected ConflictOfficialBusy when moving the match, got %v", err)
+	}
+
+	if _, err := f.officialUseCase.AssignToMatch(ctx, uuid.New(), nil); !errors.Is(err, usecase.ErrMatchNotFound) {
+		t.Fatalf("expected ErrMatchNotFound, got %v", err)
+	}
+}
+
+func TestOfficialUseCase_GetStats(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	persija := f.createTeam(t, "Persija")
+	persib := f.createTeam(t, "Persib")
+	player := f.createPlayer(t, persija.ID, "Rizky Ridho", 5)
+	referee := f.createOfficial(t, "Thoriq Alkatiri")
+	fourth := f.createOfficial(t, "Yudi Nurcahya")
+
+	for i := 0; i < 2; i++ {
+		match := f.createMatch(t, persija.ID, persib.ID)
+		_, err := f.officialUseCase.AssignToMatch(ctx, match.ID, []usecase.OfficialAssignment{
+			{OfficialID: referee.ID, Role: entity.OfficialRoleReferee},
+			{OfficialID: fourth.ID, Role: entity.OfficialRoleFourth},
+		})
+		if err != nil {
+			t.Fatalf("assign: %v", err)
+		}
+		_, _, err = f.matchUseCase.RecordResult(ctx, match.ID, usecase.MatchResultInput{
+			Cards: []usecase.CardInput{
+				{PlayerID: player.ID, TeamID: persija.ID, Minute: 20, Type: entity.CardYellow},
+				{PlayerID: player.ID, TeamID: persija.ID, Minute: 60 + i, Type: entity.CardRed},
+			},
+		})
+		if err != nil {
+			t.Fatalf("record result: %v", err)
+		}
+	}
+
+	stats, err := f.officialUseCase.GetStats(ctx, referee.ID)
+	if err != nil {
+		t.Fatalf("stats: %v", err)
+	}
+	if stats.Matches != 2 || stats.MatchesBy[entity.OfficialRoleReferee] != 2 || stats.YellowCards != 2 || stats.RedCards != 2 {
+		t.Fatalf("unexpected referee stats: %+v", stats)
+	}
+
+	stats, err = f.officialUseCase.GetStats(ctx, fourth.ID)
+	if err != nil {
+		t.Fatalf("stats: %v", err)
+	}
+	if stats.Matches != 2 || stats.MatchesBy[entity.OfficialRoleFourth] != 2 || stats.YellowCards != 0 {
+		t.Fatalf("cards should count for the referee only, got %+v", stats)
+	}
+}
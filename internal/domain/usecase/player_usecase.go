@@ -3,75 +3,101 @@ package usecase
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/pkg/iso3166"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
 	"gorm.io/gorm"
 )
 
 var (
-	ErrPlayerNotFound       = errors.New("player not found")
-	ErrJerseyNumberTaken    = errors.New("jersey number is already taken by another player in this team")
-	ErrInvalidPosition      = errors.New("invalid player position")
-	ErrInvalidJerseyNumber  = errors.New("jersey number must be between 1 and 99")
+	ErrPlayerNotFound          = errors.New("player not found")
+	ErrJerseyNumberTaken       = errors.New("jersey number is already taken by another player in this team")
+	ErrInvalidPosition         = errors.New("invalid player position")
+	ErrInvalidJerseyNumber     = errors.New("jersey number must be between 1 and 99")
+	ErrTeamChangeNeedsTransfer = errors.New("a player moves to another team through a transfer")
+
+	// Profile errors
+	ErrInvalidNationality        = errors.New("nationality must be an ISO 3166-1 alpha-2 country code")
+	ErrInvalidPreferredFoot      = errors.New("preferred foot must be left, right or both")
+	ErrInvalidSecondaryPositions = errors.New("secondary positions must be distinct valid positions other than the primary one")
+	ErrInvalidDateOfBirth        = errors.New("date of birth must be after 1900 and not in the future")
 )
 
+// earliestDateOfBirth bounds dates of birth to catch typos such as 0204
+var earliestDateOfBirth = time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Eligibility is the outcome of checking a player against an age group
+type Eligibility struct {
+	Player    *entity.Player
+	AgeGroup  entity.AgeGroup
+	On        time.Time // Cut-off date of the check
+	BornAfter time.Time // Players must be born after this date to be eligible
+	Age       *int      // Nil when the date of birth is unknown
+	Eligible  bool
+}
+
 // PlayerUseCase defines the interface for player operations
 type PlayerUseCase interface {
 	Create(ctx context.Context, player *entity.Player) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Player, error)
 	GetByIDWithTeam(ctx context.Context, id uuid.UUID) (*entity.Player, error)
+	// GetByIDWith returns a player with the related resources of include,
+	// see repository.PlayerListSchema
+	GetByIDWith(ctx context.Context, id uuid.UUID, include listquery.Include) (*entity.Player, error)
 	Update(ctx context.Context, player *entity.Player) error
 	Delete(ctx context.Context, id uuid.UUID) error
-	GetAll(ctx context.Context, page, limit int) ([]entity.Player, int64, error)
+	// GetAll returns a page of the players matching the query, see
+	// repository.PlayerListSchema. It fails with ErrTeamNotFound when the
+	// query filters by a team that does not exist.
+	GetAll(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Player, int64, error)
+	// Scroll is GetAll with a cursor window instead of a page number
+	Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Player], error)
 	GetByTeamID(ctx context.Context, teamID uuid.UUID, page, limit int) ([]entity.Player, int64, error)
-	Search(ctx context.Context, query string, page, limit int) ([]entity.Player, int64, error)
+	// GetByTeamIDAsOf returns the players that belonged to a team at the
+	// given time, with the team and jersey number of their contract then
+	GetByTeamIDAsOf(ctx context.Context, teamID uuid.UUID, at time.Time, page, limit int) ([]entity.Player, int64, error)
+	// CheckEligibility checks whether a player may play in an age group
+	// whose cut-off date is on
+	CheckEligibility(ctx context.Context, playerID uuid.UUID, group entity.AgeGroup, on time.Time) (*Eligibility, error)
 }
 
 type playerUseCaseImpl struct {
-	playerRepo repository.PlayerRepository
-	teamRepo   repository.TeamRepository
+	playerRepo   repository.PlayerRepository
+	teamRepo     repository.TeamRepository
+	contractRepo repository.PlayerContractRepository
+	auditUseCase AuditUseCase
 }
 
 // NewPlayerUseCase creates a new instance of PlayerUseCase
-func NewPlayerUseCase(playerRepo repository.PlayerRepository, teamRepo repository.TeamRepository) PlayerUseCase {
+func NewPlayerUseCase(
+	playerRepo repository.PlayerRepository,
+	teamRepo repository.TeamRepository,
+	contractRepo repository.PlayerContractRepository,
+	auditUseCase AuditUseCase,
+) PlayerUseCase {
 	return &playerUseCaseImpl{
-		playerRepo: playerRepo,
-		teamRepo:   teamRepo,
+		playerRepo:   playerRepo,
+		teamRepo:     teamRepo,
+		contractRepo: contractRepo,
+		auditUseCase: auditUseCase,
 	}
 }
 
 func (uc *playerUseCaseImpl) Create(ctx context.Context, player *entity.Player) error {
-	// Validate team exists
-	exists, err := uc.teamRepo.Exists(ctx, player.TeamID)
-	if err != nil {
+	if err := validatePlayer(ctx, uc.playerRepo, uc.teamRepo, player, nil); err != nil {
 		return err
 	}
-	if !exists {
-		return ErrTeamNotFound
-	}
 
-	// Validate position
-	if !entity.IsValidPosition(player.Position) {
-		return ErrInvalidPosition
-	}
-
-	// Validate jersey number
-	if player.JerseyNumber < 1 || player.JerseyNumber > 99 {
-		return ErrInvalidJerseyNumber
+	if err := uc.playerRepo.Create(ctx, player); err != nil {
+		return translatePlayerError(err)
 	}
 
-	// Check if jersey number is taken
-	taken, err := uc.playerRepo.IsJerseyNumberTaken(ctx, player.TeamID, player.JerseyNumber, nil)
-	if err != nil {
-		return err
-	}
-	if taken {
-		return ErrJerseyNumberTaken
-	}
-
-	return uc.playerRepo.Create(ctx, player)
+	uc.auditUseCase.Record(ctx, entity.AuditActionCreate, entity.AuditEntityPlayer, player.ID, nil, player)
+	return nil
 }
 
 func (uc *playerUseCaseImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Player, error) {
@@ -96,18 +122,143 @@ func (uc *playerUseCaseImpl) GetByIDWithTeam(ctx context.Context, id uuid.UUID)
 	return player, nil
 }
 
+func (uc *playerUseCaseImpl) GetByIDWith(ctx context.Context, id uuid.UUID, include listquery.Include) (*entity.Player, error) {
+	player, err := uc.playerRepo.FindByIDWith(ctx, id, include)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPlayerNotFound
+		}
+		return nil, err
+	}
+	return player, nil
+}
+
 func (uc *playerUseCaseImpl) Update(ctx context.Context, player *entity.Player) error {
 	// Check player exists
-	exists, err := uc.playerRepo.Exists(ctx, player.ID)
+	before, err := uc.playerRepo.FindByID(ctx, player.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrPlayerNotFound
+		}
+		return err
+	}
+
+	// Moving the player here would rewrite the team of their past matches
+	if player.TeamID != before.TeamID {
+		return ErrTeamChangeNeedsTransfer
+	}
+
+	// Validate the new values, excluding the player's own jersey number
+	if err := validatePlayer(ctx, uc.playerRepo, uc.teamRepo, player, &player.ID); err != nil {
+		return err
+	}
+
+	if err := uc.playerRepo.Update(ctx, player); err != nil {
+		return translatePlayerError(err)
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionUpdate, entity.AuditEntityPlayer, player.ID, before, player)
+	return nil
+}
+
+func (uc *playerUseCaseImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	before, err := uc.playerRepo.FindByID(ctx, id)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrPlayerNotFound
+		}
+		return err
+	}
+
+	if err := uc.playerRepo.Delete(ctx, id); err != nil {
 		return err
 	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionDelete, entity.AuditEntityPlayer, id, before, nil)
+	return nil
+}
+
+func (uc *playerUseCaseImpl) GetAll(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Player, int64, error) {
+	if err := checkTeamFilter(ctx, uc.teamRepo, query); err != nil {
+		return nil, 0, err
+	}
+	return uc.playerRepo.List(ctx, query, page, limit)
+}
+
+func (uc *playerUseCaseImpl) Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Player], error) {
+	if err := checkTeamFilter(ctx, uc.teamRepo, query); err != nil {
+		return listquery.Slice[entity.Player]{}, err
+	}
+	return uc.playerRepo.Scroll(ctx, query, window)
+}
+
+func (uc *playerUseCaseImpl) GetByTeamID(ctx context.Context, teamID uuid.UUID, page, limit int) ([]entity.Player, int64, error) {
+	// Validate team exists
+	exists, err := uc.teamRepo.Exists(ctx, teamID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !exists {
+		return nil, 0, ErrTeamNotFound
+	}
+
+	return uc.playerRepo.FindByTeamID(ctx, teamID, page, limit)
+}
+
+func (uc *playerUseCaseImpl) GetByTeamIDAsOf(ctx context.Context, teamID uuid.UUID, at time.Time, page, limit int) ([]entity.Player, int64, error) {
+	exists, err := uc.teamRepo.Exists(ctx, teamID)
+	if err != nil {
+		return nil, 0, err
+	}
 	if !exists {
-		return ErrPlayerNotFound
+		return nil, 0, ErrTeamNotFound
+	}
+
+	contracts, total, err := uc.contractRepo.FindByTeamAsOf(ctx, teamID, at, page, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	players := make([]entity.Player, len(contracts))
+	for i, contract := range contracts {
+		players[i] = *contract.Player
+		players[i].TeamID = contract.TeamID
+		players[i].JerseyNumber = contract.JerseyNumber
+	}
+	return players, total, nil
+}
+
+func (uc *playerUseCaseImpl) CheckEligibility(ctx context.Context, playerID uuid.UUID, group entity.AgeGroup, on time.Time) (*Eligibility, error) {
+	player, err := uc.GetByIDWithTeam(ctx, playerID)
+	if err != nil {
+		return nil, err
 	}
 
+	eligibility := &Eligibility{
+		Player:    player,
+		AgeGroup:  group,
+		On:        on,
+		BornAfter: group.BornAfter(on),
+		Eligible:  player.IsEligibleFor(group, on),
+	}
+	if age, ok := player.AgeOn(on); ok {
+		eligibility.Age = &age
+	}
+	return eligibility, nil
+}
+
+// validatePlayer checks the business rules a player must satisfy before it
+// is stored: the team exists, the positions are known, the jersey number is
+// in range and not worn by another active player of the team, and the
+// profile fields are well formed. The nationality is normalized in place.
+func validatePlayer(
+	ctx context.Context,
+	playerRepo repository.PlayerRepository,
+	teamRepo repository.TeamRepository,
+	player *entity.Player,
+	excludePlayerID *uuid.UUID,
+) error {
 	// Validate team exists
-	exists, err = uc.teamRepo.Exists(ctx, player.TeamID)
+	exists, err := teamRepo.Exists(ctx, player.TeamID)
 	if err != nil {
 		return err
 	}
@@ -120,51 +271,74 @@ func (uc *playerUseCaseImpl) Update(ctx context.Context, player *entity.Player)
 		return ErrInvalidPosition
 	}
 
+	// Validate secondary positions
+	for i, position := range player.SecondaryPositions {
+		if !entity.IsValidPosition(position) || position == player.Position ||
+			player.SecondaryPositions[:i].Contains(position) {
+			return ErrInvalidSecondaryPositions
+		}
+	}
+
 	// Validate jersey number
 	if player.JerseyNumber < 1 || player.JerseyNumber > 99 {
 		return ErrInvalidJerseyNumber
 	}
 
-	// Check if jersey number is taken (exclude current player)
-	taken, err := uc.playerRepo.IsJerseyNumberTaken(ctx, player.TeamID, player.JerseyNumber, &player.ID)
-	if err != nil {
-		return err
+	// Validate profile
+	if player.Nationality != "" {
+		player.Nationality = iso3166.Normalize(player.Nationality)
+		if !iso3166.IsAlpha2(player.Nationality) {
+			return ErrInvalidNationality
+		}
 	}
-	if taken {
-		return ErrJerseyNumberTaken
+	if !entity.IsValidPreferredFoot(player.PreferredFoot) {
+		return ErrInvalidPreferredFoot
+	}
+	if born := entity.CalendarDate(player.DateOfBirth); born != nil &&
+		(born.Before(earliestDateOfBirth) || born.After(entity.Today())) {
+		return ErrInvalidDateOfBirth
 	}
 
-	return uc.playerRepo.Update(ctx, player)
-}
-
-func (uc *playerUseCaseImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	exists, err := uc.playerRepo.Exists(ctx, id)
+	// Check if jersey number is taken
+	taken, err := playerRepo.IsJerseyNumberTaken(ctx, player.TeamID, player.JerseyNumber, excludePlayerID)
 	if err != nil {
 		return err
 	}
-	if !exists {
-		return ErrPlayerNotFound
+	if taken {
+		return ErrJerseyNumberTaken
 	}
-	return uc.playerRepo.Delete(ctx, id)
+	return nil
 }
 
-func (uc *playerUseCaseImpl) GetAll(ctx context.Context, page, limit int) ([]entity.Player, int64, error) {
-	return uc.playerRepo.FindAll(ctx, page, limit)
+// translatePlayerError maps constraint violations caught by the database,
+// e.g. a concurrent insert of the same jersey number, to domain errors
+func translatePlayerError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrDuplicateJerseyNumber):
+		return ErrJerseyNumberTaken
+	case repository.IsCheckViolation(err, repository.ConstraintPlayersJerseyNumber):
+		return ErrInvalidJerseyNumber
+	case repository.IsCheckViolation(err, repository.ConstraintPlayersPreferredFoot):
+		return ErrInvalidPreferredFoot
+	case errors.Is(err, repository.ErrReferenceNotFound):
+		return ErrTeamNotFound
+	}
+	return err
 }
 
-func (uc *playerUseCaseImpl) GetByTeamID(ctx context.Context, teamID uuid.UUID, page, limit int) ([]entity.Player, int64, error) {
-	// Validate team exists
-	exists, err := uc.teamRepo.Exists(ctx, teamID)
+// checkTeamFilter fails with ErrTeamNotFound when a list query filters by a
+// team_id that does not exist, rather than returning an empty page
+func checkTeamFilter(ctx context.Context, teamRepo repository.TeamRepository, query listquery.Query) error {
+	id, ok := query.Lookup("team_id")
+	if !ok {
+		return nil
+	}
+	exists, err := teamRepo.Exists(ctx, id.(uuid.UUID))
 	if err != nil {
-		return nil, 0, err
+		return err
 	}
 	if !exists {
-		return nil, 0, ErrTeamNotFound
+		return ErrTeamNotFound
 	}
-
-	return uc.playerRepo.FindByTeamID(ctx, teamID, page, limit)
-}
-
-func (uc *playerUseCaseImpl) Search(ctx context.Context, query string, page, limit int) ([]entity.Player, int64, error) {
-	return uc.playerRepo.Search(ctx, query, page, limit)
+	return nil
 }
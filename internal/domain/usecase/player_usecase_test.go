@@ -0,0 +1,231 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+)
+
+func TestPlayerUseCase_CreateValidation(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	team := f.createTeam(t, "PSM Makassar")
+	f.createPlayer(t, team.ID, "Captain", 10)
+
+	tests := []struct {
+		name   string
+		mutate func(player *entity.Player)
+		want   error
+	}{
+		{"unknown team", func(p *entity.Player) { p.TeamID = uuid.New() }, usecase.ErrTeamNotFound},
+		{"invalid position", func(p *entity.Player) { p.Position = "striker" }, usecase.ErrInvalidPosition},
+		{"jersey too low", func(p *entity.Player) { p.JerseyNumber = 0 }, usecase.ErrInvalidJerseyNumber},
+		{"jersey too high", func(p *entity.Player) { p.JerseyNumber = 100 }, usecase.ErrInvalidJerseyNumber},
+		{"jersey taken", func(p *entity.Player) { p.JerseyNumber = 10 }, usecase.ErrJerseyNumberTaken},
+		{"unknown nationality", func(p *entity.Player) { p.Nationality = "XX" }, usecase.ErrInvalidNationality},
+		{"invalid preferred foot", func(p *entity.Player) { p.PreferredFoot = "middle" }, usecase.ErrInvalidPreferredFoot},
+		{"secondary position equals primary", func(p *entity.Player) {
+			p.SecondaryPositions = entity.PlayerPositions{p.Position}
+		}, usecase.ErrInvalidSecondaryPositions},
+		{"duplicate secondary position", func(p *entity.Player) {
+			p.SecondaryPositions = entity.PlayerPositions{entity.PositionDefender, entity.PositionDefender}
+		}, usecase.ErrInvalidSecondaryPositions},
+		{"born in the future", func(p *entity.Player) {
+			born := entity.Today().AddDate(0, 0, 1)
+			p.DateOfBirth = &born
+		}, usecase.ErrInvalidDateOfBirth},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			player := newPlayer(team.ID, "Winger", 7)
+			tt.mutate(player)
+
+			if err := f.playerUseCase.Create(ctx, player); !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+// rejectingPlayerRepository fails every Create with err, as a database
+// constraint would
+type rejectingPlayerRepository struct {
+	repository.PlayerRepository
+	err error
+}
+
+func (r rejectingPlayerRepository) Create(ctx context.Context, player *entity.Player) error {
+	return r.err
+}
+
+// Constraint violations the database reports are told apart by constraint
+func TestPlayerUseCase_CreateConstraintViolation(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	team := f.createTeam(t, "Bali United")
+
+	tests := []struct {
+		constraint string
+		want       error
+	}{
+		{repository.ConstraintPlayersJerseyNumber, usecase.ErrInvalidJerseyNumber},
+		{repository.ConstraintPlayersPreferredFoot, usecase.ErrInvalidPreferredFoot},
+		{"chk_players_unknown", repository.ErrCheckViolation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.constraint, func(t *testing.T) {
+			players := rejectingPlayerRepository{f.players, fmt.Errorf("%w: %s", repository.ErrCheckViolation, tt.constraint)}
+			uc := usecase.NewPlayerUseCase(players, f.teams, f.contracts, f.auditUseCase)
+
+			err := uc.Create(ctx, newPlayer(team.ID, "Winger", 7))
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestPlayerUseCase_CheckEligibility(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	team := f.createTeam(t, "Persija")
+	unknown := f.createPlayer(t, team.ID, "Unknown", 1)
+
+	player := newPlayer(team.ID, "Rizky Ridho", 5)
+	born := time.Date(2004, 3, 15, 0, 0, 0, 0, time.UTC)
+	player.DateOfBirth = &born
+	player.Nationality = "id"
+	if err := f.playerUseCase.Create(ctx, player); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if player.Nationality != "ID" {
+		t.Fatalf("expected the nationality to be normalized, got %q", player.Nationality)
+	}
+
+	u21, _ := entity.ParseAgeGroup("U21")
+	checks := []struct {
+		on       time.Time
+		age      int
+		eligible bool
+	}{
+		{time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC), 20, true},
+		{time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC), 21, false},
+	}
+	for _, c := range checks {
+		eligibility, err := f.playerUseCase.CheckEligibility(ctx, player.ID, u21, c.on)
+		if err != nil {
+			t.Fatalf("check eligibility: %v", err)
+		}
+		if eligibility.Age == nil || *eligibility.Age != c.age || eligibility.Eligible != c.eligible {
+			t.Errorf("on %s: expected age %d and eligible %v, got %+v", c.on.Format("2006-01-02"), c.age, c.eligible, eligibility)
+		}
+	}
+
+	eligibility, err := f.playerUseCase.CheckEligibility(ctx, unknown.ID, u21, checks[0].on)
+	if err != nil {
+		t.Fatalf("check eligibility: %v", err)
+	}
+	if eligibility.Age != nil || eligibility.Eligible {
+		t.Fatalf("expected a player without a date of birth to be ineligible, got %+v", eligibility)
+	}
+	if _, err := f.playerUseCase.CheckEligibility(ctx, uuid.New(), u21, checks[0].on); !errors.Is(err, usecase.ErrPlayerNotFound) {
+		t.Fatalf("expected ErrPlayerNotFound, got %v", err)
+	}
+
+	query, err := listquery.Parse(url.Values{"nationality": {"id"}}, repository.PlayerListSchema)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	players, total, err := f.playerUseCase.GetAll(ctx, query, 1, 10)
+	if err != nil {
+		t.Fatalf("get all: %v", err)
+	}
+	if total != 1 || players[0].ID != player.ID {
+		t.Fatalf("expected a lower-case nationality to match, got %d players", total)
+	}
+	missing := listquery.Query{}.Where("team_id", listquery.Eq, uuid.New())
+	if _, _, err := f.playerUseCase.GetAll(ctx, missing, 1, 10); !errors.Is(err, usecase.ErrTeamNotFound) {
+		t.Fatalf("expected ErrTeamNotFound, got %v", err)
+	}
+}
+
+func TestPlayerUseCase_JerseyFreedByDelete(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	team := f.createTeam(t, "Persik")
+	player := f.createPlayer(t, team.ID, "Veteran", 8)
+
+	if err := f.playerUseCase.Delete(ctx, player.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := f.playerUseCase.Delete(ctx, player.ID); !errors.Is(err, usecase.ErrPlayerNotFound) {
+		t.Fatalf("expected ErrPlayerNotFound on second delete, got %v", err)
+	}
+
+	f.createPlayer(t, team.ID, "Newcomer", 8)
+}
+
+func TestPlayerUseCase_Update(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	team := f.createTeam(t, "Madura United")
+	first := f.createPlayer(t, team.ID, "First", 4)
+	f.createPlayer(t, team.ID, "Second", 5)
+
+	// Keeping one's own number is not a conflict
+	first.Name = "First Renamed"
+	if err := f.playerUseCase.Update(ctx, first); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	first.JerseyNumber = 5
+	if err := f.playerUseCase.Update(ctx, first); !errors.Is(err, usecase.ErrJerseyNumberTaken) {
+		t.Fatalf("expected ErrJerseyNumberTaken, got %v", err)
+	}
+
+	missing := newPlayer(team.ID, "Ghost", 6)
+	missing.ID = uuid.New()
+	if err := f.playerUseCase.Update(ctx, missing); !errors.Is(err, usecase.ErrPlayerNotFound) {
+		t.Fatalf("expected ErrPlayerNotFound, got %v", err)
+	}
+
+	got, err := f.playerUseCase.GetByIDWithTeam(ctx, first.ID)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Name != "First Renamed" || got.JerseyNumber != 4 {
+		t.Fatalf("unexpected player after updates: %+v", got)
+	}
+	if got.Team == nil || got.Team.ID != team.ID {
+		t.Fatalf("expected team to be preloaded")
+	}
+}
+
+func TestPlayerUseCase_GetByTeamID(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	team := f.createTeam(t, "Dewa United")
+	f.createPlayer(t, team.ID, "Nine", 9)
+	f.createPlayer(t, team.ID, "One", 1)
+
+	players, total, err := f.playerUseCase.GetByTeamID(ctx, team.ID, 1, 10)
+	if err != nil {
+		t.Fatalf("get by team: %v", err)
+	}
+	if total != 2 || players[0].JerseyNumber != 1 || players[1].JerseyNumber != 9 {
+		t.Fatalf("expected players ordered by jersey number, got %+v", players)
+	}
+
+	if _, _, err := f.playerUseCase.GetByTeamID(ctx, uuid.New(), 1, 10); !errors.Is(err, usecase.ErrTeamNotFound) {
+		t.Fatalf("expected ErrTeamNotFound, got %v", err)
+	}
+}
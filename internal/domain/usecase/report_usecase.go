@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"sort"
 
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
@@ -38,11 +39,16 @@ type LeaderboardEntry struct {
 	Points      int64        `json:"points"`
 }
 
+// standingsBatchSize is the number of teams or matches read at a time when
+// computing the standings
+const standingsBatchSize = 500
+
 // ReportUseCase defines the interface for report operations
 type ReportUseCase interface {
 	GetMatchReport(ctx context.Context, matchID uuid.UUID) (*MatchReport, error)
 	GetAllMatchReports(ctx context.Context, page, limit int) ([]MatchReport, int64, error)
 	GetTopScorers(ctx context.Context, limit int) ([]repository.TopScorerResult, error)
+	GetStandings(ctx context.Context) ([]LeaderboardEntry, error)
 }
 
 type reportUseCaseImpl struct {
@@ -184,3 +190,76 @@ func (uc *reportUseCaseImpl) GetAllMatchReports(ctx context.Context, page, limit
 func (uc *reportUseCaseImpl) GetTopScorers(ctx context.Context, limit int) ([]repository.TopScorerResult, error) {
 	return uc.goalRepo.GetTopScorers(ctx, limit)
 }
+
+// GetStandings returns the league table of the active teams, computed from
+// completed matches: 3 points for a win and 1 for a draw. Teams are ranked by
+// points, goal difference, goals scored and name.
+func (uc *reportUseCaseImpl) GetStandings(ctx context.Context) ([]LeaderboardEntry, error) {
+	entries := make(map[uuid.UUID]*LeaderboardEntry)
+	err := uc.teamRepo.FindInBatches(ctx, standingsBatchSize, func(teams []entity.Team) error {
+		for i := range teams {
+			team := teams[i]
+			entries[team.ID] = &LeaderboardEntry{Team: &team}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.matchRepo.FindInBatches(ctx, standingsBatchSize, func(matches []entity.Match) error {
+		for _, match := range matches {
+			if match.Status != entity.MatchStatusCompleted || match.HomeScore == nil || match.AwayScore == nil {
+				continue
+			}
+			// Matches against a deleted team still count for the other team
+			if home, ok := entries[match.HomeTeamID]; ok {
+				home.record(*match.HomeScore, *match.AwayScore)
+			}
+			if away, ok := entries[match.AwayTeamID]; ok {
+				away.record(*match.AwayScore, *match.HomeScore)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	standings := make([]LeaderboardEntry, 0, len(entries))
+	for _, entry := range entries {
+		standings = append(standings, *entry)
+	}
+	sort.Slice(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		switch {
+		case a.Points != b.Points:
+			return a.Points > b.Points
+		case a.GoalDiff != b.GoalDiff:
+			return a.GoalDiff > b.GoalDiff
+		case a.GoalsFor != b.GoalsFor:
+			return a.GoalsFor > b.GoalsFor
+		default:
+			return a.Team.Name < b.Team.Name
+		}
+	})
+	return standings, nil
+}
+
+// record adds the result of one completed match to the entry
+func (e *LeaderboardEntry) record(scored, conceded int) {
+	e.Played++
+	e.GoalsFor += int64(scored)
+	e.GoalsAgainst += int64(conceded)
+	e.GoalDiff = e.GoalsFor - e.GoalsAgainst
+	switch {
+	case scored > conceded:
+		e.Won++
+		e.Points += 3
+	case scored == conceded:
+		e.Drawn++
+		e.Points++
+	default:
+		e.Lost++
+	}
+}
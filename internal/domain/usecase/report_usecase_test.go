@@ -0,0 +1,143 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+func TestReportUseCase_GetMatchReport(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	home := f.createTeam(t, "Persija")
+	away := f.createTeam(t, "Persib")
+	striker := f.createPlayer(t, home.ID, "Striker", 9)
+	winger := f.createPlayer(t, away.ID, "Winger", 7)
+
+	first := f.createMatch(t, home.ID, away.ID)
+	second := f.createMatch(t, away.ID, home.ID)
+	results := []struct {
+		matchID uuid.UUID
+		input   usecase.MatchResultInput
+	}{
+		{first.ID, usecase.MatchResultInput{HomeScore: 2, AwayScore: 1, Goals: []usecase.GoalInput{
+			{PlayerID: striker.ID, TeamID: home.ID, Minute: 10},
+			{PlayerID: striker.ID, TeamID: home.ID, Minute: 50},
+			{PlayerID: winger.ID, TeamID: away.ID, Minute: 70},
+		}}},
+		{second.ID, usecase.MatchResultInput{HomeScore: 0, AwayScore: 1, Goals: []usecase.GoalInput{
+			{PlayerID: striker.ID, TeamID: home.ID, Minute: 30},
+		}}},
+	}
+	for _, r := range results {
+		if _, _, err := f.matchUseCase.RecordResult(ctx, r.matchID, r.input); err != nil {
+			t.Fatalf("record result: %v", err)
+		}
+	}
+
+	report, err := f.reportUseCase.GetMatchReport(ctx, first.ID)
+	if err != nil {
+		t.Fatalf("get report: %v", err)
+	}
+	if report.HomeScore != 2 || report.AwayScore != 1 || len(report.Goals) != 3 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	if report.HomeTeam == nil || report.HomeTeam.ID != home.ID {
+		t.Fatalf("expected home team to be preloaded")
+	}
+	// The home side won both matches, once at home and once away
+	if report.HomeTeamTotalWins != 2 || report.AwayTeamTotalWins != 0 {
+		t.Fatalf("expected win totals 2 and 0, got %d and %d", report.HomeTeamTotalWins, report.AwayTeamTotalWins)
+	}
+	if report.TopScorer == nil || report.TopScorer.PlayerID != striker.ID || report.TopScorer.GoalCount != 3 {
+		t.Fatalf("expected striker as top scorer with 3 goals, got %+v", report.TopScorer)
+	}
+
+	if _, err := f.reportUseCase.GetMatchReport(ctx, uuid.New()); !errors.Is(err, usecase.ErrMatchNotFound) {
+		t.Fatalf("expected ErrMatchNotFound, got %v", err)
+	}
+
+	reports, total, err := f.reportUseCase.GetAllMatchReports(ctx, 1, 10)
+	if err != nil || total != 2 || len(reports) != 2 {
+		t.Fatalf("expected 2 reports, got %d (%v)", total, err)
+	}
+}
+
+func TestReportUseCase_TopScorersIgnoreOwnGoals(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	home := f.createTeam(t, "Arema")
+	away := f.createTeam(t, "Persebaya")
+	striker := f.createPlayer(t, home.ID, "Striker", 9)
+	defender := f.createPlayer(t, away.ID, "Defender", 4)
+	match := f.createMatch(t, home.ID, away.ID)
+
+	input := usecase.MatchResultInput{HomeScore: 3, Goals: []usecase.GoalInput{
+		{PlayerID: striker.ID, TeamID: home.ID, Minute: 5},
+		{PlayerID: defender.ID, TeamID: home.ID, Minute: 20, IsOwnGoal: true},
+		{PlayerID: defender.ID, TeamID: home.ID, Minute: 40, IsOwnGoal: true},
+	}}
+	if _, _, err := f.matchUseCase.RecordResult(ctx, match.ID, input); err != nil {
+		t.Fatalf("record result: %v", err)
+	}
+
+	scorers, err := f.reportUseCase.GetTopScorers(ctx, 10)
+	if err != nil {
+		t.Fatalf("top scorers: %v", err)
+	}
+	if len(scorers) != 1 || scorers[0].PlayerID != striker.ID || scorers[0].GoalCount != 1 {
+		t.Fatalf("expected only the striker, got %+v", scorers)
+	}
+}
+
+func TestReportUseCase_GetStandings(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	persija := f.createTeam(t, "Persija")
+	persib := f.createTeam(t, "Persib")
+	arema := f.createTeam(t, "Arema")
+	bali := f.createTeam(t, "Bali United")
+
+	record := func(home, away uuid.UUID, homeScore, awayScore int) {
+		t.Helper()
+		match := f.createMatch(t, home, away)
+		input := usecase.MatchResultInput{HomeScore: homeScore, AwayScore: awayScore}
+		if _, _, err := f.matchUseCase.RecordResult(ctx, match.ID, input); err != nil {
+			t.Fatalf("record result: %v", err)
+		}
+	}
+	record(persija.ID, persib.ID, 3, 0)
+	record(arema.ID, persija.ID, 1, 1)
+	record(persib.ID, arema.ID, 2, 1)
+	// Scheduled matches do not count
+	f.createMatch(t, bali.ID, persija.ID)
+
+	standings, err := f.reportUseCase.GetStandings(ctx)
+	if err != nil {
+		t.Fatalf("get standings: %v", err)
+	}
+	want := []struct {
+		name                     string
+		played, won, drawn, lost int64
+		goalDiff, points         int64
+	}{
+		{"Persija", 2, 1, 1, 0, 3, 4},
+		{"Persib", 2, 1, 0, 1, -2, 3},
+		{"Arema", 2, 0, 1, 1, -1, 1},
+		// Teams without completed matches are listed with zero points
+		{"Bali United", 0, 0, 0, 0, 0, 0},
+	}
+	if len(standings) != len(want) {
+		t.Fatalf("expected %d standings, got %d", len(want), len(standings))
+	}
+	for i, w := range want {
+		got := standings[i]
+		if got.Team == nil || got.Team.Name != w.name || got.Played != w.played || got.Won != w.won ||
+			got.Drawn != w.drawn || got.Lost != w.lost || got.GoalDiff != w.goalDiff || got.Points != w.points {
+			t.Fatalf("position %d: expected %+v, got %+v", i+1, w, got)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/pkg/textsearch"
+)
+
+var (
+	ErrSearchQueryTooShort = errors.New("search query must contain at least 2 letters or digits")
+	ErrInvalidSearchType   = errors.New("invalid search type, must be team, player or venue")
+)
+
+// SearchType is a kind of result of the unified search
+type SearchType string
+
+const (
+	SearchTypeTeam   SearchType = "team"
+	SearchTypePlayer SearchType = "player"
+	SearchTypeVenue  SearchType = "venue"
+)
+
+// SearchTypes lists every kind of result, in the order they are grouped
+var SearchTypes = []SearchType{SearchTypeTeam, SearchTypePlayer, SearchTypeVenue}
+
+// ParseSearchTypes parses a comma-separated list of search types. An empty
+// list selects all of them.
+func ParseSearchTypes(list string) ([]SearchType, error) {
+	if strings.TrimSpace(list) == "" {
+		return SearchTypes, nil
+	}
+	var types []SearchType
+	for _, part := range strings.Split(list, ",") {
+		searchType := SearchType(strings.TrimSpace(part))
+		switch searchType {
+		case SearchTypeTeam, SearchTypePlayer, SearchTypeVenue:
+			types = append(types, searchType)
+		default:
+			return nil, ErrInvalidSearchType
+		}
+	}
+	return types, nil
+}
+
+// SearchResult groups the hits of a search by type. Types that were not
+// searched stay empty.
+type SearchResult struct {
+	Teams   []textsearch.Hit[entity.Team]
+	Players []textsearch.Hit[entity.Player]
+	Venues  []textsearch.Hit[entity.Venue]
+}
+
+// SearchUseCase defines the interface for searching across entities
+type SearchUseCase interface {
+	// Search returns up to limit teams, players and venues of the given
+	// types best matching text, ignoring case and accents and tolerating
+	// typos
+	Search(ctx context.Context, text string, types []SearchType, limit int) (*SearchResult, error)
+}
+
+type searchUseCaseImpl struct {
+	teamRepo   repository.TeamRepository
+	playerRepo repository.PlayerRepository
+	venueRepo  repository.VenueRepository
+}
+
+// NewSearchUseCase creates a new instance of SearchUseCase
+func NewSearchUseCase(teamRepo repository.TeamRepository, playerRepo repository.PlayerRepository, venueRepo repository.VenueRepository) SearchUseCase {
+	return &searchUseCaseImpl{
+		teamRepo:   teamRepo,
+		playerRepo: playerRepo,
+		venueRepo:  venueRepo,
+	}
+}
+
+func (uc *searchUseCaseImpl) Search(ctx context.Context, text string, types []SearchType, limit int) (*SearchResult, error) {
+	// A single letter would match the start of nearly every name
+	if utf8.RuneCountInString(strings.ReplaceAll(textsearch.Fold(text), " ", "")) < 2 {
+		return nil, ErrSearchQueryTooShort
+	}
+
+	result := &SearchResult{}
+	var err error
+	for _, searchType := range types {
+		switch searchType {
+		case SearchTypeTeam:
+			result.Teams, err = uc.teamRepo.Search(ctx, text, limit)
+		case SearchTypePlayer:
+			result.Players, err = uc.playerRepo.Search(ctx, text, limit)
+		case SearchTypeVenue:
+			result.Venues, err = uc.venueRepo.Search(ctx, text, limit)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
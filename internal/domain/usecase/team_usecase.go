@@ -7,35 +7,91 @@ import (
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
 	"gorm.io/gorm"
 )
 
 var (
-	ErrTeamNotFound = errors.New("team not found")
+	ErrTeamNotFound   = errors.New("team not found")
+	ErrTeamHasMatches = errors.New("team has matches and cannot be deleted without force")
 )
 
+// maxListedDependentMatches caps the matches listed when a delete is blocked
+const maxListedDependentMatches = 20
+
+// TeamDependencies summarizes the records that depend on a team
+type TeamDependencies struct {
+	PlayerCount int64
+	MatchCount  int64
+	Matches     []entity.Match // first maxListedDependentMatches matches
+}
+
+// TeamDeleteBlockedError is returned when a team cannot be deleted because of its dependents
+type TeamDeleteBlockedError struct {
+	Dependencies TeamDependencies
+}
+
+func (e *TeamDeleteBlockedError) Error() string {
+	return ErrTeamHasMatches.Error()
+}
+
+func (e *TeamDeleteBlockedError) Unwrap() error {
+	return ErrTeamHasMatches
+}
+
 // TeamUseCase defines the interface for team operations
 type TeamUseCase interface {
 	Create(ctx context.Context, team *entity.Team) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Team, error)
-	GetByIDWithPlayers(ctx context.Context, id uuid.UUID) (*entity.Team, error)
+	// GetByIDWith returns a team with the related resources of include, see
+	// repository.TeamListSchema
+	GetByIDWith(ctx context.Context, id uuid.UUID, include listquery.Include) (*entity.Team, error)
 	Update(ctx context.Context, team *entity.Team) error
-	Delete(ctx context.Context, id uuid.UUID) error
-	GetAll(ctx context.Context, page, limit int) ([]entity.Team, int64, error)
-	Search(ctx context.Context, query string, page, limit int) ([]entity.Team, int64, error)
+	Delete(ctx context.Context, id uuid.UUID, force bool) error
+	GetDependencies(ctx context.Context, id uuid.UUID) (*TeamDependencies, error)
+	// GetAll returns a page of the teams matching the query, see
+	// repository.TeamListSchema
+	GetAll(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Team, int64, error)
+	// Scroll is GetAll with a cursor window instead of a page number
+	Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Team], error)
 }
 
 type teamUseCaseImpl struct {
-	teamRepo repository.TeamRepository
+	teamRepo     repository.TeamRepository
+	playerRepo   repository.PlayerRepository
+	matchRepo    repository.MatchRepository
+	venueRepo    repository.VenueRepository
+	auditUseCase AuditUseCase
 }
 
 // NewTeamUseCase creates a new instance of TeamUseCase
-func NewTeamUseCase(teamRepo repository.TeamRepository) TeamUseCase {
-	return &teamUseCaseImpl{teamRepo: teamRepo}
+func NewTeamUseCase(
+	teamRepo repository.TeamRepository,
+	playerRepo repository.PlayerRepository,
+	matchRepo repository.MatchRepository,
+	venueRepo repository.VenueRepository,
+	auditUseCase AuditUseCase,
+) TeamUseCase {
+	return &teamUseCaseImpl{
+		teamRepo:     teamRepo,
+		playerRepo:   playerRepo,
+		matchRepo:    matchRepo,
+		venueRepo:    venueRepo,
+		auditUseCase: auditUseCase,
+	}
 }
 
 func (uc *teamUseCaseImpl) Create(ctx context.Context, team *entity.Team) error {
-	return uc.teamRepo.Create(ctx, team)
+	if err := uc.checkHomeVenue(ctx, team, nil); err != nil {
+		return err
+	}
+
+	if err := uc.teamRepo.Create(ctx, team); err != nil {
+		return err
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionCreate, entity.AuditEntityTeam, team.ID, nil, team)
+	return nil
 }
 
 func (uc *teamUseCaseImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Team, error) {
@@ -49,8 +105,8 @@ func (uc *teamUseCaseImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.T
 	return team, nil
 }
 
-func (uc *teamUseCaseImpl) GetByIDWithPlayers(ctx context.Context, id uuid.UUID) (*entity.Team, error) {
-	team, err := uc.teamRepo.FindByIDWithPlayers(ctx, id)
+func (uc *teamUseCaseImpl) GetByIDWith(ctx context.Context, id uuid.UUID, include listquery.Include) (*entity.Team, error) {
+	team, err := uc.teamRepo.FindByIDWith(ctx, id, include)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrTeamNotFound
@@ -61,31 +117,102 @@ func (uc *teamUseCaseImpl) GetByIDWithPlayers(ctx context.Context, id uuid.UUID)
 }
 
 func (uc *teamUseCaseImpl) Update(ctx context.Context, team *entity.Team) error {
-	exists, err := uc.teamRepo.Exists(ctx, team.ID)
+	before, err := uc.teamRepo.FindByID(ctx, team.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrTeamNotFound
+		}
+		return err
+	}
+
+	if err := uc.checkHomeVenue(ctx, team, before); err != nil {
+		return err
+	}
+
+	if err := uc.teamRepo.Update(ctx, team); err != nil {
+		return err
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionUpdate, entity.AuditEntityTeam, team.ID, before, team)
+	return nil
+}
+
+// checkHomeVenue checks that a new home venue exists. A team keeps a home
+// venue that has since been deleted until it is changed.
+func (uc *teamUseCaseImpl) checkHomeVenue(ctx context.Context, team, before *entity.Team) error {
+	if team.HomeVenueID == nil || (before != nil && sameID(before.HomeVenueID, team.HomeVenueID)) {
+		return nil
+	}
+	exists, err := uc.venueRepo.Exists(ctx, *team.HomeVenueID)
 	if err != nil {
 		return err
 	}
 	if !exists {
-		return ErrTeamNotFound
+		return ErrVenueNotFound
 	}
-	return uc.teamRepo.Update(ctx, team)
+	return nil
 }
 
-func (uc *teamUseCaseImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	exists, err := uc.teamRepo.Exists(ctx, id)
+// Delete soft deletes a team and its players. A team that has played or is
+// scheduled to play matches is only deleted when force is set; its pending
+// matches are then cancelled while completed results are kept.
+func (uc *teamUseCaseImpl) Delete(ctx context.Context, id uuid.UUID, force bool) error {
+	before, err := uc.teamRepo.FindByIDWith(ctx, id, listquery.NewInclude(repository.TeamListSchema.Relations...))
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrTeamNotFound
+		}
+		return err
+	}
+
+	if !force {
+		dependencies, err := uc.GetDependencies(ctx, id)
+		if err != nil {
+			return err
+		}
+		if dependencies.MatchCount > 0 {
+			return &TeamDeleteBlockedError{Dependencies: *dependencies}
+		}
+	}
+
+	if err := uc.teamRepo.DeleteCascade(ctx, id); err != nil {
 		return err
 	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionDelete, entity.AuditEntityTeam, id, before, nil)
+	return nil
+}
+
+func (uc *teamUseCaseImpl) GetDependencies(ctx context.Context, id uuid.UUID) (*TeamDependencies, error) {
+	exists, err := uc.teamRepo.Exists(ctx, id)
+	if err != nil {
+		return nil, err
+	}
 	if !exists {
-		return ErrTeamNotFound
+		return nil, ErrTeamNotFound
+	}
+
+	_, playerCount, err := uc.playerRepo.FindByTeamID(ctx, id, 1, 1)
+	if err != nil {
+		return nil, err
 	}
-	return uc.teamRepo.Delete(ctx, id)
+
+	matches, matchCount, err := uc.matchRepo.FindByTeamID(ctx, id, 1, maxListedDependentMatches)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TeamDependencies{
+		PlayerCount: playerCount,
+		MatchCount:  matchCount,
+		Matches:     matches,
+	}, nil
 }
 
-func (uc *teamUseCaseImpl) GetAll(ctx context.Context, page, limit int) ([]entity.Team, int64, error) {
-	return uc.teamRepo.FindAll(ctx, page, limit)
+func (uc *teamUseCaseImpl) GetAll(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Team, int64, error) {
+	return uc.teamRepo.List(ctx, query, page, limit)
 }
 
-func (uc *teamUseCaseImpl) Search(ctx context.Context, query string, page, limit int) ([]entity.Team, int64, error) {
-	return uc.teamRepo.Search(ctx, query, page, limit)
+func (uc *teamUseCaseImpl) Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Team], error) {
+	return uc.teamRepo.Scroll(ctx, query, window)
 }
@@ -0,0 +1,132 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+)
+
+func TestTeamUseCase_GetByIDNotFound(t *testing.T) {
+	f := newFixture(t)
+
+	if _, err := f.teamUseCase.GetByID(context.Background(), uuid.New()); !errors.Is(err, usecase.ErrTeamNotFound) {
+		t.Fatalf("expected ErrTeamNotFound, got %v", err)
+	}
+	if err := f.teamUseCase.Update(context.Background(), &entity.Team{BaseEntity: entity.BaseEntity{ID: uuid.New()}}); !errors.Is(err, usecase.ErrTeamNotFound) {
+		t.Fatalf("expected ErrTeamNotFound on update, got %v", err)
+	}
+}
+
+func TestTeamUseCase_UpdateRecordsAudit(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	team := f.createTeam(t, "Persija")
+
+	team.City = "Bandung"
+	if err := f.teamUseCase.Update(ctx, team); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	got, err := f.teamUseCase.GetByID(ctx, team.ID)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.City != "Bandung" {
+		t.Fatalf("expected city Bandung, got %q", got.City)
+	}
+
+	actions := f.auditActions(t, team.ID)
+	want := []entity.AuditAction{entity.AuditActionCreate, entity.AuditActionUpdate}
+	if len(actions) != len(want) || actions[0] != want[0] || actions[1] != want[1] {
+		t.Fatalf("expected audit actions %v, got %v", want, actions)
+	}
+}
+
+func TestTeamUseCase_DeleteWithoutMatches(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	team := f.createTeam(t, "Persib")
+	player := f.createPlayer(t, team.ID, "Striker", 9)
+
+	if err := f.teamUseCase.Delete(ctx, team.ID, false); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	if _, err := f.teamUseCase.GetByID(ctx, team.ID); !errors.Is(err, usecase.ErrTeamNotFound) {
+		t.Fatalf("expected deleted team to be gone, got %v", err)
+	}
+	if _, err := f.playerUseCase.GetByID(ctx, player.ID); !errors.Is(err, usecase.ErrPlayerNotFound) {
+		t.Fatalf("expected team's player to be deleted, got %v", err)
+	}
+}
+
+func TestTeamUseCase_DeleteBlockedByMatches(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	home := f.createTeam(t, "Arema")
+	away := f.createTeam(t, "Persebaya")
+	f.createPlayer(t, home.ID, "Keeper", 1)
+	match := f.createMatch(t, home.ID, away.ID)
+
+	err := f.teamUseCase.Delete(ctx, home.ID, false)
+	var blocked *usecase.TeamDeleteBlockedError
+	if !errors.As(err, &blocked) {
+		t.Fatalf("expected TeamDeleteBlockedError, got %v", err)
+	}
+	if !errors.Is(err, usecase.ErrTeamHasMatches) {
+		t.Fatalf("expected error to wrap ErrTeamHasMatches")
+	}
+	if blocked.Dependencies.PlayerCount != 1 || blocked.Dependencies.MatchCount != 1 {
+		t.Fatalf("unexpected dependencies: %+v", blocked.Dependencies)
+	}
+	if len(blocked.Dependencies.Matches) != 1 || blocked.Dependencies.Matches[0].ID != match.ID {
+		t.Fatalf("expected blocking match to be listed, got %+v", blocked.Dependencies.Matches)
+	}
+
+	if err := f.teamUseCase.Delete(ctx, home.ID, true); err != nil {
+		t.Fatalf("forced delete: %v", err)
+	}
+	if _, err := f.teamUseCase.GetByID(ctx, home.ID); !errors.Is(err, usecase.ErrTeamNotFound) {
+		t.Fatalf("expected team to be deleted, got %v", err)
+	}
+}
+
+func TestTeamUseCase_GetDependenciesNotFound(t *testing.T) {
+	f := newFixture(t)
+
+	if _, err := f.teamUseCase.GetDependencies(context.Background(), uuid.New()); !errors.Is(err, usecase.ErrTeamNotFound) {
+		t.Fatalf("expected ErrTeamNotFound, got %v", err)
+	}
+}
+
+func TestTeamUseCase_GetAllAndSearch(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	f.createTeam(t, "Bali United")
+	f.createTeam(t, "Borneo FC")
+	deleted := f.createTeam(t, "Bhayangkara")
+	if err := f.teamUseCase.Delete(ctx, deleted.ID, false); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	teams, total, err := f.teamUseCase.GetAll(ctx, listquery.Query{}, 1, 1)
+	if err != nil {
+		t.Fatalf("get all: %v", err)
+	}
+	if total != 2 || len(teams) != 1 {
+		t.Fatalf("expected 1 of 2 teams, got %d of %d", len(teams), total)
+	}
+
+	teams, total, err = f.teamUseCase.GetAll(ctx, listquery.Query{Search: "bali"}, 1, 10)
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if total != 1 || teams[0].Name != "Bali United" {
+		t.Fatalf("expected to find Bali United, got %+v", teams)
+	}
+}
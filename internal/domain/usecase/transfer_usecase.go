@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrTransferWindowNotFound = errors.New("transfer window not found")
+	ErrSameTeamTransfer       = errors.New("player already belongs to this team")
+	ErrInvalidTransferDate    = errors.New("transfer date must be after the player joined their current team and not in the future")
+	ErrTransferWindowClosed   = errors.New("no transfer window of the season is open on the transfer date")
+	ErrInvalidTransferWindow  = errors.New("transfer window must close after it opens and lie within its season")
+	ErrTransferWindowOverlap  = errors.New("transfer window overlaps another window of the season")
+)
+
+// TransferInput represents the input for moving a player to another team
+type TransferInput struct {
+	TeamID       uuid.UUID
+	JerseyNumber int
+	At           time.Time // when the player joins the team
+}
+
+// TransferUseCase defines the interface for player transfers and the
+// transfer windows of seasons
+type TransferUseCase interface {
+	// Transfer ends the current contract of a player and starts one at
+	// another team. Within a season that has transfer windows, the transfer
+	// must fall in one of them.
+	Transfer(ctx context.Context, playerID uuid.UUID, input TransferInput) (*entity.PlayerContract, error)
+	GetContracts(ctx context.Context, playerID uuid.UUID) ([]entity.PlayerContract, error)
+	GetWindows(ctx context.Context, season *Season) ([]entity.TransferWindow, error)
+	CreateWindow(ctx context.Context, season *Season, window *entity.TransferWindow) error
+	DeleteWindow(ctx context.Context, season *Season, id uuid.UUID) error
+}
+
+type transferUseCaseImpl struct {
+	playerRepo   repository.PlayerRepository
+	teamRepo     repository.TeamRepository
+	contractRepo repository.PlayerContractRepository
+	windowRepo   repository.TransferWindowRepository
+	auditUseCase AuditUseCase
+}
+
+// NewTransferUseCase creates a new instance of TransferUseCase
+func NewTransferUseCase(
+	playerRepo repository.PlayerRepository,
+	teamRepo repository.TeamRepository,
+	contractRepo repository.PlayerContractRepository,
+	windowRepo repository.TransferWindowRepository,
+	auditUseCase AuditUseCase,
+) TransferUseCase {
+	return &transferUseCaseImpl{
+		playerRepo:   playerRepo,
+		teamRepo:     teamRepo,
+		contractRepo: contractRepo,
+		windowRepo:   windowRepo,
+		auditUseCase: auditUseCase,
+	}
+}
+
+func (uc *transferUseCaseImpl) Transfer(ctx context.Context, playerID uuid.UUID, input TransferInput) (*entity.PlayerContract, error) {
+	player, err := uc.playerRepo.FindByID(ctx, playerID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPlayerNotFound
+		}
+		return nil, err
+	}
+
+	team, err := uc.teamRepo.FindByID(ctx, input.TeamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, err
+	}
+	if team.ID == player.TeamID {
+		return nil, ErrSameTeamTransfer
+	}
+
+	if input.JerseyNumber < 1 || input.JerseyNumber > 99 {
+		return nil, ErrInvalidJerseyNumber
+	}
+	taken, err := uc.playerRepo.IsJerseyNumberTaken(ctx, team.ID, input.JerseyNumber, &player.ID)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return nil, ErrJerseyNumberTaken
+	}
+
+	current, err := uc.contractRepo.FindCurrent(ctx, player.ID)
+	if err != nil {
+		return nil, err
+	}
+	if input.At.After(time.Now()) || (current.StartsAt != nil && !input.At.After(*current.StartsAt)) {
+		return nil, ErrInvalidTransferDate
+	}
+	if err := uc.checkWindowOpen(ctx, input.At); err != nil {
+		return nil, err
+	}
+
+	at := input.At.UTC()
+	contract := &entity.PlayerContract{
+		PlayerID:     player.ID,
+		TeamID:       team.ID,
+		JerseyNumber: input.JerseyNumber,
+		StartsAt:     &at,
+	}
+	if err := uc.contractRepo.Transfer(ctx, contract); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPlayerNotFound
+		}
+		return nil, translatePlayerError(err)
+	}
+	contract.Team = team
+
+	after := *player
+	after.TeamID = team.ID
+	after.JerseyNumber = input.JerseyNumber
+	uc.auditUseCase.Record(ctx, entity.AuditActionTransfer, entity.AuditEntityPlayer, player.ID, player, &after)
+	return contract, nil
+}
+
+func (uc *transferUseCaseImpl) GetContracts(ctx context.Context, playerID uuid.UUID) ([]entity.PlayerContract, error) {
+	exists, err := uc.playerRepo.Exists(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrPlayerNotFound
+	}
+	return uc.contractRepo.FindByPlayerID(ctx, playerID)
+}
+
+func (uc *transferUseCaseImpl) GetWindows(ctx context.Context, season *Season) ([]entity.TransferWindow, error) {
+	return uc.windowRepo.FindBySeason(ctx, season.Name)
+}
+
+func (uc *transferUseCaseImpl) CreateWindow(ctx context.Context, season *Season, window *entity.TransferWindow) error {
+	if !window.OpensAt.Before(window.ClosesAt) || window.OpensAt.Before(season.Start) || window.ClosesAt.After(season.End) {
+		return ErrInvalidTransferWindow
+	}
+
+	existing, err := uc.windowRepo.FindBySeason(ctx, season.Name)
+	if err != nil {
+		return err
+	}
+	for _, other := range existing {
+		if window.OpensAt.Before(other.ClosesAt) && other.OpensAt.Before(window.ClosesAt) {
+			return ErrTransferWindowOverlap
+		}
+	}
+
+	window.Season = season.Name
+	if err := uc.windowRepo.Create(ctx, window); err != nil {
+		return err
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionCreate, entity.AuditEntityTransferWindow, window.ID, nil, window)
+	return nil
+}
+
+func (uc *transferUseCaseImpl) DeleteWindow(ctx context.Context, season *Season, id uuid.UUID) error {
+	window, err := uc.windowRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrTransferWindowNotFound
+		}
+		return err
+	}
+	if window.Season != season.Name {
+		return ErrTransferWindowNotFound
+	}
+
+	if err := uc.windowRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionDelete, entity.AuditEntityTransferWindow, id, window, nil)
+	return nil
+}
+
+// checkWindowOpen returns ErrTransferWindowClosed when a season the time
+// falls in has transfer windows and none of them is open at that time
+func (uc *transferUseCaseImpl) checkWindowOpen(ctx context.Context, at time.Time) error {
+	names, err := seasonNamesAt(at)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		windows, err := uc.windowRepo.FindBySeason(ctx, name)
+		if err != nil {
+			return err
+		}
+		if len(windows) == 0 {
+			continue
+		}
+		open := false
+		for _, window := range windows {
+			if window.IsOpenAt(at) {
+				open = true
+				break
+			}
+		}
+		if !open {
+			return ErrTransferWindowClosed
+		}
+	}
+	return nil
+}
+
+// seasonNamesAt returns the names of both seasons, as read by ParseSeason,
+// that a time falls in: its calendar year and its July to June season
+func seasonNamesAt(at time.Time) ([]string, error) {
+	loc, err := entity.LoadLocation(entity.DefaultTimezone)
+	if err != nil {
+		return nil, err
+	}
+	local := at.In(loc)
+	year := local.Year()
+	if local.Month() < time.July {
+		return []string{strconv.Itoa(year), fmt.Sprintf("%d-%d", year-1, year)}, nil
+	}
+	return []string{strconv.Itoa(year), fmt.Sprintf("%d-%d", year, year+1)}, nil
+}
@@ -0,0 +1,200 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+func TestTransferUseCase_Transfer(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	persija := f.createTeam(t, "Persija")
+	persib := f.createTeam(t, "Persib")
+	player := f.createPlayer(t, persija.ID, "Marko Simic", 9)
+	f.createPlayer(t, persib.ID, "David da Silva", 9)
+	joined := time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC)
+
+	invalid := []struct {
+		name  string
+		input usecase.TransferInput
+		want  error
+	}{
+		{"same team", usecase.TransferInput{TeamID: persija.ID, JerseyNumber: 10, At: joined}, usecase.ErrSameTeamTransfer},
+		{"jersey taken", usecase.TransferInput{TeamID: persib.ID, JerseyNumber: 9, At: joined}, usecase.ErrJerseyNumberTaken},
+		{"future date", usecase.TransferInput{TeamID: persib.ID, JerseyNumber: 10, At: time.Now().Add(time.Hour)}, usecase.ErrInvalidTransferDate},
+		{"unknown team", usecase.TransferInput{TeamID: uuid.New(), JerseyNumber: 10, At: joined}, usecase.ErrTeamNotFound},
+	}
+	for _, tc := range invalid {
+		if _, err := f.transferUseCase.Transfer(ctx, player.ID, tc.input); !errors.Is(err, tc.want) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.want, err)
+		}
+	}
+
+	contract, err := f.transferUseCase.Transfer(ctx, player.ID, usecase.TransferInput{TeamID: persib.ID, JerseyNumber: 10, At: joined})
+	if err != nil {
+		t.Fatalf("transfer: %v", err)
+	}
+	if !contract.IsCurrent() || contract.StartsAt == nil || !contract.StartsAt.Equal(joined) {
+		t.Fatalf("unexpected contract: %+v", contract)
+	}
+	if got := f.auditActions(t, player.ID); got[len(got)-1] != entity.AuditActionTransfer {
+		t.Fatalf("expected a transfer audit entry, got %v", got)
+	}
+
+	moved, err := f.playerUseCase.GetByID(ctx, player.ID)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if moved.TeamID != persib.ID || moved.JerseyNumber != 10 {
+		t.Fatalf("expected the player at Persib with number 10, got %+v", moved)
+	}
+
+	contracts, err := f.transferUseCase.GetContracts(ctx, player.ID)
+	if err != nil {
+		t.Fatalf("contracts: %v", err)
+	}
+	if len(contracts) != 2 || contracts[0].TeamID != persija.ID || contracts[0].EndsAt == nil || !contracts[0].EndsAt.Equal(joined) {
+		t.Fatalf("expected the Persija contract to end on the transfer, got %+v", contracts)
+	}
+
+	// A transfer cannot predate the current contract
+	back := usecase.TransferInput{TeamID: persija.ID, JerseyNumber: 9, At: joined.AddDate(0, 0, -1)}
+	if _, err := f.transferUseCase.Transfer(ctx, player.ID, back); !errors.Is(err, usecase.ErrInvalidTransferDate) {
+		t.Fatalf("expected ErrInvalidTransferDate, got %v", err)
+	}
+
+	// Moving a player through an update is rejected
+	moved.TeamID = persija.ID
+	if err := f.playerUseCase.Update(ctx, moved); !errors.Is(err, usecase.ErrTeamChangeNeedsTransfer) {
+		t.Fatalf("expected ErrTeamChangeNeedsTransfer, got %v", err)
+	}
+
+	if _, err := f.transferUseCase.GetContracts(ctx, uuid.New()); !errors.Is(err, usecase.ErrPlayerNotFound) {
+		t.Fatalf("expected ErrPlayerNotFound, got %v", err)
+	}
+}
+
+func TestTransferUseCase_Windows(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	persija := f.createTeam(t, "Persija")
+	persib := f.createTeam(t, "Persib")
+	player := f.createPlayer(t, persija.ID, "Marko Simic", 9)
+
+	season, err := usecase.ParseSeason("2024-2025")
+	if err != nil {
+		t.Fatalf("parse season: %v", err)
+	}
+	loc := season.Start.Location()
+	summer := &entity.TransferWindow{
+		Name:     "Summer",
+		OpensAt:  time.Date(2024, 7, 1, 0, 0, 0, 0, loc),
+		ClosesAt: time.Date(2024, 9, 1, 0, 0, 0, 0, loc),
+	}
+	if err := f.transferUseCase.CreateWindow(ctx, season, summer); err != nil {
+		t.Fatalf("create window: %v", err)
+	}
+
+	invalid := []struct {
+		name   string
+		window *entity.TransferWindow
+		want   error
+	}{
+		{"overlap", &entity.TransferWindow{OpensAt: time.Date(2024, 8, 15, 0, 0, 0, 0, loc), ClosesAt: time.Date(2024, 9, 15, 0, 0, 0, 0, loc)}, usecase.ErrTransferWindowOverlap},
+		{"outside season", &entity.TransferWindow{OpensAt: time.Date(2025, 6, 15, 0, 0, 0, 0, loc), ClosesAt: time.Date(2025, 7, 15, 0, 0, 0, 0, loc)}, usecase.ErrInvalidTransferWindow},
+		{"closes before opening", &entity.TransferWindow{OpensAt: time.Date(2025, 2, 1, 0, 0, 0, 0, loc), ClosesAt: time.Date(2025, 1, 1, 0, 0, 0, 0, loc)}, usecase.ErrInvalidTransferWindow},
+	}
+	for _, tc := range invalid {
+		if err := f.transferUseCase.CreateWindow(ctx, season, tc.window); !errors.Is(err, tc.want) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.want, err)
+		}
+	}
+
+	closed := usecase.TransferInput{TeamID: persib.ID, JerseyNumber: 10, At: time.Date(2024, 10, 1, 0, 0, 0, 0, loc)}
+	if _, err := f.transferUseCase.Transfer(ctx, player.ID, closed); !errors.Is(err, usecase.ErrTransferWindowClosed) {
+		t.Fatalf("expected ErrTransferWindowClosed, got %v", err)
+	}
+	open := usecase.TransferInput{TeamID: persib.ID, JerseyNumber: 10, At: time.Date(2024, 8, 31, 23, 0, 0, 0, loc)}
+	if _, err := f.transferUseCase.Transfer(ctx, player.ID, open); err != nil {
+		t.Fatalf("expected the summer window to be open, got %v", err)
+	}
+
+	other, err := usecase.ParseSeason("2025-2026")
+	if err != nil {
+		t.Fatalf("parse season: %v", err)
+	}
+	if err := f.transferUseCase.DeleteWindow(ctx, other, summer.ID); !errors.Is(err, usecase.ErrTransferWindowNotFound) {
+		t.Fatalf("expected ErrTransferWindowNotFound for another season, got %v", err)
+	}
+	if err := f.transferUseCase.DeleteWindow(ctx, season, summer.ID); err != nil {
+		t.Fatalf("delete window: %v", err)
+	}
+	windows, err := f.transferUseCase.GetWindows(ctx, season)
+	if err != nil || len(windows) != 0 {
+		t.Fatalf("expected no windows left, got %d (%v)", len(windows), err)
+	}
+}
+
+func TestTransferUseCase_MatchHistory(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	persija := f.createTeam(t, "Persija")
+	persib := f.createTeam(t, "Persib")
+	player := f.createPlayer(t, persija.ID, "Marko Simic", 9)
+	before := f.createMatch(t, persija.ID, persib.ID)
+	after := f.createMatch(t, persija.ID, persib.ID)
+
+	// The transfer falls between the two kickoffs
+	joined := before.KickoffAt.Add(12 * time.Hour)
+	if _, err := f.transferUseCase.Transfer(ctx, player.ID, usecase.TransferInput{TeamID: persib.ID, JerseyNumber: 10, At: joined}); err != nil {
+		t.Fatalf("transfer: %v", err)
+	}
+
+	_, _, err := f.matchUseCase.RecordResult(ctx, before.ID, usecase.MatchResultInput{HomeScore: 1, Goals: []usecase.GoalInput{
+		{PlayerID: player.ID, TeamID: persija.ID, Minute: 10},
+	}})
+	if err != nil {
+		t.Fatalf("expected the goal to count for Persija before the transfer, got %v", err)
+	}
+
+	wrongTeam := []usecase.MatchResultInput{
+		{HomeScore: 1, Goals: []usecase.GoalInput{{PlayerID: player.ID, TeamID: persija.ID, Minute: 10}}},
+		{Cards: []usecase.CardInput{{PlayerID: player.ID, TeamID: persija.ID, Minute: 5, Type: entity.CardYellow}}},
+		{AwayScore: 1, Goals: []usecase.GoalInput{{PlayerID: player.ID, TeamID: persib.ID, Minute: 10, IsOwnGoal: true}}},
+	}
+	for i, input := range wrongTeam {
+		if _, _, err := f.matchUseCase.RecordResult(ctx, after.ID, input); !errors.Is(err, usecase.ErrPlayerNotInTeam) {
+			t.Errorf("input %d: expected ErrPlayerNotInTeam, got %v", i, err)
+		}
+	}
+	_, _, err = f.matchUseCase.RecordResult(ctx, after.ID, usecase.MatchResultInput{AwayScore: 2, Goals: []usecase.GoalInput{
+		{PlayerID: player.ID, TeamID: persib.ID, Minute: 30},
+		{PlayerID: player.ID, TeamID: persib.ID, Minute: 60},
+	}})
+	if err != nil {
+		t.Fatalf("expected the goals to count for Persib after the transfer, got %v", err)
+	}
+
+	squad, total, err := f.playerUseCase.GetByTeamIDAsOf(ctx, persija.ID, before.KickoffAt, 1, 10)
+	if err != nil || total != 1 || squad[0].ID != player.ID || squad[0].JerseyNumber != 9 {
+		t.Fatalf("expected the player in the Persija squad with number 9, got %+v (%v)", squad, err)
+	}
+	if _, total, _ := f.playerUseCase.GetByTeamIDAsOf(ctx, persija.ID, after.KickoffAt, 1, 10); total != 0 {
+		t.Fatalf("expected no Persija players after the transfer, got %d", total)
+	}
+
+	// Goals stay with the team they were scored for
+	scorers, err := f.reportUseCase.GetTopScorers(ctx, 10)
+	if err != nil {
+		t.Fatalf("top scorers: %v", err)
+	}
+	if len(scorers) != 2 || scorers[0].TeamID != persib.ID || scorers[0].GoalCount != 2 || scorers[1].TeamID != persija.ID {
+		t.Fatalf("expected a Persib and a Persija entry for the player, got %+v", scorers)
+	}
+}
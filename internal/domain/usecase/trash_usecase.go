@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrNotInTrash           = errors.New("record not found in trash")
+	ErrUnsupportedTrashType = errors.New("unsupported trash entity type")
+	ErrStillReferenced      = errors.New("record is still referenced by other records and cannot be purged")
+)
+
+// PurgeSummary counts the rows removed by a retention run
+type PurgeSummary struct {
+	Goals   int64
+	Cards   int64
+	Matches int64
+	Players int64
+	Teams   int64
+}
+
+// Total returns the number of rows removed across all tables
+func (s PurgeSummary) Total() int64 {
+	return s.Goals + s.Cards + s.Matches + s.Players + s.Teams
+}
+
+// TrashUseCase defines the interface for soft-deleted record operations.
+// Entity types are the audit entity types (entity.AuditEntityTeam, ...).
+type TrashUseCase interface {
+	ListTeams(ctx context.Context, page, limit int) ([]entity.Team, int64, error)
+	ListPlayers(ctx context.Context, page, limit int) ([]entity.Player, int64, error)
+	ListMatches(ctx context.Context, page, limit int) ([]entity.Match, int64, error)
+	Restore(ctx context.Context, entityType string, id uuid.UUID) error
+	Purge(ctx context.Context, entityType string, id uuid.UUID) error
+	PurgeExpired(ctx context.Context, retention time.Duration) (*PurgeSummary, error)
+}
+
+type trashUseCaseImpl struct {
+	teamRepo     repository.TeamRepository
+	playerRepo   repository.PlayerRepository
+	matchRepo    repository.MatchRepository
+	goalRepo     repository.GoalRepository
+	cardRepo     repository.CardRepository
+	auditUseCase AuditUseCase
+}
+
+// NewTrashUseCase creates a new instance of TrashUseCase
+func NewTrashUseCase(
+	teamRepo repository.TeamRepository,
+	playerRepo repository.PlayerRepository,
+	matchRepo repository.MatchRepository,
+	goalRepo repository.GoalRepository,
+	cardRepo repository.CardRepository,
+	auditUseCase AuditUseCase,
+) TrashUseCase {
+	return &trashUseCaseImpl{
+		teamRepo:     teamRepo,
+		playerRepo:   playerRepo,
+		matchRepo:    matchRepo,
+		goalRepo:     goalRepo,
+		cardRepo:     cardRepo,
+		auditUseCase: auditUseCase,
+	}
+}
+
+func (uc *trashUseCaseImpl) ListTeams(ctx context.Context, page, limit int) ([]entity.Team, int64, error) {
+	return uc.teamRepo.FindDeleted(ctx, page, limit)
+}
+
+func (uc *trashUseCaseImpl) ListPlayers(ctx context.Context, page, limit int) ([]entity.Player, int64, error) {
+	return uc.playerRepo.FindDeleted(ctx, page, limit)
+}
+
+func (uc *trashUseCaseImpl) ListMatches(ctx context.Context, page, limit int) ([]entity.Match, int64, error) {
+	return uc.matchRepo.FindDeleted(ctx, page, limit)
+}
+
+// Restore brings a soft-deleted record back. Restoring a team also restores
+// the players that were deleted together with it; players and matches can
+// only be restored while their teams are active.
+func (uc *trashUseCaseImpl) Restore(ctx context.Context, entityType string, id uuid.UUID) error {
+	var err error
+	switch entityType {
+	case entity.AuditEntityTeam:
+		err = uc.teamRepo.Restore(ctx, id)
+	case entity.AuditEntityPlayer:
+		err = uc.playerRepo.Restore(ctx, id)
+	case entity.AuditEntityMatch:
+		err = uc.matchRepo.Restore(ctx, id)
+	default:
+		return ErrUnsupportedTrashType
+	}
+	if err != nil {
+		return translateTrashError(err)
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionRestore, entityType, id, nil, nil)
+	return nil
+}
+
+// Purge permanently deletes a soft-deleted record. Purging a match also
+// removes its goals, cards and officials; teams and players that are still referenced are kept.
+func (uc *trashUseCaseImpl) Purge(ctx context.Context, entityType string, id uuid.UUID) error {
+	var err error
+	switch entityType {
+	case entity.AuditEntityTeam:
+		err = uc.teamRepo.Purge(ctx, id)
+	case entity.AuditEntityPlayer:
+		err = uc.playerRepo.Purge(ctx, id)
+	case entity.AuditEntityMatch:
+		err = uc.matchRepo.Purge(ctx, id)
+	default:
+		return ErrUnsupportedTrashType
+	}
+	if err != nil {
+		return translateTrashError(err)
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionPurge, entityType, id, nil, nil)
+	return nil
+}
+
+// PurgeExpired permanently deletes records that have been in the trash for
+// longer than retention. Dependents go first so that teams and players freed
+// up by the same run are purged as well.
+func (uc *trashUseCaseImpl) PurgeExpired(ctx context.Context, retention time.Duration) (*PurgeSummary, error) {
+	cutoff := time.Now().Add(-retention)
+	summary := &PurgeSummary{}
+
+	var err error
+	if summary.Goals, err = uc.goalRepo.PurgeDeletedBefore(ctx, cutoff); err != nil {
+		return summary, err
+	}
+	if summary.Cards, err = uc.cardRepo.PurgeDeletedBefore(ctx, cutoff); err != nil {
+		return summary, err
+	}
+	if summary.Matches, err = uc.matchRepo.PurgeDeletedBefore(ctx, cutoff); err != nil {
+		return summary, err
+	}
+	if summary.Players, err = uc.playerRepo.PurgeDeletedBefore(ctx, cutoff); err != nil {
+		return summary, err
+	}
+	if summary.Teams, err = uc.teamRepo.PurgeDeletedBefore(ctx, cutoff); err != nil {
+		return summary, err
+	}
+
+	return summary, nil
+}
+
+// translateTrashError maps repository errors to trash use case errors
+func translateTrashError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrNotInTrash
+	case errors.Is(err, repository.ErrStillReferenced):
+		return ErrStillReferenced
+	case errors.Is(err, repository.ErrReferenceNotFound):
+		return ErrTeamNotFound
+	case errors.Is(err, repository.ErrDuplicateJerseyNumber):
+		return ErrJerseyNumberTaken
+	}
+	return err
+}
@@ -0,0 +1,147 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+func TestTrashUseCase_RestoreTeamWithPlayers(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	team := f.createTeam(t, "Persija")
+	squad := f.createPlayer(t, team.ID, "Squad Player", 9)
+	released := f.createPlayer(t, team.ID, "Released Player", 10)
+
+	// A player deleted on their own stays in the trash when the team comes back
+	if err := f.playerUseCase.Delete(ctx, released.ID); err != nil {
+		t.Fatalf("delete player: %v", err)
+	}
+	if err := f.teamUseCase.Delete(ctx, team.ID, false); err != nil {
+		t.Fatalf("delete team: %v", err)
+	}
+
+	teams, total, err := f.trashUseCase.ListTeams(ctx, 1, 10)
+	if err != nil || total != 1 || teams[0].ID != team.ID {
+		t.Fatalf("expected the team in the trash, got %d (%v)", total, err)
+	}
+
+	if err := f.trashUseCase.Restore(ctx, entity.AuditEntityTeam, team.ID); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if _, err := f.playerUseCase.GetByID(ctx, squad.ID); err != nil {
+		t.Fatalf("expected squad player to be restored, got %v", err)
+	}
+	if _, err := f.playerUseCase.GetByID(ctx, released.ID); !errors.Is(err, usecase.ErrPlayerNotFound) {
+		t.Fatalf("expected released player to stay deleted, got %v", err)
+	}
+
+	actions := f.auditActions(t, team.ID)
+	if last := actions[len(actions)-1]; last != entity.AuditActionRestore {
+		t.Fatalf("expected restore to be audited, got %v", actions)
+	}
+
+	if err := f.trashUseCase.Restore(ctx, entity.AuditEntityTeam, team.ID); !errors.Is(err, usecase.ErrNotInTrash) {
+		t.Fatalf("expected ErrNotInTrash for an active team, got %v", err)
+	}
+}
+
+func TestTrashUseCase_RestoreErrors(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	team := f.createTeam(t, "Persib")
+	player := f.createPlayer(t, team.ID, "Midfielder", 8)
+
+	if err := f.trashUseCase.Restore(ctx, "stadium", uuid.New()); !errors.Is(err, usecase.ErrUnsupportedTrashType) {
+		t.Fatalf("expected ErrUnsupportedTrashType, got %v", err)
+	}
+
+	if err := f.playerUseCase.Delete(ctx, player.ID); err != nil {
+		t.Fatalf("delete player: %v", err)
+	}
+	f.createPlayer(t, team.ID, "Replacement", 8)
+	if err := f.trashUseCase.Restore(ctx, entity.AuditEntityPlayer, player.ID); !errors.Is(err, usecase.ErrJerseyNumberTaken) {
+		t.Fatalf("expected ErrJerseyNumberTaken, got %v", err)
+	}
+
+	if err := f.teamUseCase.Delete(ctx, team.ID, false); err != nil {
+		t.Fatalf("delete team: %v", err)
+	}
+	if err := f.trashUseCase.Restore(ctx, entity.AuditEntityPlayer, player.ID); !errors.Is(err, usecase.ErrTeamNotFound) {
+		t.Fatalf("expected ErrTeamNotFound while the team is deleted, got %v", err)
+	}
+}
+
+func TestTrashUseCase_Purge(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	home := f.createTeam(t, "Arema")
+	away := f.createTeam(t, "Persebaya")
+	striker := f.createPlayer(t, home.ID, "Striker", 9)
+	match := f.createMatch(t, home.ID, away.ID)
+	input := usecase.MatchResultInput{HomeScore: 1, Goals: []usecase.GoalInput{
+		{PlayerID: striker.ID, TeamID: home.ID, Minute: 15},
+	}}
+	if _, _, err := f.matchUseCase.RecordResult(ctx, match.ID, input); err != nil {
+		t.Fatalf("record result: %v", err)
+	}
+
+	if err := f.teamUseCase.Delete(ctx, home.ID, true); err != nil {
+		t.Fatalf("delete team: %v", err)
+	}
+	if err := f.trashUseCase.Purge(ctx, entity.AuditEntityTeam, home.ID); !errors.Is(err, usecase.ErrStillReferenced) {
+		t.Fatalf("expected ErrStillReferenced while the match exists, got %v", err)
+	}
+
+	if err := f.matchUseCase.Delete(ctx, match.ID); err != nil {
+		t.Fatalf("delete match: %v", err)
+	}
+	if err := f.trashUseCase.Purge(ctx, entity.AuditEntityMatch, match.ID); err != nil {
+		t.Fatalf("purge match: %v", err)
+	}
+	if err := f.trashUseCase.Purge(ctx, entity.AuditEntityPlayer, striker.ID); err != nil {
+		t.Fatalf("purge player: %v", err)
+	}
+	if err := f.trashUseCase.Purge(ctx, entity.AuditEntityTeam, home.ID); err != nil {
+		t.Fatalf("purge team: %v", err)
+	}
+	if err := f.trashUseCase.Purge(ctx, entity.AuditEntityTeam, home.ID); !errors.Is(err, usecase.ErrNotInTrash) {
+		t.Fatalf("expected ErrNotInTrash after purge, got %v", err)
+	}
+}
+
+func TestTrashUseCase_PurgeExpired(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	home := f.createTeam(t, "Bali United")
+	away := f.createTeam(t, "Borneo FC")
+	f.createPlayer(t, home.ID, "Keeper", 1)
+	f.createMatch(t, home.ID, away.ID)
+
+	if err := f.teamUseCase.Delete(ctx, away.ID, true); err != nil {
+		t.Fatalf("delete team: %v", err)
+	}
+	if err := f.teamUseCase.Delete(ctx, home.ID, true); err != nil {
+		t.Fatalf("delete team: %v", err)
+	}
+
+	// With a long retention nothing has expired yet
+	summary, err := f.trashUseCase.PurgeExpired(ctx, 24*time.Hour)
+	if err != nil || summary.Total() != 0 {
+		t.Fatalf("expected nothing to expire, got %+v (%v)", summary, err)
+	}
+
+	summary, err = f.trashUseCase.PurgeExpired(ctx, -time.Hour)
+	if err != nil {
+		t.Fatalf("purge expired: %v", err)
+	}
+	// The match was cancelled rather than deleted, so both teams stay referenced
+	if summary.Players != 1 || summary.Teams != 0 {
+		t.Fatalf("unexpected purge summary: %+v", summary)
+	}
+}
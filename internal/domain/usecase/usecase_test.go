@@ -0,0 +1,169 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/memory"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/storage"
+)
+
+// fixture wires the use cases to in-memory repositories sharing one store
+type fixture struct {
+	users   repository.UserRepository
+	teams   repository.TeamRepository
+	players repository.PlayerRepository
+	matches repository.MatchRepository
+	goals   repository.GoalRepository
+	audit   repository.AuditRepository
+	venues  repository.VenueRepository
+	cards   repository.CardRepository
+
+	officials      repository.OfficialRepository
+	matchOfficials repository.MatchOfficialRepository
+	contracts      repository.PlayerContractRepository
+	windows        repository.TransferWindowRepository
+	rules          repository.DisciplinaryRuleRepository
+	availability   repository.PlayerAvailabilityRepository
+	blobs          storage.BlobStore
+
+	auditUseCase    usecase.AuditUseCase
+	teamUseCase     usecase.TeamUseCase
+	playerUseCase   usecase.PlayerUseCase
+	matchUseCase    usecase.MatchUseCase
+	reportUseCase   usecase.ReportUseCase
+	trashUseCase    usecase.TrashUseCase
+	importUseCase   usecase.ImportUseCase
+	exportUseCase   usecase.ExportUseCase
+	fixtureUseCase  usecase.FixtureUseCase
+	venueUseCase    usecase.VenueUseCase
+	officialUseCase usecase.OfficialUseCase
+	transferUseCase usecase.TransferUseCase
+
+	disciplinaryUseCase usecase.DisciplinaryUseCase
+	availabilityUseCase usecase.AvailabilityUseCase
+	mediaUseCase        usecase.MediaUseCase
+
+	matchDays int // matches created so far, each on its own day
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	store := memory.NewStore()
+	f := &fixture{
+		users:   memory.NewUserRepository(store),
+		teams:   memory.NewTeamRepository(store),
+		players: memory.NewPlayerRepository(store),
+		matches: memory.NewMatchRepository(store),
+		goals:   memory.NewGoalRepository(store),
+		audit:   memory.NewAuditRepository(store),
+		venues:  memory.NewVenueRepository(store),
+		cards:   memory.NewCardRepository(store),
+
+		officials:      memory.NewOfficialRepository(store),
+		matchOfficials: memory.NewMatchOfficialRepository(store),
+		contracts:      memory.NewPlayerContractRepository(store),
+		windows:        memory.NewTransferWindowRepository(store),
+		rules:          memory.NewDisciplinaryRuleRepository(store),
+		availability:   memory.NewPlayerAvailabilityRepository(store),
+		blobs:          storage.NewLocalBlobStore(t.TempDir()),
+	}
+	f.auditUseCase = usecase.NewAuditUseCase(f.audit)
+	f.teamUseCase = usecase.NewTeamUseCase(f.teams, f.players, f.matches, f.venues, f.auditUseCase)
+	f.playerUseCase = usecase.NewPlayerUseCase(f.players, f.teams, f.contracts, f.auditUseCase)
+	f.matchUseCase = usecase.NewMatchUseCase(f.matches, f.teams, f.players, f.contracts, f.goals, f.cards, f.rules, f.availability, f.venues, f.matchOfficials, f.auditUseCase)
+	f.reportUseCase = usecase.NewReportUseCase(f.matches, f.goals, f.teams)
+	f.trashUseCase = usecase.NewTrashUseCase(f.teams, f.players, f.matches, f.goals, f.cards, f.auditUseCase)
+	f.importUseCase = usecase.NewImportUseCase(f.teams, f.players, f.auditUseCase)
+	f.exportUseCase = usecase.NewExportUseCase(f.teams, f.players, f.matches, f.goals)
+	f.fixtureUseCase = usecase.NewFixtureUseCase(f.matches, f.teams)
+	f.venueUseCase = usecase.NewVenueUseCase(f.venues, f.auditUseCase)
+	f.officialUseCase = usecase.NewOfficialUseCase(f.officials, f.matchOfficials, f.matches, f.auditUseCase)
+	f.transferUseCase = usecase.NewTransferUseCase(f.players, f.teams, f.contracts, f.windows, f.auditUseCase)
+	f.disciplinaryUseCase = usecase.NewDisciplinaryUseCase(f.rules, f.matches, f.cards, f.contracts, f.auditUseCase)
+	f.availabilityUseCase = usecase.NewAvailabilityUseCase(f.availability, f.players, f.teams, f.contracts, f.auditUseCase)
+	f.mediaUseCase = usecase.NewMediaUseCase(f.blobs, f.teams, f.players, f.auditUseCase, "https://api.example.com")
+	return f
+}
+
+func (f *fixture) createTeam(t *testing.T, name string) *entity.Team {
+	t.Helper()
+	team := &entity.Team{Name: name, FoundedYear: 1990, City: "Jakarta"}
+	if err := f.teamUseCase.Create(context.Background(), team); err != nil {
+		t.Fatalf("create team %q: %v", name, err)
+	}
+	return team
+}
+
+func (f *fixture) createPlayer(t *testing.T, teamID uuid.UUID, name string, jersey int) *entity.Player {
+	t.Helper()
+	player := newPlayer(teamID, name, jersey)
+	if err := f.playerUseCase.Create(context.Background(), player); err != nil {
+		t.Fatalf("create player %q: %v", name, err)
+	}
+	return player
+}
+
+// createMatch schedules a match a day after the previous one, so teams are
+// never booked twice on the same day
+func (f *fixture) createMatch(t *testing.T, home, away uuid.UUID) *entity.Match {
+	t.Helper()
+	match := &entity.Match{
+		HomeTeamID: home,
+		AwayTeamID: away,
+		KickoffAt:  time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC).AddDate(0, 0, f.matchDays),
+	}
+	f.matchDays++
+	if err := f.matchUseCase.Create(context.Background(), match); err != nil {
+		t.Fatalf("create match: %v", err)
+	}
+	return match
+}
+
+func (f *fixture) createVenue(t *testing.T, name string) *entity.Venue {
+	t.Helper()
+	venue := &entity.Venue{Name: name, City: "Jakarta", Capacity: 30000}
+	if err := f.venueUseCase.Create(context.Background(), venue); err != nil {
+		t.Fatalf("create venue %q: %v", name, err)
+	}
+	return venue
+}
+
+func (f *fixture) createOfficial(t *testing.T, name string) *entity.Official {
+	t.Helper()
+	official := &entity.Official{Name: name, LicenseLevel: entity.LicenseNational, City: "Jakarta"}
+	if err := f.officialUseCase.Create(context.Background(), official); err != nil {
+		t.Fatalf("create official %q: %v", name, err)
+	}
+	return official
+}
+
+// auditActions returns the actions recorded for an entity, oldest first
+func (f *fixture) auditActions(t *testing.T, entityID uuid.UUID) []entity.AuditAction {
+	t.Helper()
+	logs, _, err := f.audit.FindAll(context.Background(), repository.AuditLogFilter{EntityID: &entityID}, 1, 100)
+	if err != nil {
+		t.Fatalf("list audit logs: %v", err)
+	}
+	actions := make([]entity.AuditAction, len(logs))
+	for i, log := range logs {
+		actions[len(logs)-1-i] = log.Action
+	}
+	return actions
+}
+
+func newPlayer(teamID uuid.UUID, name string, jersey int) *entity.Player {
+	return &entity.Player{
+		TeamID:       teamID,
+		Name:         name,
+		Height:       180,
+		Weight:       75,
+		Position:     entity.PositionForward,
+		JerseyNumber: jersey,
+	}
+}
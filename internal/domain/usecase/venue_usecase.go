@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+	"gorm.io/gorm"
+)
+
+var ErrVenueNotFound = errors.New("venue not found")
+
+// VenueUseCase defines the interface for venue operations
+type VenueUseCase interface {
+	Create(ctx context.Context, venue *entity.Venue) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Venue, error)
+	Update(ctx context.Context, venue *entity.Venue) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// GetAll returns a page of the venues matching the query, see
+	// repository.VenueListSchema
+	GetAll(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Venue, int64, error)
+	// Scroll is GetAll with a cursor window instead of a page number
+	Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Venue], error)
+}
+
+type venueUseCaseImpl struct {
+	venueRepo    repository.VenueRepository
+	auditUseCase AuditUseCase
+}
+
+// NewVenueUseCase creates a new instance of VenueUseCase
+func NewVenueUseCase(venueRepo repository.VenueRepository, auditUseCase AuditUseCase) VenueUseCase {
+	return &venueUseCaseImpl{
+		venueRepo:    venueRepo,
+		auditUseCase: auditUseCase,
+	}
+}
+
+func (uc *venueUseCaseImpl) Create(ctx context.Context, venue *entity.Venue) error {
+	if err := uc.venueRepo.Create(ctx, venue); err != nil {
+		return err
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionCreate, entity.AuditEntityVenue, venue.ID, nil, venue)
+	return nil
+}
+
+func (uc *venueUseCaseImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Venue, error) {
+	venue, err := uc.venueRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrVenueNotFound
+		}
+		return nil, err
+	}
+	return venue, nil
+}
+
+func (uc *venueUseCaseImpl) Update(ctx context.Context, venue *entity.Venue) error {
+	before, err := uc.venueRepo.FindByID(ctx, venue.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrVenueNotFound
+		}
+		return err
+	}
+
+	if err := uc.venueRepo.Update(ctx, venue); err != nil {
+		return err
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionUpdate, entity.AuditEntityVenue, venue.ID, before, venue)
+	return nil
+}
+
+// Delete soft deletes a venue. Teams and matches keep referring to it, so
+// past fixtures still show where they were played.
+func (uc *venueUseCaseImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	before, err := uc.venueRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrVenueNotFound
+		}
+		return err
+	}
+
+	if err := uc.venueRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.auditUseCase.Record(ctx, entity.AuditActionDelete, entity.AuditEntityVenue, id, before, nil)
+	return nil
+}
+
+func (uc *venueUseCaseImpl) GetAll(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Venue, int64, error) {
+	return uc.venueRepo.List(ctx, query, page, limit)
+}
+
+func (uc *venueUseCaseImpl) Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Venue], error) {
+	return uc.venueRepo.Scroll(ctx, query, window)
+}
+
+// sameID reports whether two optional references point at the same record
+func sameID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type auditRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewAuditRepository creates a new instance of AuditRepository
+func NewAuditRepository(db *gorm.DB) repository.AuditRepository {
+	return &auditRepositoryImpl{db: db}
+}
+
+func (r *auditRepositoryImpl) Create(ctx context.Context, log *entity.AuditLog) error {
+	return r.db.WithContext(ctx).Create(log).Error
+}
+
+func (r *auditRepositoryImpl) FindAll(ctx context.Context, filter repository.AuditLogFilter, page, limit int) ([]entity.AuditLog, int64, error) {
+	var logs []entity.AuditLog
+	var total int64
+
+	offset := (page - 1) * limit
+
+	err := r.filtered(ctx, filter).Model(&entity.AuditLog{}).Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = r.filtered(ctx, filter).
+		Offset(offset).
+		Limit(limit).
+		Order("created_at DESC").
+		Find(&logs).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return logs, total, nil
+}
+
+// filtered applies the audit log filter criteria to a new query
+func (r *auditRepositoryImpl) filtered(ctx context.Context, filter repository.AuditLogFilter) *gorm.DB {
+	query := r.db.WithContext(ctx)
+
+	if filter.EntityType != "" {
+		query = query.Where("entity_type = ?", filter.EntityType)
+	}
+	if filter.EntityID != nil {
+		query = query.Where("entity_id = ?", *filter.EntityID)
+	}
+	if filter.ActorID != nil {
+		query = query.Where("actor_id = ?", *filter.ActorID)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at <= ?", *filter.To)
+	}
+
+	return query
+}
@@ -0,0 +1,65 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type cardRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewCardRepository creates a new instance of CardRepository
+func NewCardRepository(db *gorm.DB) repository.CardRepository {
+	return &cardRepositoryImpl{db: db}
+}
+
+func (r *cardRepositoryImpl) CreateBatch(ctx context.Context, cards []entity.Card) error {
+	if len(cards) == 0 {
+		return nil
+	}
+	return translateError(r.db.WithContext(ctx).Create(&cards).Error)
+}
+
+func (r *cardRepositoryImpl) FindByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.Card, error) {
+	var cards []entity.Card
+	err := r.db.WithContext(ctx).
+		Preload("Player", withDeleted).
+		Preload("Team", withDeleted).
+		Where("match_id = ?", matchID).
+		Order("minute ASC").
+		Find(&cards).Error
+	return cards, err
+}
+
+func (r *cardRepositoryImpl) FindCompletedBetween(ctx context.Context, from, to time.Time) ([]entity.Card, error) {
+	var cards []entity.Card
+	err := r.db.WithContext(ctx).
+		Joins("JOIN matches ON matches.id = cards.match_id AND matches.deleted_at IS NULL").
+		Preload("Match").
+		Preload("Player", withDeleted).
+		Preload("Team", withDeleted).
+		Where("matches.status = ?", entity.MatchStatusCompleted).
+		Where("matches.kickoff_at >= ? AND matches.kickoff_at < ?", from.UTC(), to.UTC()).
+		Order("matches.kickoff_at ASC, cards.match_id ASC, cards.minute ASC").
+		Find(&cards).Error
+	return cards, err
+}
+
+func (r *cardRepositoryImpl) DeleteByMatchID(ctx context.Context, matchID uuid.UUID) error {
+	return translateError(r.db.WithContext(ctx).
+		Where("match_id = ?", matchID).
+		Delete(&entity.Card{}).Error)
+}
+
+func (r *cardRepositoryImpl) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Scopes(deletedBefore(cutoff)).
+		Delete(&entity.Card{})
+	return result.RowsAffected, translateError(result.Error)
+}
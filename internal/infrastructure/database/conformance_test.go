@@ -0,0 +1,106 @@
+package database_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/config"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository/repositorytest"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/database"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// The conformance suite always runs against in-memory SQLite. Set
+// TEST_POSTGRES_DSN or TEST_MYSQL_DSN to also run it against a server; the
+// database behind the DSN is wiped and re-migrated for every test case.
+func TestRepositoryConformance(t *testing.T) {
+	t.Run("sqlite", func(t *testing.T) {
+		repositorytest.Run(t, func(t *testing.T) repositorytest.Repositories {
+			cfg := &config.Config{}
+			cfg.Database.Driver = "sqlite"
+			cfg.Database.Name = ":memory:"
+			cfg.Server.Mode = "release"
+
+			db, err := database.NewDatabase(cfg)
+			if err != nil {
+				t.Fatalf("failed to open sqlite: %v", err)
+			}
+			t.Cleanup(func() { closeDB(db) })
+			return newRepositories(db)
+		})
+	})
+
+	servers := []struct {
+		name string
+		env  string
+		open func(dsn string) gorm.Dialector
+	}{
+		{"postgres", "TEST_POSTGRES_DSN", postgres.Open},
+		{"mysql", "TEST_MYSQL_DSN", mysql.Open},
+	}
+	for _, server := range servers {
+		t.Run(server.name, func(t *testing.T) {
+			dsn := os.Getenv(server.env)
+			if dsn == "" {
+				t.Skipf("%s not set", server.env)
+			}
+
+			db, err := gorm.Open(server.open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+			if err != nil {
+				t.Fatalf("failed to connect: %v", err)
+			}
+			t.Cleanup(func() { closeDB(db) })
+
+			repositorytest.Run(t, func(t *testing.T) repositorytest.Repositories {
+				resetSchema(t, db)
+				return newRepositories(db)
+			})
+		})
+	}
+}
+
+func newRepositories(db *gorm.DB) repositorytest.Repositories {
+	return repositorytest.Repositories{
+		Users:   database.NewUserRepository(db),
+		Teams:   database.NewTeamRepository(db),
+		Players: database.NewPlayerRepository(db),
+		Matches: database.NewMatchRepository(db),
+		Goals:   database.NewGoalRepository(db),
+		Audit:   database.NewAuditRepository(db),
+		Venues:  database.NewVenueRepository(db),
+		Cards:   database.NewCardRepository(db),
+
+		Officials:      database.NewOfficialRepository(db),
+		MatchOfficials: database.NewMatchOfficialRepository(db),
+		Contracts:      database.NewPlayerContractRepository(db),
+		Windows:        database.NewTransferWindowRepository(db),
+		Rules:          database.NewDisciplinaryRuleRepository(db),
+		Availability:   database.NewPlayerAvailabilityRepository(db),
+	}
+}
+
+// resetSchema rolls back every migration and applies them again
+func resetSchema(t *testing.T, db *gorm.DB) {
+	t.Helper()
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	ctx := context.Background()
+	if _, err := migrator.To(ctx, 0); err != nil {
+		t.Fatalf("failed to roll back migrations: %v", err)
+	}
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("failed to apply migrations: %v", err)
+	}
+}
+
+func closeDB(db *gorm.DB) {
+	if sqlDB, err := db.DB(); err == nil {
+		sqlDB.Close()
+	}
+}
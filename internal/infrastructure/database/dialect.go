@@ -0,0 +1,75 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// dialect hides the SQL differences between the supported database drivers.
+// Repositories derive it from their connection instead of hard-coding
+// Postgres syntax.
+type dialect struct {
+	name string
+}
+
+// dialectOf returns the dialect of the driver behind db
+func dialectOf(db *gorm.DB) dialect {
+	return dialect{name: db.Dialector.Name()}
+}
+
+// containsFold returns a condition matching rows where column contains the
+// bound pattern, ignoring case. Bind the pattern built by containsPattern.
+func (d dialect) containsFold(column string) string {
+	switch d.name {
+	case "postgres":
+		return column + ` ILIKE ? ESCAPE '\'`
+	case "mysql":
+		// Backslashes are escape characters inside MySQL string literals
+		return "LOWER(" + column + `) LIKE ? ESCAPE '\\'`
+	default:
+		return "LOWER(" + column + `) LIKE ? ESCAPE '\'`
+	}
+}
+
+// uuidType returns the column type UUIDs are stored in. Values are always
+// written and read in their canonical 36-character text form.
+func (d dialect) uuidType() string {
+	switch d.name {
+	case "postgres":
+		return "uuid"
+	case "mysql":
+		return "char(36)"
+	default:
+		return "text"
+	}
+}
+
+// containsPattern builds the LIKE pattern for containsFold. Wildcards in the
+// query are escaped so that searching for "100%" does not match everything.
+func containsPattern(query string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(strings.ToLower(query))
+	return "%" + escaped + "%"
+}
+
+// useDialectColumnTypes rewrites the `type:uuid` column tags of the entities
+// to the driver's UUID column type before GORM AutoMigrate runs. Parsed
+// schemas are cached per connection, so AutoMigrate picks up the change.
+func useDialectColumnTypes(db *gorm.DB, models ...interface{}) error {
+	uuidType := dialectOf(db).uuidType()
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return fmt.Errorf("failed to parse %T: %w", model, err)
+		}
+		for _, field := range stmt.Schema.Fields {
+			if strings.EqualFold(string(field.DataType), "uuid") {
+				field.DataType = schema.DataType(uuidType)
+				field.TagSettings["TYPE"] = uuidType
+			}
+		}
+	}
+	return nil
+}
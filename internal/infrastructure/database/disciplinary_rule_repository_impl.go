@@ -0,0 +1,52 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type disciplinaryRuleRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewDisciplinaryRuleRepository creates a new instance of DisciplinaryRuleRepository
+func NewDisciplinaryRuleRepository(db *gorm.DB) repository.DisciplinaryRuleRepository {
+	return &disciplinaryRuleRepositoryImpl{db: db}
+}
+
+func (r *disciplinaryRuleRepositoryImpl) Create(ctx context.Context, rule *entity.DisciplinaryRule) error {
+	return translateError(r.db.WithContext(ctx).Create(rule).Error)
+}
+
+func (r *disciplinaryRuleRepositoryImpl) Update(ctx context.Context, rule *entity.DisciplinaryRule) error {
+	return translateError(r.db.WithContext(ctx).Save(rule).Error)
+}
+
+func (r *disciplinaryRuleRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return translateError(r.db.WithContext(ctx).Delete(&entity.DisciplinaryRule{}, "id = ?", id).Error)
+}
+
+func (r *disciplinaryRuleRepositoryImpl) FindBySeason(ctx context.Context, season string) (*entity.DisciplinaryRule, error) {
+	var rule entity.DisciplinaryRule
+	err := r.db.WithContext(ctx).First(&rule, "season = ?", season).Error
+	if err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (r *disciplinaryRuleRepositoryImpl) FindBySeasons(ctx context.Context, seasons []string) ([]entity.DisciplinaryRule, error) {
+	rules := []entity.DisciplinaryRule{}
+	if len(seasons) == 0 {
+		return rules, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("season IN ?", seasons).
+		Order("season ASC").
+		Find(&rules).Error
+	return rules, err
+}
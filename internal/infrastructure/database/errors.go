@@ -0,0 +1,172 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/glebarez/go-sqlite"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+)
+
+// Constraint names declared by the migrations
+const (
+	constraintPlayersTeamJersey    = "uq_players_team_jersey_active"
+	constraintUsersEmail           = "idx_users_email"
+	constraintMatchesDistinctTeams = "chk_matches_distinct_teams"
+	constraintMatchesHomeScore     = "chk_matches_home_score"
+	constraintMatchesAwayScore     = "chk_matches_away_score"
+	constraintMatchOfficialsRole   = "uq_match_officials_role"
+	constraintMatchOfficialsPerson = "uq_match_officials_official"
+	constraintContractsCurrent     = "uq_player_contracts_current"
+	constraintDisciplinarySeason   = "uq_disciplinary_rules_season"
+)
+
+// sqliteUniqueColumns maps the column list SQLite reports for a unique
+// violation to the name of the index, which SQLite does not report
+var sqliteUniqueColumns = map[string]string{
+	"players.team_id, players.jersey_number":                constraintPlayersTeamJersey,
+	"users.email":                                           constraintUsersEmail,
+	"match_officials.match_id, match_officials.role":        constraintMatchOfficialsRole,
+	"match_officials.match_id, match_officials.official_id": constraintMatchOfficialsPerson,
+	"player_contracts.player_id":                            constraintContractsCurrent,
+	"disciplinary_rules.season":                             constraintDisciplinarySeason,
+}
+
+// violationKind classifies a constraint violation independently of the driver
+type violationKind int
+
+const (
+	violationNone violationKind = iota
+	violationUnique
+	violationCheck
+	violationMissingReference
+	violationStillReferenced
+)
+
+// translateError maps constraint violations reported by the database driver
+// to repository errors. Any other error is returned unchanged.
+func translateError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	kind, constraint := classifyViolation(err)
+	switch kind {
+	case violationUnique:
+		switch constraint {
+		case constraintPlayersTeamJersey:
+			return repository.ErrDuplicateJerseyNumber
+		case constraintUsersEmail:
+			return repository.ErrDuplicateEmail
+		case constraintMatchOfficialsRole, constraintMatchOfficialsPerson:
+			return repository.ErrDuplicateAppointment
+		case constraintContractsCurrent:
+			return repository.ErrDuplicateContract
+		}
+		return fmt.Errorf("%w: %s", repository.ErrDuplicateKey, constraint)
+	case violationCheck:
+		switch constraint {
+		case constraintMatchesDistinctTeams:
+			return repository.ErrSameTeams
+		case constraintMatchesHomeScore, constraintMatchesAwayScore:
+			return repository.ErrNegativeScore
+		}
+		return fmt.Errorf("%w: %s", repository.ErrCheckViolation, constraint)
+	case violationMissingReference:
+		return fmt.Errorf("%w: %s", repository.ErrReferenceNotFound, constraint)
+	case violationStillReferenced:
+		return fmt.Errorf("%w: %s", repository.ErrStillReferenced, constraint)
+	}
+	return err
+}
+
+// classifyViolation extracts the violation kind and constraint name from a driver error
+func classifyViolation(err error) (violationKind, string) {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505":
+			return violationUnique, pgErr.ConstraintName
+		case "23514":
+			return violationCheck, pgErr.ConstraintName
+		case "23503":
+			// The same foreign key is reported when inserting an orphan and
+			// when deleting a parent that still has children.
+			if strings.HasPrefix(pgErr.Message, "update or delete") {
+				return violationStillReferenced, pgErr.ConstraintName
+			}
+			return violationMissingReference, pgErr.ConstraintName
+		}
+		return violationNone, ""
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case 1062: // Duplicate entry '...' for key 'table.constraint'
+			return violationUnique, quotedName(mysqlErr.Message, "for key ")
+		case 3819: // Check constraint 'constraint' is violated.
+			return violationCheck, quotedName(mysqlErr.Message, "constraint ")
+		case 1451: // Cannot delete or update a parent row: ... CONSTRAINT `name` ...
+			return violationStillReferenced, quotedName(mysqlErr.Message, "CONSTRAINT ")
+		case 1452: // Cannot add or update a child row: ... CONSTRAINT `name` ...
+			return violationMissingReference, quotedName(mysqlErr.Message, "CONSTRAINT ")
+		}
+	}
+
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		switch sqliteErr.Code() {
+		case 2067, 1555: // UNIQUE constraint failed: table.column, ...
+			columns := messageSuffix(sqliteErr.Error(), "UNIQUE constraint failed: ")
+			if constraint, ok := sqliteUniqueColumns[columns]; ok {
+				return violationUnique, constraint
+			}
+			return violationUnique, columns
+		case 275: // CHECK constraint failed: constraint
+			return violationCheck, messageSuffix(sqliteErr.Error(), "CHECK constraint failed: ")
+		case 787: // FOREIGN KEY constraint failed
+			// SQLite reports neither the constraint nor the direction.
+			// Deletes check for dependents before deleting, so a failure
+			// here almost always means an insert or update referenced a
+			// missing row.
+			return violationMissingReference, ""
+		}
+	}
+
+	return violationNone, ""
+}
+
+// quotedName returns the quoted identifier following marker in a driver
+// message, without any table qualifier.
+func quotedName(message, marker string) string {
+	idx := strings.Index(message, marker)
+	if idx < 0 {
+		return ""
+	}
+	rest := strings.TrimLeft(message[idx+len(marker):], "'`\"")
+	if end := strings.IndexAny(rest, "'`\""); end >= 0 {
+		rest = rest[:end]
+	}
+	if dot := strings.LastIndex(rest, "."); dot >= 0 {
+		rest = rest[dot+1:]
+	}
+	return rest
+}
+
+// messageSuffix returns the text following marker in a driver message, up to
+// the " (code)" suffix some drivers append
+func messageSuffix(message, marker string) string {
+	idx := strings.Index(message, marker)
+	if idx < 0 {
+		return ""
+	}
+	rest := message[idx+len(marker):]
+	if end := strings.LastIndex(rest, " ("); end >= 0 {
+		rest = rest[:end]
+	}
+	return strings.TrimSpace(rest)
+}
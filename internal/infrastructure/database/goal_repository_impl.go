@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
@@ -19,21 +20,21 @@ func NewGoalRepository(db *gorm.DB) repository.GoalRepository {
 }
 
 func (r *goalRepositoryImpl) Create(ctx context.Context, goal *entity.Goal) error {
-	return r.db.WithContext(ctx).Create(goal).Error
+	return translateError(r.db.WithContext(ctx).Create(goal).Error)
 }
 
 func (r *goalRepositoryImpl) CreateBatch(ctx context.Context, goals []entity.Goal) error {
 	if len(goals) == 0 {
 		return nil
 	}
-	return r.db.WithContext(ctx).Create(&goals).Error
+	return translateError(r.db.WithContext(ctx).Create(&goals).Error)
 }
 
 func (r *goalRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Goal, error) {
 	var goal entity.Goal
 	err := r.db.WithContext(ctx).
-		Preload("Player").
-		Preload("Team").
+		Preload("Player", withDeleted).
+		Preload("Team", withDeleted).
 		First(&goal, "id = ?", id).Error
 	if err != nil {
 		return nil, err
@@ -42,29 +43,41 @@ func (r *goalRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entit
 }
 
 func (r *goalRepositoryImpl) Update(ctx context.Context, goal *entity.Goal) error {
-	return r.db.WithContext(ctx).Save(goal).Error
+	return translateError(r.db.WithContext(ctx).Save(goal).Error)
 }
 
 func (r *goalRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&entity.Goal{}, "id = ?", id).Error
+	return translateError(r.db.WithContext(ctx).Delete(&entity.Goal{}, "id = ?", id).Error)
 }
 
 func (r *goalRepositoryImpl) FindByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.Goal, error) {
 	var goals []entity.Goal
 	err := r.db.WithContext(ctx).
-		Preload("Player").
-		Preload("Team").
+		Preload("Player", withDeleted).
+		Preload("Team", withDeleted).
 		Where("match_id = ?", matchID).
 		Order("minute ASC").
 		Find(&goals).Error
 	return goals, err
 }
 
+func (r *goalRepositoryImpl) FindInBatches(ctx context.Context, batchSize int, fn func(goals []entity.Goal) error) error {
+	var batch []entity.Goal
+	return r.db.WithContext(ctx).
+		Preload("Match").
+		Preload("Player", withDeleted).
+		Preload("Team", withDeleted).
+		Where("EXISTS (SELECT 1 FROM matches WHERE matches.id = goals.match_id AND matches.deleted_at IS NULL)").
+		FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {
+			return fn(batch)
+		}).Error
+}
+
 func (r *goalRepositoryImpl) FindByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.Goal, error) {
 	var goals []entity.Goal
 	err := r.db.WithContext(ctx).
 		Preload("Match").
-		Preload("Team").
+		Preload("Team", withDeleted).
 		Where("player_id = ?", playerID).
 		Order("created_at DESC").
 		Find(&goals).Error
@@ -72,21 +85,31 @@ func (r *goalRepositoryImpl) FindByPlayerID(ctx context.Context, playerID uuid.U
 }
 
 func (r *goalRepositoryImpl) DeleteByMatchID(ctx context.Context, matchID uuid.UUID) error {
-	return r.db.WithContext(ctx).
+	return translateError(r.db.WithContext(ctx).
 		Where("match_id = ?", matchID).
-		Delete(&entity.Goal{}).Error
+		Delete(&entity.Goal{}).Error)
+}
+
+func (r *goalRepositoryImpl) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Scopes(deletedBefore(cutoff)).
+		Delete(&entity.Goal{})
+	return result.RowsAffected, translateError(result.Error)
 }
 
 func (r *goalRepositoryImpl) GetTopScorers(ctx context.Context, limit int) ([]repository.TopScorerResult, error) {
 	var results []repository.TopScorerResult
 
+	// Goals count for the team the player scored them for, not their current
+	// team. Soft-deleted players and teams are still joined so that deleting
+	// a team does not rewrite past results.
 	err := r.db.WithContext(ctx).
 		Table("goals").
-		Select("goals.player_id, players.name as player_name, players.team_id, teams.name as team_name, COUNT(goals.id) as goal_count").
-		Joins("JOIN players ON players.id = goals.player_id AND players.deleted_at IS NULL").
-		Joins("JOIN teams ON teams.id = players.team_id AND teams.deleted_at IS NULL").
-		Where("goals.deleted_at IS NULL AND goals.is_own_goal = false").
-		Group("goals.player_id, players.name, players.team_id, teams.name").
+		Select("goals.player_id, players.name as player_name, goals.team_id, teams.name as team_name, COUNT(goals.id) as goal_count").
+		Joins("JOIN players ON players.id = goals.player_id").
+		Joins("JOIN teams ON teams.id = goals.team_id").
+		Where("goals.deleted_at IS NULL AND goals.is_own_goal = ?", false).
+		Group("goals.player_id, players.name, goals.team_id, teams.name").
 		Order("goal_count DESC").
 		Limit(limit).
 		Scan(&results).Error
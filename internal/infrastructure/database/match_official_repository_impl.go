@@ -0,0 +1,117 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type matchOfficialRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewMatchOfficialRepository creates a new instance of MatchOfficialRepository
+func NewMatchOfficialRepository(db *gorm.DB) repository.MatchOfficialRepository {
+	return &matchOfficialRepositoryImpl{db: db}
+}
+
+func (r *matchOfficialRepositoryImpl) FindByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.MatchOfficial, error) {
+	var officials []entity.MatchOfficial
+	err := r.db.WithContext(ctx).
+		Preload("Official", withDeleted).
+		Where("match_id = ?", matchID).
+		Order("created_at ASC").
+		Find(&officials).Error
+	return officials, err
+}
+
+func (r *matchOfficialRepositoryImpl) ReplaceForMatch(ctx context.Context, matchID uuid.UUID, officials []entity.MatchOfficial) error {
+	return translateError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&entity.MatchOfficial{}, "match_id = ?", matchID).Error; err != nil {
+			return err
+		}
+		if len(officials) == 0 {
+			return nil
+		}
+		for i := range officials {
+			officials[i].MatchID = matchID
+		}
+		return tx.Omit("Match", "Official").Create(&officials).Error
+	}))
+}
+
+func (r *matchOfficialRepositoryImpl) FindScheduledForOfficials(ctx context.Context, officialIDs []uuid.UUID, from, to time.Time) ([]entity.MatchOfficial, error) {
+	if len(officialIDs) == 0 {
+		return []entity.MatchOfficial{}, nil
+	}
+
+	var appointments []entity.MatchOfficial
+	err := r.db.WithContext(ctx).
+		Preload("Match").
+		Preload("Official", withDeleted).
+		Joins("JOIN matches ON matches.id = match_officials.match_id").
+		Where("matches.deleted_at IS NULL AND matches.status <> ?", entity.MatchStatusCancelled).
+		Where("matches.kickoff_at > ? AND matches.kickoff_at < ?", from.UTC(), to.UTC()).
+		Where("match_officials.official_id IN ?", officialIDs).
+		Order("matches.kickoff_at ASC").
+		Find(&appointments).Error
+	if err != nil {
+		return nil, err
+	}
+	return appointments, nil
+}
+
+func (r *matchOfficialRepositoryImpl) GetOfficialStats(ctx context.Context, officialID uuid.UUID) (*repository.OfficialStats, error) {
+	stats := &repository.OfficialStats{MatchesBy: map[entity.OfficialRole]int64{}}
+
+	var roles []struct {
+		Role  entity.OfficialRole
+		Count int64
+	}
+	err := r.db.WithContext(ctx).
+		Table("match_officials").
+		Select("match_officials.role, COUNT(*) as count").
+		Joins("JOIN matches ON matches.id = match_officials.match_id").
+		Where("matches.deleted_at IS NULL AND match_officials.official_id = ?", officialID).
+		Group("match_officials.role").
+		Scan(&roles).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles {
+		stats.MatchesBy[role.Role] = role.Count
+		stats.Matches += role.Count
+	}
+
+	// Cards count towards the referee of the match only
+	var cards []struct {
+		Type  entity.CardType
+		Count int64
+	}
+	err = r.db.WithContext(ctx).
+		Table("cards").
+		Select("cards.type, COUNT(*) as count").
+		Joins("JOIN matches ON matches.id = cards.match_id").
+		Joins("JOIN match_officials ON match_officials.match_id = cards.match_id").
+		Where("cards.deleted_at IS NULL AND matches.deleted_at IS NULL").
+		Where("match_officials.official_id = ? AND match_officials.role = ?", officialID, entity.OfficialRoleReferee).
+		Group("cards.type").
+		Scan(&cards).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, card := range cards {
+		switch card.Type {
+		case entity.CardYellow:
+			stats.YellowCards = card.Count
+		case entity.CardRed:
+			stats.RedCards = card.Count
+		}
+	}
+
+	return stats, nil
+}
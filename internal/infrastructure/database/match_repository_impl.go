@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
 	"gorm.io/gorm"
 )
 
@@ -20,7 +21,7 @@ func NewMatchRepository(db *gorm.DB) repository.MatchRepository {
 }
 
 func (r *matchRepositoryImpl) Create(ctx context.Context, match *entity.Match) error {
-	return r.db.WithContext(ctx).Create(match).Error
+	return translateError(r.db.WithContext(ctx).Create(match).Error)
 }
 
 func (r *matchRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Match, error) {
@@ -33,13 +34,13 @@ func (r *matchRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*enti
 }
 
 func (r *matchRepositoryImpl) FindByIDWithDetails(ctx context.Context, id uuid.UUID) (*entity.Match, error) {
+	return r.FindByIDWith(ctx, id, listquery.NewInclude(repository.MatchListSchema.Relations...))
+}
+
+func (r *matchRepositoryImpl) FindByIDWith(ctx context.Context, id uuid.UUID, include listquery.Include) (*entity.Match, error) {
 	var match entity.Match
 	err := r.db.WithContext(ctx).
-		Preload("HomeTeam").
-		Preload("AwayTeam").
-		Preload("Goals").
-		Preload("Goals.Player").
-		Preload("Goals.Team").
+		Scopes(matchRelations.scope(include)).
 		First(&match, "id = ?", id).Error
 	if err != nil {
 		return nil, err
@@ -48,123 +49,130 @@ func (r *matchRepositoryImpl) FindByIDWithDetails(ctx context.Context, id uuid.U
 }
 
 func (r *matchRepositoryImpl) Update(ctx context.Context, match *entity.Match) error {
-	return r.db.WithContext(ctx).Save(match).Error
+	return translateError(r.db.WithContext(ctx).Save(match).Error)
 }
 
 func (r *matchRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&entity.Match{}, "id = ?", id).Error
+	return translateError(r.db.WithContext(ctx).Delete(&entity.Match{}, "id = ?", id).Error)
 }
 
-func (r *matchRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]entity.Match, int64, error) {
-	var matches []entity.Match
-	var total int64
-
-	offset := (page - 1) * limit
-
-	err := r.db.WithContext(ctx).Model(&entity.Match{}).Count(&total).Error
-	if err != nil {
-		return nil, 0, err
-	}
-
-	err = r.db.WithContext(ctx).
-		Preload("HomeTeam").
-		Preload("AwayTeam").
-		Offset(offset).
-		Limit(limit).
-		Order("match_date DESC, match_time DESC").
-		Find(&matches).Error
-	if err != nil {
-		return nil, 0, err
-	}
+// matchList maps MatchListSchema to the columns of the matches table
+var matchList = listSpec{
+	table:  "matches",
+	schema: repository.MatchListSchema,
+	columns: map[string]column{
+		"status":         {expr: "matches.status"},
+		"team_id":        {where: anyOf("matches.home_team_id", "matches.away_team_id")},
+		"home_team_id":   {expr: "matches.home_team_id"},
+		"away_team_id":   {expr: "matches.away_team_id"},
+		"venue_id":       {expr: "matches.venue_id"},
+		"kickoff_at":     {expr: "matches.kickoff_at"},
+		"match_date":     {expr: "matches.kickoff_at"},
+		"created_at":     {expr: "matches.created_at"},
+		"home_team.name": {expr: "home_team.name", join: "LEFT JOIN teams home_team ON home_team.id = matches.home_team_id"},
+		"away_team.name": {expr: "away_team.name", join: "LEFT JOIN teams away_team ON away_team.id = matches.away_team_id"},
+	},
+}
 
-	return matches, total, nil
+// matchRelations preloads the relations of MatchListSchema. Teams, players
+// and venues are preloaded even when soft-deleted, like the records they
+// appear in.
+var matchRelations = relations{
+	"home_team":    preload("HomeTeam", withDeleted),
+	"away_team":    preload("AwayTeam", withDeleted),
+	"venue":        preload("Venue", withDeleted),
+	"goals":        preload("Goals", byMinute),
+	"goals.player": preload("Goals.Player", withDeleted),
+	"goals.team":   preload("Goals.Team", withDeleted),
+	"cards":        preload("Cards", byMinute),
+	"cards.player": preload("Cards.Player", withDeleted),
+	"cards.team":   preload("Cards.Team", withDeleted),
 }
 
-func (r *matchRepositoryImpl) FindByDateRange(ctx context.Context, startDate, endDate time.Time, page, limit int) ([]entity.Match, int64, error) {
-	var matches []entity.Match
-	var total int64
+// withTeamsAndVenue includes what internal listings of matches show
+func withTeamsAndVenue(query listquery.Query) listquery.Query {
+	return query.Including("home_team", "away_team", "venue")
+}
 
-	offset := (page - 1) * limit
+func (r *matchRepositoryImpl) List(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Match, int64, error) {
+	return list[entity.Match](ctx, r.db, matchList, query, page, limit, matchRelations.scope(query.Include))
+}
 
-	err := r.db.WithContext(ctx).
-		Model(&entity.Match{}).
-		Where("match_date BETWEEN ? AND ?", startDate, endDate).
-		Count(&total).Error
+func (r *matchRepositoryImpl) Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Match], error) {
+	include := query.Include.With(query.SortRelations(matchList.schema)...)
+	slice, err := scroll(ctx, r.db, matchList, repository.MatchListReader, query, window, matchRelations.scope(include))
 	if err != nil {
-		return nil, 0, err
+		return slice, err
 	}
-
-	err = r.db.WithContext(ctx).
-		Preload("HomeTeam").
-		Preload("AwayTeam").
-		Where("match_date BETWEEN ? AND ?", startDate, endDate).
-		Offset(offset).
-		Limit(limit).
-		Order("match_date ASC, match_time ASC").
-		Find(&matches).Error
-	if err != nil {
-		return nil, 0, err
+	// The teams a cursor was read from are only returned when included
+	for i := range slice.Items {
+		if !query.Include.Has("home_team") {
+			slice.Items[i].HomeTeam = nil
+		}
+		if !query.Include.Has("away_team") {
+			slice.Items[i].AwayTeam = nil
+		}
 	}
+	return slice, nil
+}
 
-	return matches, total, nil
+func (r *matchRepositoryImpl) FindByDateRange(ctx context.Context, from, to time.Time, page, limit int) ([]entity.Match, int64, error) {
+	query := listquery.Query{}.
+		Where("kickoff_at", listquery.Gte, from.UTC()).
+		Where("kickoff_at", listquery.Lt, to.UTC()).
+		OrderBy(listquery.Asc("kickoff_at"))
+	return r.List(ctx, withTeamsAndVenue(query), page, limit)
 }
 
 func (r *matchRepositoryImpl) FindByTeamID(ctx context.Context, teamID uuid.UUID, page, limit int) ([]entity.Match, int64, error) {
-	var matches []entity.Match
-	var total int64
-
-	offset := (page - 1) * limit
+	return r.List(ctx, withTeamsAndVenue(listquery.Query{}.Where("team_id", listquery.Eq, teamID)), page, limit)
+}
 
-	err := r.db.WithContext(ctx).
-		Model(&entity.Match{}).
-		Where("home_team_id = ? OR away_team_id = ?", teamID, teamID).
-		Count(&total).Error
-	if err != nil {
-		return nil, 0, err
-	}
+func (r *matchRepositoryImpl) FindScheduledAtVenue(ctx context.Context, venueID uuid.UUID, from, to time.Time) ([]entity.Match, error) {
+	return r.findScheduled(ctx, from, to, "venue_id = ?", venueID)
+}
 
-	err = r.db.WithContext(ctx).
-		Preload("HomeTeam").
-		Preload("AwayTeam").
-		Where("home_team_id = ? OR away_team_id = ?", teamID, teamID).
-		Offset(offset).
-		Limit(limit).
-		Order("match_date DESC, match_time DESC").
-		Find(&matches).Error
-	if err != nil {
-		return nil, 0, err
+func (r *matchRepositoryImpl) FindScheduledForTeams(ctx context.Context, teamIDs []uuid.UUID, from, to time.Time) ([]entity.Match, error) {
+	if len(teamIDs) == 0 {
+		return []entity.Match{}, nil
 	}
-
-	return matches, total, nil
+	return r.findScheduled(ctx, from, to, "(home_team_id IN ? OR away_team_id IN ?)", teamIDs, teamIDs)
 }
 
-func (r *matchRepositoryImpl) FindByStatus(ctx context.Context, status entity.MatchStatus, page, limit int) ([]entity.Match, int64, error) {
-	var matches []entity.Match
-	var total int64
-
-	offset := (page - 1) * limit
+func (r *matchRepositoryImpl) FindScheduled(ctx context.Context, from, to time.Time) ([]entity.Match, error) {
+	return r.findScheduled(ctx, from, to, "")
+}
 
-	err := r.db.WithContext(ctx).
-		Model(&entity.Match{}).
-		Where("status = ?", status).
-		Count(&total).Error
-	if err != nil {
-		return nil, 0, err
+// findScheduled returns the matches that are not cancelled, kick off in
+// [from, to) and meet the condition, if any, earliest first
+func (r *matchRepositoryImpl) findScheduled(ctx context.Context, from, to time.Time, condition string, args ...interface{}) ([]entity.Match, error) {
+	query := r.db.WithContext(ctx).
+		Preload("HomeTeam", withDeleted).
+		Preload("AwayTeam", withDeleted).
+		Preload("Venue", withDeleted).
+		Where("status <> ?", entity.MatchStatusCancelled).
+		Where("kickoff_at >= ? AND kickoff_at < ?", from.UTC(), to.UTC())
+	if condition != "" {
+		query = query.Where(condition, args...)
 	}
 
-	err = r.db.WithContext(ctx).
-		Preload("HomeTeam").
-		Preload("AwayTeam").
-		Where("status = ?", status).
-		Offset(offset).
-		Limit(limit).
-		Order("match_date ASC, match_time ASC").
-		Find(&matches).Error
+	var matches []entity.Match
+	err := query.Order("kickoff_at ASC").Find(&matches).Error
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
+	return matches, nil
+}
 
-	return matches, total, nil
+func (r *matchRepositoryImpl) FindInBatches(ctx context.Context, batchSize int, fn func(matches []entity.Match) error) error {
+	var batch []entity.Match
+	return r.db.WithContext(ctx).
+		Preload("HomeTeam", withDeleted).
+		Preload("AwayTeam", withDeleted).
+		Preload("Venue", withDeleted).
+		FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {
+			return fn(batch)
+		}).Error
 }
 
 func (r *matchRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
@@ -195,28 +203,32 @@ func (r *matchRepositoryImpl) GetTeamWinCount(ctx context.Context, teamID uuid.U
 }
 
 func (r *matchRepositoryImpl) GetCompletedMatches(ctx context.Context, page, limit int) ([]entity.Match, int64, error) {
+	query := listquery.Query{}.
+		Where("status", listquery.Eq, string(entity.MatchStatusCompleted)).
+		OrderBy(listquery.Desc("kickoff_at")).
+		Including("goals.player")
+	return r.List(ctx, withTeamsAndVenue(query), page, limit)
+}
+
+func (r *matchRepositoryImpl) FindDeleted(ctx context.Context, page, limit int) ([]entity.Match, int64, error) {
 	var matches []entity.Match
 	var total int64
 
 	offset := (page - 1) * limit
 
-	err := r.db.WithContext(ctx).
-		Model(&entity.Match{}).
-		Where("status = ?", entity.MatchStatusCompleted).
-		Count(&total).Error
+	err := r.db.WithContext(ctx).Model(&entity.Match{}).Scopes(onlyDeleted).Count(&total).Error
 	if err != nil {
 		return nil, 0, err
 	}
 
 	err = r.db.WithContext(ctx).
-		Preload("HomeTeam").
-		Preload("AwayTeam").
-		Preload("Goals").
-		Preload("Goals.Player").
-		Where("status = ?", entity.MatchStatusCompleted).
+		Scopes(onlyDeleted).
+		Preload("HomeTeam", withDeleted).
+		Preload("AwayTeam", withDeleted).
+		Preload("Venue", withDeleted).
 		Offset(offset).
 		Limit(limit).
-		Order("match_date DESC, match_time DESC").
+		Order("deleted_at DESC").
 		Find(&matches).Error
 	if err != nil {
 		return nil, 0, err
@@ -224,3 +236,70 @@ func (r *matchRepositoryImpl) GetCompletedMatches(ctx context.Context, page, lim
 
 	return matches, total, nil
 }
+
+func (r *matchRepositoryImpl) Restore(ctx context.Context, id uuid.UUID) error {
+	return translateError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var match entity.Match
+		if err := tx.Scopes(onlyDeleted).First(&match, "id = ?", id).Error; err != nil {
+			return err
+		}
+
+		var teams int64
+		err := tx.Model(&entity.Team{}).
+			Where("id IN ?", []uuid.UUID{match.HomeTeamID, match.AwayTeamID}).
+			Count(&teams).Error
+		if err != nil {
+			return err
+		}
+		if teams < 2 {
+			return repository.ErrReferenceNotFound
+		}
+
+		return tx.Unscoped().Model(&entity.Match{}).
+			Where("id = ?", id).
+			Update("deleted_at", nil).Error
+	}))
+}
+
+func (r *matchRepositoryImpl) Purge(ctx context.Context, id uuid.UUID) error {
+	return translateError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var match entity.Match
+		if err := tx.Scopes(onlyDeleted).First(&match, "id = ?", id).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Delete(&entity.Goal{}, "match_id = ?", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Delete(&entity.Card{}, "match_id = ?", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&entity.MatchOfficial{}, "match_id = ?", id).Error; err != nil {
+			return err
+		}
+
+		return tx.Unscoped().Delete(&entity.Match{}, "id = ?", id).Error
+	}))
+}
+
+func (r *matchRepositoryImpl) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	var purged int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		expired := tx.Model(&entity.Match{}).Scopes(deletedBefore(cutoff)).Select("id")
+
+		// Goals, cards and appointments go with their match
+		for _, dependent := range []interface{}{&entity.Goal{}, &entity.Card{}, &entity.MatchOfficial{}} {
+			err := tx.Unscoped().
+				Where("match_id IN (?)", expired).
+				Delete(dependent).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		result := tx.Scopes(deletedBefore(cutoff)).Delete(&entity.Match{})
+		purged = result.RowsAffected
+		return result.Error
+	})
+	return purged, translateError(err)
+}
@@ -0,0 +1,11 @@
+// Package migrations holds the versioned SQL schema migrations, one
+// directory per database driver. Files are named
+// <version>_<name>.up.sql / <version>_<name>.down.sql.
+package migrations
+
+import "embed"
+
+// FS contains the migration files for every supported driver
+//
+//go:embed postgres/*.sql mysql/*.sql sqlite/*.sql
+var FS embed.FS
@@ -0,0 +1,81 @@
+-- Baseline schema. UUIDs are stored as char(36) in their canonical text form.
+
+CREATE TABLE IF NOT EXISTS users (
+    id         char(36) NOT NULL,
+    created_at datetime(3) NULL,
+    updated_at datetime(3) NULL,
+    deleted_at datetime(3) NULL,
+    email      varchar(255) NOT NULL,
+    password   varchar(255) NOT NULL,
+    name       varchar(255) NOT NULL,
+    role       varchar(20) DEFAULT 'user',
+    PRIMARY KEY (id),
+    UNIQUE INDEX idx_users_email (email),
+    INDEX idx_users_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS teams (
+    id           char(36) NOT NULL,
+    created_at   datetime(3) NULL,
+    updated_at   datetime(3) NULL,
+    deleted_at   datetime(3) NULL,
+    name         varchar(255) NOT NULL,
+    logo         varchar(500),
+    founded_year bigint NOT NULL,
+    address      varchar(500),
+    city         varchar(100) NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_teams_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS players (
+    id            char(36) NOT NULL,
+    created_at    datetime(3) NULL,
+    updated_at    datetime(3) NULL,
+    deleted_at    datetime(3) NULL,
+    team_id       char(36) NOT NULL,
+    name          varchar(255) NOT NULL,
+    height        double NOT NULL,
+    weight        double NOT NULL,
+    position      varchar(20) NOT NULL,
+    jersey_number bigint NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_players_deleted_at (deleted_at),
+    INDEX idx_players_team_id (team_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS matches (
+    id           char(36) NOT NULL,
+    created_at   datetime(3) NULL,
+    updated_at   datetime(3) NULL,
+    deleted_at   datetime(3) NULL,
+    match_date   datetime(3) NOT NULL,
+    match_time   varchar(10) NOT NULL,
+    home_team_id char(36) NOT NULL,
+    away_team_id char(36) NOT NULL,
+    home_score   bigint DEFAULT NULL,
+    away_score   bigint DEFAULT NULL,
+    status       varchar(20) DEFAULT 'scheduled',
+    PRIMARY KEY (id),
+    INDEX idx_matches_deleted_at (deleted_at),
+    INDEX idx_matches_match_date (match_date),
+    INDEX idx_matches_home_team_id (home_team_id),
+    INDEX idx_matches_away_team_id (away_team_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS goals (
+    id          char(36) NOT NULL,
+    created_at  datetime(3) NULL,
+    updated_at  datetime(3) NULL,
+    deleted_at  datetime(3) NULL,
+    match_id    char(36) NOT NULL,
+    player_id   char(36) NOT NULL,
+    team_id     char(36) NOT NULL,
+    minute      bigint NOT NULL,
+    is_own_goal boolean DEFAULT false,
+    PRIMARY KEY (id),
+    INDEX idx_goals_deleted_at (deleted_at),
+    INDEX idx_goals_match_id (match_id),
+    INDEX idx_goals_player_id (player_id),
+    INDEX idx_goals_team_id (team_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
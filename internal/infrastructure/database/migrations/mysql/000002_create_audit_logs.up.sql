@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id          char(36) NOT NULL,
+    actor_id    char(36) NULL,
+    action      varchar(30) NOT NULL,
+    entity_type varchar(50) NOT NULL,
+    entity_id   char(36) NOT NULL,
+    `before`    text,
+    `after`     text,
+    changes     text,
+    ip_address  varchar(45),
+    request_id  varchar(64),
+    created_at  datetime(3) NULL,
+    PRIMARY KEY (id),
+    INDEX idx_audit_logs_actor_id (actor_id),
+    INDEX idx_audit_logs_action (action),
+    INDEX idx_audit_logs_entity (entity_type, entity_id),
+    INDEX idx_audit_logs_request_id (request_id),
+    INDEX idx_audit_logs_created_at (created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,15 @@
+ALTER TABLE goals DROP FOREIGN KEY fk_goals_team;
+ALTER TABLE goals DROP FOREIGN KEY fk_goals_player;
+ALTER TABLE goals DROP FOREIGN KEY fk_goals_match;
+ALTER TABLE matches DROP FOREIGN KEY fk_matches_away_team;
+ALTER TABLE matches DROP FOREIGN KEY fk_matches_home_team;
+ALTER TABLE players DROP FOREIGN KEY fk_players_team;
+
+ALTER TABLE goals DROP CHECK chk_goals_minute;
+ALTER TABLE matches DROP CHECK chk_matches_status;
+ALTER TABLE matches DROP CHECK chk_matches_away_score;
+ALTER TABLE matches DROP CHECK chk_matches_home_score;
+ALTER TABLE matches DROP CHECK chk_matches_distinct_teams;
+ALTER TABLE players DROP CHECK chk_players_jersey_number;
+
+ALTER TABLE players DROP INDEX uq_players_team_jersey_active, DROP COLUMN jersey_active;
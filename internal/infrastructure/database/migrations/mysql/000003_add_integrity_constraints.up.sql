@@ -0,0 +1,25 @@
+-- Enforce domain rules in the database instead of only in use cases.
+-- Constraint names are matched by translateError in the repositories.
+
+-- MySQL has no partial indexes. jersey_active is 1 for active players and
+-- NULL once soft deleted; NULLs never collide in a unique index, so only
+-- active players compete for a jersey number.
+ALTER TABLE players
+    ADD COLUMN jersey_active tinyint GENERATED ALWAYS AS (IF(deleted_at IS NULL, 1, NULL)) VIRTUAL,
+    ADD UNIQUE INDEX uq_players_team_jersey_active (team_id, jersey_number, jersey_active);
+
+ALTER TABLE players ADD CONSTRAINT chk_players_jersey_number CHECK (jersey_number BETWEEN 1 AND 99);
+ALTER TABLE matches ADD CONSTRAINT chk_matches_distinct_teams CHECK (home_team_id <> away_team_id);
+ALTER TABLE matches ADD CONSTRAINT chk_matches_home_score CHECK (home_score IS NULL OR home_score >= 0);
+ALTER TABLE matches ADD CONSTRAINT chk_matches_away_score CHECK (away_score IS NULL OR away_score >= 0);
+ALTER TABLE matches ADD CONSTRAINT chk_matches_status CHECK (status IN ('scheduled', 'ongoing', 'completed', 'cancelled'));
+ALTER TABLE goals ADD CONSTRAINT chk_goals_minute CHECK (minute BETWEEN 1 AND 120);
+
+-- Teams, players and matches are soft deleted, so a hard delete of a row that
+-- still has dependents is a bug and is rejected. Goals belong to their match.
+ALTER TABLE players ADD CONSTRAINT fk_players_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE RESTRICT;
+ALTER TABLE matches ADD CONSTRAINT fk_matches_home_team FOREIGN KEY (home_team_id) REFERENCES teams (id) ON DELETE RESTRICT;
+ALTER TABLE matches ADD CONSTRAINT fk_matches_away_team FOREIGN KEY (away_team_id) REFERENCES teams (id) ON DELETE RESTRICT;
+ALTER TABLE goals ADD CONSTRAINT fk_goals_match FOREIGN KEY (match_id) REFERENCES matches (id) ON DELETE CASCADE;
+ALTER TABLE goals ADD CONSTRAINT fk_goals_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE RESTRICT;
+ALTER TABLE goals ADD CONSTRAINT fk_goals_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE RESTRICT;
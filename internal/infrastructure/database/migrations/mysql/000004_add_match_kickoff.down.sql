@@ -0,0 +1,18 @@
+ALTER TABLE matches
+    ADD COLUMN match_date datetime(3) NULL AFTER deleted_at,
+    ADD COLUMN match_time varchar(10) NULL AFTER match_date;
+
+-- The old columns hold the local date and time of the match. CONVERT_TZ
+-- returns NULL without the server's time zone tables, so fall back to
+-- Asia/Jakarta.
+UPDATE matches SET
+    match_date = DATE(COALESCE(CONVERT_TZ(kickoff_at, '+00:00', timezone), DATE_ADD(kickoff_at, INTERVAL 7 HOUR))),
+    match_time = DATE_FORMAT(COALESCE(CONVERT_TZ(kickoff_at, '+00:00', timezone), DATE_ADD(kickoff_at, INTERVAL 7 HOUR)), '%H:%i');
+
+ALTER TABLE matches
+    MODIFY match_date datetime(3) NOT NULL,
+    MODIFY match_time varchar(10) NOT NULL,
+    DROP INDEX idx_matches_kickoff_at,
+    DROP COLUMN kickoff_at,
+    DROP COLUMN timezone,
+    ADD INDEX idx_matches_match_date (match_date);
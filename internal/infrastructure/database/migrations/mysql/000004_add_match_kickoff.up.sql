@@ -0,0 +1,21 @@
+-- Replace match_date and the free-form match_time with a single kickoff in
+-- UTC plus the IANA time zone it is played in. Existing matches were entered
+-- as Asia/Jakarta local times (UTC+7, no daylight saving); a match_time that
+-- is not HH:MM becomes midnight. match_date must hold UTC, so databases
+-- written by a release that stored host-local times need
+-- `migrate convert-utc` first.
+ALTER TABLE matches
+    ADD COLUMN kickoff_at datetime(3) NULL AFTER deleted_at,
+    ADD COLUMN timezone varchar(64) NOT NULL DEFAULT 'Asia/Jakarta' AFTER kickoff_at;
+
+UPDATE matches SET kickoff_at = DATE_SUB(
+    TIMESTAMP(DATE(match_date), IF(match_time REGEXP '^([01][0-9]|2[0-3]):[0-5][0-9]$', CONCAT(match_time, ':00'), '00:00:00')),
+    INTERVAL 7 HOUR
+);
+
+ALTER TABLE matches
+    MODIFY kickoff_at datetime(3) NOT NULL,
+    DROP INDEX idx_matches_match_date,
+    DROP COLUMN match_date,
+    DROP COLUMN match_time,
+    ADD INDEX idx_matches_kickoff_at (kickoff_at);
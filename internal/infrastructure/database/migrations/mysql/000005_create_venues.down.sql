@@ -0,0 +1,5 @@
+ALTER TABLE matches DROP FOREIGN KEY fk_matches_venue;
+ALTER TABLE matches DROP INDEX idx_matches_venue_id, DROP COLUMN venue_id;
+ALTER TABLE teams DROP FOREIGN KEY fk_teams_home_venue;
+ALTER TABLE teams DROP INDEX idx_teams_home_venue_id, DROP COLUMN home_venue_id;
+DROP TABLE IF EXISTS venues;
@@ -0,0 +1,31 @@
+-- Venues are stadiums shared by teams. A team has an optional home venue,
+-- and a match an optional venue.
+CREATE TABLE IF NOT EXISTS venues (
+    id         char(36) NOT NULL,
+    created_at datetime(3) NULL,
+    updated_at datetime(3) NULL,
+    deleted_at datetime(3) NULL,
+    name       varchar(255) NOT NULL,
+    address    varchar(500),
+    city       varchar(100) NOT NULL,
+    capacity   bigint NOT NULL,
+    latitude   double DEFAULT NULL,
+    longitude  double DEFAULT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_venues_deleted_at (deleted_at),
+    CONSTRAINT chk_venues_capacity CHECK (capacity > 0),
+    CONSTRAINT chk_venues_latitude CHECK (latitude IS NULL OR latitude BETWEEN -90 AND 90),
+    CONSTRAINT chk_venues_longitude CHECK (longitude IS NULL OR longitude BETWEEN -180 AND 180)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Venues are soft deleted like teams, so a hard delete of a venue still in
+-- use is rejected
+ALTER TABLE teams
+    ADD COLUMN home_venue_id char(36) NULL DEFAULT NULL AFTER city,
+    ADD INDEX idx_teams_home_venue_id (home_venue_id),
+    ADD CONSTRAINT fk_teams_home_venue FOREIGN KEY (home_venue_id) REFERENCES venues (id) ON DELETE RESTRICT;
+
+ALTER TABLE matches
+    ADD COLUMN venue_id char(36) NULL DEFAULT NULL AFTER away_team_id,
+    ADD INDEX idx_matches_venue_id (venue_id),
+    ADD CONSTRAINT fk_matches_venue FOREIGN KEY (venue_id) REFERENCES venues (id) ON DELETE RESTRICT;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS cards;
+DROP TABLE IF EXISTS match_officials;
+DROP TABLE IF EXISTS officials;
@@ -0,0 +1,55 @@
+-- Officials are referees appointed to matches. An official holds at most one
+-- role per match and every role is filled at most once.
+CREATE TABLE IF NOT EXISTS officials (
+    id            char(36) NOT NULL,
+    created_at    datetime(3) NULL,
+    updated_at    datetime(3) NULL,
+    deleted_at    datetime(3) NULL,
+    name          varchar(255) NOT NULL,
+    license_level varchar(20) NOT NULL,
+    city          varchar(100),
+    PRIMARY KEY (id),
+    INDEX idx_officials_deleted_at (deleted_at),
+    CONSTRAINT chk_officials_license_level CHECK (license_level IN ('fifa', 'national', 'regional'))
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Appointments are replaced as a whole and go away with their match.
+-- Officials are soft deleted, so a hard delete of an appointed official is
+-- rejected.
+CREATE TABLE IF NOT EXISTS match_officials (
+    id          char(36) NOT NULL,
+    created_at  datetime(3) NULL,
+    match_id    char(36) NOT NULL,
+    official_id char(36) NOT NULL,
+    role        varchar(20) NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE INDEX uq_match_officials_role (match_id, role),
+    UNIQUE INDEX uq_match_officials_official (match_id, official_id),
+    INDEX idx_match_officials_official_id (official_id),
+    CONSTRAINT chk_match_officials_role CHECK (role IN ('referee', 'assistant_1', 'assistant_2', 'fourth_official')),
+    CONSTRAINT fk_match_officials_match FOREIGN KEY (match_id) REFERENCES matches (id) ON DELETE CASCADE,
+    CONSTRAINT fk_match_officials_official FOREIGN KEY (official_id) REFERENCES officials (id) ON DELETE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Cards are recorded with the result of a match, like goals
+CREATE TABLE IF NOT EXISTS cards (
+    id         char(36) NOT NULL,
+    created_at datetime(3) NULL,
+    updated_at datetime(3) NULL,
+    deleted_at datetime(3) NULL,
+    match_id   char(36) NOT NULL,
+    player_id  char(36) NOT NULL,
+    team_id    char(36) NOT NULL,
+    minute     bigint NOT NULL,
+    type       varchar(10) NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_cards_deleted_at (deleted_at),
+    INDEX idx_cards_match_id (match_id),
+    INDEX idx_cards_player_id (player_id),
+    INDEX idx_cards_team_id (team_id),
+    CONSTRAINT chk_cards_minute CHECK (minute BETWEEN 1 AND 120),
+    CONSTRAINT chk_cards_type CHECK (type IN ('yellow', 'red')),
+    CONSTRAINT fk_cards_match FOREIGN KEY (match_id) REFERENCES matches (id) ON DELETE CASCADE,
+    CONSTRAINT fk_cards_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE RESTRICT,
+    CONSTRAINT fk_cards_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
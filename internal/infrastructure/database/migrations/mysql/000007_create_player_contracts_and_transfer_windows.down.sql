@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS transfer_windows;
+DROP TABLE IF EXISTS player_contracts;
@@ -0,0 +1,46 @@
+-- Contracts are the membership history of players. A transfer ends the
+-- current contract and starts a new one; a player has at most one current
+-- contract, and its team and jersey number are mirrored on the player.
+-- MySQL has no partial indexes, so is_current is 1 for the current contract
+-- and NULL otherwise, like players.jersey_active.
+CREATE TABLE IF NOT EXISTS player_contracts (
+    id            char(36) NOT NULL,
+    created_at    datetime(3) NULL,
+    updated_at    datetime(3) NULL,
+    player_id     char(36) NOT NULL,
+    team_id       char(36) NOT NULL,
+    jersey_number bigint NOT NULL,
+    starts_at     datetime(3) NULL DEFAULT NULL,
+    ends_at       datetime(3) NULL DEFAULT NULL,
+    is_current    tinyint GENERATED ALWAYS AS (IF(ends_at IS NULL, 1, NULL)) VIRTUAL,
+    PRIMARY KEY (id),
+    UNIQUE INDEX uq_player_contracts_current (player_id, is_current),
+    INDEX idx_player_contracts_player_id (player_id),
+    INDEX idx_player_contracts_team_id (team_id),
+    CONSTRAINT chk_player_contracts_jersey_number CHECK (jersey_number BETWEEN 1 AND 99),
+    CONSTRAINT chk_player_contracts_period CHECK (starts_at IS NULL OR ends_at IS NULL OR starts_at < ends_at),
+    CONSTRAINT fk_player_contracts_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE CASCADE,
+    CONSTRAINT fk_player_contracts_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Every existing player starts with a current contract at their team that
+-- covers all their past matches. It reuses the id of the player, which is
+-- unique and a valid UUID in every driver.
+INSERT INTO player_contracts (id, created_at, updated_at, player_id, team_id, jersey_number)
+SELECT id, created_at, created_at, id, team_id, jersey_number FROM players;
+
+-- Transfer windows belong to a season by its name, such as 2024-2025
+CREATE TABLE IF NOT EXISTS transfer_windows (
+    id         char(36) NOT NULL,
+    created_at datetime(3) NULL,
+    updated_at datetime(3) NULL,
+    deleted_at datetime(3) NULL,
+    season     varchar(9) NOT NULL,
+    name       varchar(100),
+    opens_at   datetime(3) NOT NULL,
+    closes_at  datetime(3) NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_transfer_windows_deleted_at (deleted_at),
+    INDEX idx_transfer_windows_season (season),
+    CONSTRAINT chk_transfer_windows_period CHECK (opens_at < closes_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,21 @@
+-- Availability records are periods in which a player cannot play. A record
+-- ends when the player returns or, until then, at the expected return.
+CREATE TABLE IF NOT EXISTS player_availabilities (
+    id                 char(36) NOT NULL,
+    created_at         datetime(3) NULL,
+    updated_at         datetime(3) NULL,
+    deleted_at         datetime(3) NULL,
+    player_id          char(36) NOT NULL,
+    status             varchar(20) NOT NULL,
+    note               varchar(255),
+    starts_at          datetime(3) NOT NULL,
+    expected_return_at datetime(3) NULL DEFAULT NULL,
+    returned_at        datetime(3) NULL DEFAULT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_player_availabilities_deleted_at (deleted_at),
+    INDEX idx_player_availabilities_player_id (player_id),
+    CONSTRAINT chk_player_availabilities_status CHECK (status IN ('injured', 'ill', 'international_duty', 'personal', 'other')),
+    CONSTRAINT chk_player_availabilities_expected_return CHECK (expected_return_at IS NULL OR starts_at < expected_return_at),
+    CONSTRAINT chk_player_availabilities_returned CHECK (returned_at IS NULL OR starts_at < returned_at),
+    CONSTRAINT fk_player_availabilities_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,8 @@
+ALTER TABLE players DROP CHECK chk_players_preferred_foot;
+ALTER TABLE players
+    DROP INDEX idx_players_date_of_birth,
+    DROP COLUMN secondary_positions,
+    DROP COLUMN photo,
+    DROP COLUMN preferred_foot,
+    DROP COLUMN nationality,
+    DROP COLUMN date_of_birth;
@@ -0,0 +1,12 @@
+-- Player profile: date of birth, ISO 3166-1 alpha-2 nationality, preferred
+-- foot, photo URL and secondary positions as comma-separated text. Existing
+-- players have none of them, so the text columns default to empty.
+ALTER TABLE players
+    ADD COLUMN date_of_birth date NULL AFTER jersey_number,
+    ADD COLUMN nationality varchar(2) NOT NULL DEFAULT '' AFTER date_of_birth,
+    ADD COLUMN preferred_foot varchar(5) NOT NULL DEFAULT '' AFTER nationality,
+    ADD COLUMN photo varchar(500) NOT NULL DEFAULT '' AFTER preferred_foot,
+    ADD COLUMN secondary_positions varchar(100) NOT NULL DEFAULT '' AFTER position,
+    ADD INDEX idx_players_date_of_birth (date_of_birth);
+
+ALTER TABLE players ADD CONSTRAINT chk_players_preferred_foot CHECK (preferred_foot IN ('', 'left', 'right', 'both'));
@@ -0,0 +1,3 @@
+-- Unified search: only Postgres has trigram indexes and accent folding.
+-- This driver ranks the searched columns in the application instead, so
+-- there is nothing to migrate; the version keeps the drivers in step.
@@ -0,0 +1,77 @@
+-- Baseline schema. IF NOT EXISTS lets databases that were previously
+-- created by GORM AutoMigrate adopt versioned migrations in place.
+
+CREATE TABLE IF NOT EXISTS users (
+    id         uuid PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    email      varchar(255) NOT NULL,
+    password   varchar(255) NOT NULL,
+    name       varchar(255) NOT NULL,
+    role       varchar(20) DEFAULT 'user'
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users (email);
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at);
+
+CREATE TABLE IF NOT EXISTS teams (
+    id           uuid PRIMARY KEY,
+    created_at   timestamptz,
+    updated_at   timestamptz,
+    deleted_at   timestamptz,
+    name         varchar(255) NOT NULL,
+    logo         varchar(500),
+    founded_year bigint NOT NULL,
+    address      varchar(500),
+    city         varchar(100) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_teams_deleted_at ON teams (deleted_at);
+
+CREATE TABLE IF NOT EXISTS players (
+    id            uuid PRIMARY KEY,
+    created_at    timestamptz,
+    updated_at    timestamptz,
+    deleted_at    timestamptz,
+    team_id       uuid NOT NULL,
+    name          varchar(255) NOT NULL,
+    height        double precision NOT NULL,
+    weight        double precision NOT NULL,
+    position      varchar(20) NOT NULL,
+    jersey_number bigint NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_players_deleted_at ON players (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_players_team_id ON players (team_id);
+
+CREATE TABLE IF NOT EXISTS matches (
+    id           uuid PRIMARY KEY,
+    created_at   timestamptz,
+    updated_at   timestamptz,
+    deleted_at   timestamptz,
+    match_date   timestamptz NOT NULL,
+    match_time   varchar(10) NOT NULL,
+    home_team_id uuid NOT NULL,
+    away_team_id uuid NOT NULL,
+    home_score   bigint DEFAULT NULL,
+    away_score   bigint DEFAULT NULL,
+    status       varchar(20) DEFAULT 'scheduled'
+);
+CREATE INDEX IF NOT EXISTS idx_matches_deleted_at ON matches (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_matches_match_date ON matches (match_date);
+CREATE INDEX IF NOT EXISTS idx_matches_home_team_id ON matches (home_team_id);
+CREATE INDEX IF NOT EXISTS idx_matches_away_team_id ON matches (away_team_id);
+
+CREATE TABLE IF NOT EXISTS goals (
+    id          uuid PRIMARY KEY,
+    created_at  timestamptz,
+    updated_at  timestamptz,
+    deleted_at  timestamptz,
+    match_id    uuid NOT NULL,
+    player_id   uuid NOT NULL,
+    team_id     uuid NOT NULL,
+    minute      bigint NOT NULL,
+    is_own_goal boolean DEFAULT false
+);
+CREATE INDEX IF NOT EXISTS idx_goals_deleted_at ON goals (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_goals_match_id ON goals (match_id);
+CREATE INDEX IF NOT EXISTS idx_goals_player_id ON goals (player_id);
+CREATE INDEX IF NOT EXISTS idx_goals_team_id ON goals (team_id);
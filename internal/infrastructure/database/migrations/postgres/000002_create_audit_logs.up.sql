@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id          uuid PRIMARY KEY,
+    actor_id    uuid,
+    action      varchar(30) NOT NULL,
+    entity_type varchar(50) NOT NULL,
+    entity_id   uuid NOT NULL,
+    before      text,
+    after       text,
+    changes     text,
+    ip_address  varchar(45),
+    request_id  varchar(64),
+    created_at  timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_actor_id ON audit_logs (actor_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_action ON audit_logs (action);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_entity ON audit_logs (entity_type, entity_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_request_id ON audit_logs (request_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_created_at ON audit_logs (created_at);
@@ -0,0 +1,15 @@
+ALTER TABLE goals DROP CONSTRAINT IF EXISTS fk_goals_team;
+ALTER TABLE goals DROP CONSTRAINT IF EXISTS fk_goals_player;
+ALTER TABLE goals DROP CONSTRAINT IF EXISTS fk_goals_match;
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS fk_matches_away_team;
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS fk_matches_home_team;
+ALTER TABLE players DROP CONSTRAINT IF EXISTS fk_players_team;
+
+ALTER TABLE goals DROP CONSTRAINT IF EXISTS chk_goals_minute;
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS chk_matches_status;
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS chk_matches_away_score;
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS chk_matches_home_score;
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS chk_matches_distinct_teams;
+ALTER TABLE players DROP CONSTRAINT IF EXISTS chk_players_jersey_number;
+
+DROP INDEX IF EXISTS uq_players_team_jersey_active;
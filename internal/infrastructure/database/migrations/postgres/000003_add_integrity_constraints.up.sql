@@ -0,0 +1,31 @@
+-- Enforce domain rules in the database instead of only in use cases.
+-- Constraint names are matched by translateError in the repositories.
+
+-- Foreign keys GORM AutoMigrate may have created without an explicit ON DELETE
+ALTER TABLE players DROP CONSTRAINT IF EXISTS fk_teams_players;
+ALTER TABLE players DROP CONSTRAINT IF EXISTS fk_players_team;
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS fk_matches_home_team;
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS fk_matches_away_team;
+ALTER TABLE goals DROP CONSTRAINT IF EXISTS fk_matches_goals;
+ALTER TABLE goals DROP CONSTRAINT IF EXISTS fk_goals_match;
+ALTER TABLE goals DROP CONSTRAINT IF EXISTS fk_goals_player;
+ALTER TABLE goals DROP CONSTRAINT IF EXISTS fk_goals_team;
+
+-- A jersey number is unique among the active players of a team
+CREATE UNIQUE INDEX uq_players_team_jersey_active ON players (team_id, jersey_number) WHERE deleted_at IS NULL;
+
+ALTER TABLE players ADD CONSTRAINT chk_players_jersey_number CHECK (jersey_number BETWEEN 1 AND 99);
+ALTER TABLE matches ADD CONSTRAINT chk_matches_distinct_teams CHECK (home_team_id <> away_team_id);
+ALTER TABLE matches ADD CONSTRAINT chk_matches_home_score CHECK (home_score IS NULL OR home_score >= 0);
+ALTER TABLE matches ADD CONSTRAINT chk_matches_away_score CHECK (away_score IS NULL OR away_score >= 0);
+ALTER TABLE matches ADD CONSTRAINT chk_matches_status CHECK (status IN ('scheduled', 'ongoing', 'completed', 'cancelled'));
+ALTER TABLE goals ADD CONSTRAINT chk_goals_minute CHECK (minute BETWEEN 1 AND 120);
+
+-- Teams, players and matches are soft deleted, so a hard delete of a row that
+-- still has dependents is a bug and is rejected. Goals belong to their match.
+ALTER TABLE players ADD CONSTRAINT fk_players_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE RESTRICT;
+ALTER TABLE matches ADD CONSTRAINT fk_matches_home_team FOREIGN KEY (home_team_id) REFERENCES teams (id) ON DELETE RESTRICT;
+ALTER TABLE matches ADD CONSTRAINT fk_matches_away_team FOREIGN KEY (away_team_id) REFERENCES teams (id) ON DELETE RESTRICT;
+ALTER TABLE goals ADD CONSTRAINT fk_goals_match FOREIGN KEY (match_id) REFERENCES matches (id) ON DELETE CASCADE;
+ALTER TABLE goals ADD CONSTRAINT fk_goals_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE RESTRICT;
+ALTER TABLE goals ADD CONSTRAINT fk_goals_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE RESTRICT;
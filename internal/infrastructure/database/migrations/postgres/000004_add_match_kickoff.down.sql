@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_matches_kickoff_at;
+ALTER TABLE matches ADD COLUMN match_date timestamptz, ADD COLUMN match_time varchar(10);
+
+-- The old columns hold the local date and time of the match
+UPDATE matches SET
+    match_date = CAST(CAST(kickoff_at AT TIME ZONE timezone AS date) AS timestamp) AT TIME ZONE 'UTC',
+    match_time = to_char(kickoff_at AT TIME ZONE timezone, 'HH24:MI');
+
+ALTER TABLE matches ALTER COLUMN match_date SET NOT NULL, ALTER COLUMN match_time SET NOT NULL;
+ALTER TABLE matches DROP COLUMN kickoff_at, DROP COLUMN timezone;
+CREATE INDEX IF NOT EXISTS idx_matches_match_date ON matches (match_date);
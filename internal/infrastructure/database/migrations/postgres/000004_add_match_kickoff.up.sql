@@ -0,0 +1,16 @@
+-- Replace match_date and the free-form match_time with a single kickoff in
+-- UTC plus the IANA time zone it is played in. Existing matches were entered
+-- as Asia/Jakarta local times; a match_time that is not HH:MM becomes
+-- midnight.
+ALTER TABLE matches ADD COLUMN kickoff_at timestamptz;
+ALTER TABLE matches ADD COLUMN timezone varchar(64) NOT NULL DEFAULT 'Asia/Jakarta';
+
+UPDATE matches SET kickoff_at = (
+    CAST(match_date AT TIME ZONE 'UTC' AS date)
+    + CASE WHEN match_time ~ '^([01][0-9]|2[0-3]):[0-5][0-9]$' THEN CAST(match_time AS time) ELSE time '00:00' END
+) AT TIME ZONE 'Asia/Jakarta';
+
+ALTER TABLE matches ALTER COLUMN kickoff_at SET NOT NULL;
+DROP INDEX IF EXISTS idx_matches_match_date;
+ALTER TABLE matches DROP COLUMN match_date, DROP COLUMN match_time;
+CREATE INDEX IF NOT EXISTS idx_matches_kickoff_at ON matches (kickoff_at);
@@ -0,0 +1,3 @@
+ALTER TABLE matches DROP COLUMN IF EXISTS venue_id;
+ALTER TABLE teams DROP COLUMN IF EXISTS home_venue_id;
+DROP TABLE IF EXISTS venues;
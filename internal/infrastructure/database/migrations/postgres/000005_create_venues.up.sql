@@ -0,0 +1,28 @@
+-- Venues are stadiums shared by teams. A team has an optional home venue,
+-- and a match an optional venue.
+CREATE TABLE IF NOT EXISTS venues (
+    id         uuid PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    name       varchar(255) NOT NULL,
+    address    varchar(500),
+    city       varchar(100) NOT NULL,
+    capacity   bigint NOT NULL,
+    latitude   double precision DEFAULT NULL,
+    longitude  double precision DEFAULT NULL,
+    CONSTRAINT chk_venues_capacity CHECK (capacity > 0),
+    CONSTRAINT chk_venues_latitude CHECK (latitude IS NULL OR latitude BETWEEN -90 AND 90),
+    CONSTRAINT chk_venues_longitude CHECK (longitude IS NULL OR longitude BETWEEN -180 AND 180)
+);
+CREATE INDEX IF NOT EXISTS idx_venues_deleted_at ON venues (deleted_at);
+
+-- Venues are soft deleted like teams, so a hard delete of a venue still in
+-- use is rejected
+ALTER TABLE teams ADD COLUMN home_venue_id uuid DEFAULT NULL;
+ALTER TABLE teams ADD CONSTRAINT fk_teams_home_venue FOREIGN KEY (home_venue_id) REFERENCES venues (id) ON DELETE RESTRICT;
+CREATE INDEX IF NOT EXISTS idx_teams_home_venue_id ON teams (home_venue_id);
+
+ALTER TABLE matches ADD COLUMN venue_id uuid DEFAULT NULL;
+ALTER TABLE matches ADD CONSTRAINT fk_matches_venue FOREIGN KEY (venue_id) REFERENCES venues (id) ON DELETE RESTRICT;
+CREATE INDEX IF NOT EXISTS idx_matches_venue_id ON matches (venue_id);
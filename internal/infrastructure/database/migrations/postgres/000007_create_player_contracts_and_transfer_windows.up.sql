@@ -0,0 +1,41 @@
+-- Contracts are the membership history of players. A transfer ends the
+-- current contract and starts a new one; a player has at most one current
+-- contract, and its team and jersey number are mirrored on the player.
+CREATE TABLE IF NOT EXISTS player_contracts (
+    id            uuid PRIMARY KEY,
+    created_at    timestamptz,
+    updated_at    timestamptz,
+    player_id     uuid NOT NULL,
+    team_id       uuid NOT NULL,
+    jersey_number bigint NOT NULL,
+    starts_at     timestamptz DEFAULT NULL,
+    ends_at       timestamptz DEFAULT NULL,
+    CONSTRAINT chk_player_contracts_jersey_number CHECK (jersey_number BETWEEN 1 AND 99),
+    CONSTRAINT chk_player_contracts_period CHECK (starts_at IS NULL OR ends_at IS NULL OR starts_at < ends_at),
+    CONSTRAINT fk_player_contracts_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE CASCADE,
+    CONSTRAINT fk_player_contracts_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE RESTRICT
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_player_contracts_current ON player_contracts (player_id) WHERE ends_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_player_contracts_player_id ON player_contracts (player_id);
+CREATE INDEX IF NOT EXISTS idx_player_contracts_team_id ON player_contracts (team_id);
+
+-- Every existing player starts with a current contract at their team that
+-- covers all their past matches. It reuses the id of the player, which is
+-- unique and a valid UUID in every driver.
+INSERT INTO player_contracts (id, created_at, updated_at, player_id, team_id, jersey_number)
+SELECT id, created_at, created_at, id, team_id, jersey_number FROM players;
+
+-- Transfer windows belong to a season by its name, such as 2024-2025
+CREATE TABLE IF NOT EXISTS transfer_windows (
+    id         uuid PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    season     varchar(9) NOT NULL,
+    name       varchar(100),
+    opens_at   timestamptz NOT NULL,
+    closes_at  timestamptz NOT NULL,
+    CONSTRAINT chk_transfer_windows_period CHECK (opens_at < closes_at)
+);
+CREATE INDEX IF NOT EXISTS idx_transfer_windows_deleted_at ON transfer_windows (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_transfer_windows_season ON transfer_windows (season);
@@ -0,0 +1,14 @@
+-- Disciplinary rules of a season, such as 2024-2025. Every yellow_card_limit
+-- yellow cards ban a player for one match and a red card bans them for
+-- red_card_ban matches. Seasons without rules have no suspensions.
+CREATE TABLE IF NOT EXISTS disciplinary_rules (
+    id                uuid PRIMARY KEY,
+    created_at        timestamptz,
+    updated_at        timestamptz,
+    season            varchar(9) NOT NULL,
+    yellow_card_limit bigint NOT NULL,
+    red_card_ban      bigint NOT NULL,
+    CONSTRAINT chk_disciplinary_rules_yellow_card_limit CHECK (yellow_card_limit >= 1),
+    CONSTRAINT chk_disciplinary_rules_red_card_ban CHECK (red_card_ban >= 1)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_disciplinary_rules_season ON disciplinary_rules (season);
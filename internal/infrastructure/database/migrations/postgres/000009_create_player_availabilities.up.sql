@@ -0,0 +1,20 @@
+-- Availability records are periods in which a player cannot play. A record
+-- ends when the player returns or, until then, at the expected return.
+CREATE TABLE IF NOT EXISTS player_availabilities (
+    id                 uuid PRIMARY KEY,
+    created_at         timestamptz,
+    updated_at         timestamptz,
+    deleted_at         timestamptz,
+    player_id          uuid NOT NULL,
+    status             varchar(20) NOT NULL,
+    note               varchar(255),
+    starts_at          timestamptz NOT NULL,
+    expected_return_at timestamptz DEFAULT NULL,
+    returned_at        timestamptz DEFAULT NULL,
+    CONSTRAINT chk_player_availabilities_status CHECK (status IN ('injured', 'ill', 'international_duty', 'personal', 'other')),
+    CONSTRAINT chk_player_availabilities_expected_return CHECK (expected_return_at IS NULL OR starts_at < expected_return_at),
+    CONSTRAINT chk_player_availabilities_returned CHECK (returned_at IS NULL OR starts_at < returned_at),
+    CONSTRAINT fk_player_availabilities_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_player_availabilities_deleted_at ON player_availabilities (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_player_availabilities_player_id ON player_availabilities (player_id);
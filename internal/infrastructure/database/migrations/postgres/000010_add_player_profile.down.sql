@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_players_date_of_birth;
+ALTER TABLE players DROP CONSTRAINT IF EXISTS chk_players_preferred_foot;
+ALTER TABLE players
+    DROP COLUMN secondary_positions,
+    DROP COLUMN photo,
+    DROP COLUMN preferred_foot,
+    DROP COLUMN nationality,
+    DROP COLUMN date_of_birth;
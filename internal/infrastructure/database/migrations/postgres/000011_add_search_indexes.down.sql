@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_venues_search_tsv;
+DROP INDEX IF EXISTS idx_venues_search_trgm;
+DROP INDEX IF EXISTS idx_players_search_tsv;
+DROP INDEX IF EXISTS idx_players_search_trgm;
+DROP INDEX IF EXISTS idx_teams_search_tsv;
+DROP INDEX IF EXISTS idx_teams_search_trgm;
+DROP FUNCTION IF EXISTS search_fold(text);
+-- The extensions may be used by other schemas, so they stay installed
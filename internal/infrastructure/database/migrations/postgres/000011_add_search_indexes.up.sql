@@ -0,0 +1,18 @@
+-- Unified search: unaccent folds accents and pg_trgm ranks by trigram word
+-- similarity, which tolerates typos. unaccent() is only STABLE because its
+-- dictionary could change, so search_fold wraps it with the dictionary
+-- spelled out to be usable in indexes. The expressions must match
+-- searchSpec.document in the repositories.
+CREATE EXTENSION IF NOT EXISTS unaccent;
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE OR REPLACE FUNCTION search_fold(text) RETURNS text
+    LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT
+    AS $$ SELECT lower(public.unaccent('public.unaccent'::regdictionary, $1)) $$;
+
+CREATE INDEX IF NOT EXISTS idx_teams_search_trgm ON teams USING gin (search_fold(name || ' ' || city) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_teams_search_tsv ON teams USING gin (to_tsvector('simple', search_fold(name || ' ' || city)));
+CREATE INDEX IF NOT EXISTS idx_players_search_trgm ON players USING gin (search_fold(name) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_players_search_tsv ON players USING gin (to_tsvector('simple', search_fold(name)));
+CREATE INDEX IF NOT EXISTS idx_venues_search_trgm ON venues USING gin (search_fold(name || ' ' || city) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_venues_search_tsv ON venues USING gin (to_tsvector('simple', search_fold(name || ' ' || city)));
@@ -0,0 +1,92 @@
+-- Baseline schema. UUIDs are stored as text in their canonical form.
+--
+-- SQLite cannot add CHECK or FOREIGN KEY constraints to an existing table,
+-- so the constraints that 000003 adds on the other drivers are declared
+-- inline here. Constraint names match the other drivers.
+
+CREATE TABLE IF NOT EXISTS users (
+    id         text PRIMARY KEY,
+    created_at datetime,
+    updated_at datetime,
+    deleted_at datetime,
+    email      varchar(255) NOT NULL,
+    password   varchar(255) NOT NULL,
+    name       varchar(255) NOT NULL,
+    role       varchar(20) DEFAULT 'user'
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users (email);
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at);
+
+CREATE TABLE IF NOT EXISTS teams (
+    id           text PRIMARY KEY,
+    created_at   datetime,
+    updated_at   datetime,
+    deleted_at   datetime,
+    name         varchar(255) NOT NULL,
+    logo         varchar(500),
+    founded_year integer NOT NULL,
+    address      varchar(500),
+    city         varchar(100) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_teams_deleted_at ON teams (deleted_at);
+
+CREATE TABLE IF NOT EXISTS players (
+    id            text PRIMARY KEY,
+    created_at    datetime,
+    updated_at    datetime,
+    deleted_at    datetime,
+    team_id       text NOT NULL,
+    name          varchar(255) NOT NULL,
+    height        real NOT NULL,
+    weight        real NOT NULL,
+    position      varchar(20) NOT NULL,
+    jersey_number integer NOT NULL,
+    CONSTRAINT chk_players_jersey_number CHECK (jersey_number BETWEEN 1 AND 99),
+    CONSTRAINT fk_players_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS idx_players_deleted_at ON players (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_players_team_id ON players (team_id);
+
+CREATE TABLE IF NOT EXISTS matches (
+    id           text PRIMARY KEY,
+    created_at   datetime,
+    updated_at   datetime,
+    deleted_at   datetime,
+    match_date   datetime NOT NULL,
+    match_time   varchar(10) NOT NULL,
+    home_team_id text NOT NULL,
+    away_team_id text NOT NULL,
+    home_score   integer DEFAULT NULL,
+    away_score   integer DEFAULT NULL,
+    status       varchar(20) DEFAULT 'scheduled',
+    CONSTRAINT chk_matches_distinct_teams CHECK (home_team_id <> away_team_id),
+    CONSTRAINT chk_matches_home_score CHECK (home_score IS NULL OR home_score >= 0),
+    CONSTRAINT chk_matches_away_score CHECK (away_score IS NULL OR away_score >= 0),
+    CONSTRAINT chk_matches_status CHECK (status IN ('scheduled', 'ongoing', 'completed', 'cancelled')),
+    CONSTRAINT fk_matches_home_team FOREIGN KEY (home_team_id) REFERENCES teams (id) ON DELETE RESTRICT,
+    CONSTRAINT fk_matches_away_team FOREIGN KEY (away_team_id) REFERENCES teams (id) ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS idx_matches_deleted_at ON matches (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_matches_match_date ON matches (match_date);
+CREATE INDEX IF NOT EXISTS idx_matches_home_team_id ON matches (home_team_id);
+CREATE INDEX IF NOT EXISTS idx_matches_away_team_id ON matches (away_team_id);
+
+CREATE TABLE IF NOT EXISTS goals (
+    id          text PRIMARY KEY,
+    created_at  datetime,
+    updated_at  datetime,
+    deleted_at  datetime,
+    match_id    text NOT NULL,
+    player_id   text NOT NULL,
+    team_id     text NOT NULL,
+    minute      integer NOT NULL,
+    is_own_goal numeric DEFAULT false,
+    CONSTRAINT chk_goals_minute CHECK (minute BETWEEN 1 AND 120),
+    CONSTRAINT fk_goals_match FOREIGN KEY (match_id) REFERENCES matches (id) ON DELETE CASCADE,
+    CONSTRAINT fk_goals_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE RESTRICT,
+    CONSTRAINT fk_goals_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS idx_goals_deleted_at ON goals (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_goals_match_id ON goals (match_id);
+CREATE INDEX IF NOT EXISTS idx_goals_player_id ON goals (player_id);
+CREATE INDEX IF NOT EXISTS idx_goals_team_id ON goals (team_id);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS uq_players_team_jersey_active;
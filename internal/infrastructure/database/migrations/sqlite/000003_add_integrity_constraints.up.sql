@@ -0,0 +1,6 @@
+-- Enforce domain rules in the database instead of only in use cases.
+-- The CHECK and FOREIGN KEY constraints are declared in 000001 because
+-- SQLite cannot add them to an existing table.
+
+-- A jersey number is unique among the active players of a team
+CREATE UNIQUE INDEX uq_players_team_jersey_active ON players (team_id, jersey_number) WHERE deleted_at IS NULL;
@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_matches_kickoff_at;
+ALTER TABLE matches ADD COLUMN match_date datetime;
+ALTER TABLE matches ADD COLUMN match_time varchar(10) NOT NULL DEFAULT '';
+
+-- SQLite has no time zone database, so every match is converted back as an
+-- Asia/Jakarta local time
+UPDATE matches SET
+    match_date = strftime('%Y-%m-%d 00:00:00+00:00', kickoff_at, '+7 hours'),
+    match_time = strftime('%H:%M', kickoff_at, '+7 hours');
+
+ALTER TABLE matches DROP COLUMN timezone;
+ALTER TABLE matches DROP COLUMN kickoff_at;
+CREATE INDEX IF NOT EXISTS idx_matches_match_date ON matches (match_date);
@@ -0,0 +1,19 @@
+-- Replace match_date and the free-form match_time with a single kickoff in
+-- UTC plus the IANA time zone it is played in. Existing matches were entered
+-- as Asia/Jakarta local times (UTC+7, no daylight saving); a match_time that
+-- is not a valid time becomes midnight.
+-- SQLite cannot add a NOT NULL column without a default, so kickoff_at stays
+-- nullable here; the application always sets it.
+ALTER TABLE matches ADD COLUMN kickoff_at datetime;
+ALTER TABLE matches ADD COLUMN timezone varchar(64) NOT NULL DEFAULT 'Asia/Jakarta';
+
+-- Kickoffs use the text format the driver writes, so they compare in order
+UPDATE matches SET kickoff_at = COALESCE(
+    strftime('%Y-%m-%d %H:%M:%S+00:00', date(match_date) || ' ' || match_time, '-7 hours'),
+    strftime('%Y-%m-%d %H:%M:%S+00:00', date(match_date), '-7 hours')
+);
+
+DROP INDEX IF EXISTS idx_matches_match_date;
+ALTER TABLE matches DROP COLUMN match_date;
+ALTER TABLE matches DROP COLUMN match_time;
+CREATE INDEX IF NOT EXISTS idx_matches_kickoff_at ON matches (kickoff_at);
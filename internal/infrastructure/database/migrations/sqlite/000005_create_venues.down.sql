@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_matches_venue_id;
+ALTER TABLE matches DROP COLUMN venue_id;
+DROP INDEX IF EXISTS idx_teams_home_venue_id;
+ALTER TABLE teams DROP COLUMN home_venue_id;
+DROP TABLE IF EXISTS venues;
@@ -0,0 +1,27 @@
+-- Venues are stadiums shared by teams. A team has an optional home venue,
+-- and a match an optional venue.
+CREATE TABLE IF NOT EXISTS venues (
+    id         text PRIMARY KEY,
+    created_at datetime,
+    updated_at datetime,
+    deleted_at datetime,
+    name       varchar(255) NOT NULL,
+    address    varchar(500),
+    city       varchar(100) NOT NULL,
+    capacity   integer NOT NULL,
+    latitude   real DEFAULT NULL,
+    longitude  real DEFAULT NULL,
+    CONSTRAINT chk_venues_capacity CHECK (capacity > 0),
+    CONSTRAINT chk_venues_latitude CHECK (latitude IS NULL OR latitude BETWEEN -90 AND 90),
+    CONSTRAINT chk_venues_longitude CHECK (longitude IS NULL OR longitude BETWEEN -180 AND 180)
+);
+CREATE INDEX IF NOT EXISTS idx_venues_deleted_at ON venues (deleted_at);
+
+-- SQLite cannot drop a column that has a foreign key, so these references
+-- are left undeclared to keep the migration reversible. The use cases check
+-- that the venue exists.
+ALTER TABLE teams ADD COLUMN home_venue_id text DEFAULT NULL;
+CREATE INDEX IF NOT EXISTS idx_teams_home_venue_id ON teams (home_venue_id);
+
+ALTER TABLE matches ADD COLUMN venue_id text DEFAULT NULL;
+CREATE INDEX IF NOT EXISTS idx_matches_venue_id ON matches (venue_id);
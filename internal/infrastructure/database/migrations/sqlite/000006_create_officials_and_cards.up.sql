@@ -0,0 +1,52 @@
+-- Officials are referees appointed to matches. An official holds at most one
+-- role per match and every role is filled at most once.
+CREATE TABLE IF NOT EXISTS officials (
+    id            text PRIMARY KEY,
+    created_at    datetime,
+    updated_at    datetime,
+    deleted_at    datetime,
+    name          varchar(255) NOT NULL,
+    license_level varchar(20) NOT NULL,
+    city          varchar(100),
+    CONSTRAINT chk_officials_license_level CHECK (license_level IN ('fifa', 'national', 'regional'))
+);
+CREATE INDEX IF NOT EXISTS idx_officials_deleted_at ON officials (deleted_at);
+
+-- Appointments are replaced as a whole and go away with their match.
+-- Officials are soft deleted, so a hard delete of an appointed official is
+-- rejected.
+CREATE TABLE IF NOT EXISTS match_officials (
+    id          text PRIMARY KEY,
+    created_at  datetime,
+    match_id    text NOT NULL,
+    official_id text NOT NULL,
+    role        varchar(20) NOT NULL,
+    CONSTRAINT chk_match_officials_role CHECK (role IN ('referee', 'assistant_1', 'assistant_2', 'fourth_official')),
+    CONSTRAINT fk_match_officials_match FOREIGN KEY (match_id) REFERENCES matches (id) ON DELETE CASCADE,
+    CONSTRAINT fk_match_officials_official FOREIGN KEY (official_id) REFERENCES officials (id) ON DELETE RESTRICT
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_match_officials_role ON match_officials (match_id, role);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_match_officials_official ON match_officials (match_id, official_id);
+CREATE INDEX IF NOT EXISTS idx_match_officials_official_id ON match_officials (official_id);
+
+-- Cards are recorded with the result of a match, like goals
+CREATE TABLE IF NOT EXISTS cards (
+    id         text PRIMARY KEY,
+    created_at datetime,
+    updated_at datetime,
+    deleted_at datetime,
+    match_id   text NOT NULL,
+    player_id  text NOT NULL,
+    team_id    text NOT NULL,
+    minute     integer NOT NULL,
+    type       varchar(10) NOT NULL,
+    CONSTRAINT chk_cards_minute CHECK (minute BETWEEN 1 AND 120),
+    CONSTRAINT chk_cards_type CHECK (type IN ('yellow', 'red')),
+    CONSTRAINT fk_cards_match FOREIGN KEY (match_id) REFERENCES matches (id) ON DELETE CASCADE,
+    CONSTRAINT fk_cards_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE RESTRICT,
+    CONSTRAINT fk_cards_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS idx_cards_deleted_at ON cards (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_cards_match_id ON cards (match_id);
+CREATE INDEX IF NOT EXISTS idx_cards_player_id ON cards (player_id);
+CREATE INDEX IF NOT EXISTS idx_cards_team_id ON cards (team_id);
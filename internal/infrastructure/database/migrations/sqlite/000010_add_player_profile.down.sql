@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_players_date_of_birth;
+ALTER TABLE players DROP COLUMN secondary_positions;
+ALTER TABLE players DROP COLUMN photo;
+ALTER TABLE players DROP COLUMN preferred_foot;
+ALTER TABLE players DROP COLUMN nationality;
+ALTER TABLE players DROP COLUMN date_of_birth;
@@ -0,0 +1,12 @@
+-- Player profile: date of birth, ISO 3166-1 alpha-2 nationality, preferred
+-- foot, photo URL and secondary positions as comma-separated text. Existing
+-- players have none of them, so the text columns default to empty.
+-- SQLite accepts a CHECK constraint on an added column but cannot add one
+-- to the table.
+ALTER TABLE players ADD COLUMN date_of_birth date;
+ALTER TABLE players ADD COLUMN nationality varchar(2) NOT NULL DEFAULT '';
+ALTER TABLE players ADD COLUMN preferred_foot varchar(5) NOT NULL DEFAULT ''
+    CONSTRAINT chk_players_preferred_foot CHECK (preferred_foot IN ('', 'left', 'right', 'both'));
+ALTER TABLE players ADD COLUMN photo varchar(500) NOT NULL DEFAULT '';
+ALTER TABLE players ADD COLUMN secondary_positions varchar(100) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_players_date_of_birth ON players (date_of_birth);
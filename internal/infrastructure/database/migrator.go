@@ -0,0 +1,435 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/database/migrations"
+	"gorm.io/gorm"
+)
+
+const schemaMigrationsTable = "schema_migrations"
+
+// Migration represents a single versioned schema change
+type Migration struct {
+	Version int64
+	Name    string
+	UpSQL   string
+	DownSQL string
+}
+
+// MigrationStatus reports whether a migration has been applied. A dirty
+// migration started but did not finish, see Migrator.Force.
+type MigrationStatus struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	Dirty     bool
+	AppliedAt *time.Time
+}
+
+// schemaMigration is a row of the schema_migrations table. Dirty marks a
+// migration that is being applied or rolled back outside a transaction.
+type schemaMigration struct {
+	Version   int64     `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"size:255;not null"`
+	AppliedAt time.Time `gorm:"not null"`
+	Dirty     bool      `gorm:"not null;default:false"`
+}
+
+// TableName returns the table name for schemaMigration
+func (schemaMigration) TableName() string {
+	return schemaMigrationsTable
+}
+
+// Migrator applies and rolls back versioned SQL migrations. On PostgreSQL and
+// SQLite each migration runs in a transaction. MySQL commits DDL implicitly,
+// so a migration that fails halfway there leaves its version dirty, and the
+// migrator refuses to run until the schema is repaired by hand and the
+// version is resolved with Force.
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// NewMigrator creates a Migrator for the driver of the given connection
+func NewMigrator(db *gorm.DB) (*Migrator, error) {
+	loaded, err := loadMigrations(migrations.FS, db.Dialector.Name())
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: loaded}, nil
+}
+
+// Migrations returns all known migrations ordered by version
+func (m *Migrator) Migrations() []Migration {
+	return m.migrations
+}
+
+// Up applies all pending migrations and returns how many were applied
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	if len(m.migrations) == 0 {
+		return 0, nil
+	}
+	return m.To(ctx, m.migrations[len(m.migrations)-1].Version)
+}
+
+// Down rolls back the given number of most recently applied migrations
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	applied, err := m.cleanVersions(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	rolledBack := 0
+	for i := len(m.migrations) - 1; i >= 0 && rolledBack < steps; i-- {
+		migration := m.migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+		if err := m.rollback(ctx, migration); err != nil {
+			return rolledBack, err
+		}
+		rolledBack++
+	}
+	return rolledBack, nil
+}
+
+// To migrates up or down until exactly the migrations up to version are applied.
+// Version 0 rolls back everything.
+func (m *Migrator) To(ctx context.Context, version int64) (int, error) {
+	if version != 0 && m.find(version) == nil {
+		return 0, fmt.Errorf("unknown migration version %d", version)
+	}
+
+	applied, err := m.cleanVersions(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	changed := 0
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		migration := m.migrations[i]
+		if _, ok := applied[migration.Version]; ok && migration.Version > version {
+			if err := m.rollback(ctx, migration); err != nil {
+				return changed, err
+			}
+			changed++
+		}
+	}
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; !ok && migration.Version <= version {
+			if err := m.apply(ctx, migration); err != nil {
+				return changed, err
+			}
+			changed++
+		}
+	}
+	return changed, nil
+}
+
+// Status lists every known migration along with whether it has been applied
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, len(m.migrations))
+	for i, migration := range m.migrations {
+		statuses[i] = MigrationStatus{
+			Version: migration.Version,
+			Name:    migration.Name,
+		}
+		if row, ok := applied[migration.Version]; ok {
+			appliedAt := row.AppliedAt
+			statuses[i].Applied = !row.Dirty
+			statuses[i].Dirty = row.Dirty
+			statuses[i].AppliedAt = &appliedAt
+		}
+	}
+	return statuses, nil
+}
+
+// Pending returns the migrations that have not been applied yet
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// Force resolves a dirty migration after its schema changes were finished or
+// undone by hand, recording it as applied or as not applied
+func (m *Migrator) Force(ctx context.Context, version int64, applied bool) error {
+	migration := m.find(version)
+	if migration == nil {
+		return fmt.Errorf("unknown migration version %d", version)
+	}
+	if _, err := m.appliedVersions(ctx); err != nil {
+		return err
+	}
+
+	db := m.db.WithContext(ctx)
+	if !applied {
+		return db.Delete(&schemaMigration{}, "version = ?", version).Error
+	}
+	return db.Save(&schemaMigration{
+		Version:   migration.Version,
+		Name:      migration.Name,
+		AppliedAt: time.Now().UTC(),
+	}).Error
+}
+
+func (m *Migrator) apply(ctx context.Context, migration Migration) error {
+	row := &schemaMigration{
+		Version:   migration.Version,
+		Name:      migration.Name,
+		AppliedAt: time.Now().UTC(),
+	}
+	err := m.run(ctx, migration.UpSQL,
+		func(tx *gorm.DB) error {
+			row.Dirty = true
+			return tx.Create(row).Error
+		},
+		func(tx *gorm.DB) error {
+			row.Dirty = false
+			return tx.Save(row).Error
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("migration %06d_%s up: %w", migration.Version, migration.Name, err)
+	}
+	return nil
+}
+
+func (m *Migrator) rollback(ctx context.Context, migration Migration) error {
+	err := m.run(ctx, migration.DownSQL,
+		func(tx *gorm.DB) error {
+			return tx.Model(&schemaMigration{}).Where("version = ?", migration.Version).Update("dirty", true).Error
+		},
+		func(tx *gorm.DB) error {
+			return tx.Delete(&schemaMigration{}, "version = ?", migration.Version).Error
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("migration %06d_%s down: %w", migration.Version, migration.Name, err)
+	}
+	return nil
+}
+
+// run executes script between marking its version dirty and recording the
+// outcome. Where DDL is transactional everything runs in one transaction and
+// the dirty mark never becomes visible.
+func (m *Migrator) run(ctx context.Context, script string, start, finish func(tx *gorm.DB) error) error {
+	steps := func(tx *gorm.DB) error {
+		if err := start(tx); err != nil {
+			return err
+		}
+		if err := execScript(tx, script); err != nil {
+			return err
+		}
+		return finish(tx)
+	}
+
+	db := m.db.WithContext(ctx)
+	if db.Dialector.Name() == "mysql" {
+		return steps(db)
+	}
+	return db.Transaction(steps)
+}
+
+// cleanVersions returns the applied versions like appliedVersions, or an
+// error if a migration did not finish
+func (m *Migrator) cleanVersions(ctx context.Context) (map[int64]schemaMigration, error) {
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range applied {
+		if row.Dirty {
+			return nil, fmt.Errorf(
+				"migration %06d_%s did not finish and may have left the schema partly changed; "+
+					"complete or undo it by hand, then run `migrate force %d` or `migrate force %d pending`",
+				row.Version, row.Name, row.Version, row.Version)
+		}
+	}
+	return applied, nil
+}
+
+// appliedVersions ensures the schema_migrations table exists and returns its rows by version
+func (m *Migrator) appliedVersions(ctx context.Context) (map[int64]schemaMigration, error) {
+	db := m.db.WithContext(ctx)
+	if err := db.Exec(
+		"CREATE TABLE IF NOT EXISTS " + schemaMigrationsTable +
+			" (version BIGINT NOT NULL PRIMARY KEY, name VARCHAR(255) NOT NULL, applied_at TIMESTAMP NOT NULL, dirty BOOLEAN NOT NULL DEFAULT FALSE)",
+	).Error; err != nil {
+		return nil, fmt.Errorf("failed to create %s table: %w", schemaMigrationsTable, err)
+	}
+	// Tables created before dirty tracking lack the column
+	if !db.Migrator().HasColumn(&schemaMigration{}, "dirty") {
+		if err := db.Exec("ALTER TABLE " + schemaMigrationsTable + " ADD COLUMN dirty BOOLEAN NOT NULL DEFAULT FALSE").Error; err != nil {
+			return nil, fmt.Errorf("failed to add dirty column to %s: %w", schemaMigrationsTable, err)
+		}
+	}
+
+	var rows []schemaMigration
+	if err := db.Order("version ASC").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int64]schemaMigration, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+	return applied, nil
+}
+
+func (m *Migrator) find(version int64) *Migration {
+	for i := range m.migrations {
+		if m.migrations[i].Version == version {
+			return &m.migrations[i]
+		}
+	}
+	return nil
+}
+
+// loadMigrations reads and pairs the up/down files in the driver's directory
+func loadMigrations(fsys fs.FS, driver string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, driver)
+	if err != nil {
+		return nil, fmt.Errorf("no migrations for driver %s: %w", driver, err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		fileName := entry.Name()
+		var direction string
+		switch {
+		case strings.HasSuffix(fileName, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(fileName, ".down.sql"):
+			direction = "down"
+		default:
+			continue
+		}
+
+		base := strings.TrimSuffix(fileName, "."+direction+".sql")
+		versionStr, name, found := strings.Cut(base, "_")
+		if !found {
+			return nil, fmt.Errorf("invalid migration file name: %s", fileName)
+		}
+		version, err := strconv.ParseInt(versionStr, 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version in %s", fileName)
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(driver, fileName))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		} else if migration.Name != name {
+			return nil, fmt.Errorf("migration version %d has conflicting names %q and %q", version, migration.Name, name)
+		}
+
+		if direction == "up" {
+			migration.UpSQL = string(content)
+		} else {
+			migration.DownSQL = string(content)
+		}
+	}
+
+	result := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.UpSQL == "" || migration.DownSQL == "" {
+			return nil, fmt.Errorf("migration %06d_%s must have both up and down files", migration.Version, migration.Name)
+		}
+		result = append(result, *migration)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+	return result, nil
+}
+
+// execScript executes every statement of a SQL script in order
+func execScript(tx *gorm.DB, script string) error {
+	for _, statement := range splitStatements(script) {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitStatements splits a SQL script on semicolons, ignoring those inside
+// quoted strings, dollar-quoted bodies and comments. Both -- line comments
+// and /* */ block comments are dropped. Drivers differ on whether they accept
+// multiple statements per call, so each runs separately.
+func splitStatements(script string) []string {
+	var statements []string
+	var current strings.Builder
+	inSingleQuote, inDollarQuote := false, false
+
+	flush := func() {
+		statement := strings.TrimSpace(current.String())
+		if statement != "" {
+			statements = append(statements, statement)
+		}
+		current.Reset()
+	}
+
+	for i := 0; i < len(script); i++ {
+		ch := script[i]
+		switch {
+		case !inSingleQuote && !inDollarQuote && ch == '-' && i+1 < len(script) && script[i+1] == '-':
+			// Skip line comment
+			for i < len(script) && script[i] != '\n' {
+				i++
+			}
+			current.WriteByte('\n')
+			continue
+		case !inSingleQuote && !inDollarQuote && ch == '/' && i+1 < len(script) && script[i+1] == '*':
+			// Skip block comment
+			end := strings.Index(script[i+2:], "*/")
+			if end < 0 {
+				i = len(script)
+			} else {
+				i += 2 + end + 1
+			}
+			current.WriteByte(' ')
+			continue
+		case !inDollarQuote && ch == '\'':
+			inSingleQuote = !inSingleQuote
+		case !inSingleQuote && ch == '$' && i+1 < len(script) && script[i+1] == '$':
+			inDollarQuote = !inDollarQuote
+			current.WriteString("$$")
+			i++
+			continue
+		case !inSingleQuote && !inDollarQuote && ch == ';':
+			flush()
+			continue
+		}
+		current.WriteByte(ch)
+	}
+	flush()
+
+	return statements
+}
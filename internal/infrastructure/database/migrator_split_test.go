@@ -0,0 +1,58 @@
+package database
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitStatements(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		want   []string
+	}{
+		{
+			name:   "statements",
+			script: "CREATE TABLE a (id INT);\nCREATE TABLE b (id INT);\n",
+			want:   []string{"CREATE TABLE a (id INT)", "CREATE TABLE b (id INT)"},
+		},
+		{
+			name:   "quoted semicolon",
+			script: "INSERT INTO a VALUES ('x;y');",
+			want:   []string{"INSERT INTO a VALUES ('x;y')"},
+		},
+		{
+			name:   "dollar-quoted body",
+			script: "CREATE FUNCTION f() RETURNS void AS $$ BEGIN PERFORM 1; END $$ LANGUAGE plpgsql;",
+			want:   []string{"CREATE FUNCTION f() RETURNS void AS $$ BEGIN PERFORM 1; END $$ LANGUAGE plpgsql"},
+		},
+		{
+			name:   "line comment",
+			script: "-- drop a; then b\nDROP TABLE a;",
+			want:   []string{"DROP TABLE a"},
+		},
+		{
+			name:   "block comment",
+			script: "/* drop a;\n   then b; */\nDROP TABLE a /* ; */;\nDROP TABLE b;",
+			want:   []string{"DROP TABLE a", "DROP TABLE b"},
+		},
+		{
+			name:   "comment markers in strings",
+			script: "INSERT INTO a VALUES ('/* x', '-- y');",
+			want:   []string{"INSERT INTO a VALUES ('/* x', '-- y')"},
+		},
+		{
+			name:   "only comments",
+			script: "-- nothing\n/* to do; */\n",
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitStatements(tt.script); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("splitStatements(%q) = %q, want %q", tt.script, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/config"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/database"
+)
+
+// Matches created before 000004 stored a date and a free-form local time.
+// The migration turns them into a UTC kickoff, and rolling it back restores
+// the local date and time.
+func TestMigrateMatchKickoff(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Database.Driver = "sqlite"
+	cfg.Database.Name = ":memory:"
+	cfg.Server.Mode = "release"
+
+	db, err := database.NewDatabase(cfg)
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %v", err)
+	}
+	t.Cleanup(func() { closeDB(db) })
+
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	ctx := context.Background()
+	if _, err := migrator.To(ctx, 3); err != nil {
+		t.Fatalf("failed to roll back to 000003: %v", err)
+	}
+
+	now := time.Now().UTC()
+	home, away := uuid.New(), uuid.New()
+	for _, id := range []uuid.UUID{home, away} {
+		err := db.Exec("INSERT INTO teams (id, created_at, updated_at, name, founded_year, city) VALUES (?, ?, ?, ?, ?, ?)",
+			id.String(), now, now, "Team "+id.String()[:8], 1928, "Jakarta").Error
+		if err != nil {
+			t.Fatalf("insert team: %v", err)
+		}
+	}
+	evening, unparsed := uuid.New(), uuid.New()
+	rows := []struct {
+		id   uuid.UUID
+		time string
+	}{
+		{evening, "19:30"},
+		{unparsed, "TBA"},
+	}
+	for _, row := range rows {
+		err := db.Exec("INSERT INTO matches (id, created_at, updated_at, match_date, match_time, home_team_id, away_team_id) VALUES (?, ?, ?, ?, ?, ?, ?)",
+			row.id.String(), now, now, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), row.time, home.String(), away.String()).Error
+		if err != nil {
+			t.Fatalf("insert match: %v", err)
+		}
+	}
+
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("failed to migrate up: %v", err)
+	}
+
+	matches := database.NewMatchRepository(db)
+	want := map[uuid.UUID]time.Time{
+		evening:  time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC),
+		unparsed: time.Date(2024, 4, 30, 17, 0, 0, 0, time.UTC),
+	}
+	for id, kickoff := range want {
+		match, err := matches.FindByID(ctx, id)
+		if err != nil {
+			t.Fatalf("find match: %v", err)
+		}
+		if !match.KickoffAt.Equal(kickoff) || match.Timezone != entity.DefaultTimezone {
+			t.Fatalf("expected kickoff %v in %s, got %v in %s", kickoff, entity.DefaultTimezone, match.KickoffAt, match.Timezone)
+		}
+	}
+
+	if _, err := migrator.To(ctx, 3); err != nil {
+		t.Fatalf("failed to roll back 000004: %v", err)
+	}
+	var restored struct {
+		MatchDate time.Time
+		MatchTime string
+	}
+	if err := db.Raw("SELECT match_date, match_time FROM matches WHERE id = ?", evening.String()).Scan(&restored).Error; err != nil {
+		t.Fatalf("read restored match: %v", err)
+	}
+	if restored.MatchDate.Format(time.DateOnly) != "2024-05-01" || restored.MatchTime != "19:30" {
+		t.Fatalf("expected 2024-05-01 19:30, got %s %s", restored.MatchDate.Format(time.DateOnly), restored.MatchTime)
+	}
+}
+
+// Players created before 000007 get a current contract at their team that
+// covers all their past matches
+func TestMigratePlayerContracts(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Database.Driver = "sqlite"
+	cfg.Database.Name = ":memory:"
+	cfg.Server.Mode = "release"
+
+	db, err := database.NewDatabase(cfg)
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %v", err)
+	}
+	t.Cleanup(func() { closeDB(db) })
+
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	ctx := context.Background()
+	if _, err := migrator.To(ctx, 6); err != nil {
+		t.Fatalf("failed to roll back to 000006: %v", err)
+	}
+
+	now := time.Now().UTC()
+	team, player := uuid.New(), uuid.New()
+	err = db.Exec("INSERT INTO teams (id, created_at, updated_at, name, founded_year, city) VALUES (?, ?, ?, ?, ?, ?)",
+		team.String(), now, now, "Persija", 1928, "Jakarta").Error
+	if err != nil {
+		t.Fatalf("insert team: %v", err)
+	}
+	err = db.Exec("INSERT INTO players (id, created_at, updated_at, team_id, name, height, weight, position, jersey_number) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		player.String(), now, now, team.String(), "Rizky Ridho", 183, 75, "defender", 5).Error
+	if err != nil {
+		t.Fatalf("insert player: %v", err)
+	}
+
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("failed to migrate up: %v", err)
+	}
+
+	contracts := database.NewPlayerContractRepository(db)
+	contract, err := contracts.FindAsOf(ctx, player, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("find contract: %v", err)
+	}
+	if contract.TeamID != team || contract.JerseyNumber != 5 || !contract.IsCurrent() {
+		t.Fatalf("expected a current contract at the team with number 5, got %+v", contract)
+	}
+
+	if _, err := migrator.To(ctx, 6); err != nil {
+		t.Fatalf("failed to roll back 000007: %v", err)
+	}
+}
+
+// A migration that failed outside a transaction stays dirty, and nothing
+// runs until it is resolved with Force. Tables from before dirty tracking
+// gain the column.
+func TestMigrateDirtyVersion(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Database.Driver = "sqlite"
+	cfg.Database.Name = ":memory:"
+	cfg.Server.Mode = "release"
+
+	db, err := database.NewDatabase(cfg)
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %v", err)
+	}
+	t.Cleanup(func() { closeDB(db) })
+
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	ctx := context.Background()
+	if _, err := migrator.To(ctx, 0); err != nil {
+		t.Fatalf("failed to roll back everything: %v", err)
+	}
+	if err := db.Exec("DROP TABLE schema_migrations").Error; err != nil {
+		t.Fatalf("drop schema_migrations: %v", err)
+	}
+	err = db.Exec("CREATE TABLE schema_migrations (version BIGINT NOT NULL PRIMARY KEY, name VARCHAR(255) NOT NULL, applied_at TIMESTAMP NOT NULL)").Error
+	if err != nil {
+		t.Fatalf("create old schema_migrations: %v", err)
+	}
+	if _, err := migrator.To(ctx, 1); err != nil {
+		t.Fatalf("failed to migrate to 000001: %v", err)
+	}
+
+	if err := db.Exec("UPDATE schema_migrations SET dirty = ? WHERE version = 1", true).Error; err != nil {
+		t.Fatalf("mark 000001 dirty: %v", err)
+	}
+	if _, err := migrator.Up(ctx); err == nil {
+		t.Fatal("expected up to refuse to run with a dirty migration")
+	}
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	if !statuses[0].Dirty || statuses[0].Applied {
+		t.Fatalf("expected 000001 to be dirty, got %+v", statuses[0])
+	}
+
+	if err := migrator.Force(ctx, 1, true); err != nil {
+		t.Fatalf("force 000001: %v", err)
+	}
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("failed to migrate up after force: %v", err)
+	}
+	pending, err := migrator.Pending(ctx)
+	if err != nil {
+		t.Fatalf("pending: %v", err)
+	}
+	if len(pending) != 0 {
+		t.Fatalf("expected no pending migrations, got %d", len(pending))
+	}
+}
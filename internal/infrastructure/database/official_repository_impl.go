@@ -0,0 +1,104 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+	"gorm.io/gorm"
+)
+
+type officialRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewOfficialRepository creates a new instance of OfficialRepository
+func NewOfficialRepository(db *gorm.DB) repository.OfficialRepository {
+	return &officialRepositoryImpl{db: db}
+}
+
+func (r *officialRepositoryImpl) Create(ctx context.Context, official *entity.Official) error {
+	return translateError(r.db.WithContext(ctx).Create(official).Error)
+}
+
+func (r *officialRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Official, error) {
+	var official entity.Official
+	err := r.db.WithContext(ctx).First(&official, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &official, nil
+}
+
+func (r *officialRepositoryImpl) Update(ctx context.Context, official *entity.Official) error {
+	return translateError(r.db.WithContext(ctx).Save(official).Error)
+}
+
+func (r *officialRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return translateError(r.db.WithContext(ctx).Delete(&entity.Official{}, "id = ?", id).Error)
+}
+
+// officialList maps OfficialListSchema to the columns of the officials table
+var officialList = listSpec{
+	table:  "officials",
+	schema: repository.OfficialListSchema,
+	columns: map[string]column{
+		"name":          {expr: "officials.name"},
+		"city":          {expr: "officials.city"},
+		"license_level": {expr: "officials.license_level"},
+		"created_at":    {expr: "officials.created_at"},
+	},
+}
+
+func (r *officialRepositoryImpl) List(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Official, int64, error) {
+	return list[entity.Official](ctx, r.db, officialList, query, page, limit)
+}
+
+func (r *officialRepositoryImpl) Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Official], error) {
+	return scroll(ctx, r.db, officialList, repository.OfficialListReader, query, window)
+}
+
+func (r *officialRepositoryImpl) FindAvailable(ctx context.Context, from, to time.Time, exceptMatchID uuid.UUID, page, limit int) ([]entity.Official, int64, error) {
+	var officials []entity.Official
+	var total int64
+
+	offset := (page - 1) * limit
+	appointed := r.db.Model(&entity.MatchOfficial{}).
+		Select("match_officials.official_id").
+		Joins("JOIN matches ON matches.id = match_officials.match_id").
+		Where("matches.deleted_at IS NULL AND matches.status <> ?", entity.MatchStatusCancelled).
+		Where("matches.kickoff_at > ? AND matches.kickoff_at < ?", from.UTC(), to.UTC()).
+		Where("match_officials.match_id <> ?", exceptMatchID)
+
+	err := r.db.WithContext(ctx).
+		Model(&entity.Official{}).
+		Where("id NOT IN (?)", appointed).
+		Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = r.db.WithContext(ctx).
+		Where("id NOT IN (?)", appointed).
+		Offset(offset).
+		Limit(limit).
+		Order("name ASC").
+		Find(&officials).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return officials, total, nil
+}
+
+func (r *officialRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entity.Official{}).
+		Where("id = ?", id).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,67 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type playerAvailabilityRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewPlayerAvailabilityRepository creates a new instance of PlayerAvailabilityRepository
+func NewPlayerAvailabilityRepository(db *gorm.DB) repository.PlayerAvailabilityRepository {
+	return &playerAvailabilityRepositoryImpl{db: db}
+}
+
+func (r *playerAvailabilityRepositoryImpl) Create(ctx context.Context, availability *entity.PlayerAvailability) error {
+	return translateError(r.db.WithContext(ctx).Create(availability).Error)
+}
+
+func (r *playerAvailabilityRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.PlayerAvailability, error) {
+	var availability entity.PlayerAvailability
+	err := r.db.WithContext(ctx).First(&availability, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &availability, nil
+}
+
+func (r *playerAvailabilityRepositoryImpl) Update(ctx context.Context, availability *entity.PlayerAvailability) error {
+	return translateError(r.db.WithContext(ctx).Omit("Player").Save(availability).Error)
+}
+
+func (r *playerAvailabilityRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return translateError(r.db.WithContext(ctx).Delete(&entity.PlayerAvailability{}, "id = ?", id).Error)
+}
+
+func (r *playerAvailabilityRepositoryImpl) FindByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.PlayerAvailability, error) {
+	var records []entity.PlayerAvailability
+	err := r.db.WithContext(ctx).
+		Where("player_id = ?", playerID).
+		Order("starts_at DESC").
+		Find(&records).Error
+	return records, err
+}
+
+func (r *playerAvailabilityRepositoryImpl) FindUnavailableAt(ctx context.Context, playerIDs []uuid.UUID, at time.Time) ([]entity.PlayerAvailability, error) {
+	records := []entity.PlayerAvailability{}
+	if len(playerIDs) == 0 {
+		return records, nil
+	}
+
+	at = at.UTC()
+	err := r.db.WithContext(ctx).
+		Preload("Player", withDeleted).
+		Where("player_id IN ?", playerIDs).
+		Where("starts_at <= ?", at).
+		Where("(returned_at IS NOT NULL AND returned_at > ?) OR (returned_at IS NULL AND (expected_return_at IS NULL OR expected_return_at > ?))", at, at).
+		Order("starts_at ASC").
+		Find(&records).Error
+	return records, err
+}
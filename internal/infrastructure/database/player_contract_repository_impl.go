@@ -0,0 +1,121 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type playerContractRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewPlayerContractRepository creates a new instance of PlayerContractRepository
+func NewPlayerContractRepository(db *gorm.DB) repository.PlayerContractRepository {
+	return &playerContractRepositoryImpl{db: db}
+}
+
+func (r *playerContractRepositoryImpl) FindByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.PlayerContract, error) {
+	var contracts []entity.PlayerContract
+	// A contract can only start after the previous one, so they are created
+	// in order
+	err := r.db.WithContext(ctx).
+		Preload("Team", withDeleted).
+		Where("player_id = ?", playerID).
+		Order("created_at ASC").
+		Find(&contracts).Error
+	return contracts, err
+}
+
+func (r *playerContractRepositoryImpl) FindCurrent(ctx context.Context, playerID uuid.UUID) (*entity.PlayerContract, error) {
+	var contract entity.PlayerContract
+	err := r.db.WithContext(ctx).
+		Where("player_id = ? AND ends_at IS NULL", playerID).
+		First(&contract).Error
+	if err != nil {
+		return nil, err
+	}
+	return &contract, nil
+}
+
+func (r *playerContractRepositoryImpl) FindAsOf(ctx context.Context, playerID uuid.UUID, at time.Time) (*entity.PlayerContract, error) {
+	var contract entity.PlayerContract
+	err := r.db.WithContext(ctx).
+		Scopes(coveringContracts(at)).
+		Where("player_id = ?", playerID).
+		First(&contract).Error
+	if err != nil {
+		return nil, err
+	}
+	return &contract, nil
+}
+
+func (r *playerContractRepositoryImpl) FindByTeamAsOf(ctx context.Context, teamID uuid.UUID, at time.Time, page, limit int) ([]entity.PlayerContract, int64, error) {
+	var contracts []entity.PlayerContract
+	var total int64
+
+	offset := (page - 1) * limit
+	roster := func(db *gorm.DB) *gorm.DB {
+		return db.Scopes(coveringContracts(at)).
+			Joins("JOIN players ON players.id = player_contracts.player_id AND players.deleted_at IS NULL").
+			Where("player_contracts.team_id = ?", teamID)
+	}
+
+	err := r.db.WithContext(ctx).Model(&entity.PlayerContract{}).Scopes(roster).Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = r.db.WithContext(ctx).
+		Scopes(roster).
+		Preload("Player").
+		Offset(offset).
+		Limit(limit).
+		Order("player_contracts.jersey_number ASC").
+		Find(&contracts).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return contracts, total, nil
+}
+
+func (r *playerContractRepositoryImpl) Transfer(ctx context.Context, contract *entity.PlayerContract) error {
+	return translateError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.PlayerContract{}).
+			Where("player_id = ? AND ends_at IS NULL", contract.PlayerID).
+			Update("ends_at", contract.StartsAt.UTC())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := tx.Omit("Player", "Team").Create(contract).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&entity.Player{}).
+			Where("id = ?", contract.PlayerID).
+			Updates(map[string]interface{}{
+				"team_id":       contract.TeamID,
+				"jersey_number": contract.JerseyNumber,
+			}).Error
+	}))
+}
+
+// coveringContracts limits a query to the contracts that cover the given
+// time. Starts are inclusive and ends exclusive.
+func coveringContracts(at time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		at := at.UTC()
+		return db.
+			Where("player_contracts.starts_at IS NULL OR player_contracts.starts_at <= ?", at).
+			Where("player_contracts.ends_at IS NULL OR player_contracts.ends_at > ?", at)
+	}
+}
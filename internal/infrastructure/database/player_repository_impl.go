@@ -2,10 +2,14 @@ package database
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+	"github.com/zenkriztao/ayo-football-backend/pkg/textsearch"
 	"gorm.io/gorm"
 )
 
@@ -19,7 +23,39 @@ func NewPlayerRepository(db *gorm.DB) repository.PlayerRepository {
 }
 
 func (r *playerRepositoryImpl) Create(ctx context.Context, player *entity.Player) error {
-	return r.db.WithContext(ctx).Create(player).Error
+	return translateError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(player).Error; err != nil {
+			return err
+		}
+		contract := firstContract(player)
+		return tx.Create(&contract).Error
+	}))
+}
+
+func (r *playerRepositoryImpl) CreateBatch(ctx context.Context, players []entity.Player) error {
+	if len(players) == 0 {
+		return nil
+	}
+	return translateError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&players).Error; err != nil {
+			return err
+		}
+		contracts := make([]entity.PlayerContract, len(players))
+		for i := range players {
+			contracts[i] = firstContract(&players[i])
+		}
+		return tx.Create(&contracts).Error
+	}))
+}
+
+// firstContract returns the contract a new player starts with. It has no
+// start, so it covers the matches played before the player was registered.
+func firstContract(player *entity.Player) entity.PlayerContract {
+	return entity.PlayerContract{
+		PlayerID:     player.ID,
+		TeamID:       player.TeamID,
+		JerseyNumber: player.JerseyNumber,
+	}
 }
 
 func (r *playerRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Player, error) {
@@ -32,9 +68,13 @@ func (r *playerRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*ent
 }
 
 func (r *playerRepositoryImpl) FindByIDWithTeam(ctx context.Context, id uuid.UUID) (*entity.Player, error) {
+	return r.FindByIDWith(ctx, id, listquery.NewInclude("team"))
+}
+
+func (r *playerRepositoryImpl) FindByIDWith(ctx context.Context, id uuid.UUID, include listquery.Include) (*entity.Player, error) {
 	var player entity.Player
 	err := r.db.WithContext(ctx).
-		Preload("Team").
+		Scopes(playerRelations.scope(include)).
 		First(&player, "id = ?", id).Error
 	if err != nil {
 		return nil, err
@@ -43,62 +83,95 @@ func (r *playerRepositoryImpl) FindByIDWithTeam(ctx context.Context, id uuid.UUI
 }
 
 func (r *playerRepositoryImpl) Update(ctx context.Context, player *entity.Player) error {
-	return r.db.WithContext(ctx).Save(player).Error
+	return translateError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(player).Error; err != nil {
+			return err
+		}
+		return tx.Model(&entity.PlayerContract{}).
+			Where("player_id = ? AND ends_at IS NULL", player.ID).
+			Update("jersey_number", player.JerseyNumber).Error
+	}))
 }
 
 func (r *playerRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&entity.Player{}, "id = ?", id).Error
+	return translateError(r.db.WithContext(ctx).Delete(&entity.Player{}, "id = ?", id).Error)
 }
 
-func (r *playerRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]entity.Player, int64, error) {
-	var players []entity.Player
-	var total int64
-
-	offset := (page - 1) * limit
+// playerList maps PlayerListSchema to the columns of the players table
+var playerList = listSpec{
+	table:  "players",
+	schema: repository.PlayerListSchema,
+	columns: map[string]column{
+		"name":           {expr: "players.name"},
+		"team_id":        {expr: "players.team_id"},
+		"position":       {where: positionWhere},
+		"nationality":    {expr: "players.nationality"},
+		"preferred_foot": {expr: "players.preferred_foot"},
+		"height":         {expr: "players.height"},
+		"weight":         {expr: "players.weight"},
+		"jersey_number":  {expr: "players.jersey_number"},
+		"date_of_birth":  {expr: "players.date_of_birth", nullable: true},
+		"created_at":     {expr: "players.created_at"},
+		"team.name":      {expr: "team.name", join: "LEFT JOIN teams team ON team.id = players.team_id"},
+	},
+}
 
-	err := r.db.WithContext(ctx).Model(&entity.Player{}).Count(&total).Error
-	if err != nil {
-		return nil, 0, err
+// positionWhere matches the primary or a secondary position. Secondary
+// positions are comma-separated, so whole items are matched.
+func positionWhere(op listquery.Operator, value interface{}) (string, []interface{}) {
+	positions := []interface{}{value}
+	if op == listquery.In {
+		positions = value.([]interface{})
 	}
-
-	err = r.db.WithContext(ctx).
-		Preload("Team").
-		Offset(offset).
-		Limit(limit).
-		Order("created_at DESC").
-		Find(&players).Error
-	if err != nil {
-		return nil, 0, err
+	clauses := make([]string, len(positions))
+	var args []interface{}
+	for i, p := range positions {
+		position := p.(string)
+		clauses[i] = "players.position = ? OR players.secondary_positions = ? OR players.secondary_positions LIKE ? OR players.secondary_positions LIKE ? OR players.secondary_positions LIKE ?"
+		args = append(args, position, position, position+",%", "%,"+position, "%,"+position+",%")
 	}
-
-	return players, total, nil
+	return "(" + strings.Join(clauses, " OR ") + ")", args
 }
 
-func (r *playerRepositoryImpl) FindByTeamID(ctx context.Context, teamID uuid.UUID, page, limit int) ([]entity.Player, int64, error) {
-	var players []entity.Player
-	var total int64
+// playerRelations preloads the relations of PlayerListSchema. The team is
+// preloaded even when soft-deleted.
+var playerRelations = relations{
+	"team": preload("Team", withDeleted),
+}
 
-	offset := (page - 1) * limit
+func (r *playerRepositoryImpl) List(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Player, int64, error) {
+	return list[entity.Player](ctx, r.db, playerList, query, page, limit, playerRelations.scope(query.Include))
+}
 
-	err := r.db.WithContext(ctx).
-		Model(&entity.Player{}).
-		Where("team_id = ?", teamID).
-		Count(&total).Error
+func (r *playerRepositoryImpl) Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Player], error) {
+	include := query.Include.With(query.SortRelations(playerList.schema)...)
+	slice, err := scroll(ctx, r.db, playerList, repository.PlayerListReader, query, window, playerRelations.scope(include))
 	if err != nil {
-		return nil, 0, err
+		return slice, err
 	}
-
-	err = r.db.WithContext(ctx).
-		Where("team_id = ?", teamID).
-		Offset(offset).
-		Limit(limit).
-		Order("jersey_number ASC").
-		Find(&players).Error
-	if err != nil {
-		return nil, 0, err
+	// The team a cursor was read from is only returned when included
+	if !query.Include.Has("team") {
+		for i := range slice.Items {
+			slice.Items[i].Team = nil
+		}
 	}
+	return slice, nil
+}
 
-	return players, total, nil
+// playerSearch is the text the player search matches
+var playerSearch = searchSpec{table: "players", columns: []string{"name"}}
+
+func (r *playerRepositoryImpl) Search(ctx context.Context, text string, limit int) ([]textsearch.Hit[entity.Player], error) {
+	return search(ctx, r.db, playerSearch, text, limit, repository.PlayerListReader.ID, func(db *gorm.DB) *gorm.DB {
+		return db.Preload("Team", withDeleted)
+	})
+}
+
+func (r *playerRepositoryImpl) FindByTeamID(ctx context.Context, teamID uuid.UUID, page, limit int) ([]entity.Player, int64, error) {
+	query := listquery.Query{}.
+		Where("team_id", listquery.Eq, teamID).
+		OrderBy(listquery.Asc("jersey_number"))
+	return list[entity.Player](ctx, r.db, playerList, query, page, limit)
 }
 
 func (r *playerRepositoryImpl) IsJerseyNumberTaken(ctx context.Context, teamID uuid.UUID, jerseyNumber int, excludePlayerID *uuid.UUID) (bool, error) {
@@ -115,33 +188,13 @@ func (r *playerRepositoryImpl) IsJerseyNumberTaken(ctx context.Context, teamID u
 	return count > 0, err
 }
 
-func (r *playerRepositoryImpl) Search(ctx context.Context, query string, page, limit int) ([]entity.Player, int64, error) {
-	var players []entity.Player
-	var total int64
-
-	offset := (page - 1) * limit
-	searchQuery := "%" + query + "%"
-
-	err := r.db.WithContext(ctx).
-		Model(&entity.Player{}).
-		Where("name ILIKE ?", searchQuery).
-		Count(&total).Error
-	if err != nil {
-		return nil, 0, err
-	}
-
-	err = r.db.WithContext(ctx).
-		Preload("Team").
-		Where("name ILIKE ?", searchQuery).
-		Offset(offset).
-		Limit(limit).
-		Order("created_at DESC").
-		Find(&players).Error
-	if err != nil {
-		return nil, 0, err
-	}
-
-	return players, total, nil
+func (r *playerRepositoryImpl) FindInBatches(ctx context.Context, batchSize int, fn func(players []entity.Player) error) error {
+	var batch []entity.Player
+	return r.db.WithContext(ctx).
+		Preload("Team", withDeleted).
+		FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {
+			return fn(batch)
+		}).Error
 }
 
 func (r *playerRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
@@ -160,7 +213,7 @@ func (r *playerRepositoryImpl) GetTopScorers(ctx context.Context, limit int) ([]
 		Table("goals").
 		Select("players.*, COUNT(goals.id) as goal_count").
 		Joins("JOIN players ON players.id = goals.player_id").
-		Where("goals.deleted_at IS NULL AND players.deleted_at IS NULL").
+		Where("goals.deleted_at IS NULL").
 		Group("players.id").
 		Order("goal_count DESC").
 		Limit(limit).
@@ -168,3 +221,109 @@ func (r *playerRepositoryImpl) GetTopScorers(ctx context.Context, limit int) ([]
 
 	return results, err
 }
+
+func (r *playerRepositoryImpl) FindDeleted(ctx context.Context, page, limit int) ([]entity.Player, int64, error) {
+	var players []entity.Player
+	var total int64
+
+	offset := (page - 1) * limit
+
+	err := r.db.WithContext(ctx).Model(&entity.Player{}).Scopes(onlyDeleted).Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = r.db.WithContext(ctx).
+		Scopes(onlyDeleted).
+		Preload("Team", withDeleted).
+		Offset(offset).
+		Limit(limit).
+		Order("deleted_at DESC").
+		Find(&players).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return players, total, nil
+}
+
+func (r *playerRepositoryImpl) Restore(ctx context.Context, id uuid.UUID) error {
+	return translateError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var player entity.Player
+		if err := tx.Scopes(onlyDeleted).First(&player, "id = ?", id).Error; err != nil {
+			return err
+		}
+
+		// A player cannot come back into a team that is itself in the trash
+		var teams int64
+		if err := tx.Model(&entity.Team{}).Where("id = ?", player.TeamID).Count(&teams).Error; err != nil {
+			return err
+		}
+		if teams == 0 {
+			return repository.ErrReferenceNotFound
+		}
+
+		return tx.Unscoped().Model(&entity.Player{}).
+			Where("id = ?", id).
+			Update("deleted_at", nil).Error
+	}))
+}
+
+func (r *playerRepositoryImpl) Purge(ctx context.Context, id uuid.UUID) error {
+	return translateError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var player entity.Player
+		if err := tx.Scopes(onlyDeleted).First(&player, "id = ?", id).Error; err != nil {
+			return err
+		}
+
+		var references int64
+		err := tx.Unscoped().Model(&entity.Player{}).
+			Where("id = ?", id).
+			Where(playerReferencedCondition).
+			Count(&references).Error
+		if err != nil {
+			return err
+		}
+		if references > 0 {
+			return repository.ErrStillReferenced
+		}
+
+		if err := tx.Delete(&entity.PlayerContract{}, "player_id = ?", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Delete(&entity.PlayerAvailability{}, "player_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&entity.Player{}, "id = ?", id).Error
+	}))
+}
+
+func (r *playerRepositoryImpl) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	var purged int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		expired := tx.Model(&entity.Player{}).
+			Scopes(deletedBefore(cutoff)).
+			Not(playerReferencedCondition).
+			Select("id")
+
+		// Contracts and availability records go with their player
+		if err := tx.Where("player_id IN (?)", expired).Delete(&entity.PlayerContract{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("player_id IN (?)", expired).Delete(&entity.PlayerAvailability{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Scopes(deletedBefore(cutoff)).
+			Not(playerReferencedCondition).
+			Delete(&entity.Player{})
+		purged = result.RowsAffected
+		return result.Error
+	})
+	return purged, translateError(err)
+}
+
+// playerReferencedCondition matches players that goals or cards, including
+// soft-deleted ones, still point at
+const playerReferencedCondition = "(EXISTS (SELECT 1 FROM goals WHERE goals.player_id = players.id) OR " +
+	"EXISTS (SELECT 1 FROM cards WHERE cards.player_id = players.id))"
@@ -1,9 +1,12 @@
 package database
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 
+	"github.com/glebarez/sqlite"
 	"github.com/zenkriztao/ayo-football-backend/internal/config"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
 	"gorm.io/driver/mysql"
@@ -41,8 +44,9 @@ func NewDatabase(cfg *config.Config) (*gorm.DB, error) {
 		}
 		dialector = postgres.Open(dsn)
 	case "mysql":
+		// loc=UTC stores and reads datetimes as UTC whatever the host time zone
 		dsn := fmt.Sprintf(
-			"%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+			"%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=UTC",
 			cfg.Database.User,
 			cfg.Database.Password,
 			cfg.Database.Host,
@@ -50,6 +54,8 @@ func NewDatabase(cfg *config.Config) (*gorm.DB, error) {
 			cfg.Database.Name,
 		)
 		dialector = mysql.Open(dsn)
+	case "sqlite":
+		dialector = sqlite.Open(sqliteDSN(cfg.Database.Name))
 	default:
 		return nil, fmt.Errorf("unsupported database driver: %s", cfg.Database.Driver)
 	}
@@ -66,9 +72,18 @@ func NewDatabase(cfg *config.Config) (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
-	// Auto migrate entities
-	if err := autoMigrate(db); err != nil {
-		return nil, fmt.Errorf("failed to auto migrate: %w", err)
+	if cfg.Database.Driver == "sqlite" {
+		if err := configureSQLite(db, cfg.Database.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	// Auto migrate entities (development only, see DB_AUTO_MIGRATE)
+	if cfg.Database.AutoMigrate {
+		log.Println("Warning: DB_AUTO_MIGRATE is enabled, running GORM AutoMigrate instead of versioned migrations")
+		if err := autoMigrate(db); err != nil {
+			return nil, fmt.Errorf("failed to auto migrate: %w", err)
+		}
 	}
 
 	log.Println("Database connection established successfully")
@@ -77,11 +92,66 @@ func NewDatabase(cfg *config.Config) (*gorm.DB, error) {
 
 // autoMigrate runs auto migration for all entities
 func autoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(
+	models := []interface{}{
 		&entity.User{},
+		&entity.Venue{},
 		&entity.Team{},
 		&entity.Player{},
+		&entity.PlayerContract{},
+		&entity.TransferWindow{},
+		&entity.DisciplinaryRule{},
+		&entity.PlayerAvailability{},
 		&entity.Match{},
 		&entity.Goal{},
-	)
+		&entity.Card{},
+		&entity.Official{},
+		&entity.MatchOfficial{},
+		&entity.AuditLog{},
+	}
+	if err := useDialectColumnTypes(db, models...); err != nil {
+		return err
+	}
+	return db.AutoMigrate(models...)
+}
+
+// sqliteDSN builds the SQLite connection string for a database file, or for
+// a private in-memory database when name is ":memory:". Foreign keys are off
+// by default in SQLite and have to be enabled on every connection.
+func sqliteDSN(name string) string {
+	if isSQLiteMemory(name) {
+		name = ":memory:"
+	}
+	return "file:" + name + "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)"
+}
+
+// isSQLiteMemory reports whether name refers to an in-memory SQLite database
+func isSQLiteMemory(name string) bool {
+	return name == "" || name == ":memory:" || strings.Contains(name, "mode=memory")
+}
+
+// configureSQLite limits the pool to a single connection and, for in-memory
+// databases, applies all migrations. Every SQLite connection to ":memory:"
+// opens its own empty database and SQLite allows only one writer at a time,
+// so sharing one connection keeps the data visible and avoids busy errors.
+// An in-memory database starts empty on every run, so there is no separate
+// `migrate up` step that could prepare it.
+func configureSQLite(db *gorm.DB, name string) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get sqlite connection pool: %w", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+
+	if !isSQLiteMemory(name) {
+		return nil
+	}
+
+	migrator, err := NewMigrator(db)
+	if err != nil {
+		return err
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		return fmt.Errorf("failed to migrate in-memory database: %w", err)
+	}
+	return nil
 }
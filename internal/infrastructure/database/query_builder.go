@@ -0,0 +1,246 @@
+package database
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+	"gorm.io/gorm"
+)
+
+// column maps a listquery field to SQL
+type column struct {
+	// expr is the qualified column, such as players.name
+	expr string
+	// join is the join expr needs, for the columns of a related table
+	join string
+	// nullable columns sort their NULLs last in either direction
+	nullable bool
+	// where, if set, replaces the comparison of expr in conditions
+	where func(op listquery.Operator, value interface{}) (string, []interface{})
+}
+
+// listSpec maps the fields of a listquery schema to the columns of a table
+type listSpec struct {
+	table   string
+	schema  listquery.Schema
+	columns map[string]column
+}
+
+// list counts the rows of T matching query and loads one page of them in
+// the query's sort order. preload is only applied to the page query.
+func list[T any](ctx context.Context, db *gorm.DB, spec listSpec, query listquery.Query, page, limit int, preload ...func(*gorm.DB) *gorm.DB) ([]T, int64, error) {
+	var items []T
+	var total int64
+
+	offset := (page - 1) * limit
+
+	err := db.WithContext(ctx).
+		Model(new(T)).
+		Scopes(spec.filter(db, query)).
+		Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = db.WithContext(ctx).
+		Scopes(preload...).
+		Scopes(spec.filter(db, query), spec.order(query)).
+		Offset(offset).
+		Limit(limit).
+		Find(&items).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return items, total, nil
+}
+
+// filter returns a scope applying the search and every condition of query
+func (s listSpec) filter(db *gorm.DB, query listquery.Query) func(*gorm.DB) *gorm.DB {
+	d := dialectOf(db)
+	return func(tx *gorm.DB) *gorm.DB {
+		if query.Search != "" && len(s.schema.Search) > 0 {
+			pattern := containsPattern(query.Search)
+			clauses := make([]string, len(s.schema.Search))
+			args := make([]interface{}, len(s.schema.Search))
+			for i, field := range s.schema.Search {
+				clauses[i] = d.containsFold(s.columns[field].expr)
+				args[i] = pattern
+			}
+			tx = tx.Where("("+strings.Join(clauses, " OR ")+")", args...)
+		}
+		for _, c := range query.Conditions {
+			col := s.columns[c.Field]
+			if col.where != nil {
+				sql, args := col.where(c.Op, c.Value)
+				tx = tx.Where(sql, args...)
+				continue
+			}
+			sql, args := compare(d, col.expr, c.Op, c.Value)
+			tx = tx.Where(sql, args...)
+		}
+		return tx
+	}
+}
+
+// order returns a scope sorting by query's sort order, or the schema's
+// default, with the ID as the final tie-breaker so pages are stable
+func (s listSpec) order(query listquery.Query) func(*gorm.DB) *gorm.DB {
+	return s.sorted(query.SortOrDefault(s.schema), false)
+}
+
+// sorted returns a scope sorting by orders and then the ID, or in the exact
+// reverse order
+func (s listSpec) sorted(orders []listquery.Order, reverse bool) func(*gorm.DB) *gorm.DB {
+	direction := func(desc bool) string {
+		if desc != reverse {
+			return " DESC"
+		}
+		return " ASC"
+	}
+	return func(tx *gorm.DB) *gorm.DB {
+		joined := false
+		for _, o := range orders {
+			col := s.columns[o.Field]
+			if col.join != "" {
+				tx = tx.Joins(col.join)
+				joined = true
+			}
+			if col.nullable {
+				tx = tx.Order("CASE WHEN " + col.expr + " IS NULL THEN 1 ELSE 0 END" + direction(false))
+			}
+			tx = tx.Order(col.expr + direction(o.Desc))
+		}
+		if joined {
+			tx = tx.Select(s.table + ".*")
+		}
+		return tx.Order(s.table + ".id" + direction(false))
+	}
+}
+
+// scroll loads the slice of window from the rows of T matching query. The
+// rows past the cursor are found by their sort values (keyset pagination),
+// so no rows are skipped with OFFSET and nothing is counted unless asked.
+func scroll[T any](ctx context.Context, db *gorm.DB, spec listSpec, read listquery.Reader[T], query listquery.Query, window listquery.Window, preload ...func(*gorm.DB) *gorm.DB) (listquery.Slice[T], error) {
+	orders := query.SortOrDefault(spec.schema)
+	backward := window.Cursor != nil && window.Cursor.Before
+
+	var items []T
+	tx := db.WithContext(ctx).
+		Scopes(preload...).
+		Scopes(spec.filter(db, query), spec.sorted(orders, backward))
+	if window.Cursor != nil {
+		sql, args := spec.seek(window.Cursor)
+		tx = tx.Where(sql, args...)
+	}
+	if err := tx.Limit(window.Limit + 1).Find(&items).Error; err != nil {
+		return listquery.Slice[T]{}, err
+	}
+
+	slice := listquery.NewSlice(items, window, func(item T) *listquery.Cursor {
+		return read.Cursor(item, orders)
+	})
+	if window.Count {
+		var total int64
+		err := db.WithContext(ctx).
+			Model(new(T)).
+			Scopes(spec.filter(db, query)).
+			Count(&total).Error
+		if err != nil {
+			return listquery.Slice[T]{}, err
+		}
+		slice.Total = &total
+	}
+	return slice, nil
+}
+
+// seek returns the condition selecting the rows after the cursor in its sort
+// order, or before it. A row is past the cursor if it has the same values up
+// to some sort column and is past it in that column, or has all the same
+// values and is past it by ID. NULLs sort last.
+func (s listSpec) seek(cursor *listquery.Cursor) (string, []interface{}) {
+	var clauses []string
+	var args []interface{}
+	var equal []string
+	var equalArgs []interface{}
+
+	for i, o := range cursor.Sort {
+		expr, value := s.columns[o.Field].expr, cursor.Values[i]
+
+		var past string
+		var pastArgs []interface{}
+		switch {
+		case value == nil && !cursor.Before:
+			// Nothing sorts after NULL
+		case value == nil:
+			past = expr + " IS NOT NULL"
+		default:
+			op := " > ?"
+			if o.Desc != cursor.Before {
+				op = " < ?"
+			}
+			past, pastArgs = expr+op, []interface{}{value}
+			if !cursor.Before && s.columns[o.Field].nullable {
+				past = "(" + past + " OR " + expr + " IS NULL)"
+			}
+		}
+		if past != "" {
+			clauses = append(clauses, "("+strings.Join(append(equal[:len(equal):len(equal)], past), " AND ")+")")
+			args = append(append(args, equalArgs...), pastArgs...)
+		}
+
+		if value == nil {
+			equal = append(equal, expr+" IS NULL")
+		} else {
+			equal = append(equal, expr+" = ?")
+			equalArgs = append(equalArgs, value)
+		}
+	}
+
+	op := " > ?"
+	if cursor.Before {
+		op = " < ?"
+	}
+	clauses = append(clauses, "("+strings.Join(append(equal, s.table+".id"+op), " AND ")+")")
+	args = append(append(args, equalArgs...), cursor.ID)
+	return "(" + strings.Join(clauses, " OR ") + ")", args
+}
+
+// compare returns the condition comparing expr with value
+func compare(d dialect, expr string, op listquery.Operator, value interface{}) (string, []interface{}) {
+	switch op {
+	case listquery.Ne:
+		return expr + " <> ?", []interface{}{value}
+	case listquery.Gt:
+		return expr + " > ?", []interface{}{value}
+	case listquery.Gte:
+		return expr + " >= ?", []interface{}{value}
+	case listquery.Lt:
+		return expr + " < ?", []interface{}{value}
+	case listquery.Lte:
+		return expr + " <= ?", []interface{}{value}
+	case listquery.In:
+		return expr + " IN ?", []interface{}{value}
+	case listquery.Contains:
+		return d.containsFold(expr), []interface{}{containsPattern(value.(string))}
+	default:
+		return expr + " = ?", []interface{}{value}
+	}
+}
+
+// anyOf returns a where func matching rows where any of the columns equals
+// the value, or one of the values of in
+func anyOf(exprs ...string) func(op listquery.Operator, value interface{}) (string, []interface{}) {
+	return func(op listquery.Operator, value interface{}) (string, []interface{}) {
+		clauses := make([]string, len(exprs))
+		args := make([]interface{}, len(exprs))
+		for i, expr := range exprs {
+			clauses[i], args[i] = expr+" = ?", value
+			if op == listquery.In {
+				clauses[i] = expr + " IN ?"
+			}
+		}
+		return "(" + strings.Join(clauses, " OR ") + ")", args
+	}
+}
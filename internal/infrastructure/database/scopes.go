@@ -0,0 +1,64 @@
+package database
+
+import (
+	"time"
+
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+	"gorm.io/gorm"
+)
+
+// withDeleted is a preload scope that includes soft-deleted rows, so
+// historical records keep showing the team or player they referred to
+func withDeleted(db *gorm.DB) *gorm.DB {
+	return db.Unscoped()
+}
+
+// onlyDeleted limits a query to soft-deleted rows, i.e. the trash
+func onlyDeleted(db *gorm.DB) *gorm.DB {
+	return db.Unscoped().Where("deleted_at IS NOT NULL")
+}
+
+// deletedBefore limits a query to rows that were soft-deleted before cutoff
+func deletedBefore(cutoff time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return onlyDeleted(db).Where("deleted_at < ?", cutoff)
+	}
+}
+
+// deletionTime returns the timestamp written to deleted_at when several rows
+// are soft-deleted together. It is truncated to milliseconds so every
+// supported driver stores it exactly and the rows can be matched up again
+// on restore.
+func deletionTime() time.Time {
+	return time.Now().Truncate(time.Millisecond)
+}
+
+// relations maps the paths of a listquery.Include to the scopes preloading
+// them
+type relations map[string]func(db *gorm.DB) *gorm.DB
+
+// scope preloads the included relations. Include is sorted, so a parent is
+// always preloaded before its children.
+func (r relations) scope(include listquery.Include) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		for _, path := range include {
+			if preload, ok := r[path]; ok {
+				db = preload(db)
+			}
+		}
+		return db
+	}
+}
+
+// preload is a scope preloading an association with conditions, like
+// gorm.DB.Preload
+func preload(association string, conds ...interface{}) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Preload(association, conds...)
+	}
+}
+
+// byMinute orders preloaded goals or cards
+func byMinute(db *gorm.DB) *gorm.DB {
+	return db.Order("minute")
+}
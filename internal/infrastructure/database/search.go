@@ -0,0 +1,136 @@
+package database
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/pkg/textsearch"
+	"gorm.io/gorm"
+)
+
+// searchSpec names the columns of a table whose text is searched
+type searchSpec struct {
+	table string
+	// columns are joined with spaces into the searched text. On Postgres the
+	// trigram and full text indexes of migration 11 are built on the same
+	// expression, so keep both in sync.
+	columns []string
+}
+
+// document returns the SQL expression of the searched text
+func (s searchSpec) document() string {
+	return strings.Join(s.columns, " || ' ' || ")
+}
+
+// searchRow is the ID and searched text of a row ranked outside the database
+type searchRow struct {
+	id   uuid.UUID
+	text string
+}
+
+// search finds the active rows of T best matching text and loads them,
+// best first. Postgres ranks them itself with pg_trgm word similarity over
+// the unaccented text and also matches the words in any order through a
+// tsvector. The other drivers have no such indexes, so the searched columns
+// of every active row are ranked with textsearch instead, which is fine for
+// the size of a league.
+func search[T any](ctx context.Context, db *gorm.DB, spec searchSpec, text string, limit int, id func(T) uuid.UUID, preload ...func(*gorm.DB) *gorm.DB) ([]textsearch.Hit[T], error) {
+	var ranked []textsearch.Hit[uuid.UUID]
+	var err error
+	if dialectOf(db).name == "postgres" {
+		ranked, err = spec.rankInDatabase(ctx, db, text, limit)
+	} else {
+		ranked, err = spec.rankInMemory(ctx, db, text, limit)
+	}
+	if err != nil || len(ranked) == 0 {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(ranked))
+	for i, hit := range ranked {
+		ids[i] = hit.Item
+	}
+	var items []T
+	if err := db.WithContext(ctx).Scopes(preload...).Where(spec.table+".id IN ?", ids).Find(&items).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]T, len(items))
+	for _, item := range items {
+		byID[id(item)] = item
+	}
+
+	hits := make([]textsearch.Hit[T], 0, len(ranked))
+	for _, hit := range ranked {
+		// A row deleted since it was ranked is left out
+		if item, ok := byID[hit.Item]; ok {
+			hits = append(hits, textsearch.Hit[T]{Item: item, Score: hit.Score})
+		}
+	}
+	return hits, nil
+}
+
+// rankInDatabase ranks the rows with the search_fold function, pg_trgm and
+// full text search of the Postgres migrations
+func (s searchSpec) rankInDatabase(ctx context.Context, db *gorm.DB, text string, limit int) ([]textsearch.Hit[uuid.UUID], error) {
+	document := "search_fold(" + s.document() + ")"
+	sql := "SELECT id, word_similarity(search_fold(?), " + document + ") AS score FROM " + s.table +
+		" WHERE deleted_at IS NULL AND (search_fold(?) <% " + document +
+		" OR to_tsvector('simple', " + document + ") @@ plainto_tsquery('simple', search_fold(?)))" +
+		" ORDER BY score DESC, " + document + ", id LIMIT ?"
+
+	rows, err := db.WithContext(ctx).Raw(sql, text, text, text, limit).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ranked []textsearch.Hit[uuid.UUID]
+	for rows.Next() {
+		var hit textsearch.Hit[uuid.UUID]
+		if err := rows.Scan(&hit.Item, &hit.Score); err != nil {
+			return nil, err
+		}
+		ranked = append(ranked, hit)
+	}
+	return ranked, rows.Err()
+}
+
+// rankInMemory loads the searched text of every active row and ranks it
+// with textsearch
+func (s searchSpec) rankInMemory(ctx context.Context, db *gorm.DB, text string, limit int) ([]textsearch.Hit[uuid.UUID], error) {
+	rows, err := db.WithContext(ctx).
+		Table(s.table).
+		Select(append([]string{"id"}, s.columns...)).
+		Where("deleted_at IS NULL").
+		Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var all []searchRow
+	for rows.Next() {
+		values := make([]string, len(s.columns))
+		row := searchRow{}
+		dest := []interface{}{&row.id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		row.text = strings.Join(values, " ")
+		all = append(all, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	hits := textsearch.Rank(all, text, func(r searchRow) string { return r.text }, limit)
+	ranked := make([]textsearch.Hit[uuid.UUID], len(hits))
+	for i, hit := range hits {
+		ranked[i] = textsearch.Hit[uuid.UUID]{Item: hit.Item.id, Score: hit.Score}
+	}
+	return ranked, nil
+}
@@ -2,10 +2,13 @@ package database
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+	"github.com/zenkriztao/ayo-football-backend/pkg/textsearch"
 	"gorm.io/gorm"
 )
 
@@ -19,22 +22,31 @@ func NewTeamRepository(db *gorm.DB) repository.TeamRepository {
 }
 
 func (r *teamRepositoryImpl) Create(ctx context.Context, team *entity.Team) error {
-	return r.db.WithContext(ctx).Create(team).Error
+	return translateError(r.db.WithContext(ctx).Create(team).Error)
+}
+
+func (r *teamRepositoryImpl) CreateBatch(ctx context.Context, teams []entity.Team) error {
+	if len(teams) == 0 {
+		return nil
+	}
+	return translateError(r.db.WithContext(ctx).Create(&teams).Error)
 }
 
 func (r *teamRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Team, error) {
 	var team entity.Team
-	err := r.db.WithContext(ctx).First(&team, "id = ?", id).Error
+	err := r.db.WithContext(ctx).
+		Preload("HomeVenue", withDeleted).
+		First(&team, "id = ?", id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &team, nil
 }
 
-func (r *teamRepositoryImpl) FindByIDWithPlayers(ctx context.Context, id uuid.UUID) (*entity.Team, error) {
+func (r *teamRepositoryImpl) FindByIDWith(ctx context.Context, id uuid.UUID, include listquery.Include) (*entity.Team, error) {
 	var team entity.Team
 	err := r.db.WithContext(ctx).
-		Preload("Players").
+		Scopes(teamRelations.scope(include)).
 		First(&team, "id = ?", id).Error
 	if err != nil {
 		return nil, err
@@ -43,56 +55,110 @@ func (r *teamRepositoryImpl) FindByIDWithPlayers(ctx context.Context, id uuid.UU
 }
 
 func (r *teamRepositoryImpl) Update(ctx context.Context, team *entity.Team) error {
-	return r.db.WithContext(ctx).Save(team).Error
+	return translateError(r.db.WithContext(ctx).Save(team).Error)
 }
 
 func (r *teamRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&entity.Team{}, "id = ?", id).Error
+	return translateError(r.db.WithContext(ctx).Delete(&entity.Team{}, "id = ?", id).Error)
 }
 
-func (r *teamRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]entity.Team, int64, error) {
-	var teams []entity.Team
-	var total int64
+func (r *teamRepositoryImpl) DeleteCascade(ctx context.Context, id uuid.UUID) error {
+	// Players share the team's deletion timestamp so Restore can bring back
+	// exactly the players that were removed together with the team
+	deletedAt := deletionTime()
+
+	return translateError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&entity.Player{}).
+			Where("team_id = ?", id).
+			Update("deleted_at", deletedAt).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&entity.Match{}).
+			Where("(home_team_id = ? OR away_team_id = ?) AND status IN ?", id, id,
+				[]entity.MatchStatus{entity.MatchStatusScheduled, entity.MatchStatusOngoing}).
+			Update("status", entity.MatchStatusCancelled).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&entity.Team{}).
+			Where("id = ?", id).
+			Update("deleted_at", deletedAt).Error
+	}))
+}
 
-	offset := (page - 1) * limit
+// teamList maps TeamListSchema to the columns of the teams table
+var teamList = listSpec{
+	table:  "teams",
+	schema: repository.TeamListSchema,
+	columns: map[string]column{
+		"name":          {expr: "teams.name"},
+		"city":          {expr: "teams.city"},
+		"founded_year":  {expr: "teams.founded_year"},
+		"home_venue_id": {expr: "teams.home_venue_id"},
+		"created_at":    {expr: "teams.created_at"},
+	},
+}
 
-	err := r.db.WithContext(ctx).Model(&entity.Team{}).Count(&total).Error
-	if err != nil {
-		return nil, 0, err
-	}
+// teamRelations preloads the relations of TeamListSchema: the home venue,
+// even when soft-deleted, and the active players by jersey number
+var teamRelations = relations{
+	"home_venue": preload("HomeVenue", withDeleted),
+	"players": preload("Players", func(db *gorm.DB) *gorm.DB {
+		return db.Order("jersey_number")
+	}),
+}
 
-	err = r.db.WithContext(ctx).
-		Offset(offset).
-		Limit(limit).
-		Order("created_at DESC").
-		Find(&teams).Error
-	if err != nil {
-		return nil, 0, err
-	}
+func (r *teamRepositoryImpl) List(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Team, int64, error) {
+	return list[entity.Team](ctx, r.db, teamList, query, page, limit, teamRelations.scope(query.Include))
+}
 
-	return teams, total, nil
+func (r *teamRepositoryImpl) Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Team], error) {
+	return scroll(ctx, r.db, teamList, repository.TeamListReader, query, window, teamRelations.scope(query.Include))
+}
+
+// teamSearch is the text the team search matches
+var teamSearch = searchSpec{table: "teams", columns: []string{"name", "city"}}
+
+func (r *teamRepositoryImpl) Search(ctx context.Context, text string, limit int) ([]textsearch.Hit[entity.Team], error) {
+	return search(ctx, r.db, teamSearch, text, limit, repository.TeamListReader.ID)
 }
 
-func (r *teamRepositoryImpl) Search(ctx context.Context, query string, page, limit int) ([]entity.Team, int64, error) {
+func (r *teamRepositoryImpl) FindInBatches(ctx context.Context, batchSize int, fn func(teams []entity.Team) error) error {
+	var batch []entity.Team
+	return r.db.WithContext(ctx).
+		FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {
+			return fn(batch)
+		}).Error
+}
+
+func (r *teamRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entity.Team{}).
+		Where("id = ?", id).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *teamRepositoryImpl) FindDeleted(ctx context.Context, page, limit int) ([]entity.Team, int64, error) {
 	var teams []entity.Team
 	var total int64
 
 	offset := (page - 1) * limit
-	searchQuery := "%" + query + "%"
 
-	err := r.db.WithContext(ctx).
-		Model(&entity.Team{}).
-		Where("name ILIKE ? OR city ILIKE ?", searchQuery, searchQuery).
-		Count(&total).Error
+	err := r.db.WithContext(ctx).Model(&entity.Team{}).Scopes(onlyDeleted).Count(&total).Error
 	if err != nil {
 		return nil, 0, err
 	}
 
 	err = r.db.WithContext(ctx).
-		Where("name ILIKE ? OR city ILIKE ?", searchQuery, searchQuery).
+		Scopes(onlyDeleted).
 		Offset(offset).
 		Limit(limit).
-		Order("created_at DESC").
+		Order("deleted_at DESC").
 		Find(&teams).Error
 	if err != nil {
 		return nil, 0, err
@@ -101,11 +167,61 @@ func (r *teamRepositoryImpl) Search(ctx context.Context, query string, page, lim
 	return teams, total, nil
 }
 
-func (r *teamRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
-	var count int64
-	err := r.db.WithContext(ctx).
-		Model(&entity.Team{}).
-		Where("id = ?", id).
-		Count(&count).Error
-	return count > 0, err
+func (r *teamRepositoryImpl) Restore(ctx context.Context, id uuid.UUID) error {
+	return translateError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var team entity.Team
+		if err := tx.Scopes(onlyDeleted).First(&team, "id = ?", id).Error; err != nil {
+			return err
+		}
+
+		err := tx.Unscoped().Model(&entity.Player{}).
+			Where("team_id = ? AND deleted_at = ?", id, team.DeletedAt.Time).
+			Update("deleted_at", nil).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Unscoped().Model(&entity.Team{}).
+			Where("id = ?", id).
+			Update("deleted_at", nil).Error
+	}))
+}
+
+func (r *teamRepositoryImpl) Purge(ctx context.Context, id uuid.UUID) error {
+	return translateError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var team entity.Team
+		if err := tx.Scopes(onlyDeleted).First(&team, "id = ?", id).Error; err != nil {
+			return err
+		}
+
+		var references int64
+		err := tx.Unscoped().Model(&entity.Team{}).
+			Where("id = ?", id).
+			Where(teamReferencedCondition).
+			Count(&references).Error
+		if err != nil {
+			return err
+		}
+		if references > 0 {
+			return repository.ErrStillReferenced
+		}
+
+		return tx.Unscoped().Delete(&entity.Team{}, "id = ?", id).Error
+	}))
 }
+
+func (r *teamRepositoryImpl) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Scopes(deletedBefore(cutoff)).
+		Not(teamReferencedCondition).
+		Delete(&entity.Team{})
+	return result.RowsAffected, translateError(result.Error)
+}
+
+// teamReferencedCondition matches teams that players, contracts, matches, goals or
+// cards still point at, including soft-deleted ones, and so cannot be hard-deleted yet
+const teamReferencedCondition = "(EXISTS (SELECT 1 FROM players WHERE players.team_id = teams.id) OR " +
+	"EXISTS (SELECT 1 FROM player_contracts WHERE player_contracts.team_id = teams.id) OR " +
+	"EXISTS (SELECT 1 FROM matches WHERE matches.home_team_id = teams.id OR matches.away_team_id = teams.id) OR " +
+	"EXISTS (SELECT 1 FROM goals WHERE goals.team_id = teams.id) OR " +
+	"EXISTS (SELECT 1 FROM cards WHERE cards.team_id = teams.id))"
@@ -0,0 +1,64 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// ConvertDatetimesToUTC rewrites every DATETIME column of a MySQL database
+// from the time zone it was written in to UTC and returns how many columns
+// it converted. Older releases connected with loc=Local, so the driver
+// stored times in the zone of the API host; the connection now uses UTC. Run
+// it once per database, before `migrate up`, when that host was not on UTC.
+// zone is a zone CONVERT_TZ accepts, such as +07:00, or Asia/Jakarta when
+// the server's time zone tables are loaded.
+func ConvertDatetimesToUTC(ctx context.Context, db *gorm.DB, zone string) (int, error) {
+	if name := db.Dialector.Name(); name != "mysql" {
+		return 0, fmt.Errorf("%s stores times without a session zone, nothing to convert", name)
+	}
+	db = db.WithContext(ctx)
+
+	var probe sql.NullTime
+	if err := db.Raw("SELECT CONVERT_TZ('2000-01-01 00:00:00', ?, '+00:00')", zone).Row().Scan(&probe); err != nil {
+		return 0, err
+	}
+	if !probe.Valid {
+		return 0, fmt.Errorf("unknown time zone %q, use an offset such as +07:00 or load the MySQL time zone tables", zone)
+	}
+
+	var columns []struct {
+		TableName  string
+		ColumnName string
+	}
+	err := db.Raw(
+		"SELECT table_name AS table_name, column_name AS column_name FROM information_schema.columns"+
+			" WHERE table_schema = DATABASE() AND data_type = 'datetime' AND table_name <> ?"+
+			" ORDER BY table_name, ordinal_position",
+		schemaMigrationsTable,
+	).Scan(&columns).Error
+	if err != nil {
+		return 0, err
+	}
+	if len(columns) == 0 {
+		return 0, errors.New("no DATETIME columns found")
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		for _, column := range columns {
+			statement := fmt.Sprintf("UPDATE `%s` SET `%s` = CONVERT_TZ(`%[2]s`, ?, '+00:00') WHERE `%[2]s` IS NOT NULL",
+				column.TableName, column.ColumnName)
+			if err := tx.Exec(statement, zone).Error; err != nil {
+				return fmt.Errorf("convert %s.%s: %w", column.TableName, column.ColumnName, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(columns), nil
+}
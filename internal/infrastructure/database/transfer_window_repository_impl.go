@@ -0,0 +1,45 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type transferWindowRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewTransferWindowRepository creates a new instance of TransferWindowRepository
+func NewTransferWindowRepository(db *gorm.DB) repository.TransferWindowRepository {
+	return &transferWindowRepositoryImpl{db: db}
+}
+
+func (r *transferWindowRepositoryImpl) Create(ctx context.Context, window *entity.TransferWindow) error {
+	return translateError(r.db.WithContext(ctx).Create(window).Error)
+}
+
+func (r *transferWindowRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.TransferWindow, error) {
+	var window entity.TransferWindow
+	err := r.db.WithContext(ctx).First(&window, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &window, nil
+}
+
+func (r *transferWindowRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return translateError(r.db.WithContext(ctx).Delete(&entity.TransferWindow{}, "id = ?", id).Error)
+}
+
+func (r *transferWindowRepositoryImpl) FindBySeason(ctx context.Context, season string) ([]entity.TransferWindow, error) {
+	var windows []entity.TransferWindow
+	err := r.db.WithContext(ctx).
+		Where("season = ?", season).
+		Order("opens_at ASC").
+		Find(&windows).Error
+	return windows, err
+}
@@ -19,7 +19,7 @@ func NewUserRepository(db *gorm.DB) repository.UserRepository {
 }
 
 func (r *userRepositoryImpl) Create(ctx context.Context, user *entity.User) error {
-	return r.db.WithContext(ctx).Create(user).Error
+	return translateError(r.db.WithContext(ctx).Create(user).Error)
 }
 
 func (r *userRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
@@ -41,11 +41,11 @@ func (r *userRepositoryImpl) FindByEmail(ctx context.Context, email string) (*en
 }
 
 func (r *userRepositoryImpl) Update(ctx context.Context, user *entity.User) error {
-	return r.db.WithContext(ctx).Save(user).Error
+	return translateError(r.db.WithContext(ctx).Save(user).Error)
 }
 
 func (r *userRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&entity.User{}, "id = ?", id).Error
+	return translateError(r.db.WithContext(ctx).Delete(&entity.User{}, "id = ?", id).Error)
 }
 
 func (r *userRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]entity.User, int64, error) {
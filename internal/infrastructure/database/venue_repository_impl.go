@@ -0,0 +1,78 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+	"github.com/zenkriztao/ayo-football-backend/pkg/textsearch"
+	"gorm.io/gorm"
+)
+
+type venueRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewVenueRepository creates a new instance of VenueRepository
+func NewVenueRepository(db *gorm.DB) repository.VenueRepository {
+	return &venueRepositoryImpl{db: db}
+}
+
+func (r *venueRepositoryImpl) Create(ctx context.Context, venue *entity.Venue) error {
+	return translateError(r.db.WithContext(ctx).Create(venue).Error)
+}
+
+func (r *venueRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Venue, error) {
+	var venue entity.Venue
+	err := r.db.WithContext(ctx).First(&venue, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &venue, nil
+}
+
+func (r *venueRepositoryImpl) Update(ctx context.Context, venue *entity.Venue) error {
+	return translateError(r.db.WithContext(ctx).Save(venue).Error)
+}
+
+func (r *venueRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return translateError(r.db.WithContext(ctx).Delete(&entity.Venue{}, "id = ?", id).Error)
+}
+
+// venueList maps VenueListSchema to the columns of the venues table
+var venueList = listSpec{
+	table:  "venues",
+	schema: repository.VenueListSchema,
+	columns: map[string]column{
+		"name":       {expr: "venues.name"},
+		"city":       {expr: "venues.city"},
+		"capacity":   {expr: "venues.capacity"},
+		"created_at": {expr: "venues.created_at"},
+	},
+}
+
+func (r *venueRepositoryImpl) List(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Venue, int64, error) {
+	return list[entity.Venue](ctx, r.db, venueList, query, page, limit)
+}
+
+func (r *venueRepositoryImpl) Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Venue], error) {
+	return scroll(ctx, r.db, venueList, repository.VenueListReader, query, window)
+}
+
+// venueSearch is the text the venue search matches
+var venueSearch = searchSpec{table: "venues", columns: []string{"name", "city"}}
+
+func (r *venueRepositoryImpl) Search(ctx context.Context, text string, limit int) ([]textsearch.Hit[entity.Venue], error) {
+	return search(ctx, r.db, venueSearch, text, limit, repository.VenueListReader.ID)
+}
+
+func (r *venueRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entity.Venue{}).
+		Where("id = ?", id).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+)
+
+type auditRepositoryImpl struct {
+	store *Store
+}
+
+// NewAuditRepository creates a new in-memory instance of AuditRepository
+func NewAuditRepository(store *Store) repository.AuditRepository {
+	return &auditRepositoryImpl{store: store}
+}
+
+func (r *auditRepositoryImpl) Create(ctx context.Context, log *entity.AuditLog) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if log.ID == uuid.Nil {
+		log.ID = uuid.New()
+	}
+	if log.CreatedAt.IsZero() {
+		log.CreatedAt = time.Now()
+	}
+
+	r.store.auditLogs = append(r.store.auditLogs, *log)
+	return nil
+}
+
+func (r *auditRepositoryImpl) FindAll(ctx context.Context, filter repository.AuditLogFilter, page, limit int) ([]entity.AuditLog, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	// Entries are appended in order, so walking backwards is newest first
+	var logs []entity.AuditLog
+	for i := len(r.store.auditLogs) - 1; i >= 0; i-- {
+		if log := r.store.auditLogs[i]; matchesAuditFilter(log, filter) {
+			logs = append(logs, log)
+		}
+	}
+
+	return paginate(logs, page, limit), int64(len(logs)), nil
+}
+
+// matchesAuditFilter reports whether an entry meets every filter criterion
+func matchesAuditFilter(log entity.AuditLog, filter repository.AuditLogFilter) bool {
+	if filter.EntityType != "" && log.EntityType != filter.EntityType {
+		return false
+	}
+	if filter.EntityID != nil && log.EntityID != *filter.EntityID {
+		return false
+	}
+	if filter.ActorID != nil && (log.ActorID == nil || *log.ActorID != *filter.ActorID) {
+		return false
+	}
+	if filter.From != nil && log.CreatedAt.Before(*filter.From) {
+		return false
+	}
+	if filter.To != nil && log.CreatedAt.After(*filter.To) {
+		return false
+	}
+	return true
+}
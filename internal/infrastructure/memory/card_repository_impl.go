@@ -0,0 +1,124 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+)
+
+type cardRepositoryImpl struct {
+	store *Store
+}
+
+// NewCardRepository creates a new in-memory instance of CardRepository
+func NewCardRepository(store *Store) repository.CardRepository {
+	return &cardRepositoryImpl{store: store}
+}
+
+// CreateBatch stores all cards or, if any of them violates a constraint, none
+func (r *cardRepositoryImpl) CreateBatch(ctx context.Context, cards []entity.Card) error {
+	if len(cards) == 0 {
+		return nil
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now()
+	records := make([]entity.Card, len(cards))
+	for i, card := range cards {
+		record := card
+		record.Match = nil
+		record.Player = nil
+		record.Team = nil
+		prepareCreate(&record.BaseEntity, now)
+		if err := r.store.checkCard(record); err != nil {
+			return err
+		}
+		records[i] = record
+	}
+
+	for i, record := range records {
+		r.store.cards[record.ID] = record
+		cards[i].BaseEntity = record.BaseEntity
+	}
+	return nil
+}
+
+func (r *cardRepositoryImpl) FindByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.Card, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	cards := []entity.Card{}
+	for _, card := range r.store.cards {
+		if card.MatchID == matchID && isActive(card.BaseEntity) {
+			card.Player = r.store.playerRef(card.PlayerID)
+			card.Team = r.store.teamRef(card.TeamID)
+			cards = append(cards, card)
+		}
+	}
+	sort.SliceStable(cards, func(i, j int) bool {
+		return cards[i].Minute < cards[j].Minute
+	})
+	return cards, nil
+}
+
+func (r *cardRepositoryImpl) FindCompletedBetween(ctx context.Context, from, to time.Time) ([]entity.Card, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	cards := []entity.Card{}
+	for _, card := range r.store.cards {
+		match, ok := r.store.matches[card.MatchID]
+		if !ok || !isActive(card.BaseEntity) || !isActive(match.BaseEntity) ||
+			match.Status != entity.MatchStatusCompleted || match.KickoffAt.Before(from) || !match.KickoffAt.Before(to) {
+			continue
+		}
+		card.Match = &match
+		card.Player = r.store.playerRef(card.PlayerID)
+		card.Team = r.store.teamRef(card.TeamID)
+		cards = append(cards, card)
+	}
+	sort.SliceStable(cards, func(i, j int) bool {
+		if !cards[i].Match.KickoffAt.Equal(cards[j].Match.KickoffAt) {
+			return cards[i].Match.KickoffAt.Before(cards[j].Match.KickoffAt)
+		}
+		if cards[i].MatchID != cards[j].MatchID {
+			return cards[i].MatchID.String() < cards[j].MatchID.String()
+		}
+		return cards[i].Minute < cards[j].Minute
+	})
+	return cards, nil
+}
+
+func (r *cardRepositoryImpl) DeleteByMatchID(ctx context.Context, matchID uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now()
+	for id, card := range r.store.cards {
+		if card.MatchID == matchID && isActive(card.BaseEntity) {
+			softDelete(&card.BaseEntity, now)
+			r.store.cards[id] = card
+		}
+	}
+	return nil
+}
+
+func (r *cardRepositoryImpl) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var purged int64
+	for id, card := range r.store.cards {
+		if !isActive(card.BaseEntity) && card.DeletedAt.Time.Before(cutoff) {
+			delete(r.store.cards, id)
+			purged++
+		}
+	}
+	return purged, nil
+}
@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type disciplinaryRuleRepositoryImpl struct {
+	store *Store
+}
+
+// NewDisciplinaryRuleRepository creates a new in-memory instance of DisciplinaryRuleRepository
+func NewDisciplinaryRuleRepository(store *Store) repository.DisciplinaryRuleRepository {
+	return &disciplinaryRuleRepositoryImpl{store: store}
+}
+
+func (r *disciplinaryRuleRepositoryImpl) Create(ctx context.Context, rule *entity.DisciplinaryRule) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now()
+	record := *rule
+	if record.ID == uuid.Nil {
+		record.ID = uuid.New()
+	}
+	record.CreatedAt = now
+	record.UpdatedAt = now
+	if err := r.store.checkRule(record); err != nil {
+		return err
+	}
+
+	r.store.rules[record.ID] = record
+	rule.ID = record.ID
+	rule.CreatedAt = record.CreatedAt
+	rule.UpdatedAt = record.UpdatedAt
+	return nil
+}
+
+func (r *disciplinaryRuleRepositoryImpl) Update(ctx context.Context, rule *entity.DisciplinaryRule) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	existing, ok := r.store.rules[rule.ID]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	record := *rule
+	record.CreatedAt = existing.CreatedAt
+	record.UpdatedAt = time.Now()
+	if err := r.store.checkRule(record); err != nil {
+		return err
+	}
+
+	r.store.rules[record.ID] = record
+	rule.UpdatedAt = record.UpdatedAt
+	return nil
+}
+
+func (r *disciplinaryRuleRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	delete(r.store.rules, id)
+	return nil
+}
+
+func (r *disciplinaryRuleRepositoryImpl) FindBySeason(ctx context.Context, season string) (*entity.DisciplinaryRule, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, rule := range r.store.rules {
+		if rule.Season == season {
+			return &rule, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *disciplinaryRuleRepositoryImpl) FindBySeasons(ctx context.Context, seasons []string) ([]entity.DisciplinaryRule, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	rules := []entity.DisciplinaryRule{}
+	for _, rule := range r.store.rules {
+		for _, season := range seasons {
+			if rule.Season == season {
+				rules = append(rules, rule)
+				break
+			}
+		}
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Season < rules[j].Season
+	})
+	return rules, nil
+}
@@ -0,0 +1,233 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type goalRepositoryImpl struct {
+	store *Store
+}
+
+// NewGoalRepository creates a new in-memory instance of GoalRepository
+func NewGoalRepository(store *Store) repository.GoalRepository {
+	return &goalRepositoryImpl{store: store}
+}
+
+func (r *goalRepositoryImpl) Create(ctx context.Context, goal *entity.Goal) error {
+	goals := []entity.Goal{*goal}
+	if err := r.CreateBatch(ctx, goals); err != nil {
+		return err
+	}
+	goal.BaseEntity = goals[0].BaseEntity
+	return nil
+}
+
+// CreateBatch stores all goals or, if any of them violates a constraint, none
+func (r *goalRepositoryImpl) CreateBatch(ctx context.Context, goals []entity.Goal) error {
+	if len(goals) == 0 {
+		return nil
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now()
+	records := make([]entity.Goal, len(goals))
+	for i, goal := range goals {
+		record := stripGoal(goal)
+		prepareCreate(&record.BaseEntity, now)
+		if err := r.store.checkGoal(record); err != nil {
+			return err
+		}
+		records[i] = record
+	}
+
+	for i, record := range records {
+		r.store.goals[record.ID] = record
+		goals[i].BaseEntity = record.BaseEntity
+	}
+	return nil
+}
+
+func (r *goalRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Goal, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	goal, ok := r.store.goals[id]
+	if !ok || !isActive(goal.BaseEntity) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	goal.Player = r.store.playerRef(goal.PlayerID)
+	goal.Team = r.store.teamRef(goal.TeamID)
+	return &goal, nil
+}
+
+func (r *goalRepositoryImpl) Update(ctx context.Context, goal *entity.Goal) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record := stripGoal(*goal)
+	record.UpdatedAt = time.Now()
+	if err := r.store.checkGoal(record); err != nil {
+		return err
+	}
+
+	r.store.goals[record.ID] = record
+	goal.UpdatedAt = record.UpdatedAt
+	return nil
+}
+
+func (r *goalRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if goal, ok := r.store.goals[id]; ok && isActive(goal.BaseEntity) {
+		softDelete(&goal.BaseEntity, time.Now())
+		r.store.goals[id] = goal
+	}
+	return nil
+}
+
+func (r *goalRepositoryImpl) FindByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.Goal, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	goals := []entity.Goal{}
+	for _, goal := range r.store.goals {
+		if goal.MatchID == matchID && isActive(goal.BaseEntity) {
+			goal.Player = r.store.playerRef(goal.PlayerID)
+			goal.Team = r.store.teamRef(goal.TeamID)
+			goals = append(goals, goal)
+		}
+	}
+	sort.SliceStable(goals, func(i, j int) bool {
+		return goals[i].Minute < goals[j].Minute
+	})
+	return goals, nil
+}
+
+func (r *goalRepositoryImpl) FindByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.Goal, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	goals := []entity.Goal{}
+	for _, goal := range r.store.goals {
+		if goal.PlayerID == playerID && isActive(goal.BaseEntity) {
+			if match, ok := r.store.matches[goal.MatchID]; ok && isActive(match.BaseEntity) {
+				goal.Match = &match
+			}
+			goal.Team = r.store.teamRef(goal.TeamID)
+			goals = append(goals, goal)
+		}
+	}
+	sortByCreatedAtDesc(goals, func(g entity.Goal) entity.BaseEntity { return g.BaseEntity })
+	return goals, nil
+}
+
+func (r *goalRepositoryImpl) FindInBatches(ctx context.Context, batchSize int, fn func(goals []entity.Goal) error) error {
+	r.store.mu.RLock()
+	goals := []entity.Goal{}
+	for _, goal := range r.store.goals {
+		match, ok := r.store.matches[goal.MatchID]
+		if !isActive(goal.BaseEntity) || !ok || !isActive(match.BaseEntity) {
+			continue
+		}
+		goal.Match = &match
+		goal.Player = r.store.playerRef(goal.PlayerID)
+		goal.Team = r.store.teamRef(goal.TeamID)
+		goals = append(goals, goal)
+	}
+	r.store.mu.RUnlock()
+
+	sortByID(goals, func(g entity.Goal) entity.BaseEntity { return g.BaseEntity })
+	return inBatches(goals, batchSize, fn)
+}
+
+func (r *goalRepositoryImpl) DeleteByMatchID(ctx context.Context, matchID uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now()
+	for id, goal := range r.store.goals {
+		if goal.MatchID == matchID && isActive(goal.BaseEntity) {
+			softDelete(&goal.BaseEntity, now)
+			r.store.goals[id] = goal
+		}
+	}
+	return nil
+}
+
+func (r *goalRepositoryImpl) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var purged int64
+	for id, goal := range r.store.goals {
+		if !isActive(goal.BaseEntity) && goal.DeletedAt.Time.Before(cutoff) {
+			delete(r.store.goals, id)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+func (r *goalRepositoryImpl) GetTopScorers(ctx context.Context, limit int) ([]repository.TopScorerResult, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	// Goals count for the team the player scored them for, not their current
+	// team. Soft-deleted players and teams still count so that deleting a
+	// team does not rewrite past results.
+	type scorer struct{ playerID, teamID uuid.UUID }
+	counts := make(map[scorer]int64)
+	for _, goal := range r.store.goals {
+		if isActive(goal.BaseEntity) && !goal.IsOwnGoal {
+			counts[scorer{goal.PlayerID, goal.TeamID}]++
+		}
+	}
+
+	results := make([]repository.TopScorerResult, 0, len(counts))
+	for key, count := range counts {
+		player, ok := r.store.players[key.playerID]
+		if !ok {
+			continue
+		}
+		team, ok := r.store.teams[key.teamID]
+		if !ok {
+			continue
+		}
+		results = append(results, repository.TopScorerResult{
+			PlayerID:   player.ID,
+			PlayerName: player.Name,
+			TeamID:     team.ID,
+			TeamName:   team.Name,
+			GoalCount:  count,
+		})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].GoalCount != results[j].GoalCount {
+			return results[i].GoalCount > results[j].GoalCount
+		}
+		if results[i].PlayerName != results[j].PlayerName {
+			return results[i].PlayerName < results[j].PlayerName
+		}
+		return results[i].TeamName < results[j].TeamName
+	})
+
+	return paginate(results, 1, limit), nil
+}
+
+// stripGoal removes the relations, which are not stored with the goal
+func stripGoal(goal entity.Goal) entity.Goal {
+	goal.Match = nil
+	goal.Player = nil
+	goal.Team = nil
+	return goal
+}
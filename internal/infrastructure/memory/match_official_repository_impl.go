@@ -0,0 +1,161 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+)
+
+type matchOfficialRepositoryImpl struct {
+	store *Store
+}
+
+// NewMatchOfficialRepository creates a new in-memory instance of MatchOfficialRepository
+func NewMatchOfficialRepository(store *Store) repository.MatchOfficialRepository {
+	return &matchOfficialRepositoryImpl{store: store}
+}
+
+func (r *matchOfficialRepositoryImpl) FindByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.MatchOfficial, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	appointments := []entity.MatchOfficial{}
+	for _, appointment := range r.store.appointments {
+		if appointment.MatchID == matchID {
+			appointment.Official = r.store.officialRef(appointment.OfficialID)
+			appointments = append(appointments, appointment)
+		}
+	}
+	sortAppointments(appointments)
+	return appointments, nil
+}
+
+// ReplaceForMatch stores all appointments or, if any of them violates a
+// constraint, keeps the previous ones
+func (r *matchOfficialRepositoryImpl) ReplaceForMatch(ctx context.Context, matchID uuid.UUID, officials []entity.MatchOfficial) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now()
+	records := make([]entity.MatchOfficial, len(officials))
+	for i, official := range officials {
+		record := official
+		record.MatchID = matchID
+		record.Match = nil
+		record.Official = nil
+		if record.ID == uuid.Nil {
+			record.ID = uuid.New()
+		}
+		if record.CreatedAt.IsZero() {
+			record.CreatedAt = now
+		}
+		if err := r.store.checkAppointment(record, records[:i]); err != nil {
+			return err
+		}
+		records[i] = record
+	}
+
+	for id, appointment := range r.store.appointments {
+		if appointment.MatchID == matchID {
+			delete(r.store.appointments, id)
+		}
+	}
+	for i, record := range records {
+		r.store.appointments[record.ID] = record
+		officials[i].ID = record.ID
+		officials[i].MatchID = matchID
+		officials[i].CreatedAt = record.CreatedAt
+	}
+	return nil
+}
+
+func (r *matchOfficialRepositoryImpl) FindScheduledForOfficials(ctx context.Context, officialIDs []uuid.UUID, from, to time.Time) ([]entity.MatchOfficial, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	wanted := make(map[uuid.UUID]bool, len(officialIDs))
+	for _, id := range officialIDs {
+		wanted[id] = true
+	}
+
+	appointments := []entity.MatchOfficial{}
+	for _, appointment := range r.store.appointments {
+		if !wanted[appointment.OfficialID] || !r.store.scheduledBetween(appointment.MatchID, from, to) {
+			continue
+		}
+		match := r.store.matches[appointment.MatchID]
+		appointment.Match = &match
+		appointment.Official = r.store.officialRef(appointment.OfficialID)
+		appointments = append(appointments, appointment)
+	}
+	sort.SliceStable(appointments, func(i, j int) bool {
+		return appointments[i].Match.KickoffAt.Before(appointments[j].Match.KickoffAt)
+	})
+	return appointments, nil
+}
+
+func (r *matchOfficialRepositoryImpl) GetOfficialStats(ctx context.Context, officialID uuid.UUID) (*repository.OfficialStats, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	stats := &repository.OfficialStats{MatchesBy: map[entity.OfficialRole]int64{}}
+	refereed := make(map[uuid.UUID]bool)
+	for _, appointment := range r.store.appointments {
+		match, ok := r.store.matches[appointment.MatchID]
+		if appointment.OfficialID != officialID || !ok || !isActive(match.BaseEntity) {
+			continue
+		}
+		stats.Matches++
+		stats.MatchesBy[appointment.Role]++
+		if appointment.Role == entity.OfficialRoleReferee {
+			refereed[appointment.MatchID] = true
+		}
+	}
+
+	// Cards count towards the referee of the match only
+	for _, card := range r.store.cards {
+		if !isActive(card.BaseEntity) || !refereed[card.MatchID] {
+			continue
+		}
+		switch card.Type {
+		case entity.CardYellow:
+			stats.YellowCards++
+		case entity.CardRed:
+			stats.RedCards++
+		}
+	}
+	return stats, nil
+}
+
+// scheduledBetween reports whether a match is active, not cancelled and
+// kicks off strictly between from and to. Callers must hold the lock.
+func (s *Store) scheduledBetween(matchID uuid.UUID, from, to time.Time) bool {
+	match, ok := s.matches[matchID]
+	return ok && isActive(match.BaseEntity) &&
+		match.Status != entity.MatchStatusCancelled &&
+		match.KickoffAt.After(from) && match.KickoffAt.Before(to)
+}
+
+// officialRef returns a copy of an official, soft-deleted or not, for preloading
+func (s *Store) officialRef(id uuid.UUID) *entity.Official {
+	official, ok := s.officials[id]
+	if !ok {
+		return nil
+	}
+	return &official
+}
+
+// sortAppointments orders appointments by creation, like ORDER BY created_at
+func sortAppointments(appointments []entity.MatchOfficial) {
+	sort.SliceStable(appointments, func(i, j int) bool {
+		a, b := appointments[i], appointments[j]
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+		return a.ID.String() < b.ID.String()
+	})
+}
@@ -0,0 +1,409 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+	"gorm.io/gorm"
+)
+
+type matchRepositoryImpl struct {
+	store *Store
+}
+
+// NewMatchRepository creates a new in-memory instance of MatchRepository
+func NewMatchRepository(store *Store) repository.MatchRepository {
+	return &matchRepositoryImpl{store: store}
+}
+
+func (r *matchRepositoryImpl) Create(ctx context.Context, match *entity.Match) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record := stripMatch(*match)
+	prepareCreate(&record.BaseEntity, time.Now())
+	record.KickoffAt = record.KickoffAt.UTC()
+	if record.Status == "" {
+		record.Status = entity.MatchStatusScheduled
+	}
+	if err := r.store.checkMatch(record); err != nil {
+		return err
+	}
+
+	r.store.matches[record.ID] = record
+	match.BaseEntity = record.BaseEntity
+	match.KickoffAt = record.KickoffAt
+	match.Status = record.Status
+	return nil
+}
+
+func (r *matchRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Match, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	match, ok := r.store.matches[id]
+	if !ok || !isActive(match.BaseEntity) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &match, nil
+}
+
+func (r *matchRepositoryImpl) FindByIDWithDetails(ctx context.Context, id uuid.UUID) (*entity.Match, error) {
+	return r.FindByIDWith(ctx, id, listquery.NewInclude(repository.MatchListSchema.Relations...))
+}
+
+func (r *matchRepositoryImpl) FindByIDWith(ctx context.Context, id uuid.UUID, include listquery.Include) (*entity.Match, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	match, ok := r.store.matches[id]
+	if !ok || !isActive(match.BaseEntity) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	match = r.store.matchIncluding(match, include)
+	return &match, nil
+}
+
+func (r *matchRepositoryImpl) Update(ctx context.Context, match *entity.Match) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record := stripMatch(*match)
+	record.UpdatedAt = time.Now()
+	record.KickoffAt = record.KickoffAt.UTC()
+	if err := r.store.checkMatch(record); err != nil {
+		return err
+	}
+
+	r.store.matches[record.ID] = record
+	match.UpdatedAt = record.UpdatedAt
+	match.KickoffAt = record.KickoffAt
+	return nil
+}
+
+func (r *matchRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if match, ok := r.store.matches[id]; ok && isActive(match.BaseEntity) {
+		softDelete(&match.BaseEntity, time.Now())
+		r.store.matches[id] = match
+	}
+	return nil
+}
+
+// matchList evaluates MatchListSchema in memory, on matches with their teams
+// preloaded
+var matchList = listSpec[entity.Match]{
+	schema: repository.MatchListSchema,
+	read:   repository.MatchListReader,
+	match: map[string]func(entity.Match, listquery.Operator, interface{}) bool{
+		// The home or the away team
+		"team_id": func(m entity.Match, op listquery.Operator, value interface{}) bool {
+			return holds(m.HomeTeamID, op, value) || holds(m.AwayTeamID, op, value)
+		},
+	},
+}
+
+func (r *matchRepositoryImpl) List(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Match, int64, error) {
+	matches, total, err := r.find(page, limit, matchList.filter(query), matchList.order(query))
+	return r.including(matches, query.Include), total, err
+}
+
+func (r *matchRepositoryImpl) Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Match], error) {
+	matches, _, err := r.find(1, -1, matchList.filter(query), matchList.order(query))
+	if err != nil {
+		return listquery.Slice[entity.Match]{}, err
+	}
+	slice := matchList.scroll(matches, query, window)
+	slice.Items = r.including(slice.Items, query.Include)
+	return slice, nil
+}
+
+// including replaces the relations matches were found with by the related
+// resources of include
+func (r *matchRepositoryImpl) including(matches []entity.Match, include listquery.Include) []entity.Match {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for i := range matches {
+		matches[i] = r.store.matchIncluding(matches[i], include)
+	}
+	return matches
+}
+
+// withTeamsAndVenue includes what internal listings of matches show
+func withTeamsAndVenue(query listquery.Query) listquery.Query {
+	return query.Including("home_team", "away_team", "venue")
+}
+
+func (r *matchRepositoryImpl) FindByDateRange(ctx context.Context, from, to time.Time, page, limit int) ([]entity.Match, int64, error) {
+	query := listquery.Query{}.
+		Where("kickoff_at", listquery.Gte, from.UTC()).
+		Where("kickoff_at", listquery.Lt, to.UTC()).
+		OrderBy(listquery.Asc("kickoff_at"))
+	return r.List(ctx, withTeamsAndVenue(query), page, limit)
+}
+
+func (r *matchRepositoryImpl) FindByTeamID(ctx context.Context, teamID uuid.UUID, page, limit int) ([]entity.Match, int64, error) {
+	return r.List(ctx, withTeamsAndVenue(listquery.Query{}.Where("team_id", listquery.Eq, teamID)), page, limit)
+}
+
+func (r *matchRepositoryImpl) FindScheduledAtVenue(ctx context.Context, venueID uuid.UUID, from, to time.Time) ([]entity.Match, error) {
+	return r.findScheduled(from, to, func(match entity.Match) bool {
+		return match.VenueID != nil && *match.VenueID == venueID
+	})
+}
+
+func (r *matchRepositoryImpl) FindScheduledForTeams(ctx context.Context, teamIDs []uuid.UUID, from, to time.Time) ([]entity.Match, error) {
+	return r.findScheduled(from, to, func(match entity.Match) bool {
+		for _, id := range teamIDs {
+			if match.HomeTeamID == id || match.AwayTeamID == id {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (r *matchRepositoryImpl) FindScheduled(ctx context.Context, from, to time.Time) ([]entity.Match, error) {
+	return r.findScheduled(from, to, func(entity.Match) bool { return true })
+}
+
+// findScheduled returns the matches that are not cancelled, kick off in
+// [from, to) and match the predicate, earliest first
+func (r *matchRepositoryImpl) findScheduled(from, to time.Time, match func(entity.Match) bool) ([]entity.Match, error) {
+	matches, _, err := r.find(1, -1, func(m entity.Match) bool {
+		return m.Status != entity.MatchStatusCancelled &&
+			!m.KickoffAt.Before(from) && m.KickoffAt.Before(to) && match(m)
+	}, matchList.order(listquery.Query{}.OrderBy(listquery.Asc("kickoff_at"))))
+	return matches, err
+}
+
+func (r *matchRepositoryImpl) GetCompletedMatches(ctx context.Context, page, limit int) ([]entity.Match, int64, error) {
+	query := listquery.Query{}.
+		Where("status", listquery.Eq, string(entity.MatchStatusCompleted)).
+		OrderBy(listquery.Desc("kickoff_at")).
+		Including("goals.player")
+	return r.List(ctx, withTeamsAndVenue(query), page, limit)
+}
+
+// find returns a page of active matches matching the predicate, ordered by
+// order and with both teams preloaded
+func (r *matchRepositoryImpl) find(page, limit int, match func(entity.Match) bool, order func([]entity.Match)) ([]entity.Match, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var matches []entity.Match
+	for _, m := range r.store.matches {
+		if isActive(m.BaseEntity) && match(m) {
+			matches = append(matches, r.store.withTeams(m))
+		}
+	}
+	order(matches)
+
+	return paginate(matches, page, limit), int64(len(matches)), nil
+}
+
+func (r *matchRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	match, ok := r.store.matches[id]
+	return ok && isActive(match.BaseEntity), nil
+}
+
+func (r *matchRepositoryImpl) FindInBatches(ctx context.Context, batchSize int, fn func(matches []entity.Match) error) error {
+	r.store.mu.RLock()
+	matches := []entity.Match{}
+	for _, match := range r.store.matches {
+		if isActive(match.BaseEntity) {
+			match = r.store.withTeams(match)
+			matches = append(matches, match)
+		}
+	}
+	r.store.mu.RUnlock()
+
+	sortByID(matches, func(x entity.Match) entity.BaseEntity { return x.BaseEntity })
+	return inBatches(matches, batchSize, fn)
+}
+
+func (r *matchRepositoryImpl) FindDeleted(ctx context.Context, page, limit int) ([]entity.Match, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var matches []entity.Match
+	for _, match := range r.store.matches {
+		if !isActive(match.BaseEntity) {
+			matches = append(matches, r.store.withTeams(match))
+		}
+	}
+	sortByDeletedAtDesc(matches, func(m entity.Match) entity.BaseEntity { return m.BaseEntity })
+
+	return paginate(matches, page, limit), int64(len(matches)), nil
+}
+
+func (r *matchRepositoryImpl) Restore(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	match, ok := r.store.matches[id]
+	if !ok || isActive(match.BaseEntity) {
+		return gorm.ErrRecordNotFound
+	}
+
+	for _, teamID := range []uuid.UUID{match.HomeTeamID, match.AwayTeamID} {
+		if team, ok := r.store.teams[teamID]; !ok || !isActive(team.BaseEntity) {
+			return repository.ErrReferenceNotFound
+		}
+	}
+
+	match.DeletedAt = gorm.DeletedAt{}
+	r.store.matches[id] = match
+	return nil
+}
+
+func (r *matchRepositoryImpl) Purge(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	match, ok := r.store.matches[id]
+	if !ok || isActive(match.BaseEntity) {
+		return gorm.ErrRecordNotFound
+	}
+
+	r.store.purgeMatch(id)
+	return nil
+}
+
+func (r *matchRepositoryImpl) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var purged int64
+	for id, match := range r.store.matches {
+		if !isActive(match.BaseEntity) && match.DeletedAt.Time.Before(cutoff) {
+			r.store.purgeMatch(id)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+func (r *matchRepositoryImpl) GetTeamWinCount(ctx context.Context, teamID uuid.UUID, isHome bool) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var count int64
+	for _, match := range r.store.matches {
+		if !isActive(match.BaseEntity) || match.Status != entity.MatchStatusCompleted ||
+			match.HomeScore == nil || match.AwayScore == nil {
+			continue
+		}
+		if isHome && match.HomeTeamID == teamID && *match.HomeScore > *match.AwayScore {
+			count++
+		}
+		if !isHome && match.AwayTeamID == teamID && *match.AwayScore > *match.HomeScore {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// stripMatch removes the relations, which are not stored with the match
+func stripMatch(match entity.Match) entity.Match {
+	match.HomeTeam = nil
+	match.AwayTeam = nil
+	match.Venue = nil
+	match.Goals = nil
+	match.Cards = nil
+	return match
+}
+
+// withTeams returns the match with both teams and the venue preloaded,
+// soft-deleted or not. Callers must hold the lock.
+func (s *Store) withTeams(match entity.Match) entity.Match {
+	match.HomeTeam = s.teamRef(match.HomeTeamID)
+	match.AwayTeam = s.teamRef(match.AwayTeamID)
+	match.Venue = s.venueRef(match.VenueID)
+	return match
+}
+
+// matchIncluding returns the match with only the related resources of
+// include preloaded: teams, players and venues soft-deleted or not, and
+// the active goals and cards by minute. Callers must hold the lock.
+func (s *Store) matchIncluding(match entity.Match, include listquery.Include) entity.Match {
+	match = stripMatch(match)
+	if include.Has("home_team") {
+		match.HomeTeam = s.teamRef(match.HomeTeamID)
+	}
+	if include.Has("away_team") {
+		match.AwayTeam = s.teamRef(match.AwayTeamID)
+	}
+	if include.Has("venue") {
+		match.Venue = s.venueRef(match.VenueID)
+	}
+	if include.Has("goals") {
+		match.Goals = []entity.Goal{}
+		for _, goal := range s.goals {
+			if goal.MatchID != match.ID || !isActive(goal.BaseEntity) {
+				continue
+			}
+			if include.Has("goals.player") {
+				goal.Player = s.playerRef(goal.PlayerID)
+			}
+			if include.Has("goals.team") {
+				goal.Team = s.teamRef(goal.TeamID)
+			}
+			match.Goals = append(match.Goals, goal)
+		}
+		sort.Slice(match.Goals, func(i, j int) bool {
+			return match.Goals[i].Minute < match.Goals[j].Minute
+		})
+	}
+	if include.Has("cards") {
+		match.Cards = []entity.Card{}
+		for _, card := range s.cards {
+			if card.MatchID != match.ID || !isActive(card.BaseEntity) {
+				continue
+			}
+			if include.Has("cards.player") {
+				card.Player = s.playerRef(card.PlayerID)
+			}
+			if include.Has("cards.team") {
+				card.Team = s.teamRef(card.TeamID)
+			}
+			match.Cards = append(match.Cards, card)
+		}
+		sort.Slice(match.Cards, func(i, j int) bool {
+			return match.Cards[i].Minute < match.Cards[j].Minute
+		})
+	}
+	return match
+}
+
+// purgeMatch permanently removes a match with its goals, cards and
+// officials. Callers must hold the lock.
+func (s *Store) purgeMatch(id uuid.UUID) {
+	for goalID, goal := range s.goals {
+		if goal.MatchID == id {
+			delete(s.goals, goalID)
+		}
+	}
+	for cardID, card := range s.cards {
+		if card.MatchID == id {
+			delete(s.cards, cardID)
+		}
+	}
+	for appointmentID, appointment := range s.appointments {
+		if appointment.MatchID == id {
+			delete(s.appointments, appointmentID)
+		}
+	}
+	delete(s.matches, id)
+}
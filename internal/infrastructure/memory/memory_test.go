@@ -0,0 +1,79 @@
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository/repositorytest"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/memory"
+)
+
+func TestRepositoryConformance(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Repositories {
+		store := memory.NewStore()
+		return repositorytest.Repositories{
+			Users:   memory.NewUserRepository(store),
+			Teams:   memory.NewTeamRepository(store),
+			Players: memory.NewPlayerRepository(store),
+			Matches: memory.NewMatchRepository(store),
+			Goals:   memory.NewGoalRepository(store),
+			Audit:   memory.NewAuditRepository(store),
+			Venues:  memory.NewVenueRepository(store),
+			Cards:   memory.NewCardRepository(store),
+
+			Officials:      memory.NewOfficialRepository(store),
+			MatchOfficials: memory.NewMatchOfficialRepository(store),
+			Contracts:      memory.NewPlayerContractRepository(store),
+			Windows:        memory.NewTransferWindowRepository(store),
+			Rules:          memory.NewDisciplinaryRuleRepository(store),
+			Availability:   memory.NewPlayerAvailabilityRepository(store),
+		}
+	})
+}
+
+func TestConcurrentJerseyNumberClaims(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	teams := memory.NewTeamRepository(store)
+	players := memory.NewPlayerRepository(store)
+
+	team := &entity.Team{Name: "Persija", FoundedYear: 1928, City: "Jakarta"}
+	if err := teams.Create(ctx, team); err != nil {
+		t.Fatalf("create team: %v", err)
+	}
+
+	const attempts = 50
+	errs := make([]error, attempts)
+	var wg sync.WaitGroup
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = players.Create(ctx, &entity.Player{
+				TeamID:       team.ID,
+				Name:         fmt.Sprintf("Player %d", i),
+				Position:     entity.PositionForward,
+				JerseyNumber: 10,
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	created := 0
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			created++
+		case !errors.Is(err, repository.ErrDuplicateJerseyNumber):
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if created != 1 {
+		t.Fatalf("expected exactly one player to claim the number, got %d", created)
+	}
+}
@@ -0,0 +1,131 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+	"gorm.io/gorm"
+)
+
+type officialRepositoryImpl struct {
+	store *Store
+}
+
+// NewOfficialRepository creates a new in-memory instance of OfficialRepository
+func NewOfficialRepository(store *Store) repository.OfficialRepository {
+	return &officialRepositoryImpl{store: store}
+}
+
+func (r *officialRepositoryImpl) Create(ctx context.Context, official *entity.Official) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record := *official
+	prepareCreate(&record.BaseEntity, time.Now())
+	if err := r.store.checkOfficial(record); err != nil {
+		return err
+	}
+
+	r.store.officials[record.ID] = record
+	official.BaseEntity = record.BaseEntity
+	return nil
+}
+
+func (r *officialRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Official, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	official, ok := r.store.officials[id]
+	if !ok || !isActive(official.BaseEntity) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &official, nil
+}
+
+func (r *officialRepositoryImpl) Update(ctx context.Context, official *entity.Official) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record := *official
+	record.UpdatedAt = time.Now()
+	if err := r.store.checkOfficial(record); err != nil {
+		return err
+	}
+
+	r.store.officials[record.ID] = record
+	official.UpdatedAt = record.UpdatedAt
+	return nil
+}
+
+func (r *officialRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if official, ok := r.store.officials[id]; ok && isActive(official.BaseEntity) {
+		softDelete(&official.BaseEntity, time.Now())
+		r.store.officials[id] = official
+	}
+	return nil
+}
+
+// officialList evaluates OfficialListSchema in memory
+var officialList = listSpec[entity.Official]{
+	schema: repository.OfficialListSchema,
+	read:   repository.OfficialListReader,
+}
+
+func (r *officialRepositoryImpl) List(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Official, int64, error) {
+	return r.find(page, limit, officialList.filter(query), officialList.order(query))
+}
+
+func (r *officialRepositoryImpl) Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Official], error) {
+	officials, _, err := r.List(ctx, query, 1, -1)
+	if err != nil {
+		return listquery.Slice[entity.Official]{}, err
+	}
+	return officialList.scroll(officials, query, window), nil
+}
+
+func (r *officialRepositoryImpl) FindAvailable(ctx context.Context, from, to time.Time, exceptMatchID uuid.UUID, page, limit int) ([]entity.Official, int64, error) {
+	r.store.mu.RLock()
+	busy := make(map[uuid.UUID]bool)
+	for _, appointment := range r.store.appointments {
+		if appointment.MatchID != exceptMatchID && r.store.scheduledBetween(appointment.MatchID, from, to) {
+			busy[appointment.OfficialID] = true
+		}
+	}
+	r.store.mu.RUnlock()
+
+	return r.find(page, limit, func(official entity.Official) bool {
+		return !busy[official.ID]
+	}, officialList.order(listquery.Query{}))
+}
+
+// find returns a page of active officials matching the predicate, ordered by
+// order
+func (r *officialRepositoryImpl) find(page, limit int, match func(entity.Official) bool, order func([]entity.Official)) ([]entity.Official, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var officials []entity.Official
+	for _, official := range r.store.officials {
+		if isActive(official.BaseEntity) && match(official) {
+			officials = append(officials, official)
+		}
+	}
+	order(officials)
+
+	return paginate(officials, page, limit), int64(len(officials)), nil
+}
+
+func (r *officialRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	official, ok := r.store.officials[id]
+	return ok && isActive(official.BaseEntity), nil
+}
@@ -0,0 +1,137 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type playerAvailabilityRepositoryImpl struct {
+	store *Store
+}
+
+// NewPlayerAvailabilityRepository creates a new in-memory instance of PlayerAvailabilityRepository
+func NewPlayerAvailabilityRepository(store *Store) repository.PlayerAvailabilityRepository {
+	return &playerAvailabilityRepositoryImpl{store: store}
+}
+
+func (r *playerAvailabilityRepositoryImpl) Create(ctx context.Context, availability *entity.PlayerAvailability) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record := storedAvailability(*availability)
+	prepareCreate(&record.BaseEntity, time.Now())
+	if err := r.store.checkAvailability(record); err != nil {
+		return err
+	}
+
+	r.store.absences[record.ID] = record
+	availability.BaseEntity = record.BaseEntity
+	return nil
+}
+
+func (r *playerAvailabilityRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.PlayerAvailability, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	availability, ok := r.store.absences[id]
+	if !ok || !isActive(availability.BaseEntity) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &availability, nil
+}
+
+func (r *playerAvailabilityRepositoryImpl) Update(ctx context.Context, availability *entity.PlayerAvailability) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record := storedAvailability(*availability)
+	record.UpdatedAt = time.Now()
+	if err := r.store.checkAvailability(record); err != nil {
+		return err
+	}
+
+	r.store.absences[record.ID] = record
+	availability.UpdatedAt = record.UpdatedAt
+	return nil
+}
+
+func (r *playerAvailabilityRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if availability, ok := r.store.absences[id]; ok && isActive(availability.BaseEntity) {
+		softDelete(&availability.BaseEntity, time.Now())
+		r.store.absences[id] = availability
+	}
+	return nil
+}
+
+func (r *playerAvailabilityRepositoryImpl) FindByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.PlayerAvailability, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	records := []entity.PlayerAvailability{}
+	for _, availability := range r.store.absences {
+		if availability.PlayerID == playerID && isActive(availability.BaseEntity) {
+			records = append(records, availability)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].StartsAt.After(records[j].StartsAt)
+	})
+	return records, nil
+}
+
+func (r *playerAvailabilityRepositoryImpl) FindUnavailableAt(ctx context.Context, playerIDs []uuid.UUID, at time.Time) ([]entity.PlayerAvailability, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	wanted := make(map[uuid.UUID]bool, len(playerIDs))
+	for _, id := range playerIDs {
+		wanted[id] = true
+	}
+
+	records := []entity.PlayerAvailability{}
+	for _, availability := range r.store.absences {
+		if wanted[availability.PlayerID] && isActive(availability.BaseEntity) && availability.CoversTime(at) {
+			availability.Player = r.store.playerRef(availability.PlayerID)
+			records = append(records, availability)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].StartsAt.Before(records[j].StartsAt)
+	})
+	return records, nil
+}
+
+// storedAvailability returns a record as GORM stores it: in UTC and without
+// its player
+func storedAvailability(availability entity.PlayerAvailability) entity.PlayerAvailability {
+	availability.StartsAt = availability.StartsAt.UTC()
+	if availability.ExpectedReturnAt != nil {
+		expected := availability.ExpectedReturnAt.UTC()
+		availability.ExpectedReturnAt = &expected
+	}
+	if availability.ReturnedAt != nil {
+		returned := availability.ReturnedAt.UTC()
+		availability.ReturnedAt = &returned
+	}
+	availability.Player = nil
+	return availability
+}
+
+// deleteAvailabilities removes the availability records of a purged player.
+// Callers must hold the lock.
+func (s *Store) deleteAvailabilities(playerID uuid.UUID) {
+	for id, availability := range s.absences {
+		if availability.PlayerID == playerID {
+			delete(s.absences, id)
+		}
+	}
+}
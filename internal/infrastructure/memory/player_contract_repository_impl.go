@@ -0,0 +1,183 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type playerContractRepositoryImpl struct {
+	store *Store
+}
+
+// NewPlayerContractRepository creates a new in-memory instance of PlayerContractRepository
+func NewPlayerContractRepository(store *Store) repository.PlayerContractRepository {
+	return &playerContractRepositoryImpl{store: store}
+}
+
+func (r *playerContractRepositoryImpl) FindByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.PlayerContract, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	contracts := []entity.PlayerContract{}
+	for _, contract := range r.store.contracts {
+		if contract.PlayerID == playerID {
+			contract.Team = r.store.teamRef(contract.TeamID)
+			contracts = append(contracts, contract)
+		}
+	}
+	sortContracts(contracts)
+	return contracts, nil
+}
+
+func (r *playerContractRepositoryImpl) FindCurrent(ctx context.Context, playerID uuid.UUID) (*entity.PlayerContract, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	_, contract, ok := r.store.currentContract(playerID)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &contract, nil
+}
+
+func (r *playerContractRepositoryImpl) FindAsOf(ctx context.Context, playerID uuid.UUID, at time.Time) (*entity.PlayerContract, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, contract := range r.store.contracts {
+		if contract.PlayerID == playerID && contract.Covers(at) {
+			return &contract, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *playerContractRepositoryImpl) FindByTeamAsOf(ctx context.Context, teamID uuid.UUID, at time.Time, page, limit int) ([]entity.PlayerContract, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	contracts := []entity.PlayerContract{}
+	for _, contract := range r.store.contracts {
+		if contract.TeamID != teamID || !contract.Covers(at) {
+			continue
+		}
+		player, ok := r.store.players[contract.PlayerID]
+		if !ok || !isActive(player.BaseEntity) {
+			continue
+		}
+		contract.Player = &player
+		contracts = append(contracts, contract)
+	}
+	sort.Slice(contracts, func(i, j int) bool {
+		return contracts[i].JerseyNumber < contracts[j].JerseyNumber
+	})
+	return paginate(contracts, page, limit), int64(len(contracts)), nil
+}
+
+// Transfer ends the current contract, stores the new one and moves the
+// player or, if any of them violates a constraint, changes nothing
+func (r *playerContractRepositoryImpl) Transfer(ctx context.Context, contract *entity.PlayerContract) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	currentID, previous, ok := r.store.currentContract(contract.PlayerID)
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+
+	now := time.Now()
+	end := contract.StartsAt.UTC()
+	current := previous
+	current.EndsAt = &end
+	current.UpdatedAt = now
+
+	start := end
+	record := *contract
+	record.StartsAt = &start
+	record.Player = nil
+	record.Team = nil
+	if record.ID == uuid.Nil {
+		record.ID = uuid.New()
+	}
+	record.CreatedAt = now
+	record.UpdatedAt = now
+
+	moved := r.store.players[contract.PlayerID]
+	moved.TeamID = record.TeamID
+	moved.JerseyNumber = record.JerseyNumber
+	moved.UpdatedAt = now
+
+	// The current contract is ended first, so that it does not count as a
+	// second current contract of the player
+	r.store.contracts[currentID] = current
+	for _, check := range []func() error{
+		func() error { return r.store.checkContract(current) },
+		func() error { return r.store.checkContract(record) },
+		func() error { return r.store.checkPlayer(moved) },
+	} {
+		if err := check(); err != nil {
+			r.store.contracts[currentID] = previous
+			return err
+		}
+	}
+
+	r.store.contracts[record.ID] = record
+	r.store.players[moved.ID] = moved
+	contract.ID = record.ID
+	contract.CreatedAt = record.CreatedAt
+	contract.UpdatedAt = record.UpdatedAt
+	return nil
+}
+
+// startContract stores the first contract of a new player, which has no
+// start. Callers must hold the lock.
+func (s *Store) startContract(player entity.Player) {
+	contract := entity.PlayerContract{
+		ID:           uuid.New(),
+		PlayerID:     player.ID,
+		TeamID:       player.TeamID,
+		JerseyNumber: player.JerseyNumber,
+		CreatedAt:    player.CreatedAt,
+		UpdatedAt:    player.CreatedAt,
+	}
+	s.contracts[contract.ID] = contract
+}
+
+// currentContract returns the contract of a player that has not ended.
+// Callers must hold the lock.
+func (s *Store) currentContract(playerID uuid.UUID) (uuid.UUID, entity.PlayerContract, bool) {
+	for id, contract := range s.contracts {
+		if contract.PlayerID == playerID && contract.IsCurrent() {
+			return id, contract, true
+		}
+	}
+	return uuid.Nil, entity.PlayerContract{}, false
+}
+
+// deleteContracts removes the contracts of a purged player. Callers must
+// hold the lock.
+func (s *Store) deleteContracts(playerID uuid.UUID) {
+	for id, contract := range s.contracts {
+		if contract.PlayerID == playerID {
+			delete(s.contracts, id)
+		}
+	}
+}
+
+// sortContracts orders contracts oldest first. The first contract of a
+// player has no start.
+func sortContracts(contracts []entity.PlayerContract) {
+	sort.Slice(contracts, func(i, j int) bool {
+		a, b := contracts[i].StartsAt, contracts[j].StartsAt
+		if a == nil || b == nil {
+			return a == nil && b != nil
+		}
+		return a.Before(*b)
+	})
+}
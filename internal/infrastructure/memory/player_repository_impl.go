@@ -0,0 +1,379 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+	"github.com/zenkriztao/ayo-football-backend/pkg/textsearch"
+	"gorm.io/gorm"
+)
+
+type playerRepositoryImpl struct {
+	store *Store
+}
+
+// NewPlayerRepository creates a new in-memory instance of PlayerRepository
+func NewPlayerRepository(store *Store) repository.PlayerRepository {
+	return &playerRepositoryImpl{store: store}
+}
+
+func (r *playerRepositoryImpl) Create(ctx context.Context, player *entity.Player) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record := playerRecord(*player)
+	prepareCreate(&record.BaseEntity, time.Now())
+	if err := r.store.checkPlayer(record); err != nil {
+		return err
+	}
+
+	r.store.players[record.ID] = record
+	r.store.startContract(record)
+	player.BaseEntity = record.BaseEntity
+	return nil
+}
+
+// CreateBatch stores all players or, if any of them violates a constraint, none
+func (r *playerRepositoryImpl) CreateBatch(ctx context.Context, players []entity.Player) error {
+	if len(players) == 0 {
+		return nil
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now()
+	records := make([]entity.Player, len(players))
+	for i, player := range players {
+		record := playerRecord(player)
+		prepareCreate(&record.BaseEntity, now)
+		if err := r.store.checkPlayer(record); err != nil {
+			return err
+		}
+		for _, earlier := range records[:i] {
+			if earlier.TeamID == record.TeamID && earlier.JerseyNumber == record.JerseyNumber {
+				return repository.ErrDuplicateJerseyNumber
+			}
+		}
+		records[i] = record
+	}
+
+	for i, record := range records {
+		r.store.players[record.ID] = record
+		r.store.startContract(record)
+		players[i].BaseEntity = record.BaseEntity
+	}
+	return nil
+}
+
+// playerRecord returns the copy of a player kept in the store: without its
+// team, with its own positions slice and the date of birth stored the way the
+// database hook stores it
+func playerRecord(player entity.Player) entity.Player {
+	player.Team = nil
+	player.SecondaryPositions = append(entity.PlayerPositions{}, player.SecondaryPositions...)
+	player.DateOfBirth = entity.CalendarDate(player.DateOfBirth)
+	return player
+}
+
+func (r *playerRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Player, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	player, ok := r.store.players[id]
+	if !ok || !isActive(player.BaseEntity) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &player, nil
+}
+
+func (r *playerRepositoryImpl) FindByIDWithTeam(ctx context.Context, id uuid.UUID) (*entity.Player, error) {
+	return r.FindByIDWith(ctx, id, listquery.NewInclude("team"))
+}
+
+func (r *playerRepositoryImpl) FindByIDWith(ctx context.Context, id uuid.UUID, include listquery.Include) (*entity.Player, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	player, ok := r.store.players[id]
+	if !ok || !isActive(player.BaseEntity) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	if include.Has("team") {
+		player.Team = r.store.teamRef(player.TeamID)
+	}
+	return &player, nil
+}
+
+func (r *playerRepositoryImpl) Update(ctx context.Context, player *entity.Player) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record := playerRecord(*player)
+	record.UpdatedAt = time.Now()
+	if err := r.store.checkPlayer(record); err != nil {
+		return err
+	}
+
+	r.store.players[record.ID] = record
+	if id, contract, ok := r.store.currentContract(record.ID); ok {
+		contract.JerseyNumber = record.JerseyNumber
+		contract.UpdatedAt = record.UpdatedAt
+		r.store.contracts[id] = contract
+	}
+	player.UpdatedAt = record.UpdatedAt
+	return nil
+}
+
+func (r *playerRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if player, ok := r.store.players[id]; ok && isActive(player.BaseEntity) {
+		softDelete(&player.BaseEntity, time.Now())
+		r.store.players[id] = player
+	}
+	return nil
+}
+
+// playerList evaluates PlayerListSchema in memory, on players with their
+// team preloaded
+var playerList = listSpec[entity.Player]{
+	schema: repository.PlayerListSchema,
+	read:   repository.PlayerListReader,
+	match: map[string]func(entity.Player, listquery.Operator, interface{}) bool{
+		// The primary or a secondary position
+		"position": func(p entity.Player, op listquery.Operator, value interface{}) bool {
+			positions := []interface{}{value}
+			if op == listquery.In {
+				positions = value.([]interface{})
+			}
+			for _, position := range positions {
+				position := entity.PlayerPosition(position.(string))
+				if p.Position == position || p.SecondaryPositions.Contains(position) {
+					return true
+				}
+			}
+			return false
+		},
+	},
+}
+
+func (r *playerRepositoryImpl) List(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Player, int64, error) {
+	players, total, err := r.find(page, limit, playerList.filter(query), playerList.order(query))
+	return playersIncluding(players, query.Include), total, err
+}
+
+func (r *playerRepositoryImpl) Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Player], error) {
+	players, _, err := r.find(1, -1, playerList.filter(query), playerList.order(query))
+	if err != nil {
+		return listquery.Slice[entity.Player]{}, err
+	}
+	slice := playerList.scroll(players, query, window)
+	slice.Items = playersIncluding(slice.Items, query.Include)
+	return slice, nil
+}
+
+// playersIncluding clears the team players were found with unless include
+// has it
+func playersIncluding(players []entity.Player, include listquery.Include) []entity.Player {
+	if !include.Has("team") {
+		for i := range players {
+			players[i].Team = nil
+		}
+	}
+	return players
+}
+
+func (r *playerRepositoryImpl) Search(ctx context.Context, text string, limit int) ([]textsearch.Hit[entity.Player], error) {
+	players, _, err := r.List(ctx, listquery.Query{}.Including("team"), 1, -1)
+	if err != nil {
+		return nil, err
+	}
+	return textsearch.Rank(players, text, func(p entity.Player) string { return p.Name }, limit), nil
+}
+
+func (r *playerRepositoryImpl) FindByTeamID(ctx context.Context, teamID uuid.UUID, page, limit int) ([]entity.Player, int64, error) {
+	query := listquery.Query{}.
+		Where("team_id", listquery.Eq, teamID).
+		OrderBy(listquery.Asc("jersey_number"))
+	return r.List(ctx, query, page, limit)
+}
+
+func (r *playerRepositoryImpl) IsJerseyNumberTaken(ctx context.Context, teamID uuid.UUID, jerseyNumber int, excludePlayerID *uuid.UUID) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, player := range r.store.players {
+		if excludePlayerID != nil && player.ID == *excludePlayerID {
+			continue
+		}
+		if isActive(player.BaseEntity) && player.TeamID == teamID && player.JerseyNumber == jerseyNumber {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// find returns a page of active players matching the predicate, ordered by
+// order and with their team preloaded
+func (r *playerRepositoryImpl) find(page, limit int, match func(entity.Player) bool, order func([]entity.Player)) ([]entity.Player, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var players []entity.Player
+	for _, player := range r.store.players {
+		if isActive(player.BaseEntity) && match(player) {
+			player.Team = r.store.teamRef(player.TeamID)
+			players = append(players, player)
+		}
+	}
+
+	order(players)
+
+	return paginate(players, page, limit), int64(len(players)), nil
+}
+
+func (r *playerRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	player, ok := r.store.players[id]
+	return ok && isActive(player.BaseEntity), nil
+}
+
+func (r *playerRepositoryImpl) FindInBatches(ctx context.Context, batchSize int, fn func(players []entity.Player) error) error {
+	r.store.mu.RLock()
+	players := []entity.Player{}
+	for _, player := range r.store.players {
+		if isActive(player.BaseEntity) {
+			player.Team = r.store.teamRef(player.TeamID)
+			players = append(players, player)
+		}
+	}
+	r.store.mu.RUnlock()
+
+	sortByID(players, func(x entity.Player) entity.BaseEntity { return x.BaseEntity })
+	return inBatches(players, batchSize, fn)
+}
+
+func (r *playerRepositoryImpl) FindDeleted(ctx context.Context, page, limit int) ([]entity.Player, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var players []entity.Player
+	for _, player := range r.store.players {
+		if !isActive(player.BaseEntity) {
+			player.Team = r.store.teamRef(player.TeamID)
+			players = append(players, player)
+		}
+	}
+	sortByDeletedAtDesc(players, func(p entity.Player) entity.BaseEntity { return p.BaseEntity })
+
+	return paginate(players, page, limit), int64(len(players)), nil
+}
+
+func (r *playerRepositoryImpl) Restore(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	player, ok := r.store.players[id]
+	if !ok || isActive(player.BaseEntity) {
+		return gorm.ErrRecordNotFound
+	}
+
+	// A player cannot come back into a team that is itself in the trash
+	if team, ok := r.store.teams[player.TeamID]; !ok || !isActive(team.BaseEntity) {
+		return repository.ErrReferenceNotFound
+	}
+
+	player.DeletedAt = gorm.DeletedAt{}
+	if err := r.store.checkPlayer(player); err != nil {
+		return err
+	}
+
+	r.store.players[id] = player
+	return nil
+}
+
+func (r *playerRepositoryImpl) Purge(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	player, ok := r.store.players[id]
+	if !ok || isActive(player.BaseEntity) {
+		return gorm.ErrRecordNotFound
+	}
+	if r.store.playerReferenced(id) {
+		return repository.ErrStillReferenced
+	}
+
+	r.store.deleteContracts(id)
+	r.store.deleteAvailabilities(id)
+	delete(r.store.players, id)
+	return nil
+}
+
+func (r *playerRepositoryImpl) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var purged int64
+	for id, player := range r.store.players {
+		if !isActive(player.BaseEntity) && player.DeletedAt.Time.Before(cutoff) && !r.store.playerReferenced(id) {
+			r.store.deleteContracts(id)
+			r.store.deleteAvailabilities(id)
+			delete(r.store.players, id)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+func (r *playerRepositoryImpl) GetTopScorers(ctx context.Context, limit int) ([]repository.PlayerGoalCount, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	counts := make(map[uuid.UUID]int64)
+	for _, goal := range r.store.goals {
+		if isActive(goal.BaseEntity) {
+			counts[goal.PlayerID]++
+		}
+	}
+
+	results := make([]repository.PlayerGoalCount, 0, len(counts))
+	for playerID, count := range counts {
+		if player, ok := r.store.players[playerID]; ok {
+			results = append(results, repository.PlayerGoalCount{Player: player, GoalCount: count})
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].GoalCount != results[j].GoalCount {
+			return results[i].GoalCount > results[j].GoalCount
+		}
+		return results[i].Player.Name < results[j].Player.Name
+	})
+
+	return paginate(results, 1, limit), nil
+}
+
+// playerReferenced reports whether goals or cards, including soft-deleted
+// ones, still point at a player. Callers must hold the lock.
+func (s *Store) playerReferenced(id uuid.UUID) bool {
+	for _, goal := range s.goals {
+		if goal.PlayerID == id {
+			return true
+		}
+	}
+	for _, card := range s.cards {
+		if card.PlayerID == id {
+			return true
+		}
+	}
+	return false
+}
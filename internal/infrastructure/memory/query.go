@@ -0,0 +1,172 @@
+package memory
+
+import (
+	"bytes"
+	"cmp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+)
+
+// listSpec evaluates the queries of a listquery schema against items of T,
+// with the same results as the SQL of the database repositories
+type listSpec[T any] struct {
+	schema listquery.Schema
+	read   listquery.Reader[T]
+	// match, if set for a field, replaces the comparison of its value
+	match map[string]func(item T, op listquery.Operator, value interface{}) bool
+}
+
+// filter returns a predicate for the items matching the search and every
+// condition of query
+func (s listSpec[T]) filter(query listquery.Query) func(T) bool {
+	search := strings.ToLower(query.Search)
+	return func(item T) bool {
+		if search != "" && len(s.schema.Search) > 0 {
+			found := false
+			for _, field := range s.schema.Search {
+				if holds(s.read.Fields[field](item), listquery.Contains, search) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		for _, c := range query.Conditions {
+			if match, ok := s.match[c.Field]; ok {
+				if !match(item, c.Op, c.Value) {
+					return false
+				}
+				continue
+			}
+			if !holds(s.read.Fields[c.Field](item), c.Op, c.Value) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// order returns a function sorting items by query's sort order, or the
+// schema's default, with the ID as the final tie-breaker
+func (s listSpec[T]) order(query listquery.Query) func([]T) {
+	orders := query.SortOrDefault(s.schema)
+	return func(items []T) {
+		sort.SliceStable(items, func(i, j int) bool {
+			return compareCursors(s.read.Cursor(items[i], orders), s.read.Cursor(items[j], orders)) < 0
+		})
+	}
+}
+
+// scroll returns the slice of window from items sorted by query, like the
+// keyset queries of the database repositories
+func (s listSpec[T]) scroll(items []T, query listquery.Query, window listquery.Window) listquery.Slice[T] {
+	orders := query.SortOrDefault(s.schema)
+	total := int64(len(items))
+	if c := window.Cursor; c != nil {
+		var past []T
+		for _, item := range items {
+			switch cmp := compareCursors(s.read.Cursor(item, orders), c); {
+			case cmp > 0 && !c.Before, cmp < 0 && c.Before:
+				past = append(past, item)
+			}
+		}
+		if c.Before {
+			for i, j := 0, len(past)-1; i < j; i, j = i+1, j-1 {
+				past[i], past[j] = past[j], past[i]
+			}
+		}
+		items = past
+	}
+	if len(items) > window.Limit+1 {
+		items = items[:window.Limit+1]
+	}
+
+	slice := listquery.NewSlice(items, window, func(item T) *listquery.Cursor {
+		return s.read.Cursor(item, orders)
+	})
+	if window.Count {
+		slice.Total = &total
+	}
+	return slice
+}
+
+// compareCursors compares two positions in the same sort order. Unknown
+// values sort last and the ID breaks ties.
+func compareCursors(a, b *listquery.Cursor) int {
+	for i, o := range a.Sort {
+		x, y := a.Values[i], b.Values[i]
+		switch {
+		case x == nil && y == nil:
+			continue
+		case x == nil:
+			return 1
+		case y == nil:
+			return -1
+		}
+		if c := compareValues(x, y); c != 0 {
+			if o.Desc {
+				return -c
+			}
+			return c
+		}
+	}
+	return bytes.Compare(a.ID[:], b.ID[:])
+}
+
+// holds reports whether the value of a field satisfies a condition
+func holds(v interface{}, op listquery.Operator, value interface{}) bool {
+	if v == nil {
+		return false
+	}
+	switch op {
+	case listquery.In:
+		for _, item := range value.([]interface{}) {
+			if compareValues(v, item) == 0 {
+				return true
+			}
+		}
+		return false
+	case listquery.Contains:
+		return strings.Contains(strings.ToLower(v.(string)), strings.ToLower(value.(string)))
+	}
+	c := compareValues(v, value)
+	switch op {
+	case listquery.Ne:
+		return c != 0
+	case listquery.Gt:
+		return c > 0
+	case listquery.Gte:
+		return c >= 0
+	case listquery.Lt:
+		return c < 0
+	case listquery.Lte:
+		return c <= 0
+	default:
+		return c == 0
+	}
+}
+
+// compareValues compares two field values of the same type
+func compareValues(a, b interface{}) int {
+	switch a := a.(type) {
+	case string:
+		return strings.Compare(a, b.(string))
+	case int:
+		return cmp.Compare(a, b.(int))
+	case float64:
+		return cmp.Compare(a, b.(float64))
+	case time.Time:
+		return a.Compare(b.(time.Time))
+	case uuid.UUID:
+		b := b.(uuid.UUID)
+		return bytes.Compare(a[:], b[:])
+	default:
+		return 0
+	}
+}
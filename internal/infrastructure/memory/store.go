@@ -0,0 +1,416 @@
+// Package memory provides thread-safe in-memory implementations of the
+// repository interfaces. They mirror the GORM-backed repositories, including
+// soft delete, pagination, constraint errors and gorm.ErrRecordNotFound, so
+// use cases can be tested without a database.
+package memory
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// Store holds the records shared by the in-memory repositories. Repositories
+// created from the same Store see each other's data, like tables of one
+// database.
+type Store struct {
+	mu           sync.RWMutex
+	users        map[uuid.UUID]entity.User
+	teams        map[uuid.UUID]entity.Team
+	players      map[uuid.UUID]entity.Player
+	matches      map[uuid.UUID]entity.Match
+	goals        map[uuid.UUID]entity.Goal
+	venues       map[uuid.UUID]entity.Venue
+	cards        map[uuid.UUID]entity.Card
+	officials    map[uuid.UUID]entity.Official
+	appointments map[uuid.UUID]entity.MatchOfficial
+	contracts    map[uuid.UUID]entity.PlayerContract
+	windows      map[uuid.UUID]entity.TransferWindow
+	rules        map[uuid.UUID]entity.DisciplinaryRule
+	absences     map[uuid.UUID]entity.PlayerAvailability
+	auditLogs    []entity.AuditLog
+}
+
+// NewStore creates an empty Store
+func NewStore() *Store {
+	return &Store{
+		users:        make(map[uuid.UUID]entity.User),
+		teams:        make(map[uuid.UUID]entity.Team),
+		players:      make(map[uuid.UUID]entity.Player),
+		matches:      make(map[uuid.UUID]entity.Match),
+		goals:        make(map[uuid.UUID]entity.Goal),
+		venues:       make(map[uuid.UUID]entity.Venue),
+		cards:        make(map[uuid.UUID]entity.Card),
+		officials:    make(map[uuid.UUID]entity.Official),
+		appointments: make(map[uuid.UUID]entity.MatchOfficial),
+		contracts:    make(map[uuid.UUID]entity.PlayerContract),
+		windows:      make(map[uuid.UUID]entity.TransferWindow),
+		rules:        make(map[uuid.UUID]entity.DisciplinaryRule),
+		absences:     make(map[uuid.UUID]entity.PlayerAvailability),
+	}
+}
+
+// Names of the database constraints the checks below emulate
+const (
+	constraintPlayersJerseyNumber = repository.ConstraintPlayersJerseyNumber
+	constraintPlayersFoot         = repository.ConstraintPlayersPreferredFoot
+	constraintMatchesStatus       = "chk_matches_status"
+	constraintGoalsMinute         = "chk_goals_minute"
+	constraintPlayersTeam         = "fk_players_team"
+	constraintMatchesHomeTeam     = "fk_matches_home_team"
+	constraintMatchesAwayTeam     = "fk_matches_away_team"
+	constraintMatchesVenue        = "fk_matches_venue"
+	constraintTeamsHomeVenue      = "fk_teams_home_venue"
+	constraintGoalsMatch          = "fk_goals_match"
+	constraintGoalsPlayer         = "fk_goals_player"
+	constraintGoalsTeam           = "fk_goals_team"
+	constraintCardsMinute         = "chk_cards_minute"
+	constraintCardsType           = "chk_cards_type"
+	constraintCardsMatch          = "fk_cards_match"
+	constraintCardsPlayer         = "fk_cards_player"
+	constraintCardsTeam           = "fk_cards_team"
+	constraintOfficialsLicense    = "chk_officials_license_level"
+	constraintMatchOfficialsRole  = "chk_match_officials_role"
+	constraintMatchOfficialsMatch = "fk_match_officials_match"
+	constraintMatchOfficialsRef   = "fk_match_officials_official"
+	constraintContractsJersey     = "chk_player_contracts_jersey_number"
+	constraintContractsPeriod     = "chk_player_contracts_period"
+	constraintContractsPlayer     = "fk_player_contracts_player"
+	constraintContractsTeam       = "fk_player_contracts_team"
+	constraintWindowsPeriod       = "chk_transfer_windows_period"
+	constraintRulesSeason         = "uq_disciplinary_rules_season"
+	constraintRulesYellowLimit    = "chk_disciplinary_rules_yellow_card_limit"
+	constraintRulesRedBan         = "chk_disciplinary_rules_red_card_ban"
+	constraintAvailabilityStatus  = "chk_player_availabilities_status"
+	constraintAvailabilityExpect  = "chk_player_availabilities_expected_return"
+	constraintAvailabilityReturn  = "chk_player_availabilities_returned"
+	constraintAvailabilityPlayer  = "fk_player_availabilities_player"
+)
+
+// prepareCreate assigns the ID and timestamps GORM sets on insert
+func prepareCreate(base *entity.BaseEntity, now time.Time) {
+	if base.ID == uuid.Nil {
+		base.ID = uuid.New()
+	}
+	if base.CreatedAt.IsZero() {
+		base.CreatedAt = now
+	}
+	if base.UpdatedAt.IsZero() {
+		base.UpdatedAt = now
+	}
+}
+
+// softDelete marks a record as deleted at the given time
+func softDelete(base *entity.BaseEntity, at time.Time) {
+	base.DeletedAt = gorm.DeletedAt{Time: at, Valid: true}
+}
+
+// isActive reports whether a record has not been soft deleted
+func isActive(base entity.BaseEntity) bool {
+	return !base.DeletedAt.Valid
+}
+
+// checkUser enforces the unique email index. Soft-deleted users keep their
+// email reserved, as the index is not partial. Callers must hold the lock.
+func (s *Store) checkUser(user entity.User) error {
+	for id, existing := range s.users {
+		if id != user.ID && existing.Email == user.Email {
+			return repository.ErrDuplicateEmail
+		}
+	}
+	return nil
+}
+
+// checkPlayer enforces the player constraints. Callers must hold the lock.
+func (s *Store) checkPlayer(player entity.Player) error {
+	if player.JerseyNumber < 1 || player.JerseyNumber > 99 {
+		return fmt.Errorf("%w: %s", repository.ErrCheckViolation, constraintPlayersJerseyNumber)
+	}
+	if !entity.IsValidPreferredFoot(player.PreferredFoot) {
+		return fmt.Errorf("%w: %s", repository.ErrCheckViolation, constraintPlayersFoot)
+	}
+	if _, ok := s.teams[player.TeamID]; !ok {
+		return fmt.Errorf("%w: %s", repository.ErrReferenceNotFound, constraintPlayersTeam)
+	}
+	if isActive(player.BaseEntity) {
+		for id, existing := range s.players {
+			if id != player.ID && isActive(existing.BaseEntity) &&
+				existing.TeamID == player.TeamID && existing.JerseyNumber == player.JerseyNumber {
+				return repository.ErrDuplicateJerseyNumber
+			}
+		}
+	}
+	return nil
+}
+
+// checkMatch enforces the match constraints. Callers must hold the lock.
+func (s *Store) checkMatch(match entity.Match) error {
+	if match.HomeTeamID == match.AwayTeamID {
+		return repository.ErrSameTeams
+	}
+	if (match.HomeScore != nil && *match.HomeScore < 0) || (match.AwayScore != nil && *match.AwayScore < 0) {
+		return repository.ErrNegativeScore
+	}
+	switch match.Status {
+	case entity.MatchStatusScheduled, entity.MatchStatusOngoing, entity.MatchStatusCompleted, entity.MatchStatusCancelled:
+	default:
+		return fmt.Errorf("%w: %s", repository.ErrCheckViolation, constraintMatchesStatus)
+	}
+	if _, ok := s.teams[match.HomeTeamID]; !ok {
+		return fmt.Errorf("%w: %s", repository.ErrReferenceNotFound, constraintMatchesHomeTeam)
+	}
+	if _, ok := s.teams[match.AwayTeamID]; !ok {
+		return fmt.Errorf("%w: %s", repository.ErrReferenceNotFound, constraintMatchesAwayTeam)
+	}
+	if match.VenueID != nil {
+		if _, ok := s.venues[*match.VenueID]; !ok {
+			return fmt.Errorf("%w: %s", repository.ErrReferenceNotFound, constraintMatchesVenue)
+		}
+	}
+	return nil
+}
+
+// checkTeam enforces the team constraints. Callers must hold the lock.
+func (s *Store) checkTeam(team entity.Team) error {
+	if team.HomeVenueID != nil {
+		if _, ok := s.venues[*team.HomeVenueID]; !ok {
+			return fmt.Errorf("%w: %s", repository.ErrReferenceNotFound, constraintTeamsHomeVenue)
+		}
+	}
+	return nil
+}
+
+// checkGoal enforces the goal constraints. Callers must hold the lock.
+func (s *Store) checkGoal(goal entity.Goal) error {
+	if goal.Minute < 1 || goal.Minute > 120 {
+		return fmt.Errorf("%w: %s", repository.ErrCheckViolation, constraintGoalsMinute)
+	}
+	if _, ok := s.matches[goal.MatchID]; !ok {
+		return fmt.Errorf("%w: %s", repository.ErrReferenceNotFound, constraintGoalsMatch)
+	}
+	if _, ok := s.players[goal.PlayerID]; !ok {
+		return fmt.Errorf("%w: %s", repository.ErrReferenceNotFound, constraintGoalsPlayer)
+	}
+	if _, ok := s.teams[goal.TeamID]; !ok {
+		return fmt.Errorf("%w: %s", repository.ErrReferenceNotFound, constraintGoalsTeam)
+	}
+	return nil
+}
+
+// checkCard enforces the card constraints. Callers must hold the lock.
+func (s *Store) checkCard(card entity.Card) error {
+	if card.Minute < 1 || card.Minute > 120 {
+		return fmt.Errorf("%w: %s", repository.ErrCheckViolation, constraintCardsMinute)
+	}
+	if !entity.IsValidCardType(card.Type) {
+		return fmt.Errorf("%w: %s", repository.ErrCheckViolation, constraintCardsType)
+	}
+	if _, ok := s.matches[card.MatchID]; !ok {
+		return fmt.Errorf("%w: %s", repository.ErrReferenceNotFound, constraintCardsMatch)
+	}
+	if _, ok := s.players[card.PlayerID]; !ok {
+		return fmt.Errorf("%w: %s", repository.ErrReferenceNotFound, constraintCardsPlayer)
+	}
+	if _, ok := s.teams[card.TeamID]; !ok {
+		return fmt.Errorf("%w: %s", repository.ErrReferenceNotFound, constraintCardsTeam)
+	}
+	return nil
+}
+
+// checkOfficial enforces the official constraints. Callers must hold the lock.
+func (s *Store) checkOfficial(official entity.Official) error {
+	if !entity.IsValidLicenseLevel(official.LicenseLevel) {
+		return fmt.Errorf("%w: %s", repository.ErrCheckViolation, constraintOfficialsLicense)
+	}
+	return nil
+}
+
+// checkAppointment enforces the match official constraints against the
+// given appointments, which replace the stored ones of the match. Callers
+// must hold the lock.
+func (s *Store) checkAppointment(appointment entity.MatchOfficial, others []entity.MatchOfficial) error {
+	if !entity.IsValidOfficialRole(appointment.Role) {
+		return fmt.Errorf("%w: %s", repository.ErrCheckViolation, constraintMatchOfficialsRole)
+	}
+	if _, ok := s.matches[appointment.MatchID]; !ok {
+		return fmt.Errorf("%w: %s", repository.ErrReferenceNotFound, constraintMatchOfficialsMatch)
+	}
+	if _, ok := s.officials[appointment.OfficialID]; !ok {
+		return fmt.Errorf("%w: %s", repository.ErrReferenceNotFound, constraintMatchOfficialsRef)
+	}
+	for _, other := range others {
+		if other.Role == appointment.Role || other.OfficialID == appointment.OfficialID {
+			return repository.ErrDuplicateAppointment
+		}
+	}
+	return nil
+}
+
+// checkContract enforces the player contract constraints. Callers must hold
+// the lock.
+func (s *Store) checkContract(contract entity.PlayerContract) error {
+	if contract.JerseyNumber < 1 || contract.JerseyNumber > 99 {
+		return fmt.Errorf("%w: %s", repository.ErrCheckViolation, constraintContractsJersey)
+	}
+	if contract.StartsAt != nil && contract.EndsAt != nil && !contract.StartsAt.Before(*contract.EndsAt) {
+		return fmt.Errorf("%w: %s", repository.ErrCheckViolation, constraintContractsPeriod)
+	}
+	if _, ok := s.players[contract.PlayerID]; !ok {
+		return fmt.Errorf("%w: %s", repository.ErrReferenceNotFound, constraintContractsPlayer)
+	}
+	if _, ok := s.teams[contract.TeamID]; !ok {
+		return fmt.Errorf("%w: %s", repository.ErrReferenceNotFound, constraintContractsTeam)
+	}
+	if contract.IsCurrent() {
+		for id, existing := range s.contracts {
+			if id != contract.ID && existing.PlayerID == contract.PlayerID && existing.IsCurrent() {
+				return repository.ErrDuplicateContract
+			}
+		}
+	}
+	return nil
+}
+
+// checkWindow enforces the transfer window constraints. Callers must hold
+// the lock.
+func (s *Store) checkWindow(window entity.TransferWindow) error {
+	if !window.OpensAt.Before(window.ClosesAt) {
+		return fmt.Errorf("%w: %s", repository.ErrCheckViolation, constraintWindowsPeriod)
+	}
+	return nil
+}
+
+// checkRule enforces the disciplinary rule constraints. Callers must hold
+// the lock.
+func (s *Store) checkRule(rule entity.DisciplinaryRule) error {
+	if rule.YellowCardLimit < 1 {
+		return fmt.Errorf("%w: %s", repository.ErrCheckViolation, constraintRulesYellowLimit)
+	}
+	if rule.RedCardBan < 1 {
+		return fmt.Errorf("%w: %s", repository.ErrCheckViolation, constraintRulesRedBan)
+	}
+	for id, other := range s.rules {
+		if id != rule.ID && other.Season == rule.Season {
+			return fmt.Errorf("%w: %s", repository.ErrDuplicateKey, constraintRulesSeason)
+		}
+	}
+	return nil
+}
+
+// checkAvailability enforces the player availability constraints. Callers
+// must hold the lock.
+func (s *Store) checkAvailability(availability entity.PlayerAvailability) error {
+	if !entity.IsValidAvailabilityStatus(availability.Status) {
+		return fmt.Errorf("%w: %s", repository.ErrCheckViolation, constraintAvailabilityStatus)
+	}
+	if availability.ExpectedReturnAt != nil && !availability.StartsAt.Before(*availability.ExpectedReturnAt) {
+		return fmt.Errorf("%w: %s", repository.ErrCheckViolation, constraintAvailabilityExpect)
+	}
+	if availability.ReturnedAt != nil && !availability.StartsAt.Before(*availability.ReturnedAt) {
+		return fmt.Errorf("%w: %s", repository.ErrCheckViolation, constraintAvailabilityReturn)
+	}
+	if _, ok := s.players[availability.PlayerID]; !ok {
+		return fmt.Errorf("%w: %s", repository.ErrReferenceNotFound, constraintAvailabilityPlayer)
+	}
+	return nil
+}
+
+// teamRef returns a copy of a team, soft-deleted or not, for preloading
+func (s *Store) teamRef(id uuid.UUID) *entity.Team {
+	team, ok := s.teams[id]
+	if !ok {
+		return nil
+	}
+	return &team
+}
+
+// venueRef returns a copy of a venue, soft-deleted or not, for preloading
+func (s *Store) venueRef(id *uuid.UUID) *entity.Venue {
+	if id == nil {
+		return nil
+	}
+	venue, ok := s.venues[*id]
+	if !ok {
+		return nil
+	}
+	return &venue
+}
+
+// playerRef returns a copy of a player, soft-deleted or not, for preloading
+func (s *Store) playerRef(id uuid.UUID) *entity.Player {
+	player, ok := s.players[id]
+	if !ok {
+		return nil
+	}
+	return &player
+}
+
+// paginate returns the requested page of items
+func paginate[T any](items []T, page, limit int) []T {
+	offset := (page - 1) * limit
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= len(items) {
+		return []T{}
+	}
+	end := offset + limit
+	if limit < 0 || end > len(items) {
+		end = len(items)
+	}
+	return items[offset:end]
+}
+
+// sortByID orders records by ID, like the keyset cursor of FindInBatches
+func sortByID[T any](items []T, base func(T) entity.BaseEntity) {
+	sort.Slice(items, func(i, j int) bool {
+		a, b := base(items[i]).ID, base(items[j]).ID
+		return bytes.Compare(a[:], b[:]) < 0
+	})
+}
+
+// inBatches calls fn with consecutive slices of at most batchSize items
+func inBatches[T any](items []T, batchSize int, fn func([]T) error) error {
+	if batchSize < 1 {
+		batchSize = len(items)
+	}
+	for start := 0; start < len(items); start += batchSize {
+		end := start + batchSize
+		if end > len(items) {
+			end = len(items)
+		}
+		if err := fn(items[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sortByCreatedAtDesc orders records newest first, like ORDER BY created_at DESC
+func sortByCreatedAtDesc[T any](items []T, base func(T) entity.BaseEntity) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := base(items[i]), base(items[j])
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+		return a.ID.String() < b.ID.String()
+	})
+}
+
+// sortByDeletedAtDesc orders trashed records most recently deleted first
+func sortByDeletedAtDesc[T any](items []T, base func(T) entity.BaseEntity) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := base(items[i]), base(items[j])
+		if !a.DeletedAt.Time.Equal(b.DeletedAt.Time) {
+			return a.DeletedAt.Time.After(b.DeletedAt.Time)
+		}
+		return a.ID.String() < b.ID.String()
+	})
+}
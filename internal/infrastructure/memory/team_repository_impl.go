@@ -0,0 +1,344 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+	"github.com/zenkriztao/ayo-football-backend/pkg/textsearch"
+	"gorm.io/gorm"
+)
+
+type teamRepositoryImpl struct {
+	store *Store
+}
+
+// NewTeamRepository creates a new in-memory instance of TeamRepository
+func NewTeamRepository(store *Store) repository.TeamRepository {
+	return &teamRepositoryImpl{store: store}
+}
+
+func (r *teamRepositoryImpl) Create(ctx context.Context, team *entity.Team) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record := stripTeam(*team)
+	prepareCreate(&record.BaseEntity, time.Now())
+	if err := r.store.checkTeam(record); err != nil {
+		return err
+	}
+
+	r.store.teams[record.ID] = record
+	team.BaseEntity = record.BaseEntity
+	return nil
+}
+
+func (r *teamRepositoryImpl) CreateBatch(ctx context.Context, teams []entity.Team) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now()
+	records := make([]entity.Team, len(teams))
+	for i := range teams {
+		records[i] = stripTeam(teams[i])
+		prepareCreate(&records[i].BaseEntity, now)
+		if err := r.store.checkTeam(records[i]); err != nil {
+			return err
+		}
+	}
+	for i, record := range records {
+		r.store.teams[record.ID] = record
+		teams[i].BaseEntity = record.BaseEntity
+	}
+	return nil
+}
+
+func (r *teamRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Team, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	team, ok := r.store.teams[id]
+	if !ok || !isActive(team.BaseEntity) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	team.HomeVenue = r.store.venueRef(team.HomeVenueID)
+	return &team, nil
+}
+
+func (r *teamRepositoryImpl) FindByIDWith(ctx context.Context, id uuid.UUID, include listquery.Include) (*entity.Team, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	team, ok := r.store.teams[id]
+	if !ok || !isActive(team.BaseEntity) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	team = r.store.teamIncluding(team, include)
+	return &team, nil
+}
+
+func (r *teamRepositoryImpl) Update(ctx context.Context, team *entity.Team) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record := stripTeam(*team)
+	record.UpdatedAt = time.Now()
+	if err := r.store.checkTeam(record); err != nil {
+		return err
+	}
+
+	r.store.teams[record.ID] = record
+	team.UpdatedAt = record.UpdatedAt
+	return nil
+}
+
+func (r *teamRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if team, ok := r.store.teams[id]; ok && isActive(team.BaseEntity) {
+		softDelete(&team.BaseEntity, time.Now())
+		r.store.teams[id] = team
+	}
+	return nil
+}
+
+func (r *teamRepositoryImpl) DeleteCascade(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// Players share the team's deletion timestamp so Restore can bring back
+	// exactly the players that were removed together with the team
+	now := time.Now()
+
+	for playerID, player := range r.store.players {
+		if player.TeamID == id && isActive(player.BaseEntity) {
+			softDelete(&player.BaseEntity, now)
+			r.store.players[playerID] = player
+		}
+	}
+
+	for matchID, match := range r.store.matches {
+		if isActive(match.BaseEntity) && (match.HomeTeamID == id || match.AwayTeamID == id) &&
+			(match.Status == entity.MatchStatusScheduled || match.Status == entity.MatchStatusOngoing) {
+			match.Status = entity.MatchStatusCancelled
+			match.UpdatedAt = now
+			r.store.matches[matchID] = match
+		}
+	}
+
+	if team, ok := r.store.teams[id]; ok && isActive(team.BaseEntity) {
+		softDelete(&team.BaseEntity, now)
+		r.store.teams[id] = team
+	}
+	return nil
+}
+
+// teamList evaluates TeamListSchema in memory
+var teamList = listSpec[entity.Team]{
+	schema: repository.TeamListSchema,
+	read:   repository.TeamListReader,
+}
+
+func (r *teamRepositoryImpl) List(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Team, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	match := teamList.filter(query)
+	var teams []entity.Team
+	for _, team := range r.store.teams {
+		if isActive(team.BaseEntity) && match(team) {
+			teams = append(teams, team)
+		}
+	}
+	teamList.order(query)(teams)
+
+	teams, total := paginate(teams, page, limit), int64(len(teams))
+	for i := range teams {
+		teams[i] = r.store.teamIncluding(teams[i], query.Include)
+	}
+	return teams, total, nil
+}
+
+func (r *teamRepositoryImpl) Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Team], error) {
+	teams, _, err := r.List(ctx, query, 1, -1)
+	if err != nil {
+		return listquery.Slice[entity.Team]{}, err
+	}
+	return teamList.scroll(teams, query, window), nil
+}
+
+func (r *teamRepositoryImpl) Search(ctx context.Context, text string, limit int) ([]textsearch.Hit[entity.Team], error) {
+	teams, _, err := r.List(ctx, listquery.Query{}, 1, -1)
+	if err != nil {
+		return nil, err
+	}
+	return textsearch.Rank(teams, text, func(t entity.Team) string { return t.Name + " " + t.City }, limit), nil
+}
+
+func (r *teamRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	team, ok := r.store.teams[id]
+	return ok && isActive(team.BaseEntity), nil
+}
+
+func (r *teamRepositoryImpl) FindInBatches(ctx context.Context, batchSize int, fn func(teams []entity.Team) error) error {
+	r.store.mu.RLock()
+	teams := []entity.Team{}
+	for _, team := range r.store.teams {
+		if isActive(team.BaseEntity) {
+			teams = append(teams, team)
+		}
+	}
+	r.store.mu.RUnlock()
+
+	sortByID(teams, func(x entity.Team) entity.BaseEntity { return x.BaseEntity })
+	return inBatches(teams, batchSize, fn)
+}
+
+func (r *teamRepositoryImpl) FindDeleted(ctx context.Context, page, limit int) ([]entity.Team, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var teams []entity.Team
+	for _, team := range r.store.teams {
+		if !isActive(team.BaseEntity) {
+			teams = append(teams, team)
+		}
+	}
+	sortByDeletedAtDesc(teams, func(t entity.Team) entity.BaseEntity { return t.BaseEntity })
+
+	return paginate(teams, page, limit), int64(len(teams)), nil
+}
+
+func (r *teamRepositoryImpl) Restore(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	team, ok := r.store.teams[id]
+	if !ok || isActive(team.BaseEntity) {
+		return gorm.ErrRecordNotFound
+	}
+
+	// Check every player first so a conflict leaves nothing restored
+	deletedAt := team.DeletedAt.Time
+	var restored []entity.Player
+	for _, player := range r.store.players {
+		if player.TeamID == id && !isActive(player.BaseEntity) && player.DeletedAt.Time.Equal(deletedAt) {
+			player.DeletedAt = gorm.DeletedAt{}
+			if err := r.store.checkPlayer(player); err != nil {
+				return err
+			}
+			restored = append(restored, player)
+		}
+	}
+	for i, player := range restored {
+		for _, other := range restored[i+1:] {
+			if player.JerseyNumber == other.JerseyNumber {
+				return repository.ErrDuplicateJerseyNumber
+			}
+		}
+	}
+
+	for _, player := range restored {
+		r.store.players[player.ID] = player
+	}
+	team.DeletedAt = gorm.DeletedAt{}
+	r.store.teams[id] = team
+	return nil
+}
+
+func (r *teamRepositoryImpl) Purge(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	team, ok := r.store.teams[id]
+	if !ok || isActive(team.BaseEntity) {
+		return gorm.ErrRecordNotFound
+	}
+	if r.store.teamReferenced(id) {
+		return repository.ErrStillReferenced
+	}
+
+	delete(r.store.teams, id)
+	return nil
+}
+
+func (r *teamRepositoryImpl) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var purged int64
+	for id, team := range r.store.teams {
+		if !isActive(team.BaseEntity) && team.DeletedAt.Time.Before(cutoff) && !r.store.teamReferenced(id) {
+			delete(r.store.teams, id)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// stripTeam removes the relations, which are not stored with the team
+func stripTeam(team entity.Team) entity.Team {
+	team.HomeVenue = nil
+	team.Players = nil
+	return team
+}
+
+// teamIncluding returns the team with the related resources of include
+// preloaded: the home venue, soft-deleted or not, and the active players by
+// jersey number. Callers must hold the lock.
+func (s *Store) teamIncluding(team entity.Team, include listquery.Include) entity.Team {
+	if include.Has("home_venue") {
+		team.HomeVenue = s.venueRef(team.HomeVenueID)
+	}
+	if include.Has("players") {
+		team.Players = []entity.Player{}
+		for _, player := range s.players {
+			if player.TeamID == team.ID && isActive(player.BaseEntity) {
+				team.Players = append(team.Players, player)
+			}
+		}
+		sort.Slice(team.Players, func(i, j int) bool {
+			return team.Players[i].JerseyNumber < team.Players[j].JerseyNumber
+		})
+	}
+	return team
+}
+
+// teamReferenced reports whether players, contracts, matches, goals or cards,
+// including soft-deleted ones, still point at a team. Callers must hold the lock.
+func (s *Store) teamReferenced(id uuid.UUID) bool {
+	for _, player := range s.players {
+		if player.TeamID == id {
+			return true
+		}
+	}
+	for _, contract := range s.contracts {
+		if contract.TeamID == id {
+			return true
+		}
+	}
+	for _, match := range s.matches {
+		if match.HomeTeamID == id || match.AwayTeamID == id {
+			return true
+		}
+	}
+	for _, goal := range s.goals {
+		if goal.TeamID == id {
+			return true
+		}
+	}
+	for _, card := range s.cards {
+		if card.TeamID == id {
+			return true
+		}
+	}
+	return false
+}
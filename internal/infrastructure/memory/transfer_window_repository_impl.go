@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type transferWindowRepositoryImpl struct {
+	store *Store
+}
+
+// NewTransferWindowRepository creates a new in-memory instance of TransferWindowRepository
+func NewTransferWindowRepository(store *Store) repository.TransferWindowRepository {
+	return &transferWindowRepositoryImpl{store: store}
+}
+
+func (r *transferWindowRepositoryImpl) Create(ctx context.Context, window *entity.TransferWindow) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record := *window
+	record.OpensAt = record.OpensAt.UTC()
+	record.ClosesAt = record.ClosesAt.UTC()
+	prepareCreate(&record.BaseEntity, time.Now())
+	if err := r.store.checkWindow(record); err != nil {
+		return err
+	}
+
+	r.store.windows[record.ID] = record
+	window.BaseEntity = record.BaseEntity
+	return nil
+}
+
+func (r *transferWindowRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.TransferWindow, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	window, ok := r.store.windows[id]
+	if !ok || !isActive(window.BaseEntity) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &window, nil
+}
+
+func (r *transferWindowRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if window, ok := r.store.windows[id]; ok && isActive(window.BaseEntity) {
+		softDelete(&window.BaseEntity, time.Now())
+		r.store.windows[id] = window
+	}
+	return nil
+}
+
+func (r *transferWindowRepositoryImpl) FindBySeason(ctx context.Context, season string) ([]entity.TransferWindow, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	windows := []entity.TransferWindow{}
+	for _, window := range r.store.windows {
+		if window.Season == season && isActive(window.BaseEntity) {
+			windows = append(windows, window)
+		}
+	}
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].OpensAt.Before(windows[j].OpensAt)
+	})
+	return windows, nil
+}
@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type userRepositoryImpl struct {
+	store *Store
+}
+
+// NewUserRepository creates a new in-memory instance of UserRepository
+func NewUserRepository(store *Store) repository.UserRepository {
+	return &userRepositoryImpl{store: store}
+}
+
+func (r *userRepositoryImpl) Create(ctx context.Context, user *entity.User) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record := *user
+	prepareCreate(&record.BaseEntity, time.Now())
+	if record.Role == "" {
+		record.Role = entity.RoleUser
+	}
+	if err := r.store.checkUser(record); err != nil {
+		return err
+	}
+
+	r.store.users[record.ID] = record
+	*user = record
+	return nil
+}
+
+func (r *userRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	user, ok := r.store.users[id]
+	if !ok || !isActive(user.BaseEntity) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &user, nil
+}
+
+func (r *userRepositoryImpl) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, user := range r.store.users {
+		if user.Email == email && isActive(user.BaseEntity) {
+			return &user, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *userRepositoryImpl) Update(ctx context.Context, user *entity.User) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record := *user
+	record.UpdatedAt = time.Now()
+	if err := r.store.checkUser(record); err != nil {
+		return err
+	}
+
+	r.store.users[record.ID] = record
+	user.UpdatedAt = record.UpdatedAt
+	return nil
+}
+
+func (r *userRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if user, ok := r.store.users[id]; ok && isActive(user.BaseEntity) {
+		softDelete(&user.BaseEntity, time.Now())
+		r.store.users[id] = user
+	}
+	return nil
+}
+
+func (r *userRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]entity.User, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var users []entity.User
+	for _, user := range r.store.users {
+		if isActive(user.BaseEntity) {
+			users = append(users, user)
+		}
+	}
+	sortByCreatedAtDesc(users, func(u entity.User) entity.BaseEntity { return u.BaseEntity })
+
+	return paginate(users, page, limit), int64(len(users)), nil
+}
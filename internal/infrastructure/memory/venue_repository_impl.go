@@ -0,0 +1,114 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+	"github.com/zenkriztao/ayo-football-backend/pkg/textsearch"
+	"gorm.io/gorm"
+)
+
+type venueRepositoryImpl struct {
+	store *Store
+}
+
+// NewVenueRepository creates a new in-memory instance of VenueRepository
+func NewVenueRepository(store *Store) repository.VenueRepository {
+	return &venueRepositoryImpl{store: store}
+}
+
+func (r *venueRepositoryImpl) Create(ctx context.Context, venue *entity.Venue) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record := *venue
+	prepareCreate(&record.BaseEntity, time.Now())
+
+	r.store.venues[record.ID] = record
+	venue.BaseEntity = record.BaseEntity
+	return nil
+}
+
+func (r *venueRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Venue, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	venue, ok := r.store.venues[id]
+	if !ok || !isActive(venue.BaseEntity) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &venue, nil
+}
+
+func (r *venueRepositoryImpl) Update(ctx context.Context, venue *entity.Venue) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record := *venue
+	record.UpdatedAt = time.Now()
+
+	r.store.venues[record.ID] = record
+	venue.UpdatedAt = record.UpdatedAt
+	return nil
+}
+
+func (r *venueRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if venue, ok := r.store.venues[id]; ok && isActive(venue.BaseEntity) {
+		softDelete(&venue.BaseEntity, time.Now())
+		r.store.venues[id] = venue
+	}
+	return nil
+}
+
+// venueList evaluates VenueListSchema in memory
+var venueList = listSpec[entity.Venue]{
+	schema: repository.VenueListSchema,
+	read:   repository.VenueListReader,
+}
+
+func (r *venueRepositoryImpl) List(ctx context.Context, query listquery.Query, page, limit int) ([]entity.Venue, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	match := venueList.filter(query)
+	var venues []entity.Venue
+	for _, venue := range r.store.venues {
+		if isActive(venue.BaseEntity) && match(venue) {
+			venues = append(venues, venue)
+		}
+	}
+	venueList.order(query)(venues)
+
+	return paginate(venues, page, limit), int64(len(venues)), nil
+}
+
+func (r *venueRepositoryImpl) Scroll(ctx context.Context, query listquery.Query, window listquery.Window) (listquery.Slice[entity.Venue], error) {
+	venues, _, err := r.List(ctx, query, 1, -1)
+	if err != nil {
+		return listquery.Slice[entity.Venue]{}, err
+	}
+	return venueList.scroll(venues, query, window), nil
+}
+
+func (r *venueRepositoryImpl) Search(ctx context.Context, text string, limit int) ([]textsearch.Hit[entity.Venue], error) {
+	venues, _, err := r.List(ctx, listquery.Query{}, 1, -1)
+	if err != nil {
+		return nil, err
+	}
+	return textsearch.Rank(venues, text, func(v entity.Venue) string { return v.Name + " " + v.City }, limit), nil
+}
+
+func (r *venueRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	venue, ok := r.store.venues[id]
+	return ok && isActive(venue.BaseEntity), nil
+}
@@ -0,0 +1,77 @@
+// Package storage stores uploaded files, such as team logos and player
+// photos, as blobs addressed by key
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/config"
+)
+
+var (
+	ErrBlobNotFound = errors.New("blob not found")
+	ErrInvalidKey   = errors.New("invalid blob key")
+)
+
+// BlobInfo describes a stored blob
+type BlobInfo struct {
+	ContentType  string
+	Size         int64
+	ETag         string // Quoted, as sent in the ETag header
+	LastModified time.Time
+}
+
+// BlobStore defines the interface for blob storage. Keys are slash
+// separated paths such as teams/<id>/logo-<hash>.png.
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Get returns the content of a blob, which the caller must close, or
+	// ErrBlobNotFound
+	Get(ctx context.Context, key string) (io.ReadCloser, *BlobInfo, error)
+	// Delete removes a blob. Deleting a missing blob is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// NewBlobStore creates the BlobStore selected by the storage configuration
+func NewBlobStore(cfg *config.Config) (BlobStore, error) {
+	switch cfg.Storage.Driver {
+	case "", "local":
+		return NewLocalBlobStore(cfg.Storage.LocalDir), nil
+	case "s3":
+		return NewS3BlobStore(S3Options{
+			Endpoint:        cfg.Storage.S3Endpoint,
+			Region:          cfg.Storage.S3Region,
+			Bucket:          cfg.Storage.S3Bucket,
+			AccessKeyID:     cfg.Storage.S3AccessKeyID,
+			SecretAccessKey: cfg.Storage.S3SecretAccessKey,
+			PathStyle:       cfg.Storage.S3PathStyle,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported storage driver %q, must be local or s3", cfg.Storage.Driver)
+	}
+}
+
+// ValidateKey checks that key is a relative slash separated path of
+// lower-case letters, digits, dashes, underscores and dots, without empty,
+// "." or ".." segments, so it cannot escape the store or need escaping
+func ValidateKey(key string) error {
+	if key == "" || len(key) > 512 {
+		return ErrInvalidKey
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return ErrInvalidKey
+		}
+		for _, r := range segment {
+			if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.') {
+				return ErrInvalidKey
+			}
+		}
+	}
+	return nil
+}
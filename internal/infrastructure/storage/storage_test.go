@@ -0,0 +1,149 @@
+package storage_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/config"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/storage"
+)
+
+func TestValidateKey(t *testing.T) {
+	valid := []string{"teams/1/logo-ab12.png", "a", "players/x_y/photo.thumb.jpg"}
+	for _, key := range valid {
+		if err := storage.ValidateKey(key); err != nil {
+			t.Errorf("ValidateKey(%q) = %v, want nil", key, err)
+		}
+	}
+	invalid := []string{"", "/teams/a.png", "teams//a.png", "teams/../secret", "./a", "Teams/A.png", "a b", `a\b`, "teams/a.png/"}
+	for _, key := range invalid {
+		if err := storage.ValidateKey(key); !errors.Is(err, storage.ErrInvalidKey) {
+			t.Errorf("ValidateKey(%q) = %v, want ErrInvalidKey", key, err)
+		}
+	}
+}
+
+// testBlobStore runs the behaviour every BlobStore shares
+func testBlobStore(t *testing.T, store storage.BlobStore) {
+	ctx := context.Background()
+	key := "teams/persija/logo-0123.png"
+
+	if _, _, err := store.Get(ctx, key); !errors.Is(err, storage.ErrBlobNotFound) {
+		t.Fatalf("get before put: got %v, want ErrBlobNotFound", err)
+	}
+	if err := store.Put(ctx, key, []byte("png bytes"), "image/png"); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	if err := store.Put(ctx, "../escape.png", []byte("x"), "image/png"); !errors.Is(err, storage.ErrInvalidKey) {
+		t.Fatalf("put outside the store: got %v, want ErrInvalidKey", err)
+	}
+
+	body, info, err := store.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	data, _ := io.ReadAll(body)
+	body.Close()
+	if string(data) != "png bytes" || info.ContentType != "image/png" || info.Size != int64(len(data)) || info.ETag == "" {
+		t.Fatalf("get returned %q with %+v", data, info)
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("delete twice: %v", err)
+	}
+	if _, _, err := store.Get(ctx, key); !errors.Is(err, storage.ErrBlobNotFound) {
+		t.Fatalf("get after delete: got %v, want ErrBlobNotFound", err)
+	}
+}
+
+func TestLocalBlobStore(t *testing.T) {
+	testBlobStore(t, storage.NewLocalBlobStore(t.TempDir()))
+}
+
+// fakeS3 is a path-style S3 endpoint keeping objects in memory. It checks
+// that requests carry a well-formed Signature Version 4 for its payload.
+type fakeS3 struct {
+	t       *testing.T
+	mu      sync.Mutex
+	objects map[string]string
+	types   map[string]string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	sum := sha256.Sum256(body)
+	auth := r.Header.Get("Authorization")
+	if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(sum[:]) ||
+		!strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/") ||
+		!strings.Contains(auth, "/ap-southeast-3/s3/aws4_request, SignedHeaders=") ||
+		!strings.Contains(auth, "host;x-amz-content-sha256;x-amz-date") {
+		f.t.Errorf("%s %s: badly signed, Authorization %q", r.Method, r.URL.Path, auth)
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	key, ok := strings.CutPrefix(r.URL.Path, "/media/")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		f.objects[key] = string(body)
+		f.types[key] = r.Header.Get("Content-Type")
+	case http.MethodGet:
+		object, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", f.types[key])
+		w.Header().Set("ETag", `"etag"`)
+		io.WriteString(w, object)
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestS3BlobStore(t *testing.T) {
+	server := httptest.NewServer(&fakeS3{t: t, objects: map[string]string{}, types: map[string]string{}})
+	defer server.Close()
+
+	cfg := &config.Config{}
+	cfg.Storage.Driver = "s3"
+	cfg.Storage.S3Endpoint = server.URL
+	cfg.Storage.S3Region = "ap-southeast-3"
+	cfg.Storage.S3Bucket = "media"
+	cfg.Storage.S3AccessKeyID = "AKID"
+	cfg.Storage.S3SecretAccessKey = "secret"
+	cfg.Storage.S3PathStyle = true
+	store, err := storage.NewBlobStore(cfg)
+	if err != nil {
+		t.Fatalf("new blob store: %v", err)
+	}
+	testBlobStore(t, store)
+
+	cfg.Storage.S3Bucket = ""
+	if _, err := storage.NewBlobStore(cfg); err == nil {
+		t.Fatal("expected an error without a bucket")
+	}
+	cfg.Storage.Driver = "ftp"
+	if _, err := storage.NewBlobStore(cfg); err == nil {
+		t.Fatal("expected an error for an unknown driver")
+	}
+}
@@ -0,0 +1,340 @@
+// Package seed generates a realistic demo league and writes it through the
+// use cases. The league is a pure function of its Options, and every record
+// has an ID derived from the seed value, so seeding twice with the same
+// options leaves the database unchanged.
+package seed
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// namespace scopes the name-based UUIDs of seeded records
+var namespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://ayofootball.com/seed"))
+
+// Options controls the generated league
+type Options struct {
+	// Seed drives every random choice; the same seed yields the same league
+	Seed int64
+	// Teams is the number of teams, between 2 and the number of known clubs
+	Teams int
+	// Start is the date of the first round; rounds are a week apart
+	Start time.Time
+	// PlayedRounds is the number of rounds that already have results; a
+	// negative value plays the first half of the season
+	PlayedRounds int
+}
+
+// DefaultOptions returns the options used when none are given
+func DefaultOptions() Options {
+	return Options{
+		Seed:         1,
+		Teams:        8,
+		Start:        time.Date(2025, time.January, 4, 0, 0, 0, 0, time.UTC),
+		PlayedRounds: -1,
+	}
+}
+
+// League is a generated set of teams, squads, fixtures and results
+type League struct {
+	Teams   []entity.Team
+	Players []entity.Player
+	Matches []entity.Match
+	// Results holds the final score and goals of played matches by match ID
+	Results map[uuid.UUID]usecase.MatchResultInput
+}
+
+// club is a team the generator can pick
+type club struct {
+	name    string
+	city    string
+	founded int
+	address string
+}
+
+var clubs = []club{
+	{"Persija Jakarta", "Jakarta", 1928, "Jl. Casablanca No.1"},
+	{"Persib Bandung", "Bandung", 1933, "Jl. Sulanjana No.17"},
+	{"Arema FC", "Malang", 1987, "Jl. Mayjen Panjaitan No.42"},
+	{"Persebaya Surabaya", "Surabaya", 1927, "Jl. Karanggayam No.1"},
+	{"PSM Makassar", "Makassar", 1915, "Jl. Cendrawasih No.1"},
+	{"Bali United", "Gianyar", 2015, "Jl. Bypass Ida Bagus Mantra"},
+	{"Borneo FC", "Samarinda", 2014, "Jl. Kesuma Bangsa No.1"},
+	{"PSIS Semarang", "Semarang", 1932, "Jl. Jendral Sudirman No.108"},
+	{"Persita Tangerang", "Tangerang", 1953, "Jl. Ki Samaun No.1"},
+	{"Madura United", "Pamekasan", 2016, "Jl. Stadion Gelora Bangkalan"},
+	{"Dewa United", "Tangerang Selatan", 2021, "Jl. BSD Raya Utama"},
+	{"Persik Kediri", "Kediri", 1950, "Jl. Brawijaya No.1"},
+	{"PSS Sleman", "Sleman", 1976, "Jl. Magelang Km.12"},
+	{"Bhayangkara FC", "Jakarta", 2010, "Jl. Trunojoyo No.3"},
+	{"Barito Putera", "Banjarmasin", 1988, "Jl. A. Yani Km.6"},
+	{"Persis Solo", "Surakarta", 1923, "Jl. Adi Sucipto No.1"},
+	{"Semen Padang", "Padang", 1980, "Jl. Raya Indarung"},
+	{"Persikabo 1973", "Bogor", 1973, "Jl. Pakansari Raya"},
+}
+
+var (
+	firstNames = []string{
+		"Adi", "Andik", "Arkhan", "Asnawi", "Bagas", "Boaz", "Dedik", "Egy",
+		"Elkan", "Evan", "Fachruddin", "Hansamu", "Ilija", "Irfan", "Jordi",
+		"Kadek", "Marselino", "Muhammad", "Nadeo", "Pratama", "Ramadhan",
+		"Ricky", "Rizky", "Saddil", "Stefano", "Syahrian", "Witan", "Yakob",
+	}
+	lastNames = []string{
+		"Amiruddin", "Arhan", "Bachdim", "Baggott", "Dimas", "Febri",
+		"Ferdinan", "Hutagalung", "Jaya", "Kambuaya", "Lilipaly", "Mangkualam",
+		"Nugraha", "Pasaribu", "Pratama", "Putra", "Ridho", "Saputra",
+		"Sayuri", "Sulaeman", "Tampubolon", "Wanggai", "Wibowo", "Yudhistira",
+	}
+	// kickoffTimes are local times in entity.DefaultTimezone
+	kickoffTimes = []time.Duration{15*time.Hour + 30*time.Minute, 19 * time.Hour, 20*time.Hour + 30*time.Minute}
+)
+
+// squad lists how many players of each position a team gets
+var squad = []struct {
+	position entity.PlayerPosition
+	count    int
+	height   [2]int
+	// scoring is the relative chance of a player in this position scoring
+	scoring int
+}{
+	{entity.PositionGoalkeeper, 3, [2]int{183, 197}, 0},
+	{entity.PositionDefender, 7, [2]int{175, 192}, 1},
+	{entity.PositionMidfielder, 7, [2]int{165, 183}, 3},
+	{entity.PositionForward, 5, [2]int{168, 190}, 6},
+}
+
+// goalWeights is the relative chance of a side scoring 0, 1, 2, ... goals
+var goalWeights = []int{25, 33, 23, 12, 5, 2}
+
+// ownGoalPercent is the chance of a goal being an own goal
+const ownGoalPercent = 5
+
+// Generate builds the league described by opts
+func Generate(opts Options) (*League, error) {
+	if opts.Teams < 2 || opts.Teams > len(clubs) {
+		return nil, fmt.Errorf("number of teams must be between 2 and %d", len(clubs))
+	}
+	rounds := 2 * (opts.Teams - 1)
+	if opts.Teams%2 == 1 {
+		rounds = 2 * opts.Teams
+	}
+	if opts.PlayedRounds < 0 {
+		opts.PlayedRounds = rounds / 2
+	}
+	if opts.PlayedRounds > rounds {
+		return nil, fmt.Errorf("played rounds must be at most %d", rounds)
+	}
+
+	loc, err := entity.LoadLocation(entity.DefaultTimezone)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{opts: opts, rng: rand.New(rand.NewSource(opts.Seed))}
+	league := &League{Results: make(map[uuid.UUID]usecase.MatchResultInput)}
+
+	squads := make([][]entity.Player, opts.Teams)
+	for i, c := range g.pickClubs() {
+		team := entity.Team{
+			BaseEntity:  entity.BaseEntity{ID: g.id("team", i)},
+			Name:        c.name,
+			FoundedYear: c.founded,
+			Address:     c.address,
+			City:        c.city,
+		}
+		league.Teams = append(league.Teams, team)
+		squads[i] = g.squad(i, team.ID)
+		league.Players = append(league.Players, squads[i]...)
+	}
+
+	for round, pairs := range roundRobin(opts.Teams) {
+		for slot, pair := range pairs {
+			home, away := pair[0], pair[1]
+			day := opts.Start.AddDate(0, 0, 7*round+slot%2)
+			match := entity.Match{
+				BaseEntity: entity.BaseEntity{ID: g.id("match", round, slot)},
+				KickoffAt:  time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc).Add(kickoffTimes[slot%len(kickoffTimes)]).UTC(),
+				Timezone:   entity.DefaultTimezone,
+				HomeTeamID: league.Teams[home].ID,
+				AwayTeamID: league.Teams[away].ID,
+			}
+			league.Matches = append(league.Matches, match)
+
+			if round < opts.PlayedRounds {
+				league.Results[match.ID] = g.result(league.Teams[home].ID, squads[home], league.Teams[away].ID, squads[away])
+			}
+		}
+	}
+
+	return league, nil
+}
+
+// generator holds the state shared while generating one league
+type generator struct {
+	opts Options
+	rng  *rand.Rand
+}
+
+// id returns the stable ID of a seeded record
+func (g *generator) id(kind string, index ...int) uuid.UUID {
+	return uuid.NewSHA1(namespace, []byte(fmt.Sprintf("%d/%s/%v", g.opts.Seed, kind, index)))
+}
+
+// pickClubs chooses the clubs taking part
+func (g *generator) pickClubs() []club {
+	picked := make([]club, g.opts.Teams)
+	for i, index := range g.rng.Perm(len(clubs))[:g.opts.Teams] {
+		picked[i] = clubs[index]
+	}
+	return picked
+}
+
+// squad generates the players of a team with unique jersey numbers. The
+// first goalkeeper always wears number 1.
+func (g *generator) squad(team int, teamID uuid.UUID) []entity.Player {
+	size := 0
+	for _, s := range squad {
+		size += s.count
+	}
+	numbers := g.rng.Perm(98)[:size-1]
+
+	players := make([]entity.Player, 0, size)
+	for _, s := range squad {
+		for i := 0; i < s.count; i++ {
+			jersey := 1
+			if len(players) > 0 {
+				// Numbers 2-99, as 1 is taken by the first goalkeeper
+				jersey = numbers[len(players)-1] + 2
+			}
+			height := s.height[0] + g.rng.Intn(s.height[1]-s.height[0]+1)
+			players = append(players, entity.Player{
+				BaseEntity:   entity.BaseEntity{ID: g.id("player", team, len(players))},
+				TeamID:       teamID,
+				Name:         firstNames[g.rng.Intn(len(firstNames))] + " " + lastNames[g.rng.Intn(len(lastNames))],
+				Height:       float64(height),
+				Weight:       float64(height - 110 + g.rng.Intn(11)),
+				Position:     s.position,
+				JerseyNumber: jersey,
+			})
+		}
+	}
+	return players
+}
+
+// result generates the final score of a match and who scored when
+func (g *generator) result(homeID uuid.UUID, home []entity.Player, awayID uuid.UUID, away []entity.Player) usecase.MatchResultInput {
+	input := usecase.MatchResultInput{HomeScore: g.goals(), AwayScore: g.goals()}
+	input.Goals = append(input.Goals, g.scorers(input.HomeScore, homeID, home, away)...)
+	input.Goals = append(input.Goals, g.scorers(input.AwayScore, awayID, away, home)...)
+	sort.SliceStable(input.Goals, func(i, j int) bool {
+		return input.Goals[i].Minute < input.Goals[j].Minute
+	})
+	return input
+}
+
+// goals draws the number of goals one side scores
+func (g *generator) goals() int {
+	total := 0
+	for _, w := range goalWeights {
+		total += w
+	}
+	n := g.rng.Intn(total)
+	for goals, w := range goalWeights {
+		if n < w {
+			return goals
+		}
+		n -= w
+	}
+	return 0
+}
+
+// scorers credits count goals for teamID to its players, or now and then to
+// an opponent as an own goal
+func (g *generator) scorers(count int, teamID uuid.UUID, players, opponents []entity.Player) []usecase.GoalInput {
+	goals := make([]usecase.GoalInput, count)
+	for i := range goals {
+		goal := usecase.GoalInput{TeamID: teamID, Minute: 1 + g.rng.Intn(90)}
+		if g.rng.Intn(100) < ownGoalPercent {
+			goal.PlayerID = g.pick(opponents, func(p entity.Player) int {
+				if p.Position == entity.PositionDefender {
+					return 1
+				}
+				return 0
+			})
+			goal.IsOwnGoal = true
+		} else {
+			goal.PlayerID = g.pick(players, scoringWeight)
+		}
+		goals[i] = goal
+	}
+	return goals
+}
+
+// pick draws a player with a probability proportional to weight
+func (g *generator) pick(players []entity.Player, weight func(entity.Player) int) uuid.UUID {
+	total := 0
+	for _, p := range players {
+		total += weight(p)
+	}
+	n := g.rng.Intn(total)
+	for _, p := range players {
+		if n < weight(p) {
+			return p.ID
+		}
+		n -= weight(p)
+	}
+	return players[len(players)-1].ID
+}
+
+// scoringWeight returns the relative chance of a player scoring
+func scoringWeight(p entity.Player) int {
+	for _, s := range squad {
+		if s.position == p.Position {
+			return s.scoring
+		}
+	}
+	return 0
+}
+
+// roundRobin schedules a double round robin with the circle method and
+// returns the home and away team index of every match per round. With an odd
+// number of teams one team sits out each round.
+func roundRobin(teams int) [][][2]int {
+	slots := teams
+	if slots%2 == 1 {
+		slots++
+	}
+	rotation := make([]int, slots)
+	for i := range rotation {
+		rotation[i] = i
+	}
+
+	half := slots - 1
+	rounds := make([][][2]int, 2*half)
+	for round := 0; round < half; round++ {
+		for i := 0; i < slots/2; i++ {
+			home, away := rotation[i], rotation[slots-1-i]
+			if home >= teams || away >= teams {
+				continue
+			}
+			// Alternate home advantage so no team plays at home every week
+			if (round+i)%2 == 1 {
+				home, away = away, home
+			}
+			rounds[round] = append(rounds[round], [2]int{home, away})
+			rounds[round+half] = append(rounds[round+half], [2]int{away, home})
+		}
+		// Keep the first slot fixed and rotate the rest
+		last := rotation[slots-1]
+		copy(rotation[2:], rotation[1:slots-1])
+		rotation[1] = last
+	}
+	return rounds
+}
@@ -0,0 +1,149 @@
+package seed_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/memory"
+	"github.com/zenkriztao/ayo-football-backend/internal/seed"
+)
+
+func newSeeder() (*seed.Seeder, usecase.MatchUseCase) {
+	store := memory.NewStore()
+	teams := memory.NewTeamRepository(store)
+	players := memory.NewPlayerRepository(store)
+	matches := memory.NewMatchRepository(store)
+	goals := memory.NewGoalRepository(store)
+	venues := memory.NewVenueRepository(store)
+	contracts := memory.NewPlayerContractRepository(store)
+	audit := usecase.NewAuditUseCase(memory.NewAuditRepository(store))
+
+	matchUseCase := usecase.NewMatchUseCase(matches, teams, players, contracts, goals,
+		memory.NewCardRepository(store), memory.NewDisciplinaryRuleRepository(store), memory.NewPlayerAvailabilityRepository(store), venues, memory.NewMatchOfficialRepository(store), audit)
+	return seed.NewSeeder(
+		usecase.NewTeamUseCase(teams, players, matches, venues, audit),
+		usecase.NewPlayerUseCase(players, teams, contracts, audit),
+		matchUseCase,
+	), matchUseCase
+}
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	first, err := seed.Generate(seed.DefaultOptions())
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	second, err := seed.Generate(seed.DefaultOptions())
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Fatalf("expected the same seed to generate the same league")
+	}
+
+	opts := seed.DefaultOptions()
+	opts.Seed = 2
+	other, err := seed.Generate(opts)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if other.Teams[0].ID == first.Teams[0].ID {
+		t.Fatalf("expected a different seed to generate different records")
+	}
+}
+
+func TestGenerateLeague(t *testing.T) {
+	for _, teams := range []int{2, 7, 8} {
+		opts := seed.DefaultOptions()
+		opts.Teams = teams
+		league, err := seed.Generate(opts)
+		if err != nil {
+			t.Fatalf("generate %d teams: %v", teams, err)
+		}
+
+		// Every team meets every other team home and away
+		pairs := make(map[[2]uuid.UUID]int)
+		for _, match := range league.Matches {
+			pairs[[2]uuid.UUID{match.HomeTeamID, match.AwayTeamID}]++
+		}
+		if len(league.Matches) != teams*(teams-1) || len(pairs) != len(league.Matches) {
+			t.Fatalf("%d teams: expected %d distinct fixtures, got %d", teams, teams*(teams-1), len(pairs))
+		}
+
+		squads := make(map[uuid.UUID]map[entity.PlayerPosition]int)
+		for _, player := range league.Players {
+			if squads[player.TeamID] == nil {
+				squads[player.TeamID] = make(map[entity.PlayerPosition]int)
+			}
+			squads[player.TeamID][player.Position]++
+		}
+		for _, team := range league.Teams {
+			for _, position := range entity.ValidPositions() {
+				if squads[team.ID][position] == 0 {
+					t.Fatalf("%s has no %s", team.Name, position)
+				}
+			}
+		}
+
+		for id, result := range league.Results {
+			if len(result.Goals) != result.HomeScore+result.AwayScore {
+				t.Fatalf("match %s: %d goals for a %d-%d result", id, len(result.Goals), result.HomeScore, result.AwayScore)
+			}
+		}
+	}
+
+	opts := seed.DefaultOptions()
+	opts.Teams = 1
+	if _, err := seed.Generate(opts); err == nil {
+		t.Fatalf("expected an error for a single team")
+	}
+}
+
+func TestSeedIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	seeder, matchUseCase := newSeeder()
+	league, err := seed.Generate(seed.DefaultOptions())
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+
+	summary, err := seeder.Seed(ctx, league)
+	if err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	want := seed.Summary{Teams: 8, Players: len(league.Players), Matches: 56, Results: 28}
+	if *summary != want {
+		t.Fatalf("expected %+v, got %+v", want, *summary)
+	}
+
+	summary, err = seeder.Seed(ctx, league)
+	if err != nil {
+		t.Fatalf("seed again: %v", err)
+	}
+	if *summary != (seed.Summary{}) {
+		t.Fatalf("expected a second run to create nothing, got %+v", *summary)
+	}
+
+	// Playing more rounds later only records the new results
+	opts := seed.DefaultOptions()
+	opts.PlayedRounds = 14
+	league, err = seed.Generate(opts)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	summary, err = seeder.Seed(ctx, league)
+	if err != nil {
+		t.Fatalf("seed full season: %v", err)
+	}
+	if *summary != (seed.Summary{Results: 28}) {
+		t.Fatalf("expected only the remaining results, got %+v", *summary)
+	}
+
+	_, completed, err := matchUseCase.GetCompletedMatches(ctx, 1, 1)
+	if err != nil || completed != 56 {
+		t.Fatalf("expected every match to be completed, got %d (%v)", completed, err)
+	}
+}
@@ -0,0 +1,102 @@
+package seed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// Summary counts the records a seed run created
+type Summary struct {
+	Teams   int
+	Players int
+	Matches int
+	Results int
+}
+
+// Seeder writes generated leagues through the use cases, so seeded data
+// passes the same validation and audit trail as data entered via the API
+type Seeder struct {
+	teamUseCase   usecase.TeamUseCase
+	playerUseCase usecase.PlayerUseCase
+	matchUseCase  usecase.MatchUseCase
+}
+
+// NewSeeder creates a new Seeder
+func NewSeeder(
+	teamUseCase usecase.TeamUseCase,
+	playerUseCase usecase.PlayerUseCase,
+	matchUseCase usecase.MatchUseCase,
+) *Seeder {
+	return &Seeder{
+		teamUseCase:   teamUseCase,
+		playerUseCase: playerUseCase,
+		matchUseCase:  matchUseCase,
+	}
+}
+
+// Seed creates the records of league that do not exist yet and records the
+// results of matches that have not been played yet. Records that were
+// seeded before are left untouched.
+func (s *Seeder) Seed(ctx context.Context, league *League) (*Summary, error) {
+	summary := &Summary{}
+
+	for i := range league.Teams {
+		team := league.Teams[i]
+		_, err := s.teamUseCase.GetByID(ctx, team.ID)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, usecase.ErrTeamNotFound) {
+			return summary, err
+		}
+		if err := s.teamUseCase.Create(ctx, &team); err != nil {
+			return summary, fmt.Errorf("failed to seed team %s: %w", team.Name, err)
+		}
+		summary.Teams++
+	}
+
+	for i := range league.Players {
+		player := league.Players[i]
+		_, err := s.playerUseCase.GetByID(ctx, player.ID)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, usecase.ErrPlayerNotFound) {
+			return summary, err
+		}
+		if err := s.playerUseCase.Create(ctx, &player); err != nil {
+			return summary, fmt.Errorf("failed to seed player %s #%d: %w", player.Name, player.JerseyNumber, err)
+		}
+		summary.Players++
+	}
+
+	for i := range league.Matches {
+		match := league.Matches[i]
+		existing, err := s.matchUseCase.GetByID(ctx, match.ID)
+		switch {
+		case errors.Is(err, usecase.ErrMatchNotFound):
+			if err := s.matchUseCase.Create(ctx, &match); err != nil {
+				return summary, fmt.Errorf("failed to seed match on %s: %w", match.LocalKickoff().Format("2006-01-02"), err)
+			}
+			existing = &match
+			summary.Matches++
+		case err != nil:
+			return summary, err
+		}
+
+		result, played := league.Results[match.ID]
+		if !played || existing.Status == entity.MatchStatusCompleted {
+			continue
+		}
+		if _, _, err := s.matchUseCase.RecordResult(ctx, match.ID, result); err != nil {
+			return summary, fmt.Errorf("failed to seed result of match on %s: %w", match.LocalKickoff().Format("2006-01-02"), err)
+		}
+		summary.Results++
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,171 @@
+// Package export writes flat records as CSV or newline-delimited JSON
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported export format, must be csv or ndjson")
+
+// Format is an export file format
+type Format string
+
+const (
+	FormatCSV    Format = "csv"
+	FormatNDJSON Format = "ndjson"
+)
+
+// ParseFormat parses a format name; "jsonl" is accepted for NDJSON
+func ParseFormat(name string) (Format, error) {
+	switch strings.ToLower(name) {
+	case "csv":
+		return FormatCSV, nil
+	case "ndjson", "jsonl":
+		return FormatNDJSON, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// ContentType returns the MIME type of the format
+func (f Format) ContentType() string {
+	if f == FormatCSV {
+		return "text/csv; charset=utf-8"
+	}
+	return "application/x-ndjson"
+}
+
+// Extension returns the file extension of the format, including the dot
+func (f Format) Extension() string {
+	return "." + string(f)
+}
+
+// Writer writes records of one struct type. Output is buffered until Flush.
+type Writer interface {
+	Write(record interface{}) error
+	Flush() error
+}
+
+// NewWriter creates a Writer for records shaped like prototype, a struct
+// whose fields are strings, numbers, booleans or pointers to them. Columns
+// are named after the fields' json tags. The CSV header is written right
+// away, so an export without records still names its columns.
+func NewWriter(format Format, w io.Writer, prototype interface{}) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w, prototype)
+	case FormatNDJSON:
+		buffered := bufio.NewWriter(w)
+		encoder := json.NewEncoder(buffered)
+		encoder.SetEscapeHTML(false)
+		return &ndjsonWriter{buffered: buffered, encoder: encoder}, nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+type ndjsonWriter struct {
+	buffered *bufio.Writer
+	encoder  *json.Encoder
+}
+
+func (w *ndjsonWriter) Write(record interface{}) error {
+	return w.encoder.Encode(record)
+}
+
+func (w *ndjsonWriter) Flush() error {
+	return w.buffered.Flush()
+}
+
+type csvWriter struct {
+	writer     *csv.Writer
+	recordType reflect.Type
+	fields     []int
+	row        []string
+}
+
+func newCSVWriter(w io.Writer, prototype interface{}) (*csvWriter, error) {
+	recordType := reflect.TypeOf(prototype)
+	if recordType == nil || recordType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("export: record must be a struct, got %v", recordType)
+	}
+
+	cw := &csvWriter{writer: csv.NewWriter(w), recordType: recordType}
+	var header []string
+	for i := 0; i < recordType.NumField(); i++ {
+		field := recordType.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if !field.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		cw.fields = append(cw.fields, i)
+		header = append(header, name)
+	}
+	cw.row = make([]string, len(cw.fields))
+
+	if err := cw.writer.Write(header); err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+func (w *csvWriter) Write(record interface{}) error {
+	value := reflect.ValueOf(record)
+	if value.Type() != w.recordType {
+		return fmt.Errorf("export: expected a %v record, got %v", w.recordType, value.Type())
+	}
+	for i, index := range w.fields {
+		w.row[i] = formatCell(value.Field(index))
+	}
+	return w.writer.Write(w.row)
+}
+
+func (w *csvWriter) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+// formatCell renders a field as CSV text; nil pointers are empty cells
+func formatCell(value reflect.Value) string {
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return ""
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return escapeFormula(value.String())
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, 64)
+	default:
+		return fmt.Sprint(value.Interface())
+	}
+}
+
+// escapeFormula prefixes text that a spreadsheet application would run as a
+// formula with a quote, so exported names cannot inject formulas
+func escapeFormula(text string) string {
+	if text != "" && strings.ContainsRune("=+-@\t\r", rune(text[0])) {
+		return "'" + text
+	}
+	return text
+}
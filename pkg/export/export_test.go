@@ -0,0 +1,103 @@
+package export_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/zenkriztao/ayo-football-backend/pkg/export"
+)
+
+type record struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Height float64 `json:"height"`
+	Score  *int    `json:"score"`
+	Own    bool    `json:"is_own_goal"`
+	secret string
+}
+
+func records() []record {
+	two := 2
+	return []record{
+		{ID: "1", Name: "Marko Simic", Height: 185.5, Score: &two, secret: "x"},
+		{ID: "2", Name: "=HYPERLINK(\"x\"), \"Riko\"", Height: 170, Own: true},
+	}
+}
+
+func TestCSVWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := export.NewWriter(export.FormatCSV, &buf, record{})
+	if err != nil {
+		t.Fatalf("new writer: %v", err)
+	}
+	for _, r := range records() {
+		if err := w.Write(r); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+
+	want := "id,name,height,score,is_own_goal\n" +
+		"1,Marko Simic,185.5,2,false\n" +
+		"2,\"'=HYPERLINK(\"\"x\"\"), \"\"Riko\"\"\",170,,true\n"
+	if buf.String() != want {
+		t.Fatalf("expected\n%s\ngot\n%s", want, buf.String())
+	}
+
+	if err := w.Write(struct{ ID string }{"3"}); err == nil {
+		t.Fatal("expected an error for a record of another type")
+	}
+}
+
+func TestCSVWriterWithoutRecords(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := export.NewWriter(export.FormatCSV, &buf, record{})
+	if err != nil {
+		t.Fatalf("new writer: %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+	if buf.String() != "id,name,height,score,is_own_goal\n" {
+		t.Fatalf("expected only the header, got %q", buf.String())
+	}
+}
+
+func TestNDJSONWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := export.NewWriter(export.FormatNDJSON, &buf, record{})
+	if err != nil {
+		t.Fatalf("new writer: %v", err)
+	}
+	for _, r := range records() {
+		if err := w.Write(r); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	if buf.Len() != 0 {
+		t.Fatal("expected output to be buffered until Flush")
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+
+	want := `{"id":"1","name":"Marko Simic","height":185.5,"score":2,"is_own_goal":false}` + "\n" +
+		`{"id":"2","name":"=HYPERLINK(\"x\"), \"Riko\"","height":170,"score":null,"is_own_goal":true}` + "\n"
+	if buf.String() != want {
+		t.Fatalf("expected\n%s\ngot\n%s", want, buf.String())
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for name, want := range map[string]export.Format{"csv": export.FormatCSV, "NDJSON": export.FormatNDJSON, "jsonl": export.FormatNDJSON} {
+		if got, err := export.ParseFormat(name); err != nil || got != want {
+			t.Fatalf("ParseFormat(%q) = %q, %v", name, got, err)
+		}
+	}
+	if _, err := export.ParseFormat("xlsx"); !errors.Is(err, export.ErrUnsupportedFormat) {
+		t.Fatalf("expected ErrUnsupportedFormat, got %v", err)
+	}
+}
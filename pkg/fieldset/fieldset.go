@@ -0,0 +1,175 @@
+// Package fieldset trims JSON responses to the fields a client selects, e.g.
+// ?fields=id,name,home_team.name (sparse fieldsets). Fields are checked
+// against the JSON keys of the response type, so a misspelt field is an
+// error rather than a silently empty response.
+package fieldset
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Set is a selection of fields, each with the selection of its own fields.
+// A nil Set selects everything.
+type Set struct {
+	fields map[string]*Set
+}
+
+// Parse reads a comma-separated list of dotted field paths of the JSON
+// encoding of sample, which may also be a slice of the response items.
+// keep names top-level fields that are always selected, such as the
+// included relations, unless a path selects some of their fields. An empty
+// value selects everything.
+func Parse(value string, sample interface{}, keep ...string) (*Set, error) {
+	var paths []string
+	for _, part := range strings.Split(value, ",") {
+		if path := strings.TrimSpace(part); path != "" {
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	root := &Set{fields: make(map[string]*Set)}
+	t := reflect.TypeOf(sample)
+	for _, path := range paths {
+		if err := root.add(t, strings.Split(path, "."), path); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range keep {
+		if _, ok := root.fields[name]; !ok {
+			if err := root.add(t, []string{name}, name); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return root, nil
+}
+
+// add selects the field at names below the JSON object of t
+func (s *Set) add(t reflect.Type, names []string, path string) error {
+	keys := jsonKeys(t)
+	if keys == nil {
+		return fmt.Errorf("%q has no fields to select", path)
+	}
+	child, ok := keys[names[0]]
+	if !ok {
+		return fmt.Errorf("unknown field %q, use %s", path, strings.Join(sortedKeys(keys), ", "))
+	}
+
+	if len(names) == 1 {
+		// The whole field, even if some of its fields were selected before
+		s.fields[names[0]] = nil
+		return nil
+	}
+	selected, seen := s.fields[names[0]]
+	if selected == nil {
+		selected = &Set{fields: make(map[string]*Set)}
+		if !seen {
+			s.fields[names[0]] = selected
+		}
+		// A field already selected whole stays whole, but the path is
+		// still checked
+	}
+	return selected.add(child, names[1:], path)
+}
+
+// Apply returns data encoded as JSON and decoded again with only the
+// selected fields, or data itself when everything is selected. Arrays are
+// trimmed item by item.
+func (s *Set) Apply(data interface{}) interface{} {
+	if s == nil {
+		return data
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return data
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return data
+	}
+	return s.trim(decoded)
+}
+
+func (s *Set) trim(value interface{}) interface{} {
+	if s == nil {
+		return value
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			selected, ok := s.fields[key]
+			if !ok {
+				delete(v, key)
+				continue
+			}
+			v[key] = selected.trim(field)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = s.trim(item)
+		}
+	}
+	return value
+}
+
+// jsonKeys returns the types of the fields of the JSON object t encodes
+// to, looking through pointers, slices and embedded structs, or nil if t
+// does not encode to an object
+func jsonKeys(t reflect.Type) map[string]reflect.Type {
+	for t != nil && (t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct || t.Implements(marshalerType) || reflect.PointerTo(t).Implements(marshalerType) {
+		return nil
+	}
+
+	keys := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" {
+			for key, child := range jsonKeys(field.Type) {
+				if _, shadowed := keys[key]; !shadowed {
+					keys[key] = child
+				}
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		keys[name] = field.Type
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return keys
+}
+
+var marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+func sortedKeys(keys map[string]reflect.Type) []string {
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
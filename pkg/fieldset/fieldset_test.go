@@ -0,0 +1,91 @@
+package fieldset_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/zenkriztao/ayo-football-backend/pkg/fieldset"
+)
+
+type team struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type goal struct {
+	Minute     int    `json:"minute"`
+	PlayerName string `json:"player_name,omitempty"`
+}
+
+type base struct {
+	ID        string `json:"id"`
+	CreatedAt string `json:"created_at"`
+}
+
+type match struct {
+	base
+	HomeTeam *team  `json:"home_team,omitempty"`
+	AwayTeam *team  `json:"away_team,omitempty"`
+	Goals    []goal `json:"goals,omitempty"`
+	Score    *int   `json:"score"`
+	secret   string
+}
+
+func sample() []match {
+	score := 3
+	return []match{{
+		base:     base{ID: "m1", CreatedAt: "2025-01-01"},
+		HomeTeam: &team{ID: "t1", Name: "Persija"},
+		AwayTeam: &team{ID: "t2", Name: "Persib"},
+		Goals:    []goal{{Minute: 12, PlayerName: "Riko"}, {Minute: 80}},
+		Score:    &score,
+	}}
+}
+
+func encode(t *testing.T, v interface{}) string {
+	t.Helper()
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	return string(out)
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		fields string
+		keep   []string
+		want   string
+	}{
+		{"id,score", nil, `[{"id":"m1","score":3}]`},
+		{"id, home_team.name,goals.minute", nil, `[{"goals":[{"minute":12},{"minute":80}],"home_team":{"name":"Persija"},"id":"m1"}]`},
+		{"home_team.name,home_team", nil, `[{"home_team":{"id":"t1","name":"Persija"}}]`},
+		{"id", []string{"away_team"}, `[{"away_team":{"id":"t2","name":"Persib"},"id":"m1"}]`},
+		{"id,away_team.id", []string{"away_team"}, `[{"away_team":{"id":"t2"},"id":"m1"}]`},
+	}
+	for _, tt := range tests {
+		set, err := fieldset.Parse(tt.fields, []match{}, tt.keep...)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tt.fields, err)
+		}
+		if got := encode(t, set.Apply(sample())); got != tt.want {
+			t.Errorf("fields=%s keep %v gave %s, want %s", tt.fields, tt.keep, got, tt.want)
+		}
+	}
+
+	set, err := fieldset.Parse(" ", match{}, "home_team")
+	if err != nil || set != nil {
+		t.Fatalf("Parse of no fields = %v, %v, want everything", set, err)
+	}
+	if got, want := encode(t, set.Apply(sample())), encode(t, sample()); got != want {
+		t.Fatalf("Apply of everything = %s, want %s", got, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, fields := range []string{"nam", "secret", "base", "score.value", "home_team.city", "home_team,home_team.city", "goals.player"} {
+		if _, err := fieldset.Parse(fields, match{}); err == nil {
+			t.Errorf("Parse(%q) accepted an unknown field", fields)
+		}
+	}
+}
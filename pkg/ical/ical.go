@@ -0,0 +1,159 @@
+// Package ical writes iCalendar (RFC 5545) feeds of events
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ContentType is the MIME type of an iCalendar feed
+const ContentType = "text/calendar; charset=utf-8"
+
+// maxLineLength is the longest content line in octets, excluding CRLF
+const maxLineLength = 75
+
+// Status is the status of an event
+type Status string
+
+const (
+	StatusConfirmed Status = "CONFIRMED"
+	StatusCancelled Status = "CANCELLED"
+)
+
+// Calendar is a feed of events
+type Calendar struct {
+	// ProductID identifies the application that wrote the feed
+	ProductID string
+	Name      string
+	// RefreshInterval suggests how often subscribers poll the feed
+	RefreshInterval time.Duration
+	// Stamp is when the feed was generated, written as the DTSTAMP of every
+	// event. The zero value stands for the time of Write.
+	Stamp  time.Time
+	Events []Event
+}
+
+// Event is a calendar event. Timed events are written in UTC, so calendar
+// apps show them in the subscriber's own time zone.
+type Event struct {
+	// UID must stay the same for the lifetime of the event, so subscribers
+	// update it instead of adding a copy
+	UID string
+	// Modified is when the event last changed
+	Modified time.Time
+	Start    time.Time
+	End      time.Time
+	// AllDay writes only the dates of Start and End; End is exclusive
+	AllDay      bool
+	Summary     string
+	Location    string
+	Description string
+	Status      Status
+}
+
+// Write writes the calendar to w
+func Write(w io.Writer, cal *Calendar) error {
+	out := &writer{buf: bufio.NewWriter(w)}
+	out.line("BEGIN:VCALENDAR")
+	out.line("VERSION:2.0")
+	out.line("PRODID:" + escapeText(cal.ProductID))
+	out.line("CALSCALE:GREGORIAN")
+	out.line("METHOD:PUBLISH")
+	if cal.Name != "" {
+		out.line("X-WR-CALNAME:" + escapeText(cal.Name))
+	}
+	if cal.RefreshInterval > 0 {
+		interval := formatDuration(cal.RefreshInterval)
+		out.line("REFRESH-INTERVAL;VALUE=DURATION:" + interval)
+		out.line("X-PUBLISHED-TTL:" + interval)
+	}
+
+	stamp := cal.Stamp
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+	for i := range cal.Events {
+		event := &cal.Events[i]
+		out.line("BEGIN:VEVENT")
+		out.line("UID:" + escapeText(event.UID))
+		out.line("DTSTAMP:" + formatUTC(stamp))
+		out.line("LAST-MODIFIED:" + formatUTC(event.Modified))
+		if event.AllDay {
+			out.line("DTSTART;VALUE=DATE:" + event.Start.Format("20060102"))
+			out.line("DTEND;VALUE=DATE:" + event.End.Format("20060102"))
+		} else {
+			out.line("DTSTART:" + formatUTC(event.Start))
+			out.line("DTEND:" + formatUTC(event.End))
+		}
+		out.line("SUMMARY:" + escapeText(event.Summary))
+		if event.Location != "" {
+			out.line("LOCATION:" + escapeText(event.Location))
+		}
+		if event.Description != "" {
+			out.line("DESCRIPTION:" + escapeText(event.Description))
+		}
+		if event.Status != "" {
+			out.line("STATUS:" + string(event.Status))
+		}
+		out.line("END:VEVENT")
+	}
+
+	out.line("END:VCALENDAR")
+	if out.err != nil {
+		return out.err
+	}
+	return out.buf.Flush()
+}
+
+// writer writes folded content lines and keeps the first error
+type writer struct {
+	buf *bufio.Writer
+	err error
+}
+
+// line writes a content line, folding it into continuation lines that start
+// with a space so that no line is longer than maxLineLength octets
+func (w *writer) line(text string) {
+	if w.err != nil {
+		return
+	}
+	limit := maxLineLength
+	for len(text) > limit {
+		cut := limit
+		// Never split a UTF-8 sequence
+		for cut > 0 && !utf8.RuneStart(text[cut]) {
+			cut--
+		}
+		if _, w.err = w.buf.WriteString(text[:cut] + "\r\n "); w.err != nil {
+			return
+		}
+		text = text[cut:]
+		// The leading space counts towards the continuation line's length
+		limit = maxLineLength - 1
+	}
+	_, w.err = w.buf.WriteString(text + "\r\n")
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// escapeText escapes a TEXT value
+func escapeText(text string) string {
+	return textEscaper.Replace(text)
+}
+
+func formatUTC(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// formatDuration writes a duration in whole minutes, such as PT90M
+func formatDuration(d time.Duration) string {
+	minutes := int64(d / time.Minute)
+	if minutes < 1 {
+		minutes = 1
+	}
+	return fmt.Sprintf("PT%dM", minutes)
+}
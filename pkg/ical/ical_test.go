@@ -0,0 +1,114 @@
+package ical_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zenkriztao/ayo-football-backend/pkg/ical"
+)
+
+func TestWrite(t *testing.T) {
+	wib := time.FixedZone("WIB", 7*60*60)
+	modified := time.Date(2024, 3, 1, 8, 30, 0, 0, wib)
+	cal := &ical.Calendar{
+		ProductID:       "-//Test//Fixtures//EN",
+		Name:            "Persija, fixtures",
+		RefreshInterval: time.Hour,
+		Stamp:           time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC),
+		Events: []ical.Event{
+			{
+				UID:         "match-1@test",
+				Modified:    modified,
+				Start:       time.Date(2024, 3, 15, 19, 30, 0, 0, wib),
+				End:         time.Date(2024, 3, 15, 21, 30, 0, 0, wib),
+				Summary:     "Persija 2-1 Persib",
+				Location:    "Jl. Sudirman; Jakarta",
+				Description: "Line one\nLine two",
+				Status:      ical.StatusConfirmed,
+			},
+			{
+				UID:      "match-2@test",
+				Modified: modified,
+				Start:    time.Date(2024, 3, 22, 0, 0, 0, 0, time.UTC),
+				End:      time.Date(2024, 3, 23, 0, 0, 0, 0, time.UTC),
+				AllDay:   true,
+				Summary:  "Persib vs Persija",
+				Status:   ical.StatusCancelled,
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := ical.Write(&buf, cal); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	want := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Test//Fixtures//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		`X-WR-CALNAME:Persija\, fixtures`,
+		"REFRESH-INTERVAL;VALUE=DURATION:PT60M",
+		"X-PUBLISHED-TTL:PT60M",
+		"BEGIN:VEVENT",
+		"UID:match-1@test",
+		"DTSTAMP:20240310T000000Z",
+		"LAST-MODIFIED:20240301T013000Z",
+		"DTSTART:20240315T123000Z",
+		"DTEND:20240315T143000Z",
+		"SUMMARY:Persija 2-1 Persib",
+		`LOCATION:Jl. Sudirman\; Jakarta`,
+		`DESCRIPTION:Line one\nLine two`,
+		"STATUS:CONFIRMED",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:match-2@test",
+		"DTSTAMP:20240310T000000Z",
+		"LAST-MODIFIED:20240301T013000Z",
+		"DTSTART;VALUE=DATE:20240322",
+		"DTEND;VALUE=DATE:20240323",
+		"SUMMARY:Persib vs Persija",
+		"STATUS:CANCELLED",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+	if buf.String() != want {
+		t.Fatalf("expected\n%s\ngot\n%s", want, buf.String())
+	}
+}
+
+func TestWriteFoldsLongLines(t *testing.T) {
+	summary := strings.Repeat("Bhayangkara ", 10) + "Sepak Bola Indonésia"
+	cal := &ical.Calendar{ProductID: "-//Test//EN", Events: []ical.Event{{UID: "1", Summary: summary}}}
+
+	var buf bytes.Buffer
+	if err := ical.Write(&buf, cal); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	var unfolded []string
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Fatalf("line longer than 75 octets: %q", line)
+		}
+		if strings.HasPrefix(line, " ") {
+			unfolded[len(unfolded)-1] += line[1:]
+			continue
+		}
+		unfolded = append(unfolded, line)
+	}
+	found := false
+	for _, line := range unfolded {
+		if line == "SUMMARY:"+summary {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("folded summary does not unfold to the original:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,139 @@
+// Package imaging validates uploaded images and resizes them
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	// Register the GIF decoder; GIFs are re-encoded as PNG
+	_ "image/gif"
+)
+
+// Supported content types
+const (
+	JPEG = "image/jpeg"
+	PNG  = "image/png"
+	GIF  = "image/gif"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format, must be JPEG, PNG or GIF")
+	ErrTooLarge          = errors.New("image dimensions are too large")
+)
+
+// jpegQuality is the quality resized JPEGs are encoded with
+const jpegQuality = 85
+
+// Sniff returns the content type of data judged from its first bytes, not
+// from the file name or the type the client claims
+func Sniff(data []byte) (string, error) {
+	switch contentType := http.DetectContentType(data); contentType {
+	case JPEG, PNG, GIF:
+		return contentType, nil
+	}
+	return "", ErrUnsupportedFormat
+}
+
+// Decode decodes an image after checking its type and that it has at most
+// maxPixels pixels, before its pixels are allocated. It returns the sniffed
+// content type. Only the first frame of an animated GIF is decoded.
+func Decode(data []byte, maxPixels int) (image.Image, string, error) {
+	contentType, err := Sniff(data)
+	if err != nil {
+		return nil, "", err
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", ErrUnsupportedFormat
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width > maxPixels/config.Height {
+		return nil, "", ErrTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", ErrUnsupportedFormat
+	}
+	return img, contentType, nil
+}
+
+// Fit scales img down, keeping its aspect ratio, so neither side exceeds
+// maxSide. Smaller images keep their size. Every destination pixel is the
+// average of the source pixels it covers, which keeps thin lines of logos
+// from disappearing.
+func Fit(img image.Image, maxSide int) *image.RGBA {
+	bounds := img.Bounds()
+	src := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(src, src.Bounds(), img, bounds.Min, draw.Src)
+
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	dw, dh := sw, sh
+	if sw > maxSide || sh > maxSide {
+		if sw >= sh {
+			dw, dh = maxSide, max(1, sh*maxSide/sw)
+		} else {
+			dw, dh = max(1, sw*maxSide/sh), maxSide
+		}
+	}
+	if dw == sw && dh == sh {
+		return src
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		y0, y1 := y*sh/dh, max((y+1)*sh/dh, y*sh/dh+1)
+		for x := 0; x < dw; x++ {
+			x0, x1 := x*sw/dw, max((x+1)*sw/dw, x*sw/dw+1)
+
+			// RGBA is premultiplied, so channels can be averaged directly
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					p := row[sx*4 : sx*4+4]
+					r += uint64(p[0])
+					g += uint64(p[1])
+					b += uint64(p[2])
+					a += uint64(p[3])
+					n++
+				}
+			}
+			d := dst.Pix[y*dst.Stride+x*4:]
+			d[0], d[1], d[2], d[3] = uint8(r/n), uint8(g/n), uint8(b/n), uint8(a/n)
+		}
+	}
+	return dst
+}
+
+// Encode encodes img as a JPEG when contentType is JPEG and as a PNG
+// otherwise, so transparency survives. It returns the content type used.
+func Encode(img image.Image, contentType string) ([]byte, string, error) {
+	var buf bytes.Buffer
+	if contentType == JPEG {
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, "", err
+		}
+		return buf.Bytes(), JPEG, nil
+	}
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), PNG, nil
+}
+
+// Extension returns the file extension of a supported content type
+func Extension(contentType string) string {
+	switch contentType {
+	case JPEG:
+		return ".jpg"
+	case GIF:
+		return ".gif"
+	}
+	return ".png"
+}
@@ -0,0 +1,126 @@
+package imaging_test
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"testing"
+
+	"github.com/zenkriztao/ayo-football-backend/pkg/imaging"
+)
+
+// encodePNG returns a w×h PNG, opaque red on the left half and transparent
+// on the right
+func encodePNG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w/2; x++ {
+			img.Set(x, y, color.NRGBA{R: 255, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestSniff(t *testing.T) {
+	var gifData bytes.Buffer
+	if err := gif.Encode(&gifData, image.NewPaletted(image.Rect(0, 0, 1, 1), color.Palette{color.Black}), nil); err != nil {
+		t.Fatalf("encode gif: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		want string
+		err  error
+	}{
+		{"png", encodePNG(t, 2, 2), imaging.PNG, nil},
+		{"gif", gifData.Bytes(), imaging.GIF, nil},
+		{"jpeg magic", []byte("\xFF\xD8\xFF\xE0rest"), imaging.JPEG, nil},
+		{"html named .png", []byte("<html><script>alert(1)</script></html>"), "", imaging.ErrUnsupportedFormat},
+		{"bmp", []byte("BM\x00\x00"), "", imaging.ErrUnsupportedFormat},
+	}
+	for _, tt := range tests {
+		got, err := imaging.Sniff(tt.data)
+		if got != tt.want || !errors.Is(err, tt.err) {
+			t.Errorf("%s: Sniff = %q, %v; want %q, %v", tt.name, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestDecodeChecksDimensionsFirst(t *testing.T) {
+	data := encodePNG(t, 40, 30)
+
+	if _, _, err := imaging.Decode(data, 40*30-1); !errors.Is(err, imaging.ErrTooLarge) {
+		t.Fatalf("expected ErrTooLarge, got %v", err)
+	}
+	img, contentType, err := imaging.Decode(data, 40*30)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if contentType != imaging.PNG || img.Bounds().Dx() != 40 {
+		t.Fatalf("decoded %s %v", contentType, img.Bounds())
+	}
+
+	// A valid header with a truncated body
+	if _, _, err := imaging.Decode(data[:60], 40*30); !errors.Is(err, imaging.ErrUnsupportedFormat) {
+		t.Fatalf("expected ErrUnsupportedFormat for a truncated image, got %v", err)
+	}
+}
+
+func TestFit(t *testing.T) {
+	img, _, err := imaging.Decode(encodePNG(t, 400, 100), 1<<20)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+
+	thumb := imaging.Fit(img, 100)
+	if thumb.Bounds().Dx() != 100 || thumb.Bounds().Dy() != 25 {
+		t.Fatalf("expected 100x25, got %v", thumb.Bounds())
+	}
+	if got := thumb.RGBAAt(10, 10); got != (color.RGBA{R: 255, A: 255}) {
+		t.Errorf("left half: got %v, want opaque red", got)
+	}
+	if got := thumb.RGBAAt(90, 10); got.A != 0 {
+		t.Errorf("right half: got %v, want transparent", got)
+	}
+
+	tall := imaging.Fit(image.NewRGBA(image.Rect(0, 0, 10, 1000)), 100)
+	if tall.Bounds().Dx() != 1 || tall.Bounds().Dy() != 100 {
+		t.Fatalf("expected 1x100, got %v", tall.Bounds())
+	}
+	small := imaging.Fit(image.NewRGBA(image.Rect(0, 0, 20, 10)), 100)
+	if small.Bounds().Dx() != 20 || small.Bounds().Dy() != 10 {
+		t.Fatalf("expected a small image to keep its size, got %v", small.Bounds())
+	}
+}
+
+func TestEncode(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	tests := []struct {
+		in, want, ext string
+	}{
+		{imaging.JPEG, imaging.JPEG, ".jpg"},
+		{imaging.PNG, imaging.PNG, ".png"},
+		{imaging.GIF, imaging.PNG, ".png"},
+	}
+	for _, tt := range tests {
+		data, contentType, err := imaging.Encode(img, tt.in)
+		if err != nil {
+			t.Fatalf("encode %s: %v", tt.in, err)
+		}
+		if contentType != tt.want || imaging.Extension(contentType) != tt.ext {
+			t.Errorf("Encode(%s) = %s (%s), want %s (%s)", tt.in, contentType, imaging.Extension(contentType), tt.want, tt.ext)
+		}
+		if sniffed, err := imaging.Sniff(data); err != nil || sniffed != tt.want {
+			t.Errorf("Encode(%s) produced %q, %v", tt.in, sniffed, err)
+		}
+	}
+}
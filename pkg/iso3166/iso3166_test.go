@@ -0,0 +1,33 @@
+package iso3166_test
+
+import (
+	"testing"
+
+	"github.com/zenkriztao/ayo-football-backend/pkg/iso3166"
+)
+
+func TestIsAlpha2(t *testing.T) {
+	cases := []struct {
+		code string
+		want bool
+	}{
+		{"ID", true},
+		{"GB", true},
+		{"XK", false}, // user-assigned, not official
+		{"UK", false}, // exceptionally reserved
+		{"id", false},
+		{"IDN", false},
+		{"", false},
+	}
+	for _, tc := range cases {
+		if got := iso3166.IsAlpha2(tc.code); got != tc.want {
+			t.Errorf("IsAlpha2(%q) = %v, want %v", tc.code, got, tc.want)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	if got := iso3166.Normalize(" br "); got != "BR" || !iso3166.IsAlpha2(got) {
+		t.Fatalf("Normalize(\" br \") = %q, want BR", got)
+	}
+}
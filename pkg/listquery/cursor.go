@@ -0,0 +1,189 @@
+package listquery
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Cursor marks a position in a sorted list by the sort values and ID of an
+// item, so the next page starts right after it however many items were
+// added or removed before it
+type Cursor struct {
+	Sort []Order
+	// Values are the item's values of the Sort fields, nil if unknown
+	Values []interface{}
+	ID     uuid.UUID
+	// Before selects the items before the position instead of after it
+	Before bool
+}
+
+// Window selects a page of a list relative to a cursor, as an alternative to
+// page numbers
+type Window struct {
+	// Cursor is nil for the first page
+	Cursor *Cursor
+	Limit  int
+	// Count also counts all the items of the list, which costs a query
+	Count bool
+}
+
+// Slice is the page of a list selected by a Window
+type Slice[T any] struct {
+	Items []T
+	// Next and Prev are the cursors of the adjacent pages, nil at either end
+	// of the list
+	Next, Prev *Cursor
+	// Total is set if the window asked for a count
+	Total *int64
+}
+
+// Reader reads the fields of a schema from items of T
+type Reader[T any] struct {
+	// Fields return a string, int, float64, uuid.UUID, time.Time, or nil
+	// when the value is unknown. Like SQL NULL, nil matches no condition and
+	// sorts last.
+	Fields map[string]func(T) interface{}
+	ID     func(T) uuid.UUID
+}
+
+// Cursor returns the cursor of item in a list sorted by orders
+func (r Reader[T]) Cursor(item T, orders []Order) *Cursor {
+	values := make([]interface{}, len(orders))
+	for i, o := range orders {
+		values[i] = r.Fields[o.Field](item)
+	}
+	return &Cursor{Sort: orders, Values: values, ID: r.ID(item)}
+}
+
+// NewSlice makes the slice of window from up to window.Limit+1 items loaded
+// past its cursor: in sort order after it, or in reverse order before it. The
+// extra item tells whether the list goes on.
+func NewSlice[T any](items []T, window Window, cursor func(T) *Cursor) Slice[T] {
+	more := len(items) > window.Limit
+	if more {
+		items = items[:window.Limit]
+	}
+	backward := window.Cursor != nil && window.Cursor.Before
+	if backward {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+
+	slice := Slice[T]{Items: items}
+	if len(items) == 0 {
+		return slice
+	}
+	// Paging back comes from a later page, and paging on from an earlier one
+	if more || backward {
+		slice.Next = cursor(items[len(items)-1])
+	}
+	if (more && backward) || (window.Cursor != nil && !backward) {
+		slice.Prev = cursor(items[0])
+		slice.Prev.Before = true
+	}
+	return slice
+}
+
+// encodedCursor is the JSON of an encoded cursor
+type encodedCursor struct {
+	Sort   string        `json:"s"`
+	Values []interface{} `json:"v"`
+	ID     uuid.UUID     `json:"id"`
+	Before bool          `json:"b,omitempty"`
+}
+
+// Encode returns the cursor as an opaque URL-safe token
+func (c Cursor) Encode() string {
+	values := make([]interface{}, len(c.Values))
+	for i, v := range c.Values {
+		if t, ok := v.(time.Time); ok {
+			// Keep the offset so the value compares equal to the stored one
+			v = t.Format(time.RFC3339Nano)
+		}
+		values[i] = v
+	}
+	data, _ := json.Marshal(encodedCursor{Sort: FormatSort(c.Sort), Values: values, ID: c.ID, Before: c.Before})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor reads a token made by Cursor.Encode. The cursor must come from
+// a list sorted by orders.
+func DecodeCursor(token string, schema Schema, orders []Order) (*Cursor, error) {
+	invalid := &Error{Param: "cursor", Message: "not a cursor of this list"}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalid
+	}
+	var encoded encodedCursor
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&encoded); err != nil || len(encoded.Values) != len(orders) {
+		return nil, invalid
+	}
+	if encoded.Sort != FormatSort(orders) {
+		return nil, &Error{Param: "cursor", Message: fmt.Sprintf("the cursor is for sort=%s", encoded.Sort)}
+	}
+
+	cursor := &Cursor{Sort: orders, Values: make([]interface{}, len(orders)), ID: encoded.ID, Before: encoded.Before}
+	for i, o := range orders {
+		if encoded.Values[i] == nil {
+			continue
+		}
+		value, err := decodeValue(schema.Fields[o.Field].Type, encoded.Values[i])
+		if err != nil {
+			return nil, invalid
+		}
+		cursor.Values[i] = value
+	}
+	return cursor, nil
+}
+
+// decodeValue converts a JSON value back to the Go type of a field
+func decodeValue(typ Type, raw interface{}) (interface{}, error) {
+	switch typ {
+	case Int:
+		n, ok := raw.(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("not a number")
+		}
+		i, err := n.Int64()
+		return int(i), err
+	case Float:
+		n, ok := raw.(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("not a number")
+		}
+		return n.Float64()
+	}
+	s, ok := raw.(string)
+	if !ok {
+		return nil, fmt.Errorf("not a string")
+	}
+	switch typ {
+	case UUID:
+		return uuid.Parse(s)
+	case Time:
+		return time.Parse(time.RFC3339Nano, s)
+	default:
+		return s, nil
+	}
+}
+
+// FormatSort returns the sort parameter of orders, e.g. -match_date,name
+func FormatSort(orders []Order) string {
+	parts := make([]string, len(orders))
+	for i, o := range orders {
+		parts[i] = o.Field
+		if o.Desc {
+			parts[i] = "-" + o.Field
+		}
+	}
+	return strings.Join(parts, ",")
+}
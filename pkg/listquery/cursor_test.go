@@ -0,0 +1,91 @@
+package listquery_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	orders := []listquery.Order{listquery.Desc("kickoff_at"), listquery.Asc("name"), listquery.Asc("height"), listquery.Desc("team.name")}
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	cursor := listquery.Cursor{
+		Sort:   orders,
+		Values: []interface{}{time.Date(2025, 3, 1, 19, 30, 0, 123456789, jakarta), "Persija", 182.5, nil},
+		ID:     uuid.New(),
+		Before: true,
+	}
+
+	decoded, err := listquery.DecodeCursor(cursor.Encode(), schema, orders)
+	if err != nil {
+		t.Fatalf("DecodeCursor: %v", err)
+	}
+	kickoff := decoded.Values[0].(time.Time)
+	if !kickoff.Equal(cursor.Values[0].(time.Time)) || kickoff.Format(time.RFC3339Nano) != "2025-03-01T19:30:00.123456789+07:00" {
+		t.Fatalf("kickoff = %v, want the same instant and offset", kickoff)
+	}
+	decoded.Values[0] = cursor.Values[0]
+	if !reflect.DeepEqual(*decoded, cursor) {
+		t.Fatalf("DecodeCursor =\n%+v\nwant\n%+v", *decoded, cursor)
+	}
+}
+
+func TestDecodeCursorErrors(t *testing.T) {
+	orders := []listquery.Order{listquery.Asc("name")}
+	valid := listquery.Cursor{Sort: orders, Values: []interface{}{"Persija"}, ID: uuid.New()}.Encode()
+
+	tests := []struct {
+		name   string
+		token  string
+		orders []listquery.Order
+	}{
+		{"not base64", "%%%", orders},
+		{"not JSON", "bm90IGpzb24", orders},
+		{"another sort order", valid, []listquery.Order{listquery.Desc("name")}},
+		{"more sort fields", valid, []listquery.Order{listquery.Asc("name"), listquery.Asc("height")}},
+		{"wrong value type", listquery.Cursor{Sort: []listquery.Order{listquery.Asc("height")}, Values: []interface{}{"tall"}}.Encode(), []listquery.Order{listquery.Asc("height")}},
+	}
+	for _, tt := range tests {
+		_, err := listquery.DecodeCursor(tt.token, schema, tt.orders)
+		var queryErr *listquery.Error
+		if !errors.As(err, &queryErr) || queryErr.Param != "cursor" {
+			t.Errorf("%s: DecodeCursor = %v, want a cursor error", tt.name, err)
+		}
+	}
+}
+
+func TestNewSlice(t *testing.T) {
+	cursorOf := func(n int) *listquery.Cursor {
+		return &listquery.Cursor{Values: []interface{}{n}}
+	}
+	at := func(n int, before bool) *listquery.Cursor {
+		c := cursorOf(n)
+		c.Before = before
+		return c
+	}
+	tests := []struct {
+		name       string
+		loaded     []int
+		cursor     *listquery.Cursor
+		items      []int
+		next, prev *listquery.Cursor
+	}{
+		{"first page", []int{1, 2, 3}, nil, []int{1, 2}, at(2, false), nil},
+		{"only page", []int{1, 2}, nil, []int{1, 2}, nil, nil},
+		{"middle page", []int{3, 4, 5}, at(2, false), []int{3, 4}, at(4, false), at(3, true)},
+		{"last page", []int{5}, at(4, false), []int{5}, nil, at(5, true)},
+		{"back to the middle", []int{4, 3, 2}, at(5, true), []int{3, 4}, at(4, false), at(3, true)},
+		{"back to the start", []int{2, 1}, at(3, true), []int{1, 2}, at(2, false), nil},
+		{"empty", nil, at(9, false), nil, nil, nil},
+	}
+	for _, tt := range tests {
+		slice := listquery.NewSlice(tt.loaded, listquery.Window{Cursor: tt.cursor, Limit: 2}, cursorOf)
+		if !reflect.DeepEqual(slice.Items, tt.items) || !reflect.DeepEqual(slice.Next, tt.next) || !reflect.DeepEqual(slice.Prev, tt.prev) {
+			t.Errorf("%s: NewSlice = %v next %+v prev %+v, want %v next %+v prev %+v", tt.name, slice.Items, slice.Next, slice.Prev, tt.items, tt.next, tt.prev)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package listquery
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Include is the sorted set of related resources to load with an item, as
+// dotted paths like home_team or goals.player. A nested path also includes
+// its parents, so goals.player loads the goals with their players. The zero
+// value includes nothing.
+type Include []string
+
+// NewInclude returns the Include of paths and their parents
+func NewInclude(paths ...string) Include {
+	return Include(nil).With(paths...)
+}
+
+// With returns a copy of i that also includes paths
+func (i Include) With(paths ...string) Include {
+	if len(paths) == 0 {
+		return i
+	}
+	set := make(map[string]bool, len(i)+len(paths))
+	for _, path := range i {
+		set[path] = true
+	}
+	for _, path := range paths {
+		for {
+			set[path] = true
+			parent := strings.LastIndexByte(path, '.')
+			if parent < 0 {
+				break
+			}
+			path = path[:parent]
+		}
+	}
+
+	include := make(Include, 0, len(set))
+	for path := range set {
+		include = append(include, path)
+	}
+	sort.Strings(include)
+	return include
+}
+
+// Has reports whether the related resource path is included
+func (i Include) Has(path string) bool {
+	n := sort.SearchStrings(i, path)
+	return n < len(i) && i[n] == path
+}
+
+// ParseInclude reads a comma-separated list of relations of schema, e.g.
+// home_team,goals.player. An empty value includes nothing.
+func ParseInclude(value string, schema Schema) (Include, error) {
+	var paths []string
+	for _, part := range strings.Split(value, ",") {
+		path := strings.TrimSpace(part)
+		if path == "" {
+			continue
+		}
+		if !contains(schema.Relations, path) {
+			if len(schema.Relations) == 0 {
+				return nil, &Error{Param: "include", Message: "nothing can be included"}
+			}
+			return nil, &Error{Param: "include", Message: fmt.Sprintf("cannot include %q, use %s", path, strings.Join(schema.Relations, ", "))}
+		}
+		paths = append(paths, path)
+	}
+	return NewInclude(paths...), nil
+}
@@ -0,0 +1,61 @@
+package listquery_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+)
+
+func TestParseInclude(t *testing.T) {
+	include, err := listquery.ParseInclude(" goals.player ,team,team", schema)
+	if err != nil {
+		t.Fatalf("ParseInclude: %v", err)
+	}
+	want := listquery.Include{"goals", "goals.player", "team"}
+	if !reflect.DeepEqual(include, want) {
+		t.Fatalf("ParseInclude = %v, want %v", include, want)
+	}
+	if !include.Has("goals") || include.Has("goals.team") {
+		t.Fatalf("Has reports %v wrongly", include)
+	}
+
+	if include, err := listquery.ParseInclude("", schema); err != nil || len(include) != 0 {
+		t.Fatalf("ParseInclude of nothing = %v, %v", include, err)
+	}
+
+	for _, raw := range []string{"venue", "goals.team", "team.players"} {
+		_, err := listquery.ParseInclude(raw, schema)
+		var queryErr *listquery.Error
+		if !errors.As(err, &queryErr) || queryErr.Param != "include" {
+			t.Errorf("ParseInclude(%q) = %v, want a *listquery.Error", raw, err)
+		}
+	}
+	if _, err := listquery.ParseInclude("team", listquery.Schema{}); err == nil {
+		t.Fatal("ParseInclude accepted a relation of a schema without any")
+	}
+}
+
+func TestIncludeWithDoesNotShare(t *testing.T) {
+	base := listquery.NewInclude("team")
+	with := base.With("goals.player")
+	if !reflect.DeepEqual(base, listquery.Include{"team"}) || !with.Has("goals") || !with.Has("team") {
+		t.Fatalf("With = %v from %v", with, base)
+	}
+
+	q := listquery.Query{}.Including("team")
+	if !q.Include.Has("team") {
+		t.Fatalf("Including = %+v", q)
+	}
+}
+
+func TestSortRelations(t *testing.T) {
+	q := listquery.Query{}.OrderBy(listquery.Asc("team.name"), listquery.Desc("kickoff_at"))
+	if got := q.SortRelations(schema); !reflect.DeepEqual(got, []string{"team"}) {
+		t.Fatalf("SortRelations = %v, want [team]", got)
+	}
+	if got := (listquery.Query{}).SortRelations(schema); len(got) != 0 {
+		t.Fatalf("SortRelations of the default order = %v", got)
+	}
+}
@@ -0,0 +1,360 @@
+// Package listquery parses the filters and sort order of list endpoints,
+// e.g. ?status=completed&height[gte]=180&sort=-match_date,name, against a
+// whitelist of fields. Repositories translate the resulting Query into SQL
+// or evaluate it in memory, page through it by page number or with a
+// Cursor, and load the related resources of its Include.
+package listquery
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Operator compares a field with a filter value
+type Operator string
+
+const (
+	Eq       Operator = "eq"
+	Ne       Operator = "ne"
+	Gt       Operator = "gt"
+	Gte      Operator = "gte"
+	Lt       Operator = "lt"
+	Lte      Operator = "lte"
+	In       Operator = "in"       // Value is a []interface{} of the field's type
+	Contains Operator = "contains" // Text fields only, ignoring case
+)
+
+// Operator sets commonly allowed on a field
+var (
+	Equality = []Operator{Eq, Ne, In}
+	Range    = []Operator{Eq, Ne, In, Gt, Gte, Lt, Lte}
+	Text     = []Operator{Eq, Ne, In, Contains}
+)
+
+// Type is the type of a field's values
+type Type int
+
+const (
+	String Type = iota // string
+	Int                // int
+	Float              // float64
+	UUID               // uuid.UUID
+	Time               // time.Time in UTC; a bare YYYY-MM-DD is midnight UTC
+)
+
+// Field describes a field a list can be filtered or sorted by
+type Field struct {
+	Type Type
+	// Operators are the filters allowed on the field; none means the field
+	// cannot be filtered
+	Operators []Operator
+	Sortable  bool
+	// Values, if set, are the only values a String field accepts
+	Values []string
+	// Parse, if set, replaces the parsing of a single value by Type
+	Parse func(value string) (interface{}, error)
+}
+
+// Schema is the whitelist of fields of one kind of list
+type Schema struct {
+	Fields map[string]Field
+	// Search lists the text fields the free text search of Query.Search
+	// matches; none disables it
+	Search []string
+	// DefaultSort orders lists that are not given a sort order
+	DefaultSort []Order
+	// Relations lists the related resources that can be loaded with the
+	// items, see Include
+	Relations []string
+}
+
+// Condition filters a list by comparing a field with a value
+type Condition struct {
+	Field string
+	Op    Operator
+	Value interface{}
+}
+
+// Order sorts a list by a field
+type Order struct {
+	Field string
+	Desc  bool
+}
+
+// Asc returns the ascending order of field
+func Asc(field string) Order { return Order{Field: field} }
+
+// Desc returns the descending order of field
+func Desc(field string) Order { return Order{Field: field, Desc: true} }
+
+// Query selects and orders the items of a list. Every condition must hold.
+// The zero value lists everything in the schema's default order.
+type Query struct {
+	// Search matches a free text query against the schema's searchable
+	// fields, ignoring case
+	Search     string
+	Conditions []Condition
+	Sort       []Order
+	// Include lists the related resources to load with the items
+	Include Include
+}
+
+// Where returns a copy of q with an additional condition
+func (q Query) Where(field string, op Operator, value interface{}) Query {
+	q.Conditions = append(q.Conditions[:len(q.Conditions):len(q.Conditions)], Condition{Field: field, Op: op, Value: value})
+	return q
+}
+
+// OrderBy returns a copy of q sorted by orders instead of its sort order
+func (q Query) OrderBy(orders ...Order) Query {
+	q.Sort = orders
+	return q
+}
+
+// Including returns a copy of q that also loads the related resources paths
+func (q Query) Including(paths ...string) Query {
+	q.Include = q.Include.With(paths...)
+	return q
+}
+
+// SortRelations returns the relations of schema whose fields q is sorted
+// by, like home_team for home_team.name. Cursors read those fields from the
+// loaded items, so the relations are needed whether included or not.
+func (q Query) SortRelations(schema Schema) []string {
+	var relations []string
+	for _, order := range q.SortOrDefault(schema) {
+		if relation, _, nested := strings.Cut(order.Field, "."); nested && contains(schema.Relations, relation) {
+			relations = append(relations, relation)
+		}
+	}
+	return relations
+}
+
+// Lookup returns the value of the first equality condition on field
+func (q Query) Lookup(field string) (interface{}, bool) {
+	for _, c := range q.Conditions {
+		if c.Field == field && c.Op == Eq {
+			return c.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Filtered reports whether q has a search or any condition
+func (q Query) Filtered() bool {
+	return q.Search != "" || len(q.Conditions) > 0
+}
+
+// SortOrDefault returns the sort order of q, or the schema's default
+func (q Query) SortOrDefault(schema Schema) []Order {
+	if len(q.Sort) > 0 {
+		return q.Sort
+	}
+	return schema.DefaultSort
+}
+
+// Error reports an invalid filter or sort parameter
+type Error struct {
+	Param   string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Param, e.Message)
+}
+
+// Parse reads a query from URL query parameters:
+//
+//   - search=text for a free text search, if the schema allows it
+//   - sort=-match_date,name with a leading - for descending order
+//   - field=value for equality, or field[op]=value for the operators eq, ne,
+//     gt, gte, lt, lte, in (comma-separated values) and contains
+//
+// Parameters that are not schema fields, such as page, are left to the
+// caller, but an operator on an unknown field or a field that does not allow
+// it is an error.
+func Parse(values url.Values, schema Schema) (Query, error) {
+	var q Query
+	if len(schema.Search) > 0 {
+		q.Search = strings.TrimSpace(values.Get("search"))
+	}
+	if value := values.Get("sort"); value != "" {
+		orders, err := ParseSort(value, schema)
+		if err != nil {
+			return Query{}, err
+		}
+		q.Sort = orders
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		name, op, bracketed := splitKey(key)
+		field, known := schema.Fields[name]
+		if !known || len(field.Operators) == 0 {
+			if bracketed {
+				return Query{}, &Error{Param: key, Message: fmt.Sprintf("cannot filter by %q", name)}
+			}
+			continue
+		}
+		if !allows(field.Operators, op) {
+			return Query{}, &Error{Param: key, Message: fmt.Sprintf("operator %q is not allowed, use %s", op, joinOperators(field.Operators))}
+		}
+		for _, raw := range values[key] {
+			value, err := parseValue(field, op, raw)
+			if err != nil {
+				return Query{}, &Error{Param: key, Message: err.Error()}
+			}
+			q = q.Where(name, op, value)
+		}
+	}
+	return q, nil
+}
+
+// ParseSort reads a comma-separated sort order, e.g. -match_date,name
+func ParseSort(value string, schema Schema) ([]Order, error) {
+	var orders []Order
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		order := Order{Field: strings.TrimPrefix(part, "+")}
+		if strings.HasPrefix(part, "-") {
+			order = Desc(part[1:])
+		}
+		if field, ok := schema.Fields[order.Field]; !ok || !field.Sortable {
+			return nil, &Error{Param: "sort", Message: fmt.Sprintf("cannot sort by %q, use %s", order.Field, strings.Join(sortableFields(schema), ", "))}
+		}
+		if seen[order.Field] {
+			return nil, &Error{Param: "sort", Message: fmt.Sprintf("%q is given more than once", order.Field)}
+		}
+		seen[order.Field] = true
+		orders = append(orders, order)
+	}
+	return orders, nil
+}
+
+// splitKey splits a parameter name like height[gte] into its field and
+// operator; a plain name means equality
+func splitKey(key string) (string, Operator, bool) {
+	open := strings.IndexByte(key, '[')
+	if open < 0 || !strings.HasSuffix(key, "]") {
+		return key, Eq, false
+	}
+	return key[:open], Operator(key[open+1 : len(key)-1]), true
+}
+
+func allows(operators []Operator, op Operator) bool {
+	for _, allowed := range operators {
+		if allowed == op {
+			return true
+		}
+	}
+	return false
+}
+
+func joinOperators(operators []Operator) string {
+	names := make([]string, len(operators))
+	for i, op := range operators {
+		names[i] = string(op)
+	}
+	return strings.Join(names, ", ")
+}
+
+func sortableFields(schema Schema) []string {
+	var names []string
+	for name, field := range schema.Fields {
+		if field.Sortable {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parseValue parses the raw value of a filter; in takes a comma-separated list
+func parseValue(field Field, op Operator, raw string) (interface{}, error) {
+	if op == Contains {
+		if field.Type != String {
+			return nil, fmt.Errorf("contains needs a text field")
+		}
+		return raw, nil
+	}
+	if op != In {
+		return parseSingle(field, raw)
+	}
+	parts := strings.Split(raw, ",")
+	list := make([]interface{}, len(parts))
+	for i, part := range parts {
+		value, err := parseSingle(field, strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		list[i] = value
+	}
+	return list, nil
+}
+
+func parseSingle(field Field, raw string) (interface{}, error) {
+	if field.Parse != nil {
+		return field.Parse(raw)
+	}
+	switch field.Type {
+	case Int:
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", raw)
+		}
+		return n, nil
+	case Float:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("%q is not a number", raw)
+		}
+		return f, nil
+	case UUID:
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a UUID", raw)
+		}
+		return id, nil
+	case Time:
+		return ParseTime(raw)
+	default:
+		if len(field.Values) > 0 && !contains(field.Values, raw) {
+			return nil, fmt.Errorf("%q is not one of %s", raw, strings.Join(field.Values, ", "))
+		}
+		return raw, nil
+	}
+}
+
+// ParseTime parses an RFC 3339 time, or a YYYY-MM-DD date as midnight UTC
+func ParseTime(raw string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", raw); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not a YYYY-MM-DD date or RFC 3339 time", raw)
+	}
+	return t.UTC(), nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
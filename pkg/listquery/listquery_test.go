@@ -0,0 +1,105 @@
+package listquery_test
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/pkg/listquery"
+)
+
+var schema = listquery.Schema{
+	Fields: map[string]listquery.Field{
+		"name":       {Type: listquery.String, Operators: listquery.Text, Sortable: true},
+		"status":     {Type: listquery.String, Operators: listquery.Equality, Values: []string{"scheduled", "completed"}},
+		"height":     {Type: listquery.Float, Operators: listquery.Range, Sortable: true},
+		"goals":      {Type: listquery.Int, Operators: listquery.Range},
+		"team_id":    {Type: listquery.UUID, Operators: listquery.Equality},
+		"kickoff_at": {Type: listquery.Time, Operators: listquery.Range, Sortable: true},
+		"team.name":  {Type: listquery.String, Sortable: true},
+	},
+	Search:      []string{"name"},
+	DefaultSort: []listquery.Order{listquery.Desc("kickoff_at")},
+	Relations:   []string{"team", "goals", "goals.player"},
+}
+
+func TestParse(t *testing.T) {
+	teamID := uuid.New()
+	values, _ := url.ParseQuery("page=2&search=+persija+&status=completed&team_id=" + teamID.String() +
+		"&height[gte]=180&height[lt]=190.5&goals[gt]=2&status[in]=scheduled,completed&name[contains]=Ri" +
+		"&kickoff_at[gte]=2025-01-01&sort=-kickoff_at,team.name")
+
+	q, err := listquery.Parse(values, schema)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	want := listquery.Query{
+		Search: "persija",
+		Conditions: []listquery.Condition{
+			{Field: "goals", Op: listquery.Gt, Value: 2},
+			{Field: "height", Op: listquery.Gte, Value: 180.0},
+			{Field: "height", Op: listquery.Lt, Value: 190.5},
+			{Field: "kickoff_at", Op: listquery.Gte, Value: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{Field: "name", Op: listquery.Contains, Value: "Ri"},
+			{Field: "status", Op: listquery.Eq, Value: "completed"},
+			{Field: "status", Op: listquery.In, Value: []interface{}{"scheduled", "completed"}},
+			{Field: "team_id", Op: listquery.Eq, Value: teamID},
+		},
+		Sort: []listquery.Order{listquery.Desc("kickoff_at"), listquery.Asc("team.name")},
+	}
+	if !reflect.DeepEqual(q, want) {
+		t.Fatalf("Parse =\n%+v\nwant\n%+v", q, want)
+	}
+	if id, ok := q.Lookup("team_id"); !ok || id != teamID {
+		t.Fatalf("Lookup(team_id) = %v, %v", id, ok)
+	}
+	if got := (listquery.Query{}).SortOrDefault(schema); !reflect.DeepEqual(got, schema.DefaultSort) {
+		t.Fatalf("SortOrDefault = %v, want the default", got)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	invalid := []string{
+		"height=tall",
+		"height[contains]=1",
+		"status=postponed",
+		"team_id=42",
+		"kickoff_at[lt]=yesterday",
+		"city[eq]=Jakarta",      // unknown field
+		"team.name[eq]=Persija", // sortable only
+		"sort=city",             // unknown field
+		"sort=status",           // filterable only
+		"sort=name,-name",       // twice
+		"sort=name,",            // empty field
+		"height[in]=180,abc",    // one bad list item
+		"name[between]=a",       // unknown operator
+		"height=NaN",
+		"goals=2.5",
+	}
+	for _, raw := range invalid {
+		values, _ := url.ParseQuery(raw)
+		_, err := listquery.Parse(values, schema)
+		var queryErr *listquery.Error
+		if !errors.As(err, &queryErr) {
+			t.Errorf("Parse(%q) = %v, want a *listquery.Error", raw, err)
+		}
+	}
+
+	// Unknown plain parameters are left to the caller
+	values, _ := url.ParseQuery("page=1&limit=10&timezone=UTC")
+	if q, err := listquery.Parse(values, schema); err != nil || q.Filtered() {
+		t.Fatalf("Parse of non-field parameters = %+v, %v", q, err)
+	}
+}
+
+func TestWhereDoesNotShareConditions(t *testing.T) {
+	base := listquery.Query{}.Where("status", listquery.Eq, "completed")
+	a := base.Where("height", listquery.Gt, 180)
+	b := base.Where("height", listquery.Lt, 170)
+	if a.Conditions[1].Op != listquery.Gt || b.Conditions[1].Op != listquery.Lt || len(base.Conditions) != 1 {
+		t.Fatalf("Where changed a shared query: %+v %+v %+v", base, a, b)
+	}
+}
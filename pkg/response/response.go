@@ -13,12 +13,16 @@ type Response struct {
 	Meta    *Meta       `json:"meta,omitempty"`
 }
 
-// Meta represents pagination metadata
+// Meta represents pagination metadata. Numbered pages have the current page
+// and the totals; cursor pages have the cursors of the adjacent pages and
+// the total only when it was asked for.
 type Meta struct {
-	CurrentPage int   `json:"current_page"`
-	PerPage     int   `json:"per_page"`
-	TotalItems  int64 `json:"total_items"`
-	TotalPages  int64 `json:"total_pages"`
+	CurrentPage int    `json:"current_page,omitempty"`
+	PerPage     int    `json:"per_page"`
+	TotalItems  *int64 `json:"total_items,omitempty"`
+	TotalPages  *int64 `json:"total_pages,omitempty"`
+	NextCursor  string `json:"next_cursor,omitempty"`
+	PrevCursor  string `json:"prev_cursor,omitempty"`
 }
 
 // Success sends a success response
@@ -58,7 +62,18 @@ func NewMeta(page, limit int, total int64) *Meta {
 	return &Meta{
 		CurrentPage: page,
 		PerPage:     limit,
-		TotalItems:  total,
-		TotalPages:  totalPages,
+		TotalItems:  &total,
+		TotalPages:  &totalPages,
+	}
+}
+
+// NewCursorMeta creates the metadata of a cursor page. Empty cursors mean
+// there is no page in that direction; total is nil unless it was counted.
+func NewCursorMeta(limit int, next, prev string, total *int64) *Meta {
+	return &Meta{
+		PerPage:    limit,
+		TotalItems: total,
+		NextCursor: next,
+		PrevCursor: prev,
 	}
 }
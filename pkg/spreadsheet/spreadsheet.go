@@ -0,0 +1,69 @@
+// Package spreadsheet reads the rows of CSV files and Excel (XLSX) workbooks
+package spreadsheet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported file format, must be .csv or .xlsx")
+
+// Row is a non-empty row of a sheet. Number is the 1-based row number a
+// spreadsheet application shows for it.
+type Row struct {
+	Number int
+	Cells  []string
+}
+
+// Read reads the rows of data, choosing the format from the extension of filename
+func Read(filename string, data []byte) ([]Row, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return ReadCSV(bytes.NewReader(data))
+	case ".xlsx":
+		return ReadXLSX(bytes.NewReader(data), int64(len(data)))
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// ReadCSV reads the rows of a comma separated file. A leading byte order
+// mark, as written by Excel, is ignored.
+func ReadCSV(r io.Reader) ([]Row, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var rows []Row
+	for number := 1; ; number++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV: %w", err)
+		}
+		if number == 1 && len(record) > 0 {
+			record[0] = strings.TrimPrefix(record[0], "\ufeff")
+		}
+		if isBlank(record) {
+			continue
+		}
+		rows = append(rows, Row{Number: number, Cells: record})
+	}
+}
+
+// isBlank reports whether every cell of a row is empty
+func isBlank(cells []string) bool {
+	for _, cell := range cells {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,80 @@
+package spreadsheet_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zenkriztao/ayo-football-backend/pkg/spreadsheet"
+)
+
+func TestReadCSV(t *testing.T) {
+	data := "\ufeffname,city\n\nPersija, Jakarta\n\"Persib\nBandung\",Bandung\n,\n"
+	rows, err := spreadsheet.Read("teams.CSV", []byte(data))
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	want := []spreadsheet.Row{
+		{Number: 1, Cells: []string{"name", "city"}},
+		{Number: 2, Cells: []string{"Persija", "Jakarta"}},
+		{Number: 3, Cells: []string{"Persib\nBandung", "Bandung"}},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Fatalf("expected %q, got %q", want, rows)
+	}
+
+	if _, err := spreadsheet.Read("teams.xls", []byte(data)); !errors.Is(err, spreadsheet.ErrUnsupportedFormat) {
+		t.Fatalf("expected ErrUnsupportedFormat, got %v", err)
+	}
+}
+
+func TestReadXLSX(t *testing.T) {
+	parts := map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+			xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+			<sheets><sheet name="Roster" sheetId="1" r:id="rId3"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+			<Relationship Id="rId1" Target="styles.xml"/>
+			<Relationship Id="rId3" Target="worksheets/roster.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<sst><si><t>name</t></si><si><t>height</t></si>
+			<si><r><t>Marko </t></r><r><t>Simic</t></r></si></sst>`,
+		"xl/worksheets/roster.xml": `<worksheet><sheetData>
+			<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="D1" t="inlineStr"><is><t>captain</t></is></c></row>
+			<row r="2"/>
+			<row r="4"><c r="A4" t="s"><v>2</v></c><c r="B4"><v>182.30000000000001</v></c><c r="D4" t="b"><v>1</v></c></row>
+		</sheetData></worksheet>`,
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for name, content := range parts {
+		w, err := archive.Create(name)
+		if err != nil {
+			t.Fatalf("create %s: %v", name, err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	rows, err := spreadsheet.Read("roster.xlsx", buf.Bytes())
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	want := []spreadsheet.Row{
+		{Number: 1, Cells: []string{"name", "height", "", "captain"}},
+		{Number: 4, Cells: []string{"Marko Simic", "182.3", "", "TRUE"}},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Fatalf("expected %q, got %q", want, rows)
+	}
+
+	if _, err := spreadsheet.Read("roster.xlsx", []byte("not a zip")); err == nil {
+		t.Fatal("expected an error for a corrupt workbook")
+	}
+}
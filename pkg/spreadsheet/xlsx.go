@@ -0,0 +1,225 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// maxPartSize caps the uncompressed size of a workbook part, so a small
+// upload cannot expand into an unbounded amount of memory
+const maxPartSize = 32 << 20
+
+// defaultSheetPath is where spreadsheet applications store the first sheet
+const defaultSheetPath = "xl/worksheets/sheet1.xml"
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RelationshipID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+// xlsxText is a plain or rich text string; rich text is split into runs
+type xlsxText struct {
+	T    string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.T
+	}
+	var b strings.Builder
+	for _, run := range t.Runs {
+		b.WriteString(run.T)
+	}
+	return b.String()
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+type xlsxSheet struct {
+	Rows []struct {
+		Number int `xml:"r,attr"`
+		Cells  []struct {
+			Ref    string    `xml:"r,attr"`
+			Type   string    `xml:"t,attr"`
+			Value  string    `xml:"v"`
+			Inline *xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// ReadXLSX reads the rows of the first worksheet of an Excel workbook.
+// Cells are returned as text; formulas yield their cached result.
+func ReadXLSX(r io.ReaderAt, size int64) ([]Row, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid XLSX: %w", err)
+	}
+	parts := make(map[string]*zip.File, len(archive.File))
+	for _, file := range archive.File {
+		parts[file.Name] = file
+	}
+
+	sheetPath, err := firstSheetPath(parts)
+	if err != nil {
+		return nil, err
+	}
+	sheetFile, ok := parts[sheetPath]
+	if !ok {
+		return nil, fmt.Errorf("invalid XLSX: worksheet %s not found", sheetPath)
+	}
+
+	var shared xlsxSharedStrings
+	if file, ok := parts["xl/sharedStrings.xml"]; ok {
+		if err := decodePart(file, &shared); err != nil {
+			return nil, err
+		}
+	}
+
+	var sheet xlsxSheet
+	if err := decodePart(sheetFile, &sheet); err != nil {
+		return nil, err
+	}
+
+	var rows []Row
+	for i, row := range sheet.Rows {
+		number := row.Number
+		if number == 0 {
+			number = i + 1
+		}
+
+		var cells []string
+		for j, cell := range row.Cells {
+			column := j
+			if cell.Ref != "" {
+				if column, err = columnIndex(cell.Ref); err != nil {
+					return nil, err
+				}
+			}
+			for len(cells) <= column {
+				cells = append(cells, "")
+			}
+			if cells[column], err = cellText(cell.Type, cell.Value, cell.Inline, shared.Items); err != nil {
+				return nil, fmt.Errorf("invalid XLSX: cell %s: %w", cell.Ref, err)
+			}
+		}
+
+		if !isBlank(cells) {
+			rows = append(rows, Row{Number: number, Cells: cells})
+		}
+	}
+	return rows, nil
+}
+
+// firstSheetPath resolves the part holding the first sheet of the workbook
+func firstSheetPath(parts map[string]*zip.File) (string, error) {
+	workbookFile, ok := parts["xl/workbook.xml"]
+	if !ok {
+		return "", fmt.Errorf("invalid XLSX: workbook not found")
+	}
+	var workbook xlsxWorkbook
+	if err := decodePart(workbookFile, &workbook); err != nil {
+		return "", err
+	}
+	if len(workbook.Sheets) == 0 {
+		return "", fmt.Errorf("invalid XLSX: workbook has no sheets")
+	}
+
+	relsFile, ok := parts["xl/_rels/workbook.xml.rels"]
+	if !ok {
+		return defaultSheetPath, nil
+	}
+	var rels xlsxRelationships
+	if err := decodePart(relsFile, &rels); err != nil {
+		return "", err
+	}
+	for _, rel := range rels.Relationships {
+		if rel.ID != workbook.Sheets[0].RelationshipID {
+			continue
+		}
+		// Targets are relative to xl/ unless they start at the package root
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+	return defaultSheetPath, nil
+}
+
+// decodePart unmarshals the XML document stored in file into v
+func decodePart(file *zip.File, v interface{}) error {
+	reader, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("invalid XLSX: %s: %w", file.Name, err)
+	}
+	defer reader.Close()
+
+	if err := xml.NewDecoder(io.LimitReader(reader, maxPartSize)).Decode(v); err != nil {
+		return fmt.Errorf("invalid XLSX: %s: %w", file.Name, err)
+	}
+	return nil
+}
+
+// columnIndex returns the 0-based column of a cell reference such as "AB12"
+func columnIndex(ref string) (int, error) {
+	column := 0
+	letters := 0
+	for _, ch := range strings.ToUpper(ref) {
+		if ch < 'A' || ch > 'Z' {
+			break
+		}
+		column = column*26 + int(ch-'A'+1)
+		letters++
+	}
+	if letters == 0 || letters > 3 {
+		return 0, fmt.Errorf("invalid XLSX: invalid cell reference %q", ref)
+	}
+	return column - 1, nil
+}
+
+// cellText converts the stored value of a cell to the text a user sees
+func cellText(cellType, value string, inline *xlsxText, shared []xlsxText) (string, error) {
+	switch cellType {
+	case "s":
+		index, err := strconv.Atoi(value)
+		if err != nil || index < 0 || index >= len(shared) {
+			return "", fmt.Errorf("invalid shared string %q", value)
+		}
+		return shared[index].String(), nil
+	case "inlineStr":
+		if inline == nil {
+			return "", nil
+		}
+		return inline.String(), nil
+	case "b":
+		if value == "1" {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	case "", "n":
+		// Numbers are stored as binary floats, e.g. 182.30000000000001
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			return strconv.FormatFloat(number, 'f', -1, 64), nil
+		}
+		return value, nil
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,162 @@
+// Package textsearch matches free text against names the way a person types
+// them: ignoring case and accents, accepting the start of a word, and
+// tolerating a typo or two. Databases without trigram search rank their rows
+// with it, so every driver returns roughly the same results.
+package textsearch
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Hit is an item matching a search with its relevance, from just above 0 to
+// 1 for an exact match. Scores only compare hits of the same search.
+type Hit[T any] struct {
+	Item  T
+	Score float64
+}
+
+// letters maps the letters NFD does not decompose to their base letters
+var letters = strings.NewReplacer("ø", "o", "ß", "ss", "æ", "ae", "œ", "oe", "đ", "d", "ł", "l", "ı", "i")
+
+// Fold lower-cases s, strips its accents and collapses everything but
+// letters and digits to single spaces, so "São  Paulo-FC" becomes
+// "sao paulo fc"
+func Fold(s string) string {
+	stripped, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), strings.ToLower(s))
+	if err != nil {
+		stripped = strings.ToLower(s)
+	}
+	return strings.Join(strings.FieldsFunc(letters.Replace(stripped), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+// Score returns how well text matches query, 0 if it does not. Every word of
+// the query has to match a word of the text: exactly, as its start, inside
+// it, or within the typos allowed for its length. The score is the average
+// of the word matches.
+func Score(query, text string) float64 {
+	queryWords := strings.Fields(Fold(query))
+	if len(queryWords) == 0 {
+		return 0
+	}
+	textWords := strings.Fields(Fold(text))
+
+	total := 0.0
+	for _, q := range queryWords {
+		best := 0.0
+		for _, w := range textWords {
+			if s := scoreWord([]rune(q), []rune(w)); s > best {
+				best = s
+			}
+		}
+		if best == 0 {
+			return 0
+		}
+		total += best
+	}
+	return total / float64(len(queryWords))
+}
+
+// scoreWord scores a folded query word against a folded text word
+func scoreWord(q, w []rune) float64 {
+	query, word := string(q), string(w)
+	switch {
+	case query == word:
+		return 1
+	case strings.HasPrefix(word, query):
+		return 0.9
+	case len(q) >= 3 && strings.Contains(word, query):
+		return 0.7
+	}
+
+	allowed := typosAllowed(len(q))
+	if allowed == 0 {
+		return 0
+	}
+	if d := distance(q, w); d <= allowed {
+		return 0.8 - 0.15*float64(d-1)
+	}
+	// A word still being typed: compare with the start of the text word
+	if len(w) > len(q) {
+		if d := distance(q, w[:len(q)]); d <= allowed {
+			return 0.6 - 0.15*float64(d-1)
+		}
+	}
+	return 0
+}
+
+// typosAllowed returns how many typos a query word of n letters may contain
+func typosAllowed(n int) int {
+	switch {
+	case n < 4:
+		return 0
+	case n < 8:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// distance returns the number of insertions, deletions, substitutions and
+// swaps of adjacent letters that turn a into b
+func distance(a, b []rune) int {
+	rows := make([][]int, len(a)+1)
+	for i := range rows {
+		rows[i] = make([]int, len(b)+1)
+		rows[i][0] = i
+	}
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			rows[i][j] = min(rows[i-1][j]+1, rows[i][j-1]+1, rows[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				rows[i][j] = min(rows[i][j], rows[i-2][j-2]+1)
+			}
+		}
+	}
+	return rows[len(a)][len(b)]
+}
+
+// Rank scores the text of every item against query and returns up to limit
+// matches, best first. Equal scores keep the order of the folded texts.
+func Rank[T any](items []T, query string, text func(T) string, limit int) []Hit[T] {
+	type scored struct {
+		hit  Hit[T]
+		text string
+	}
+	var matches []scored
+	for _, item := range items {
+		t := text(item)
+		if score := Score(query, t); score > 0 {
+			matches = append(matches, scored{Hit[T]{Item: item, Score: score}, Fold(t)})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].hit.Score != matches[j].hit.Score {
+			return matches[i].hit.Score > matches[j].hit.Score
+		}
+		return matches[i].text < matches[j].text
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	hits := make([]Hit[T], len(matches))
+	for i, m := range matches {
+		hits[i] = m.hit
+	}
+	return hits
+}
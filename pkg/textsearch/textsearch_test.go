@@ -0,0 +1,70 @@
+package textsearch_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zenkriztao/ayo-football-backend/pkg/textsearch"
+)
+
+func TestFold(t *testing.T) {
+	tests := map[string]string{
+		"São  Paulo-FC":      "sao paulo fc",
+		"Estádio Gelora":     "estadio gelora",
+		"Ødegaard":           "odegaard",
+		"  PERSIJA Jakarta ": "persija jakarta",
+		"100%":               "100",
+	}
+	for in, want := range tests {
+		if got := textsearch.Fold(in); got != want {
+			t.Errorf("Fold(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		query, text string
+		match       bool
+	}{
+		{"persija", "Persija Jakarta", true},
+		{"jakarta persija", "Persija Jakarta", true},
+		{"pers", "Persija Jakarta", true},
+		{"sao paulo", "São Paulo", true},
+		{"joão", "Joao Pedro", true},
+		{"persja", "Persija Jakarta", true},
+		{"persjia", "Persija Jakarta", true},
+		{"bandugn", "Persib Bandung", true},
+		{"sija", "Persija Jakarta", true},
+		{"persija bali", "Persija Jakarta", false},
+		{"arema", "Persija Jakarta", false},
+		{"pas", "Persija Jakarta", false},
+		{"", "Persija Jakarta", false},
+	}
+	for _, tt := range tests {
+		if got := textsearch.Score(tt.query, tt.text); (got > 0) != tt.match {
+			t.Errorf("Score(%q, %q) = %v, want a match: %v", tt.query, tt.text, got, tt.match)
+		}
+	}
+
+	exact := textsearch.Score("persija", "Persija")
+	prefix := textsearch.Score("persi", "Persija")
+	typo := textsearch.Score("persjia", "Persija")
+	if !(exact == 1 && exact > prefix && prefix > typo) {
+		t.Errorf("scores exact %v, prefix %v, typo %v, want them decreasing from 1", exact, prefix, typo)
+	}
+}
+
+func TestRank(t *testing.T) {
+	names := []string{"Persib Bandung", "Persja FC", "Persija Jakarta", "Arema", "Persija", "Persijap Jepara"}
+	hits := textsearch.Rank(names, "persija", func(s string) string { return s }, 3)
+
+	var got []string
+	for _, hit := range hits {
+		got = append(got, hit.Item)
+	}
+	want := []string{"Persija", "Persija Jakarta", "Persijap Jepara"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Rank = %v, want %v", got, want)
+	}
+}
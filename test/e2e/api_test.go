@@ -0,0 +1,1185 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// publicRoutes can be called without a token; every other route requires one
+var publicRoutes = map[string]bool{
+	"GET /health":                                    true,
+	"POST /api/v1/auth/login":                        true,
+	"POST /api/v1/auth/register":                     true,
+	"GET /api/v1/teams":                              true,
+	"GET /api/v1/teams/:id":                          true,
+	"GET /api/v1/teams/:id/fixtures.ics":             true,
+	"GET /api/v1/players":                            true,
+	"GET /api/v1/players/:id":                        true,
+	"GET /api/v1/players/:id/eligibility":            true,
+	"GET /api/v1/matches":                            true,
+	"GET /api/v1/matches/:id":                        true,
+	"GET /api/v1/reports/matches":                    true,
+	"GET /api/v1/reports/matches/:id":                true,
+	"GET /api/v1/reports/top-scorers":                true,
+	"GET /api/v1/seasons/:season/fixtures.ics":       true,
+	"GET /api/v1/venues":                             true,
+	"GET /api/v1/venues/:id":                         true,
+	"GET /api/v1/officials":                          true,
+	"GET /api/v1/officials/:id":                      true,
+	"GET /api/v1/officials/:id/stats":                true,
+	"GET /api/v1/matches/:id/officials":              true,
+	"GET /api/v1/players/:id/contracts":              true,
+	"GET /api/v1/seasons/:season/transfer-windows":   true,
+	"GET /api/v1/seasons/:season/disciplinary-rules": true,
+	"GET /api/v1/reports/suspensions":                true,
+	"GET /api/v1/matches/:id/suspensions":            true,
+	"GET /api/v1/teams/:id/availability":             true,
+	"GET /api/v1/players/:id/availability":           true,
+	"GET /api/v1/media/*key":                         true,
+	"GET /api/v1/search":                             true,
+}
+
+// userRoutes require a token but no admin role
+var userRoutes = map[string]bool{
+	"GET /api/v1/auth/profile":   true,
+	"GET /api/v1/export/:entity": true,
+}
+
+func TestAuthFlow(t *testing.T) {
+	s := newServer(t)
+
+	s.do(http.MethodPost, "/api/v1/auth/register", "", map[string]string{
+		"name":     "Budi",
+		"email":    "budi@example.com",
+		"password": "password123",
+	}).expect(t, http.StatusCreated)
+
+	s.do(http.MethodPost, "/api/v1/auth/register", "", map[string]string{
+		"name":     "Budi Again",
+		"email":    "budi@example.com",
+		"password": "password123",
+	}).expect(t, http.StatusConflict)
+
+	s.do(http.MethodPost, "/api/v1/auth/register", "", map[string]string{
+		"email": "not-an-email",
+	}).expect(t, http.StatusBadRequest)
+
+	s.do(http.MethodPost, "/api/v1/auth/login", "", map[string]string{
+		"email":    "budi@example.com",
+		"password": "wrong-password",
+	}).expect(t, http.StatusUnauthorized)
+
+	token := s.login("budi@example.com", "password123")
+
+	var profile struct {
+		Email string `json:"email"`
+		Role  string `json:"role"`
+	}
+	s.do(http.MethodGet, "/api/v1/auth/profile", token, nil).expect(t, http.StatusOK).decode(t, &profile)
+	if profile.Email != "budi@example.com" || profile.Role != "user" {
+		t.Fatalf("unexpected profile: %+v", profile)
+	}
+
+	s.do(http.MethodGet, "/api/v1/auth/profile", "", nil).expect(t, http.StatusUnauthorized)
+	s.do(http.MethodGet, "/api/v1/auth/profile", "not-a-jwt", nil).expect(t, http.StatusUnauthorized)
+
+	s.do(http.MethodGet, "/api/v1/auth/profile", s.adminToken(), nil).expect(t, http.StatusOK).decode(t, &profile)
+	if profile.Role != "admin" {
+		t.Fatalf("expected the default admin to have the admin role, got %q", profile.Role)
+	}
+}
+
+// TestProtectedRoutes walks every registered route, so a new route is
+// rejected for anonymous and regular users unless it is listed as public
+func TestProtectedRoutes(t *testing.T) {
+	s := newServer(t)
+	userToken := s.userToken()
+
+	for _, route := range s.engine.Routes() {
+		key := route.Method + " " + route.Path
+		if publicRoutes[key] {
+			continue
+		}
+		path := strings.NewReplacer(":id", "00000000-0000-0000-0000-000000000001", ":entity", "teams").Replace(route.Path)
+
+		t.Run(key, func(t *testing.T) {
+			s.do(route.Method, path, "", nil).expect(t, http.StatusUnauthorized)
+			if !userRoutes[key] {
+				s.do(route.Method, path, userToken, nil).expect(t, http.StatusForbidden)
+			}
+		})
+	}
+}
+
+func TestPaginationMeta(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+	for i := 1; i <= 15; i++ {
+		s.createTeam(token, fmt.Sprintf("Team %02d", i))
+	}
+
+	tests := []struct {
+		query     string
+		page      int
+		perPage   int
+		items     int
+		totalPage int64
+	}{
+		{"", 1, 10, 10, 2},
+		{"?page=2&limit=10", 2, 10, 5, 2},
+		{"?page=3&limit=10", 3, 10, 0, 2},
+		{"?page=1&limit=4", 1, 4, 4, 4},
+		{"?page=0&limit=500", 1, 10, 10, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			res := s.do(http.MethodGet, "/api/v1/teams"+tt.query, "", nil).expect(t, http.StatusOK)
+
+			var teams []struct {
+				ID string `json:"id"`
+			}
+			res.decode(t, &teams)
+			meta := res.Body.Meta
+			if meta == nil {
+				t.Fatalf("expected pagination meta: %s", res.Raw)
+			}
+			if meta.CurrentPage != tt.page || meta.PerPage != tt.perPage || meta.TotalItems != 15 || meta.TotalPages != tt.totalPage {
+				t.Fatalf("unexpected meta: %+v", *meta)
+			}
+			if len(teams) != tt.items {
+				t.Fatalf("expected %d teams, got %d", tt.items, len(teams))
+			}
+		})
+	}
+
+	var teams []struct {
+		Name string `json:"name"`
+	}
+	res := s.do(http.MethodGet, "/api/v1/teams?search=team%200", "", nil).expect(t, http.StatusOK)
+	res.decode(t, &teams)
+	if res.Body.Meta.TotalItems != 9 || len(teams) != 9 {
+		t.Fatalf("expected 9 teams matching the search, got %d", res.Body.Meta.TotalItems)
+	}
+}
+
+func TestCursorPagination(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+	for i := 1; i <= 9; i++ {
+		s.createTeam(token, fmt.Sprintf("Team %02d", i))
+	}
+
+	type page struct {
+		names      []string
+		next, prev string
+	}
+	get := func(query string) (page, result) {
+		t.Helper()
+		var teams []struct {
+			Name string `json:"name"`
+		}
+		res := s.do(http.MethodGet, "/api/v1/teams?sort=name&limit=4&"+query, "", nil).expect(t, http.StatusOK)
+		res.decode(t, &teams)
+		p := page{next: res.Body.Meta.NextCursor, prev: res.Body.Meta.PrevCursor}
+		for _, team := range teams {
+			p.names = append(p.names, team.Name)
+		}
+		return p, res
+	}
+
+	first, res := get("pagination=cursor&total=true")
+	if strings.Join(first.names, ",") != "Team 01,Team 02,Team 03,Team 04" || first.next == "" || first.prev != "" {
+		t.Fatalf("unexpected first page: %+v", first)
+	}
+	if meta := res.Body.Meta; meta.TotalItems != 9 || meta.CurrentPage != 0 || meta.PerPage != 4 {
+		t.Fatalf("expected the total and no page number: %s", res.Raw)
+	}
+
+	// A team added before the cursor does not shift the next page, and the
+	// total is left out unless asked for
+	s.createTeam(token, "Team 00")
+	second, res := get("cursor=" + first.next)
+	if strings.Join(second.names, ",") != "Team 05,Team 06,Team 07,Team 08" || second.prev == "" {
+		t.Fatalf("unexpected second page: %+v", second)
+	}
+	if strings.Contains(string(res.Raw), "total_items") {
+		t.Fatalf("expected no total: %s", res.Raw)
+	}
+	last, _ := get("cursor=" + second.next)
+	if strings.Join(last.names, ",") != "Team 09" || last.next != "" {
+		t.Fatalf("unexpected last page: %+v", last)
+	}
+	back, _ := get("cursor=" + last.prev)
+	if strings.Join(back.names, ",") != "Team 05,Team 06,Team 07,Team 08" || back.next == "" {
+		t.Fatalf("unexpected page before the last: %+v", back)
+	}
+	back, _ = get("cursor=" + back.prev)
+	if strings.Join(back.names, ",") != "Team 01,Team 02,Team 03,Team 04" || back.prev == "" {
+		t.Fatalf("unexpected page before: %+v", back)
+	}
+	back, _ = get("cursor=" + back.prev)
+	if strings.Join(back.names, ",") != "Team 00" || back.prev != "" || back.next == "" {
+		t.Fatalf("expected the new team first: %+v", back)
+	}
+
+	// Other lists page the same way
+	var matches []struct {
+		ID string `json:"id"`
+	}
+	res = s.do(http.MethodGet, "/api/v1/matches?pagination=cursor", "", nil).expect(t, http.StatusOK)
+	res.decode(t, &matches)
+	if len(matches) != 0 || res.Body.Meta.NextCursor != "" {
+		t.Fatalf("expected no matches: %s", res.Raw)
+	}
+
+	invalid := []string{
+		"/api/v1/teams?pagination=offset",
+		"/api/v1/teams?cursor=not-a-cursor",
+		"/api/v1/teams?sort=-name&cursor=" + first.next,
+		"/api/v1/teams?sort=name&page=2&cursor=" + first.next,
+		"/api/v1/players?cursor=" + first.next,
+		"/api/v1/officials?pagination=cursor&available_for=" + uuid.NewString(),
+	}
+	for _, path := range invalid {
+		s.do(http.MethodGet, path, "", nil).expect(t, http.StatusBadRequest)
+	}
+}
+
+func TestListFiltersAndSorting(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+	persija := s.createTeam(token, "Persija")
+	arema := s.createTeam(token, "Arema")
+	var persib struct {
+		ID string `json:"id"`
+	}
+	s.do(http.MethodPost, "/api/v1/teams", token, map[string]interface{}{
+		"name":         "Persib",
+		"founded_year": 1933,
+		"city":         "Bandung",
+	}).expect(t, http.StatusCreated).decode(t, &persib)
+
+	var teams []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	s.do(http.MethodGet, "/api/v1/teams?city=Bandung&founded_year[lte]=1950", "", nil).expect(t, http.StatusOK).decode(t, &teams)
+	if len(teams) != 1 || teams[0].ID != persib.ID {
+		t.Fatalf("expected Persib, got %+v", teams)
+	}
+	s.do(http.MethodGet, "/api/v1/teams?sort=founded_year,-name", "", nil).expect(t, http.StatusOK).decode(t, &teams)
+	if len(teams) != 3 || teams[0].Name != "Persib" || teams[1].Name != "Persija" || teams[2].Name != "Arema" {
+		t.Fatalf("expected teams by founding year, then name descending, got %+v", teams)
+	}
+
+	// A team plays once a day, so the second match on 8 March needs another team
+	bali := s.createTeam(token, "Bali United")
+	first := s.createMatch(token, persija, persib.ID, "2025-03-01")
+	second := s.createMatch(token, arema, persija, "2025-03-08")
+	third := s.createMatch(token, persib.ID, bali, "2025-03-08")
+	s.recordResult(token, first, 1, 0)
+	s.recordResult(token, second, 2, 2)
+
+	var matches []struct {
+		ID string `json:"id"`
+	}
+	s.do(http.MethodGet, "/api/v1/matches?status=completed&team_id="+persija+"&start_date=2025-03-02", "", nil).
+		expect(t, http.StatusOK).decode(t, &matches)
+	if len(matches) != 1 || matches[0].ID != second {
+		t.Fatalf("expected Persija's completed match after 2 March, got %+v", matches)
+	}
+	s.do(http.MethodGet, "/api/v1/matches?sort=-match_date,home_team.name", "", nil).expect(t, http.StatusOK).decode(t, &matches)
+	if len(matches) != 3 || matches[0].ID != second || matches[1].ID != third || matches[2].ID != first {
+		t.Fatalf("expected matches by date descending, then home team, got %+v", matches)
+	}
+	s.do(http.MethodGet, "/api/v1/matches?team_id="+uuid.NewString(), "", nil).expect(t, http.StatusNotFound)
+
+	s.createPlayer(token, persija, "Marko Simic", 9)
+	for _, p := range []map[string]interface{}{
+		{"name": "Rizky Ridho", "height": 183, "jersey_number": 5, "position": "defender"},
+		{"name": "Jordi Amat", "height": 188, "jersey_number": 4, "position": "defender"},
+		{"name": "Hansamu Yama", "height": 176, "jersey_number": 3, "position": "defender"},
+	} {
+		p["team_id"], p["weight"] = persija, 75
+		s.do(http.MethodPost, "/api/v1/players", token, p).expect(t, http.StatusCreated)
+	}
+	var players []struct {
+		Name string `json:"name"`
+	}
+	s.do(http.MethodGet, "/api/v1/players?position=defender&height[gte]=180&sort=-height", "", nil).
+		expect(t, http.StatusOK).decode(t, &players)
+	if len(players) != 2 || players[0].Name != "Jordi Amat" || players[1].Name != "Rizky Ridho" {
+		t.Fatalf("expected the tall defenders, tallest first, got %+v", players)
+	}
+	s.do(http.MethodGet, "/api/v1/players?jersey_number[in]=3,9&sort=name", "", nil).expect(t, http.StatusOK).decode(t, &players)
+	if len(players) != 2 || players[0].Name != "Hansamu Yama" || players[1].Name != "Marko Simic" {
+		t.Fatalf("expected the players wearing 3 and 9, got %+v", players)
+	}
+
+	invalid := []string{
+		"/api/v1/teams?sort=capacity",
+		"/api/v1/teams?sort=name,-name",
+		"/api/v1/teams?founded_year[between]=1900",
+		"/api/v1/teams?founded_year=old",
+		"/api/v1/matches?status=postponed",
+		"/api/v1/players?height[contains]=18",
+		"/api/v1/venues?capacity[gt]=many",
+		"/api/v1/officials?license_level=Z",
+	}
+	for _, path := range invalid {
+		s.do(http.MethodGet, path, "", nil).expect(t, http.StatusBadRequest)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+	persija := s.createTeam(token, "Persija Jakarta")
+	s.createTeam(token, "São Paulo FC")
+	s.createPlayer(token, persija, "João Pedro", 10)
+	s.createPlayer(token, persija, "Marko Šimić", 9)
+	s.do(http.MethodPost, "/api/v1/venues", token, map[string]interface{}{
+		"name":     "Gelora Bung Karno",
+		"city":     "Jakarta",
+		"capacity": 77193,
+	}).expect(t, http.StatusCreated)
+
+	type hit struct {
+		ID    string  `json:"id"`
+		Name  string  `json:"name"`
+		Score float64 `json:"score"`
+	}
+	var results struct {
+		Query   string `json:"query"`
+		Teams   []hit  `json:"teams"`
+		Players []hit  `json:"players"`
+		Venues  []hit  `json:"venues"`
+	}
+	s.do(http.MethodGet, "/api/v1/search?q=persija+jakarta", "", nil).expect(t, http.StatusOK).decode(t, &results)
+	if results.Query != "persija jakarta" || len(results.Teams) != 1 || results.Teams[0].ID != persija || len(results.Venues) != 0 || len(results.Players) != 0 {
+		t.Fatalf("expected only Persija, got %+v", results)
+	}
+	// Teams match by city too, and createTeam places every team in Jakarta
+	s.do(http.MethodGet, "/api/v1/search?q=jakarta", "", nil).expect(t, http.StatusOK).decode(t, &results)
+	if len(results.Teams) != 2 || len(results.Venues) != 1 || results.Venues[0].Name != "Gelora Bung Karno" {
+		t.Fatalf("expected both teams and the venue in Jakarta, got %+v", results)
+	}
+
+	// Accents are folded and a typo is tolerated
+	s.do(http.MethodGet, "/api/v1/search?q=Joao+Pedor", "", nil).expect(t, http.StatusOK).decode(t, &results)
+	if len(results.Players) != 1 || results.Players[0].Name != "João Pedro" || results.Players[0].Score <= 0 {
+		t.Fatalf("expected João Pedro, got %+v", results.Players)
+	}
+	s.do(http.MethodGet, "/api/v1/search?q=sao+paulo&type=team,venue", "", nil).expect(t, http.StatusOK).decode(t, &results)
+	if len(results.Teams) != 1 || results.Teams[0].Name != "São Paulo FC" || results.Players == nil || len(results.Players) != 0 {
+		t.Fatalf("expected São Paulo FC and an empty player group, got %+v", results)
+	}
+	s.do(http.MethodGet, "/api/v1/search?q=simic&type=player&limit=1", "", nil).expect(t, http.StatusOK).decode(t, &results)
+	if len(results.Players) != 1 || results.Players[0].Name != "Marko Šimić" {
+		t.Fatalf("expected Marko Šimić, got %+v", results.Players)
+	}
+
+	for _, path := range []string{"/api/v1/search", "/api/v1/search?q=+", "/api/v1/search?q=a", "/api/v1/search?q=persija&type=match"} {
+		s.do(http.MethodGet, path, "", nil).expect(t, http.StatusBadRequest)
+	}
+}
+
+func TestIncludeAndFields(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+	home := s.createTeam(token, "Persija")
+	away := s.createTeam(token, "Persib")
+	striker := s.createPlayer(token, home, "Striker", 9)
+	s.createPlayer(token, home, "Keeper", 1)
+	match := s.createMatch(token, home, away, "2025-03-01")
+	s.recordResult(token, match, 1, 0, goal{PlayerID: striker, TeamID: home, Minute: 30})
+
+	keys := func(v map[string]interface{}) map[string]bool {
+		set := make(map[string]bool, len(v))
+		for key := range v {
+			set[key] = true
+		}
+		return set
+	}
+
+	// Without include, an endpoint embeds what it always did
+	var detail map[string]interface{}
+	s.do(http.MethodGet, "/api/v1/matches/"+match, "", nil).expect(t, http.StatusOK).decode(t, &detail)
+	if got := keys(detail); !got["home_team"] || !got["away_team"] || !got["goals"] {
+		t.Fatalf("expected the match with its teams and goals, got %v", detail)
+	}
+
+	// Only the included relations are embedded, and fields trims the rest
+	detail = nil
+	s.do(http.MethodGet, "/api/v1/matches/"+match+"?include=goals.player&fields=id,home_score,goals.minute,goals.player_name", "", nil).
+		expect(t, http.StatusOK).decode(t, &detail)
+	goals, _ := detail["goals"].([]interface{})
+	if got := keys(detail); len(got) != 3 || !got["id"] || !got["home_score"] || len(goals) != 1 {
+		t.Fatalf("expected id, home_score and goals only, got %v", detail)
+	}
+	if scorer, _ := goals[0].(map[string]interface{}); len(scorer) != 2 || scorer["player_name"] != "Striker" || scorer["minute"] != 30.0 {
+		t.Fatalf("expected the minute and scorer of the goal, got %v", goals[0])
+	}
+	var list []map[string]interface{}
+	s.do(http.MethodGet, "/api/v1/matches?include=home_team&fields=id", "", nil).expect(t, http.StatusOK).decode(t, &list)
+	if len(list) != 1 || len(list[0]) != 2 || list[0]["home_team"] == nil {
+		t.Fatalf("expected the id and the included home team, got %v", list)
+	}
+
+	// A team embeds its players when asked, by include or the older with_players
+	var team struct {
+		HomeVenue interface{} `json:"home_venue"`
+		Players   []struct {
+			JerseyNumber int `json:"jersey_number"`
+		} `json:"players"`
+	}
+	for _, query := range []string{"?include=players", "?with_players=true"} {
+		s.do(http.MethodGet, "/api/v1/teams/"+home+query, "", nil).expect(t, http.StatusOK).decode(t, &team)
+		if len(team.Players) != 2 || team.Players[0].JerseyNumber != 1 {
+			t.Fatalf("%s: expected both players by jersey number, got %+v", query, team)
+		}
+	}
+	list = nil
+	s.do(http.MethodGet, "/api/v1/players?team_id="+home+"&include=&fields=name", "", nil).expect(t, http.StatusOK).decode(t, &list)
+	if len(list) != 2 || len(list[0]) != 1 || list[0]["name"] != "Keeper" {
+		t.Fatalf("expected player names only, got %v", list)
+	}
+	var player map[string]interface{}
+	s.do(http.MethodGet, "/api/v1/players/"+striker+"?fields=name,team.name", "", nil).expect(t, http.StatusOK).decode(t, &player)
+	if team, _ := player["team"].(map[string]interface{}); len(player) != 2 || len(team) != 1 || team["name"] != "Persija" {
+		t.Fatalf("expected the name and team name of the player, got %v", player)
+	}
+
+	for _, path := range []string{
+		"/api/v1/matches?include=referee",
+		"/api/v1/matches/" + match + "?include=goals.minute",
+		"/api/v1/teams?fields=nam",
+		"/api/v1/teams/" + home + "?fields=home_venue.address",
+		"/api/v1/venues?fields=id,capacity.max",
+		"/api/v1/players?team_id=" + home + "&as_of=2025-01-01&include=team",
+	} {
+		s.do(http.MethodGet, path, "", nil).expect(t, http.StatusBadRequest)
+	}
+}
+
+func TestMatchReports(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+
+	home := s.createTeam(token, "Persija")
+	away := s.createTeam(token, "Persib")
+	striker := s.createPlayer(token, home, "Striker", 9)
+	winger := s.createPlayer(token, away, "Winger", 7)
+	defender := s.createPlayer(token, away, "Defender", 4)
+
+	first := s.createMatch(token, home, away, "2025-01-10")
+	second := s.createMatch(token, away, home, "2025-01-17")
+	third := s.createMatch(token, home, away, "2025-01-24")
+	s.createMatch(token, away, home, "2025-01-31")
+
+	s.recordResult(token, first, 3, 1,
+		goal{PlayerID: striker, TeamID: home, Minute: 10},
+		goal{PlayerID: striker, TeamID: home, Minute: 55},
+		goal{PlayerID: defender, TeamID: home, Minute: 70, IsOwnGoal: true},
+		goal{PlayerID: winger, TeamID: away, Minute: 88},
+	)
+	s.recordResult(token, second, 0, 1, goal{PlayerID: striker, TeamID: home, Minute: 30})
+	s.recordResult(token, third, 1, 1,
+		goal{PlayerID: winger, TeamID: away, Minute: 5},
+		goal{PlayerID: striker, TeamID: home, Minute: 90},
+	)
+
+	var report struct {
+		HomeScore          int    `json:"home_score"`
+		AwayScore          int    `json:"away_score"`
+		MatchResult        string `json:"match_result"`
+		MatchResultDisplay string `json:"match_result_display"`
+		HomeTeam           struct {
+			ID string `json:"id"`
+		} `json:"home_team"`
+		Goals []struct {
+			Minute    int  `json:"minute"`
+			IsOwnGoal bool `json:"is_own_goal"`
+		} `json:"goals"`
+		TopScorer *struct {
+			PlayerID  string `json:"player_id"`
+			GoalCount int64  `json:"goal_count"`
+		} `json:"top_scorer"`
+		HomeTeamTotalWins int64 `json:"home_team_total_wins"`
+		AwayTeamTotalWins int64 `json:"away_team_total_wins"`
+	}
+	s.do(http.MethodGet, "/api/v1/reports/matches/"+first, "", nil).expect(t, http.StatusOK).decode(t, &report)
+
+	if report.HomeScore != 3 || report.AwayScore != 1 || report.HomeTeam.ID != home {
+		t.Fatalf("unexpected score or teams: %+v", report)
+	}
+	if report.MatchResult != "home_win" || report.MatchResultDisplay != "Home Team Win" {
+		t.Fatalf("unexpected result: %q / %q", report.MatchResult, report.MatchResultDisplay)
+	}
+	if len(report.Goals) != 4 {
+		t.Fatalf("expected 4 goals, got %d", len(report.Goals))
+	}
+	// Persija won at home and away; the draw counts for neither side
+	if report.HomeTeamTotalWins != 2 || report.AwayTeamTotalWins != 0 {
+		t.Fatalf("expected win totals 2 and 0, got %d and %d", report.HomeTeamTotalWins, report.AwayTeamTotalWins)
+	}
+	// Own goals are not credited to the scorer
+	if report.TopScorer == nil || report.TopScorer.PlayerID != striker || report.TopScorer.GoalCount != 4 {
+		t.Fatalf("expected the striker as top scorer with 4 goals, got %+v", report.TopScorer)
+	}
+
+	var scorers []struct {
+		PlayerID  string `json:"player_id"`
+		GoalCount int64  `json:"goal_count"`
+	}
+	s.do(http.MethodGet, "/api/v1/reports/top-scorers?limit=10", "", nil).expect(t, http.StatusOK).decode(t, &scorers)
+	if len(scorers) != 2 || scorers[0].PlayerID != striker || scorers[1].PlayerID != winger || scorers[1].GoalCount != 2 {
+		t.Fatalf("unexpected top scorers: %+v", scorers)
+	}
+
+	var reports []struct {
+		MatchResult string `json:"match_result"`
+	}
+	res := s.do(http.MethodGet, "/api/v1/reports/matches?page=1&limit=2", "", nil).expect(t, http.StatusOK)
+	res.decode(t, &reports)
+	if res.Body.Meta == nil || res.Body.Meta.TotalItems != 3 || res.Body.Meta.TotalPages != 2 || len(reports) != 2 {
+		t.Fatalf("expected page 1 of the 3 completed matches, got %s", res.Raw)
+	}
+
+	s.do(http.MethodGet, "/api/v1/reports/matches/00000000-0000-0000-0000-000000000001", "", nil).expect(t, http.StatusNotFound)
+	s.do(http.MethodGet, "/api/v1/reports/matches/not-a-uuid", "", nil).expect(t, http.StatusBadRequest)
+}
+
+func TestBusinessRuleErrors(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+	home := s.createTeam(token, "Arema")
+	away := s.createTeam(token, "Persebaya")
+	s.createPlayer(token, home, "Captain", 10)
+	s.createMatch(token, home, away, "2025-02-01")
+
+	s.do(http.MethodPost, "/api/v1/players", token, map[string]interface{}{
+		"team_id":       home,
+		"name":          "Duplicate",
+		"height":        175,
+		"weight":        70,
+		"position":      "midfielder",
+		"jersey_number": 10,
+	}).expect(t, http.StatusConflict)
+
+	s.do(http.MethodPost, "/api/v1/matches", token, map[string]string{
+		"match_date":   "2025-02-08",
+		"match_time":   "19:30",
+		"home_team_id": home,
+		"away_team_id": home,
+	}).expect(t, http.StatusBadRequest)
+
+	res := s.do(http.MethodDelete, "/api/v1/teams/"+home, token, nil).expect(t, http.StatusConflict)
+	if !strings.Contains(string(res.Body.Error), `"match_count":1`) {
+		t.Fatalf("expected the blocking dependencies in the error, got %s", res.Raw)
+	}
+	s.do(http.MethodDelete, "/api/v1/teams/"+home+"?force=true", token, nil).expect(t, http.StatusOK)
+	s.do(http.MethodGet, "/api/v1/teams/"+home, "", nil).expect(t, http.StatusNotFound)
+
+	s.do(http.MethodPost, "/api/v1/trash/teams/"+home+"/restore", token, nil).expect(t, http.StatusOK)
+	s.do(http.MethodGet, "/api/v1/teams/"+home, "", nil).expect(t, http.StatusOK)
+}
+
+func TestMatchKickoffTimezones(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+	home := s.createTeam(token, "Persija")
+	away := s.createTeam(token, "Persib")
+	// A team plays once a day, so the second match on 1 July needs other teams
+	arema := s.createTeam(token, "Arema")
+	bali := s.createTeam(token, "Bali United")
+
+	type kickoff struct {
+		ID           string `json:"id"`
+		KickoffAt    string `json:"kickoff_at"`
+		KickoffLocal string `json:"kickoff_local"`
+		Timezone     string `json:"timezone"`
+		MatchDate    string `json:"match_date"`
+		MatchTime    string `json:"match_time"`
+	}
+	var london kickoff
+	s.do(http.MethodPost, "/api/v1/matches", token, map[string]string{
+		"match_date":   "2024-07-01",
+		"match_time":   "15:00",
+		"timezone":     "Europe/London",
+		"home_team_id": home,
+		"away_team_id": away,
+	}).expect(t, http.StatusCreated).decode(t, &london)
+	if london.KickoffAt != "2024-07-01T14:00:00Z" || london.KickoffLocal != "2024-07-01T15:00:00+01:00" || london.Timezone != "Europe/London" {
+		t.Fatalf("unexpected kickoff: %+v", london)
+	}
+
+	var jakarta kickoff
+	s.do(http.MethodPost, "/api/v1/matches", token, map[string]string{
+		"kickoff_at":   "2024-07-01T12:30:00Z",
+		"home_team_id": arema,
+		"away_team_id": bali,
+	}).expect(t, http.StatusCreated).decode(t, &jakarta)
+	if jakarta.Timezone != "Asia/Jakarta" || jakarta.MatchDate != "2024-07-01" || jakarta.MatchTime != "19:30" {
+		t.Fatalf("expected the default time zone, got %+v", jakarta)
+	}
+
+	// A new time zone keeps the local wall clock of the kickoff
+	var moved kickoff
+	s.do(http.MethodPut, "/api/v1/matches/"+london.ID, token, map[string]string{"timezone": "Asia/Makassar"}).
+		expect(t, http.StatusOK).decode(t, &moved)
+	if moved.KickoffAt != "2024-07-01T07:00:00Z" || moved.MatchTime != "15:00" {
+		t.Fatalf("expected 15:00 Makassar time, got %+v", moved)
+	}
+
+	invalid := []map[string]string{
+		{"match_date": "2024-07-01", "match_time": "25:00"},
+		{"match_date": "2024-07-01", "match_time": "7pm"},
+		{"match_date": "2024-07-01"},
+		{"match_date": "2024-07-01", "match_time": "15:00", "timezone": "Mars/Olympus"},
+		{"match_date": "2024-07-01", "match_time": "15:00", "kickoff_at": "2024-07-01T14:00:00Z"},
+		{"kickoff_at": "2024-07-01 14:00"},
+	}
+	for _, body := range invalid {
+		body["home_team_id"], body["away_team_id"] = home, away
+		s.do(http.MethodPost, "/api/v1/matches", token, body).expect(t, http.StatusBadRequest)
+	}
+
+	// Date filters are whole days in the requested time zone
+	var matches []kickoff
+	s.do(http.MethodGet, "/api/v1/matches?start_date=2024-07-01&end_date=2024-07-01", "", nil).
+		expect(t, http.StatusOK).decode(t, &matches)
+	if len(matches) != 2 {
+		t.Fatalf("expected both matches on 1 July in Jakarta, got %d", len(matches))
+	}
+	s.do(http.MethodGet, "/api/v1/matches?start_date=2024-07-01&end_date=2024-07-01&timezone=Pacific/Honolulu", "", nil).
+		expect(t, http.StatusOK).decode(t, &matches)
+	if len(matches) != 1 || matches[0].ID != jakarta.ID {
+		t.Fatalf("expected only the later match on 1 July in Honolulu, got %+v", matches)
+	}
+	s.do(http.MethodGet, "/api/v1/matches?start_date=2024-07-01&end_date=2024-07-01&timezone=Nowhere", "", nil).
+		expect(t, http.StatusBadRequest)
+}
+
+func TestVenueScheduling(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+
+	var venue struct {
+		ID       string `json:"id"`
+		Capacity int    `json:"capacity"`
+	}
+	s.do(http.MethodPost, "/api/v1/venues", token, map[string]interface{}{
+		"name":     "Gelora Bung Karno",
+		"city":     "Jakarta",
+		"capacity": 77193,
+		"latitude": -6.218335,
+	}).expect(t, http.StatusBadRequest)
+	s.do(http.MethodPost, "/api/v1/venues", token, map[string]interface{}{
+		"name":      "Gelora Bung Karno",
+		"city":      "Jakarta",
+		"capacity":  77193,
+		"latitude":  -6.218335,
+		"longitude": 106.802216,
+	}).expect(t, http.StatusCreated).decode(t, &venue)
+	s.do(http.MethodPut, "/api/v1/venues/"+venue.ID, token, map[string]int{"capacity": 78000}).
+		expect(t, http.StatusOK).decode(t, &venue)
+	if venue.Capacity != 78000 {
+		t.Fatalf("expected the new capacity, got %d", venue.Capacity)
+	}
+
+	home := s.createTeam(token, "Persija")
+	away := s.createTeam(token, "Persib")
+	other := s.createTeam(token, "Arema")
+	s.do(http.MethodPut, "/api/v1/teams/"+home, token, map[string]string{"home_venue_id": uuid.NewString()}).
+		expect(t, http.StatusNotFound)
+	s.do(http.MethodPut, "/api/v1/teams/"+home, token, map[string]string{"home_venue_id": venue.ID}).
+		expect(t, http.StatusOK)
+
+	var match struct {
+		ID      string  `json:"id"`
+		VenueID *string `json:"venue_id"`
+	}
+	s.do(http.MethodPost, "/api/v1/matches", token, map[string]string{
+		"match_date":   "2025-03-01",
+		"match_time":   "19:30",
+		"home_team_id": home,
+		"away_team_id": away,
+	}).expect(t, http.StatusCreated).decode(t, &match)
+	if match.VenueID == nil || *match.VenueID != venue.ID {
+		t.Fatalf("expected the home venue of the home team, got %v", match.VenueID)
+	}
+
+	res := s.do(http.MethodPost, "/api/v1/matches", token, map[string]string{
+		"match_date":   "2025-03-01",
+		"match_time":   "16:00",
+		"home_team_id": other,
+		"away_team_id": away,
+	}).expect(t, http.StatusConflict)
+	var conflict struct {
+		Conflict string `json:"conflict"`
+		Match    struct {
+			ID string `json:"id"`
+		} `json:"match"`
+	}
+	if err := json.Unmarshal(res.Body.Error, &conflict); err != nil || conflict.Conflict != "team_busy" || conflict.Match.ID != match.ID {
+		t.Fatalf("expected the match of Persib that day, got %s", res.Raw)
+	}
+
+	res = s.do(http.MethodPost, "/api/v1/matches", token, map[string]string{
+		"match_date":   "2025-03-01",
+		"match_time":   "21:00",
+		"home_team_id": other,
+		"away_team_id": s.createTeam(token, "Bali United"),
+		"venue_id":     venue.ID,
+	}).expect(t, http.StatusConflict)
+	if err := json.Unmarshal(res.Body.Error, &conflict); err != nil || conflict.Conflict != "venue_booked" || conflict.Match.ID != match.ID {
+		t.Fatalf("expected the venue to be booked, got %s", res.Raw)
+	}
+
+	s.do(http.MethodDelete, "/api/v1/venues/"+venue.ID, token, nil).expect(t, http.StatusOK)
+	s.do(http.MethodGet, "/api/v1/venues/"+venue.ID, "", nil).expect(t, http.StatusNotFound)
+}
+
+func TestMatchOfficials(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+
+	var referee, assistant struct {
+		ID string `json:"id"`
+	}
+	s.do(http.MethodPost, "/api/v1/officials", token, map[string]string{
+		"name":          "Thoriq Alkatiri",
+		"license_level": "continental",
+	}).expect(t, http.StatusBadRequest)
+	s.do(http.MethodPost, "/api/v1/officials", token, map[string]string{
+		"name":          "Thoriq Alkatiri",
+		"license_level": "fifa",
+		"city":          "Jakarta",
+	}).expect(t, http.StatusCreated).decode(t, &referee)
+	s.do(http.MethodPost, "/api/v1/officials", token, map[string]string{
+		"name":          "Yudi Nurcahya",
+		"license_level": "national",
+	}).expect(t, http.StatusCreated).decode(t, &assistant)
+
+	home := s.createTeam(token, "Persija")
+	away := s.createTeam(token, "Persib")
+	player := s.createPlayer(token, home, "Rizky Ridho", 5)
+	match := s.createMatch(token, home, away, "2025-03-01")
+
+	s.do(http.MethodPut, "/api/v1/matches/"+match+"/officials", token, map[string]interface{}{
+		"officials": []map[string]string{
+			{"official_id": referee.ID, "role": "referee"},
+			{"official_id": assistant.ID, "role": "referee"},
+		},
+	}).expect(t, http.StatusBadRequest)
+	s.do(http.MethodPut, "/api/v1/matches/"+match+"/officials", token, map[string]interface{}{
+		"officials": []map[string]string{{"official_id": uuid.NewString(), "role": "referee"}},
+	}).expect(t, http.StatusNotFound)
+	s.do(http.MethodPut, "/api/v1/matches/"+match+"/officials", token, map[string]interface{}{
+		"officials": []map[string]string{
+			{"official_id": referee.ID, "role": "referee"},
+			{"official_id": assistant.ID, "role": "assistant_1"},
+		},
+	}).expect(t, http.StatusOK)
+
+	var appointed []struct {
+		OfficialID string `json:"official_id"`
+		Role       string `json:"role"`
+	}
+	s.do(http.MethodGet, "/api/v1/matches/"+match+"/officials", "", nil).expect(t, http.StatusOK).decode(t, &appointed)
+	if len(appointed) != 2 {
+		t.Fatalf("expected 2 appointed officials, got %d", len(appointed))
+	}
+
+	// The same evening at another stadium clashes with the referee's appointment
+	other := s.do(http.MethodPost, "/api/v1/matches", token, map[string]string{
+		"match_date":   "2025-03-01",
+		"match_time":   "20:30",
+		"home_team_id": s.createTeam(token, "Arema"),
+		"away_team_id": s.createTeam(token, "Bali United"),
+	}).expect(t, http.StatusCreated)
+	var otherMatch struct {
+		ID string `json:"id"`
+	}
+	other.decode(t, &otherMatch)
+
+	var available []struct {
+		ID string `json:"id"`
+	}
+	s.do(http.MethodGet, "/api/v1/officials?available_for="+otherMatch.ID, "", nil).
+		expect(t, http.StatusOK).decode(t, &available)
+	if len(available) != 0 {
+		t.Fatalf("expected no official to be available, got %d", len(available))
+	}
+
+	res := s.do(http.MethodPut, "/api/v1/matches/"+otherMatch.ID+"/officials", token, map[string]interface{}{
+		"officials": []map[string]string{{"official_id": referee.ID, "role": "referee"}},
+	}).expect(t, http.StatusConflict)
+	var conflict struct {
+		Conflict string `json:"conflict"`
+		Match    struct {
+			ID string `json:"id"`
+		} `json:"match"`
+	}
+	if err := json.Unmarshal(res.Body.Error, &conflict); err != nil || conflict.Conflict != "official_busy" || conflict.Match.ID != match {
+		t.Fatalf("expected the referee to be busy, got %s", res.Raw)
+	}
+
+	s.do(http.MethodPost, "/api/v1/matches/"+match+"/result", token, map[string]interface{}{
+		"home_score": 0,
+		"away_score": 0,
+		"goals":      []goal{},
+		"cards": []map[string]interface{}{
+			{"player_id": player, "team_id": home, "minute": 34, "type": "yellow"},
+		},
+	}).expect(t, http.StatusOK)
+
+	var stats struct {
+		Matches       int64            `json:"matches"`
+		MatchesByRole map[string]int64 `json:"matches_by_role"`
+		YellowCards   int64            `json:"yellow_cards"`
+	}
+	s.do(http.MethodGet, "/api/v1/officials/"+referee.ID+"/stats", "", nil).expect(t, http.StatusOK).decode(t, &stats)
+	if stats.Matches != 1 || stats.MatchesByRole["referee"] != 1 || stats.YellowCards != 1 {
+		t.Fatalf("unexpected referee stats: %+v", stats)
+	}
+}
+
+func TestPlayerTransfers(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+
+	home := s.createTeam(token, "Persija")
+	away := s.createTeam(token, "Persib")
+	player := s.createPlayer(token, home, "Marko Simic", 9)
+	s.createPlayer(token, away, "David da Silva", 9)
+	before := s.createMatch(token, home, away, "2025-07-05")
+	after := s.createMatch(token, home, away, "2025-08-16")
+
+	s.do(http.MethodPut, "/api/v1/players/"+player, token, map[string]interface{}{
+		"team_id": away,
+	}).expect(t, http.StatusBadRequest)
+
+	var window struct {
+		ID       string `json:"id"`
+		OpensAt  string `json:"opens_at"`
+		ClosesOn string `json:"closes_on"`
+	}
+	s.do(http.MethodPost, "/api/v1/seasons/2025-2026/transfer-windows", token, map[string]string{
+		"name":      "Summer",
+		"opens_on":  "2025-07-01",
+		"closes_on": "2025-08-31",
+	}).expect(t, http.StatusCreated).decode(t, &window)
+	if window.OpensAt != "2025-06-30T17:00:00Z" || window.ClosesOn != "2025-08-31" {
+		t.Fatalf("unexpected window: %+v", window)
+	}
+	s.do(http.MethodPost, "/api/v1/seasons/2025-2026/transfer-windows", token, map[string]string{
+		"name":      "Overlap",
+		"opens_on":  "2025-08-01",
+		"closes_on": "2025-09-30",
+	}).expect(t, http.StatusConflict)
+
+	transfer := func(date string, jersey int) result {
+		return s.do(http.MethodPost, "/api/v1/players/"+player+"/transfer", token, map[string]interface{}{
+			"team_id":       away,
+			"jersey_number": jersey,
+			"date":          date,
+		})
+	}
+	transfer("2025-09-15", 10).expect(t, http.StatusConflict)
+	transfer("2025-08-01", 9).expect(t, http.StatusConflict)
+	transfer("2025-08-01", 10).expect(t, http.StatusCreated)
+
+	var contracts []struct {
+		TeamID    string  `json:"team_id"`
+		StartsAt  *string `json:"starts_at"`
+		EndsAt    *string `json:"ends_at"`
+		IsCurrent bool    `json:"is_current"`
+	}
+	s.do(http.MethodGet, "/api/v1/players/"+player+"/contracts", "", nil).expect(t, http.StatusOK).decode(t, &contracts)
+	if len(contracts) != 2 || contracts[0].TeamID != home || contracts[0].StartsAt != nil || contracts[1].TeamID != away || !contracts[1].IsCurrent {
+		t.Fatalf("unexpected contracts: %+v", contracts)
+	}
+
+	// The squad of a past match still lists the player
+	var squad []struct {
+		ID           string `json:"id"`
+		JerseyNumber int    `json:"jersey_number"`
+	}
+	s.do(http.MethodGet, "/api/v1/players?team_id="+home+"&as_of=2025-07-05", "", nil).expect(t, http.StatusOK).decode(t, &squad)
+	if len(squad) != 1 || squad[0].ID != player || squad[0].JerseyNumber != 9 {
+		t.Fatalf("expected the player in the earlier squad, got %+v", squad)
+	}
+	s.do(http.MethodGet, "/api/v1/players?as_of=2025-07-05", "", nil).expect(t, http.StatusBadRequest)
+
+	s.recordResult(token, before, 1, 0, goal{PlayerID: player, TeamID: home, Minute: 12})
+	s.do(http.MethodPost, "/api/v1/matches/"+after+"/result", token, map[string]interface{}{
+		"home_score": 1,
+		"away_score": 0,
+		"goals":      []goal{{PlayerID: player, TeamID: home, Minute: 12}},
+	}).expect(t, http.StatusBadRequest)
+	s.recordResult(token, after, 0, 1, goal{PlayerID: player, TeamID: away, Minute: 80})
+
+	var scorers []struct {
+		TeamID    string `json:"team_id"`
+		GoalCount int64  `json:"goal_count"`
+	}
+	s.do(http.MethodGet, "/api/v1/reports/top-scorers", "", nil).expect(t, http.StatusOK).decode(t, &scorers)
+	if len(scorers) != 2 {
+		t.Fatalf("expected the player's goals under both teams, got %+v", scorers)
+	}
+
+	s.do(http.MethodDelete, "/api/v1/seasons/2024-2025/transfer-windows/"+window.ID, token, nil).expect(t, http.StatusNotFound)
+	s.do(http.MethodDelete, "/api/v1/seasons/2025-2026/transfer-windows/"+window.ID, token, nil).expect(t, http.StatusOK)
+}
+
+func TestSuspensions(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+
+	home := s.createTeam(token, "Persija")
+	away := s.createTeam(token, "Persib")
+	player := s.createPlayer(token, home, "Rizky Ridho", 5)
+	sentOff := s.createMatch(token, home, away, "2025-08-02")
+	banned := s.createMatch(token, away, home, "2025-08-09")
+
+	s.do(http.MethodGet, "/api/v1/seasons/2025-2026/disciplinary-rules", "", nil).expect(t, http.StatusNotFound)
+	s.do(http.MethodPut, "/api/v1/seasons/2025-2026/disciplinary-rules", token, map[string]int{
+		"yellow_card_limit": 3,
+		"red_card_ban":      1,
+	}).expect(t, http.StatusOK)
+	s.do(http.MethodPut, "/api/v1/seasons/2025/disciplinary-rules", token, map[string]int{
+		"yellow_card_limit": 3,
+		"red_card_ban":      1,
+	}).expect(t, http.StatusConflict)
+
+	s.do(http.MethodPost, "/api/v1/matches/"+sentOff+"/result", token, map[string]interface{}{
+		"home_score": 0,
+		"away_score": 0,
+		"cards": []map[string]interface{}{
+			{"player_id": player, "team_id": home, "minute": 40, "type": "red"},
+		},
+	}).expect(t, http.StatusOK)
+
+	type suspension struct {
+		PlayerID string `json:"player_id"`
+		Reason   string `json:"reason"`
+		Matches  []struct {
+			ID string `json:"id"`
+		} `json:"matches"`
+		IsActive bool `json:"is_active"`
+	}
+	var suspensions []suspension
+	s.do(http.MethodGet, "/api/v1/matches/"+banned+"/suspensions", "", nil).expect(t, http.StatusOK).decode(t, &suspensions)
+	if len(suspensions) != 1 || suspensions[0].PlayerID != player || suspensions[0].Reason != "red_card" {
+		t.Fatalf("expected the player to be suspended for the next match, got %+v", suspensions)
+	}
+
+	s.do(http.MethodPost, "/api/v1/matches/"+banned+"/result", token, map[string]interface{}{
+		"home_score": 0,
+		"away_score": 1,
+		"goals":      []goal{{PlayerID: player, TeamID: home, Minute: 12}},
+	}).expect(t, http.StatusConflict)
+	s.recordResult(token, banned, 0, 0)
+
+	s.do(http.MethodGet, "/api/v1/reports/suspensions?season=2025-2026", "", nil).expect(t, http.StatusOK).decode(t, &suspensions)
+	if len(suspensions) != 1 || len(suspensions[0].Matches) != 1 || suspensions[0].Matches[0].ID != banned || suspensions[0].IsActive {
+		t.Fatalf("expected one served suspension, got %+v", suspensions)
+	}
+	s.do(http.MethodGet, "/api/v1/reports/suspensions?season=2025-2026&active=true", "", nil).expect(t, http.StatusOK).decode(t, &suspensions)
+	if len(suspensions) != 0 {
+		t.Fatalf("expected no active suspensions, got %+v", suspensions)
+	}
+	s.do(http.MethodGet, "/api/v1/reports/suspensions", "", nil).expect(t, http.StatusBadRequest)
+
+	s.do(http.MethodDelete, "/api/v1/seasons/2025-2026/disciplinary-rules", token, nil).expect(t, http.StatusOK)
+	s.do(http.MethodDelete, "/api/v1/seasons/2025-2026/disciplinary-rules", token, nil).expect(t, http.StatusNotFound)
+}
+
+func TestPlayerAvailability(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+
+	home := s.createTeam(token, "Persija")
+	away := s.createTeam(token, "Persib")
+	injured := s.createPlayer(token, home, "Rizky Ridho", 5)
+	fit := s.createPlayer(token, home, "Marko Simic", 9)
+	match := s.createMatch(token, home, away, "2025-08-09")
+
+	var availability struct {
+		ID       string `json:"id"`
+		IsActive bool   `json:"is_active"`
+	}
+	s.do(http.MethodPost, "/api/v1/players/"+injured+"/availability", token, map[string]string{
+		"status":             "injured",
+		"starts_on":          "2025-08-01",
+		"expected_return_on": "2025-07-25",
+	}).expect(t, http.StatusBadRequest)
+	s.do(http.MethodPost, "/api/v1/players/"+injured+"/availability", token, map[string]string{
+		"status":             "injured",
+		"note":               "Hamstring",
+		"starts_on":          "2025-08-01",
+		"expected_return_on": "2025-08-22",
+	}).expect(t, http.StatusCreated).decode(t, &availability)
+	if availability.IsActive {
+		t.Fatalf("expected a past injury to be inactive, got %+v", availability)
+	}
+
+	type member struct {
+		PlayerID    string `json:"player_id"`
+		IsAvailable bool   `json:"is_available"`
+		Unavailable []struct {
+			ID string `json:"id"`
+		} `json:"unavailable"`
+	}
+	var squad []member
+	s.do(http.MethodGet, "/api/v1/teams/"+home+"/availability?at=2025-08-10", "", nil).expect(t, http.StatusOK).decode(t, &squad)
+	if len(squad) != 2 || squad[0].PlayerID != injured || squad[0].IsAvailable || len(squad[0].Unavailable) != 1 || !squad[1].IsAvailable {
+		t.Fatalf("expected only the injured player to be unavailable, got %+v", squad)
+	}
+	s.do(http.MethodGet, "/api/v1/teams/"+home+"/availability?at=yesterday", "", nil).expect(t, http.StatusBadRequest)
+
+	type warning struct {
+		PlayerID       string `json:"player_id"`
+		AvailabilityID string `json:"availability_id"`
+	}
+	var recorded struct {
+		Status   string    `json:"status"`
+		Warnings []warning `json:"warnings"`
+	}
+	s.do(http.MethodPost, "/api/v1/matches/"+match+"/result", token, map[string]interface{}{
+		"home_score": 2,
+		"away_score": 0,
+		"goals": []goal{
+			{PlayerID: injured, TeamID: home, Minute: 12},
+			{PlayerID: fit, TeamID: home, Minute: 70},
+		},
+	}).expect(t, http.StatusOK).decode(t, &recorded)
+	if recorded.Status != "completed" || len(recorded.Warnings) != 1 || recorded.Warnings[0].PlayerID != injured || recorded.Warnings[0].AvailabilityID != availability.ID {
+		t.Fatalf("expected the result with one warning for the injured player, got %+v", recorded)
+	}
+
+	// Returning before the match clears the warning
+	s.do(http.MethodPut, "/api/v1/players/"+injured+"/availability/"+availability.ID, token, map[string]string{
+		"status":             "injured",
+		"starts_on":          "2025-08-01",
+		"expected_return_on": "2025-08-22",
+		"returned_on":        "2025-08-08",
+	}).expect(t, http.StatusOK)
+	s.do(http.MethodPost, "/api/v1/matches/"+match+"/result", token, map[string]interface{}{
+		"home_score": 1,
+		"away_score": 0,
+		"goals":      []goal{{PlayerID: injured, TeamID: home, Minute: 12}},
+	}).expect(t, http.StatusOK).decode(t, &recorded)
+	if len(recorded.Warnings) != 0 {
+		t.Fatalf("expected no warnings after the player returned, got %+v", recorded.Warnings)
+	}
+
+	var records []struct {
+		ID string `json:"id"`
+	}
+	s.do(http.MethodGet, "/api/v1/players/"+injured+"/availability", "", nil).expect(t, http.StatusOK).decode(t, &records)
+	if len(records) != 1 || records[0].ID != availability.ID {
+		t.Fatalf("expected the injury in the player's records, got %+v", records)
+	}
+
+	s.do(http.MethodDelete, "/api/v1/players/"+fit+"/availability/"+availability.ID, token, nil).expect(t, http.StatusNotFound)
+	s.do(http.MethodDelete, "/api/v1/players/"+injured+"/availability/"+availability.ID, token, nil).expect(t, http.StatusOK)
+	s.do(http.MethodDelete, "/api/v1/players/"+injured+"/availability/"+availability.ID, token, nil).expect(t, http.StatusNotFound)
+}
+
+func TestPlayerProfile(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+
+	team := s.createTeam(token, "Persija")
+	other := s.createTeam(token, "Persib")
+	veteran := s.createPlayer(token, other, "Marko Simic", 9)
+
+	profile := map[string]interface{}{
+		"team_id":             team,
+		"name":                "Rizky Ridho",
+		"height":              183,
+		"weight":              75,
+		"position":            "defender",
+		"secondary_positions": []string{"midfielder"},
+		"jersey_number":       5,
+		"date_of_birth":       "2004-03-15",
+		"nationality":         "id",
+		"preferred_foot":      "right",
+		"photo":               "https://example.com/ridho.png",
+	}
+	invalid := []struct {
+		field string
+		value interface{}
+	}{
+		{"nationality", "XX"},
+		{"preferred_foot", "middle"},
+		{"secondary_positions", []string{"defender"}},
+		{"date_of_birth", "2999-01-01"},
+		{"photo", "not a url"},
+	}
+	for _, tc := range invalid {
+		body := map[string]interface{}{}
+		for k, v := range profile {
+			body[k] = v
+		}
+		body[tc.field] = tc.value
+		s.do(http.MethodPost, "/api/v1/players", token, body).expect(t, http.StatusBadRequest)
+	}
+
+	var player struct {
+		ID                 string   `json:"id"`
+		DateOfBirth        *string  `json:"date_of_birth"`
+		Age                *int     `json:"age"`
+		Nationality        string   `json:"nationality"`
+		SecondaryPositions []string `json:"secondary_positions"`
+	}
+	s.do(http.MethodPost, "/api/v1/players", token, profile).expect(t, http.StatusCreated).decode(t, &player)
+	if player.DateOfBirth == nil || *player.DateOfBirth != "2004-03-15" || player.Age == nil ||
+		player.Nationality != "ID" || len(player.SecondaryPositions) != 1 {
+		t.Fatalf("expected the profile back, got %+v", player)
+	}
+	s.do(http.MethodPut, "/api/v1/players/"+veteran, token, map[string]interface{}{
+		"date_of_birth": "1990-01-01",
+		"nationality":   "HR",
+	}).expect(t, http.StatusOK)
+
+	var players []struct {
+		ID string `json:"id"`
+	}
+	s.do(http.MethodGet, "/api/v1/players?age_group=U21&on=2025-01-01&position=midfielder", "", nil).expect(t, http.StatusOK).decode(t, &players)
+	if len(players) != 1 || players[0].ID != player.ID {
+		t.Fatalf("expected the U-21 midfielder, got %+v", players)
+	}
+	s.do(http.MethodGet, "/api/v1/players?nationality=hr&team_id="+other, "", nil).expect(t, http.StatusOK).decode(t, &players)
+	if len(players) != 1 || players[0].ID != veteran {
+		t.Fatalf("expected the Croatian player, got %+v", players)
+	}
+	s.do(http.MethodGet, "/api/v1/players?age_group=U99", "", nil).expect(t, http.StatusBadRequest)
+	s.do(http.MethodGet, "/api/v1/players?on=2025-01-01", "", nil).expect(t, http.StatusBadRequest)
+	s.do(http.MethodGet, "/api/v1/players?position=striker", "", nil).expect(t, http.StatusBadRequest)
+	s.do(http.MethodGet, "/api/v1/players?position=forward&team_id="+team+"&as_of=2025-01-01", "", nil).expect(t, http.StatusBadRequest)
+
+	var eligibility struct {
+		AgeGroup   string `json:"age_group"`
+		BornAfter  string `json:"born_after"`
+		Age        *int   `json:"age"`
+		IsEligible bool   `json:"is_eligible"`
+	}
+	s.do(http.MethodGet, "/api/v1/players/"+player.ID+"/eligibility?age_group=U21&on=2025-03-15", "", nil).expect(t, http.StatusOK).decode(t, &eligibility)
+	if eligibility.AgeGroup != "U-21" || eligibility.BornAfter != "2004-03-15" || eligibility.Age == nil || *eligibility.Age != 21 || eligibility.IsEligible {
+		t.Fatalf("expected a player turning 21 on the cut-off to be ineligible, got %+v", eligibility)
+	}
+	s.do(http.MethodGet, "/api/v1/players/"+player.ID+"/eligibility?age_group=U21&on=2025-03-14", "", nil).expect(t, http.StatusOK).decode(t, &eligibility)
+	if !eligibility.IsEligible {
+		t.Fatalf("expected the player to be eligible the day before, got %+v", eligibility)
+	}
+	s.do(http.MethodGet, "/api/v1/players/"+player.ID+"/eligibility", "", nil).expect(t, http.StatusBadRequest)
+}
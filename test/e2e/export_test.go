@@ -0,0 +1,150 @@
+package e2e
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// readCSV parses a CSV download into its header and rows
+func readCSV(t *testing.T, res result) ([]string, [][]string) {
+	t.Helper()
+	if got := res.Header.Get("Content-Type"); got != "text/csv; charset=utf-8" {
+		t.Fatalf("expected a CSV download, got %q", got)
+	}
+	records, err := csv.NewReader(bytes.NewReader(res.Raw)).ReadAll()
+	if err != nil || len(records) == 0 {
+		t.Fatalf("invalid CSV (%v): %s", err, res.Raw)
+	}
+	return records[0], records[1:]
+}
+
+func TestExportEntities(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+
+	// An empty table still names its columns
+	header, rows := readCSV(t, s.do(http.MethodGet, "/api/v1/export/teams", token, nil).expect(t, http.StatusOK))
+	if strings.Join(header, ",") != "id,name,logo,founded_year,address,city,created_at,updated_at" || len(rows) != 0 {
+		t.Fatalf("unexpected empty export: %v %v", header, rows)
+	}
+
+	homeID := s.createTeam(token, "Persija")
+	awayID := s.createTeam(token, "=Persib")
+	scorerID := s.createPlayer(token, homeID, "Marko Simic", 9)
+	s.createPlayer(token, awayID, "Ciro Alves", 77)
+	matchID := s.createMatch(token, homeID, awayID, "2024-03-15")
+	s.recordResult(token, matchID, 2, 0,
+		goal{PlayerID: scorerID, TeamID: homeID, Minute: 12},
+		goal{PlayerID: scorerID, TeamID: homeID, Minute: 80},
+	)
+	s.createMatch(token, awayID, homeID, "2024-04-01")
+
+	res := s.do(http.MethodGet, "/api/v1/export/teams", token, nil).expect(t, http.StatusOK)
+	if disposition := res.Header.Get("Content-Disposition"); !strings.HasPrefix(disposition, `attachment; filename="teams-`) {
+		t.Fatalf("unexpected Content-Disposition %q", disposition)
+	}
+	_, rows = readCSV(t, res)
+	names := map[string]bool{}
+	for _, row := range rows {
+		names[row[1]] = true
+	}
+	if len(rows) != 2 || !names["Persija"] || !names["'=Persib"] {
+		t.Fatalf("expected both teams with formulas escaped, got %v", rows)
+	}
+
+	header, rows = readCSV(t, s.do(http.MethodGet, "/api/v1/export/matches", token, nil).expect(t, http.StatusOK))
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 matches, got %v", rows)
+	}
+	for _, row := range rows {
+		match := map[string]string{}
+		for i, column := range header {
+			match[column] = row[i]
+		}
+		switch match["status"] {
+		case "completed":
+			if match["home_score"] != "2" || match["result"] != "home_win" || match["home_team_name"] != "Persija" {
+				t.Fatalf("unexpected completed match: %v", match)
+			}
+		case "scheduled":
+			if match["home_score"] != "" || match["result"] != "" {
+				t.Fatalf("expected a scheduled match without score or result, got %v", match)
+			}
+		default:
+			t.Fatalf("unexpected match status: %v", match)
+		}
+	}
+
+	// NDJSON writes one JSON object per line
+	res = s.do(http.MethodGet, "/api/v1/export/goals?format=ndjson", token, nil).expect(t, http.StatusOK)
+	if got := res.Header.Get("Content-Type"); got != "application/x-ndjson" {
+		t.Fatalf("expected an NDJSON download, got %q", got)
+	}
+	var goals []map[string]interface{}
+	lines := bufio.NewScanner(bytes.NewReader(res.Raw))
+	for lines.Scan() {
+		var goal map[string]interface{}
+		if err := json.Unmarshal(lines.Bytes(), &goal); err != nil {
+			t.Fatalf("invalid NDJSON line %q: %v", lines.Text(), err)
+		}
+		goals = append(goals, goal)
+	}
+	if len(goals) != 2 || goals[0]["player_name"] != "Marko Simic" || goals[0]["match_date"] != "2024-03-15" {
+		t.Fatalf("unexpected goals: %v", goals)
+	}
+
+	// Regular users can export, anonymous users cannot
+	s.do(http.MethodGet, "/api/v1/export/players", s.userToken(), nil).expect(t, http.StatusOK)
+	s.do(http.MethodGet, "/api/v1/export/players", "", nil).expect(t, http.StatusUnauthorized)
+
+	s.do(http.MethodGet, "/api/v1/export/users", token, nil).expect(t, http.StatusBadRequest)
+	s.do(http.MethodGet, "/api/v1/export/teams?format=xlsx", token, nil).expect(t, http.StatusBadRequest)
+}
+
+func TestExportReports(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+
+	persijaID := s.createTeam(token, "Persija")
+	persibID := s.createTeam(token, "Persib")
+	aremaID := s.createTeam(token, "Arema")
+	simicID := s.createPlayer(token, persijaID, "Marko Simic", 9)
+	ciroID := s.createPlayer(token, persibID, "Ciro Alves", 77)
+
+	s.recordResult(token, s.createMatch(token, persijaID, persibID, "2024-03-01"), 2, 1,
+		goal{PlayerID: simicID, TeamID: persijaID, Minute: 10},
+		goal{PlayerID: simicID, TeamID: persijaID, Minute: 20},
+		goal{PlayerID: ciroID, TeamID: persibID, Minute: 30},
+	)
+	s.recordResult(token, s.createMatch(token, persibID, aremaID, "2024-03-08"), 0, 0)
+	s.createMatch(token, aremaID, persijaID, "2024-03-15")
+
+	header, rows := readCSV(t, s.do(http.MethodGet, "/api/v1/export/standings", token, nil).expect(t, http.StatusOK))
+	if strings.Join(header, ",") != "position,team_id,team_name,played,won,drawn,lost,goals_for,goals_against,goal_difference,points" {
+		t.Fatalf("unexpected standings header: %v", header)
+	}
+	want := [][]string{
+		{"1", "Persija", "1", "1", "0", "0", "2", "1", "1", "3"},
+		{"2", "Arema", "1", "0", "1", "0", "0", "0", "0", "1"},
+		{"3", "Persib", "2", "0", "1", "1", "1", "2", "-1", "1"},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("expected %d standings, got %v", len(want), rows)
+	}
+	for i, row := range rows {
+		got := append([]string{row[0]}, row[2:]...)
+		if strings.Join(got, ",") != strings.Join(want[i], ",") {
+			t.Fatalf("standing %d: expected %v, got %v", i+1, want[i], got)
+		}
+	}
+
+	_, rows = readCSV(t, s.do(http.MethodGet, "/api/v1/export/top-scorers", token, nil).expect(t, http.StatusOK))
+	if len(rows) != 2 || rows[0][0] != "1" || rows[0][2] != "Marko Simic" || rows[0][5] != "2" || rows[1][2] != "Ciro Alves" {
+		t.Fatalf("unexpected top scorers: %v", rows)
+	}
+}
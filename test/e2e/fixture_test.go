@@ -0,0 +1,120 @@
+package e2e
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// calendarEvents unfolds an iCalendar feed and returns its events as
+// property maps keyed by UID
+func calendarEvents(t *testing.T, res result) map[string]map[string]string {
+	t.Helper()
+	if got := res.Header.Get("Content-Type"); got != "text/calendar; charset=utf-8" {
+		t.Fatalf("expected a calendar, got %q", got)
+	}
+	body := strings.ReplaceAll(string(res.Raw), "\r\n ", "")
+	events := make(map[string]map[string]string)
+	var event map[string]string
+	for _, line := range strings.Split(body, "\r\n") {
+		switch line {
+		case "BEGIN:VEVENT":
+			event = make(map[string]string)
+		case "END:VEVENT":
+			events[event["UID"]] = event
+			event = nil
+		default:
+			if name, value, ok := strings.Cut(line, ":"); ok && event != nil {
+				event[name] = value
+			}
+		}
+	}
+	return events
+}
+
+func TestTeamFixtureCalendar(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+	persijaID := s.createTeam(token, "Persija")
+	persibID := s.createTeam(token, "Persib")
+	otherID := s.createTeam(token, "Arema")
+	scorerID := s.createPlayer(token, persijaID, "Marko Simic", 9)
+
+	played := s.createMatch(token, persijaID, persibID, "2024-03-15")
+	s.recordResult(token, played, 1, 0, goal{PlayerID: scorerID, TeamID: persijaID, Minute: 33})
+	moved := s.createMatch(token, persibID, persijaID, "2024-04-01")
+	cancelled := s.createMatch(token, persijaID, otherID, "2024-04-08")
+	s.createMatch(token, persibID, otherID, "2024-04-15")
+	s.do(http.MethodPut, "/api/v1/matches/"+cancelled, token, map[string]string{"status": "cancelled"}).expect(t, http.StatusOK)
+
+	path := "/api/v1/teams/" + persijaID + "/fixtures.ics"
+	events := calendarEvents(t, s.do(http.MethodGet, path, "", nil).expect(t, http.StatusOK))
+	if len(events) != 3 {
+		t.Fatalf("expected the team's 3 matches, got %d", len(events))
+	}
+
+	if summary := events[played+"@ayo-football"]["SUMMARY"]; summary != "Persija 1-0 Persib" {
+		t.Fatalf("expected the final score in the summary, got %q", summary)
+	}
+	if status := events[cancelled+"@ayo-football"]["STATUS"]; status != "CANCELLED" {
+		t.Fatalf("expected the cancelled match to be marked, got %q", status)
+	}
+	before := events[moved+"@ayo-football"]
+	if before["SUMMARY"] != "Persib vs Persija" || before["DTSTART"] != "20240401T123000Z" || before["DTEND"] != "20240401T143000Z" {
+		t.Fatalf("unexpected fixture: %v", before)
+	}
+
+	// A reschedule keeps the UID and moves LAST-MODIFIED on, so subscribed
+	// calendars move the event. Both are written to the second.
+	time.Sleep(1100 * time.Millisecond)
+	s.do(http.MethodPut, "/api/v1/matches/"+moved, token, map[string]string{
+		"match_date": "2024-04-02",
+		"match_time": "15:00",
+	}).expect(t, http.StatusOK)
+
+	after := calendarEvents(t, s.do(http.MethodGet, path, "", nil).expect(t, http.StatusOK))[moved+"@ayo-football"]
+	if after["DTSTART"] != "20240402T080000Z" {
+		t.Fatalf("expected the new kick-off, got %v", after)
+	}
+	if after["LAST-MODIFIED"] <= before["LAST-MODIFIED"] {
+		t.Fatalf("expected LAST-MODIFIED to move on, got %s then %s", before["LAST-MODIFIED"], after["LAST-MODIFIED"])
+	}
+	if after["DTSTAMP"] < after["LAST-MODIFIED"] {
+		t.Fatalf("expected DTSTAMP to be when the feed was generated, got %s before %s", after["DTSTAMP"], after["LAST-MODIFIED"])
+	}
+
+	s.do(http.MethodGet, "/api/v1/teams/00000000-0000-0000-0000-000000000001/fixtures.ics", "", nil).expect(t, http.StatusNotFound)
+	s.do(http.MethodGet, "/api/v1/teams/not-a-uuid/fixtures.ics", "", nil).expect(t, http.StatusBadRequest)
+}
+
+func TestSeasonFixtureCalendar(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+	homeID := s.createTeam(token, "Persija")
+	awayID := s.createTeam(token, "Persib")
+
+	spring := s.createMatch(token, homeID, awayID, "2024-03-15")
+	autumn := s.createMatch(token, awayID, homeID, "2024-09-20")
+	nextSpring := s.createMatch(token, homeID, awayID, "2025-02-10")
+
+	seasons := map[string][]string{
+		"2024":      {spring, autumn},
+		"2024-2025": {autumn, nextSpring},
+	}
+	for season, want := range seasons {
+		events := calendarEvents(t, s.do(http.MethodGet, "/api/v1/seasons/"+season+"/fixtures.ics", "", nil).expect(t, http.StatusOK))
+		if len(events) != len(want) {
+			t.Fatalf("season %s: expected %d fixtures, got %d", season, len(want), len(events))
+		}
+		for _, id := range want {
+			if _, ok := events[id+"@ayo-football"]; !ok {
+				t.Fatalf("season %s: expected match %s", season, id)
+			}
+		}
+	}
+
+	for _, season := range []string{"24", "2024-2026", "next"} {
+		s.do(http.MethodGet, "/api/v1/seasons/"+season+"/fixtures.ics", "", nil).expect(t, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,275 @@
+// Package e2e exercises the HTTP API end to end: every test boots the full
+// router on a fresh in-memory SQLite database and talks to it with httptest.
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zenkriztao/ayo-football-backend/internal/app"
+	"github.com/zenkriztao/ayo-football-backend/internal/config"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/database"
+)
+
+const (
+	adminEmail    = "admin@ayofootball.com"
+	adminPassword = "Admin@123"
+)
+
+// server is a running API backed by a throwaway database
+type server struct {
+	t      *testing.T
+	engine *gin.Engine
+}
+
+// envelope mirrors response.Response with the payload left undecoded
+type envelope struct {
+	Success bool            `json:"success"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+	Error   json.RawMessage `json:"error"`
+	Meta    *struct {
+		CurrentPage int    `json:"current_page"`
+		PerPage     int    `json:"per_page"`
+		TotalItems  int64  `json:"total_items"`
+		TotalPages  int64  `json:"total_pages"`
+		NextCursor  string `json:"next_cursor"`
+		PrevCursor  string `json:"prev_cursor"`
+	} `json:"meta"`
+}
+
+// result is a recorded response
+type result struct {
+	Status int
+	Header http.Header
+	Body   envelope
+	Raw    []byte
+}
+
+func newServer(t *testing.T) *server {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	cfg := &config.Config{}
+	cfg.Server.Mode = gin.TestMode
+	cfg.Database.Driver = "sqlite"
+	cfg.Database.Name = ":memory:"
+	cfg.JWT.Secret = "e2e-secret"
+	cfg.JWT.ExpirationHours = 1
+	cfg.Storage.LocalDir = t.TempDir()
+
+	db, err := database.NewDatabase(cfg)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+
+	application, err := app.New(cfg, db)
+	if err != nil {
+		t.Fatalf("failed to build application: %v", err)
+	}
+	if err := application.AuthUseCase.CreateDefaultAdmin(context.Background(), adminEmail, adminPassword); err != nil {
+		t.Fatalf("failed to create default admin: %v", err)
+	}
+
+	engine := gin.New()
+	application.Router.Setup(engine)
+	return &server{t: t, engine: engine}
+}
+
+// do sends a request with an optional bearer token and JSON body
+func (s *server) do(method, path, token string, body interface{}) result {
+	s.t.Helper()
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			s.t.Fatalf("failed to encode body: %v", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+	return s.send(method, path, token, reader)
+}
+
+// send sends a request with a raw JSON body
+func (s *server) send(method, path, token string, body io.Reader) result {
+	s.t.Helper()
+
+	req := httptest.NewRequest(method, path, body)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return s.serve(req)
+}
+
+// upload sends content as the multipart file field "file"
+func (s *server) upload(path, token, filename, content string) result {
+	s.t.Helper()
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("file", filename)
+	if err != nil {
+		s.t.Fatalf("failed to create form file: %v", err)
+	}
+	if _, err := io.WriteString(part, content); err != nil {
+		s.t.Fatalf("failed to write form file: %v", err)
+	}
+	if err := form.Close(); err != nil {
+		s.t.Fatalf("failed to close form: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, path, &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return s.serve(req)
+}
+
+// serve sends a prepared request and records the response. Only JSON
+// responses are decoded into the envelope; downloads are left in Raw.
+func (s *server) serve(req *http.Request) result {
+	s.t.Helper()
+
+	rec := httptest.NewRecorder()
+	s.engine.ServeHTTP(rec, req)
+
+	res := result{Status: rec.Code, Header: rec.Header(), Raw: rec.Body.Bytes()}
+	if !strings.HasPrefix(res.Header.Get("Content-Type"), "application/json") {
+		return res
+	}
+	if err := json.Unmarshal(res.Raw, &res.Body); err != nil {
+		s.t.Fatalf("%s %s: response is not JSON: %s", req.Method, req.URL.Path, res.Raw)
+	}
+	return res
+}
+
+// expect fails the test unless the response has the given status
+func (r result) expect(t *testing.T, status int) result {
+	t.Helper()
+	if r.Status != status {
+		t.Fatalf("expected status %d, got %d: %s", status, r.Status, r.Raw)
+	}
+	return r
+}
+
+// decode unmarshals the response data into v
+func (r result) decode(t *testing.T, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal(r.Body.Data, v); err != nil {
+		t.Fatalf("failed to decode data: %v: %s", err, r.Raw)
+	}
+}
+
+// login returns a token for the given credentials
+func (s *server) login(email, password string) string {
+	s.t.Helper()
+	var data struct {
+		Token string `json:"token"`
+	}
+	s.do(http.MethodPost, "/api/v1/auth/login", "", map[string]string{
+		"email":    email,
+		"password": password,
+	}).expect(s.t, http.StatusOK).decode(s.t, &data)
+	return data.Token
+}
+
+// adminToken logs in as the default admin
+func (s *server) adminToken() string {
+	return s.login(adminEmail, adminPassword)
+}
+
+// userToken registers a regular user and logs in
+func (s *server) userToken() string {
+	s.t.Helper()
+	s.do(http.MethodPost, "/api/v1/auth/register", "", map[string]string{
+		"name":     "Regular User",
+		"email":    "user@example.com",
+		"password": "password123",
+	}).expect(s.t, http.StatusCreated)
+	return s.login("user@example.com", "password123")
+}
+
+// createTeam creates a team and returns its ID
+func (s *server) createTeam(token, name string) string {
+	s.t.Helper()
+	var team struct {
+		ID string `json:"id"`
+	}
+	s.do(http.MethodPost, "/api/v1/teams", token, map[string]interface{}{
+		"name":         name,
+		"founded_year": 1990,
+		"city":         "Jakarta",
+	}).expect(s.t, http.StatusCreated).decode(s.t, &team)
+	return team.ID
+}
+
+// createPlayer creates a player and returns its ID
+func (s *server) createPlayer(token, teamID, name string, jersey int) string {
+	s.t.Helper()
+	var player struct {
+		ID string `json:"id"`
+	}
+	s.do(http.MethodPost, "/api/v1/players", token, map[string]interface{}{
+		"team_id":       teamID,
+		"name":          name,
+		"height":        180,
+		"weight":        75,
+		"position":      "forward",
+		"jersey_number": jersey,
+	}).expect(s.t, http.StatusCreated).decode(s.t, &player)
+	return player.ID
+}
+
+// createMatch schedules a match and returns its ID
+func (s *server) createMatch(token, homeID, awayID, date string) string {
+	s.t.Helper()
+	var match struct {
+		ID string `json:"id"`
+	}
+	s.do(http.MethodPost, "/api/v1/matches", token, map[string]string{
+		"match_date":   date,
+		"match_time":   "19:30",
+		"home_team_id": homeID,
+		"away_team_id": awayID,
+	}).expect(s.t, http.StatusCreated).decode(s.t, &match)
+	return match.ID
+}
+
+// goal is a goal in a match result request
+type goal struct {
+	PlayerID  string `json:"player_id"`
+	TeamID    string `json:"team_id"`
+	Minute    int    `json:"minute"`
+	IsOwnGoal bool   `json:"is_own_goal"`
+}
+
+// recordResult records the final score of a match
+func (s *server) recordResult(token, matchID string, home, away int, goals ...goal) {
+	s.t.Helper()
+	if goals == nil {
+		goals = []goal{}
+	}
+	s.do(http.MethodPost, "/api/v1/matches/"+matchID+"/result", token, map[string]interface{}{
+		"home_score": home,
+		"away_score": away,
+		"goals":      goals,
+	}).expect(s.t, http.StatusOK)
+}
@@ -0,0 +1,120 @@
+package e2e
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// importSummary is the subset of dto.ImportResponse the tests check
+type importSummary struct {
+	Total   int `json:"total"`
+	Valid   int `json:"valid"`
+	Created int `json:"created"`
+	Errors  []struct {
+		Row     int    `json:"row"`
+		Message string `json:"message"`
+	} `json:"errors"`
+	Players []struct {
+		ID           string `json:"id"`
+		JerseyNumber int    `json:"jersey_number"`
+	} `json:"players"`
+}
+
+const roster = "Name,Height,Weight,Position,Jersey Number\n" +
+	"Riko Simanjuntak,170,65,Midfielder,8\n" +
+	"Marko Simic,185,82,forward,9\n" +
+	"Ciro Alves,178,74,forward,8\n" +
+	"Andritany,180,abc,goalkeeper,1\n" +
+	"Rizky,182,76,defender,100\n"
+
+func TestImportPlayers(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+	teamID := s.createTeam(token, "Persija")
+	s.createPlayer(token, teamID, "Simic", 9)
+	path := "/api/v1/import/players?team_id=" + teamID
+
+	// A dry run previews the valid rows and stores nothing
+	var summary importSummary
+	s.upload(path+"&dry_run=true", token, "roster.csv", roster).expect(t, http.StatusOK).decode(t, &summary)
+	if summary.Total != 5 || summary.Valid != 1 || summary.Created != 0 || len(summary.Players) != 1 {
+		t.Fatalf("unexpected dry run: %+v", summary)
+	}
+	wantErrors := map[int]string{
+		3: "jersey number is already taken by another player in this team",
+		4: "jersey number 8 is already used by row 2",
+		5: "weight must be a number",
+		6: "jersey_number must be at most 99",
+	}
+	if len(summary.Errors) != len(wantErrors) {
+		t.Fatalf("expected %d row errors, got %+v", len(wantErrors), summary.Errors)
+	}
+	for _, rowErr := range summary.Errors {
+		if wantErrors[rowErr.Row] != rowErr.Message {
+			t.Fatalf("row %d: expected %q, got %q", rowErr.Row, wantErrors[rowErr.Row], rowErr.Message)
+		}
+	}
+
+	// All or nothing rejects the file as a whole
+	res := s.upload(path+"&all_or_nothing=true", token, "roster.csv", roster).expect(t, http.StatusUnprocessableEntity)
+	if err := json.Unmarshal(res.Body.Error, &summary); err != nil || summary.Created != 0 || len(summary.Errors) != 4 {
+		t.Fatalf("expected the row errors in the error payload, got %s", res.Raw)
+	}
+	var players []struct{}
+	s.do(http.MethodGet, "/api/v1/players", "", nil).expect(t, http.StatusOK).decode(t, &players)
+	if len(players) != 1 {
+		t.Fatalf("expected nothing to be stored, got %d players", len(players))
+	}
+
+	// By default the valid rows are stored and the rest reported
+	summary = importSummary{}
+	s.upload(path, token, "roster.csv", roster).expect(t, http.StatusOK).decode(t, &summary)
+	if summary.Created != 1 || summary.Players[0].JerseyNumber != 8 || len(summary.Errors) != 4 {
+		t.Fatalf("unexpected import: %+v", summary)
+	}
+	s.do(http.MethodGet, "/api/v1/players/"+summary.Players[0].ID, "", nil).expect(t, http.StatusOK)
+}
+
+func TestImportTeams(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+
+	teams := "city,name,founded_year,notes\nBandung,Persib,1933,ignored\nMalang,Arema,1987,\n"
+	var summary importSummary
+	s.upload("/api/v1/import/teams?all_or_nothing=true", token, "teams.csv", teams).
+		expect(t, http.StatusOK).decode(t, &summary)
+	if summary.Created != 2 || len(summary.Errors) != 0 {
+		t.Fatalf("unexpected import: %+v", summary)
+	}
+
+	var listed []struct{}
+	s.do(http.MethodGet, "/api/v1/teams", "", nil).expect(t, http.StatusOK).decode(t, &listed)
+	if len(listed) != 2 {
+		t.Fatalf("expected 2 teams, got %d", len(listed))
+	}
+}
+
+func TestImportRejectsInvalidFiles(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+
+	cases := []struct {
+		name, path, filename, content string
+		status                        int
+	}{
+		{"unknown entity", "/api/v1/import/matches", "matches.csv", "a\n", http.StatusBadRequest},
+		{"unsupported format", "/api/v1/import/teams", "teams.xls", "name\n", http.StatusBadRequest},
+		{"empty file", "/api/v1/import/teams", "teams.csv", "", http.StatusBadRequest},
+		{"missing columns", "/api/v1/import/teams", "teams.csv", "name\nPersib\n", http.StatusBadRequest},
+		{"missing team", "/api/v1/import/players", "roster.csv", roster, http.StatusBadRequest},
+		{"invalid team", "/api/v1/import/players?team_id=abc", "roster.csv", roster, http.StatusBadRequest},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s.upload(tc.path, token, tc.filename, tc.content).expect(t, tc.status)
+		})
+	}
+
+	s.send(http.MethodPost, "/api/v1/import/teams", token, nil).expect(t, http.StatusBadRequest)
+}
@@ -0,0 +1,104 @@
+package e2e
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+// imageUpload is the subset of dto.ImageUploadResponse the tests check
+type imageUpload struct {
+	Key          string `json:"key"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+	ContentType  string `json:"content_type"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+}
+
+func TestMediaUploads(t *testing.T) {
+	s := newServer(t)
+	token := s.adminToken()
+	teamID := s.createTeam(token, "Persija")
+	playerID := s.createPlayer(token, teamID, "Rizky Ridho", 5)
+
+	logo, err := os.ReadFile("../../docs/samples/logo.png")
+	if err != nil {
+		t.Fatalf("failed to read sample logo: %v", err)
+	}
+
+	// The file name does not matter, the content does
+	var uploaded struct {
+		Team struct {
+			Logo string `json:"logo"`
+		} `json:"team"`
+		Image imageUpload `json:"image"`
+	}
+	s.upload("/api/v1/teams/"+teamID+"/logo", token, "logo.txt", string(logo)).expect(t, http.StatusOK).decode(t, &uploaded)
+	stored := uploaded.Image
+	if uploaded.Team.Logo != stored.URL || stored.URL != "/api/v1/media/"+stored.Key || stored.ContentType != "image/png" ||
+		stored.Width != 64 || stored.Height != 64 || !strings.HasSuffix(stored.ThumbnailURL, "-thumb.png") {
+		t.Fatalf("unexpected upload %+v", uploaded)
+	}
+
+	var team struct {
+		Logo string `json:"logo"`
+	}
+	s.do(http.MethodGet, "/api/v1/teams/"+teamID, "", nil).expect(t, http.StatusOK).decode(t, &team)
+	if team.Logo != stored.URL {
+		t.Fatalf("expected the team logo %q, got %q", stored.URL, team.Logo)
+	}
+
+	// Images are public and cacheable for good
+	res := s.do(http.MethodGet, stored.URL, "", nil).expect(t, http.StatusOK)
+	if res.Header.Get("Content-Type") != "image/png" || !strings.Contains(res.Header.Get("Cache-Control"), "immutable") ||
+		res.Header.Get("ETag") == "" || res.Header.Get("Last-Modified") == "" {
+		t.Fatalf("unexpected media headers %v", res.Header)
+	}
+	if config, err := png.DecodeConfig(bytes.NewReader(res.Raw)); err != nil || config.Width != 64 {
+		t.Fatalf("expected the stored PNG, got %+v (%v)", config, err)
+	}
+	req := httptest.NewRequest(http.MethodGet, stored.URL, nil)
+	req.Header.Set("If-None-Match", res.Header.Get("ETag"))
+	if cached := s.serve(req); cached.Status != http.StatusNotModified || len(cached.Raw) != 0 {
+		t.Fatalf("expected 304 for a matching ETag, got %d", cached.Status)
+	}
+	s.do(http.MethodGet, stored.ThumbnailURL, "", nil).expect(t, http.StatusOK)
+	s.do(http.MethodGet, "/api/v1/media/teams/unknown.png", "", nil).expect(t, http.StatusNotFound)
+	s.do(http.MethodGet, "/api/v1/media/Teams/%2e%2e/secret", "", nil).expect(t, http.StatusNotFound)
+
+	// Player photos keep their JPEG format
+	photo, err := os.ReadFile("../../docs/samples/photo.jpg")
+	if err != nil {
+		t.Fatalf("failed to read sample photo: %v", err)
+	}
+	var photoUpload struct {
+		Player struct {
+			Photo string `json:"photo"`
+		} `json:"player"`
+		Image imageUpload `json:"image"`
+	}
+	s.upload("/api/v1/players/"+playerID+"/photo", token, "photo.jpg", string(photo)).expect(t, http.StatusOK).decode(t, &photoUpload)
+	if photoUpload.Player.Photo != photoUpload.Image.URL || photoUpload.Image.ContentType != "image/jpeg" {
+		t.Fatalf("unexpected photo upload %+v", photoUpload)
+	}
+
+	// Rejected uploads
+	s.upload("/api/v1/teams/"+teamID+"/logo", token, "logo.png", "<svg onload=alert(1)>").expect(t, http.StatusUnsupportedMediaType)
+	s.upload("/api/v1/teams/"+teamID+"/logo", token, "logo.png", string(logo)+strings.Repeat("x", 5<<20)).expect(t, http.StatusRequestEntityTooLarge)
+	s.upload("/api/v1/teams/00000000-0000-0000-0000-000000000001/logo", token, "logo.png", string(logo)).expect(t, http.StatusNotFound)
+	s.upload("/api/v1/players/not-a-uuid/photo", token, "photo.jpg", string(photo)).expect(t, http.StatusBadRequest)
+	s.do(http.MethodPost, "/api/v1/teams/"+teamID+"/logo", token, nil).expect(t, http.StatusBadRequest)
+
+	// A huge canvas is rejected before it is decoded
+	var huge bytes.Buffer
+	if err := png.Encode(&huge, image.NewGray(image.Rect(0, 0, 5000, 4000))); err != nil {
+		t.Fatalf("failed to encode image: %v", err)
+	}
+	s.upload("/api/v1/teams/"+teamID+"/logo", token, "huge.png", huge.String()).expect(t, http.StatusRequestEntityTooLarge)
+}
@@ -0,0 +1,292 @@
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+const collectionPath = "../../docs/postman_collection.json"
+
+// collection is the subset of the Postman v2.1 format the runner understands
+type collection struct {
+	Variable []keyValue `json:"variable"`
+	Auth     *struct {
+		Type   string     `json:"type"`
+		Bearer []keyValue `json:"bearer"`
+	} `json:"auth"`
+	Item []collectionItem `json:"item"`
+}
+
+type collectionItem struct {
+	Name    string           `json:"name"`
+	Item    []collectionItem `json:"item"`
+	Event   []event          `json:"event"`
+	Request *struct {
+		Method string     `json:"method"`
+		Header []keyValue `json:"header"`
+		Body   *struct {
+			Mode     string      `json:"mode"`
+			Raw      string      `json:"raw"`
+			FormData []formField `json:"formdata"`
+		} `json:"body"`
+		URL struct {
+			Raw string `json:"raw"`
+		} `json:"url"`
+	} `json:"request"`
+}
+
+type keyValue struct {
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Disabled bool   `json:"disabled"`
+}
+
+// formField is a multipart field; file fields name a file relative to docs/
+type formField struct {
+	Key      string `json:"key"`
+	Type     string `json:"type"`
+	Value    string `json:"value"`
+	Src      string `json:"src"`
+	Disabled bool   `json:"disabled"`
+}
+
+type event struct {
+	Listen string `json:"listen"`
+	Script struct {
+		Exec []string `json:"exec"`
+	} `json:"script"`
+}
+
+var (
+	variablePattern = regexp.MustCompile(`{{(\w+)}}`)
+	// setVariablePattern matches pm.collectionVariables.set('name', jsonData.path)
+	setVariablePattern = regexp.MustCompile(`pm\.collectionVariables\.set\('(\w+)',\s*jsonData\.([\w.]+)\)`)
+)
+
+// TestPostmanCollection runs the documented requests in order against a
+// fresh server, so the collection in docs/ cannot drift from the real API:
+// every request must succeed and together they must cover every route.
+func TestPostmanCollection(t *testing.T) {
+	data, err := os.ReadFile(collectionPath)
+	if err != nil {
+		t.Fatalf("failed to read collection: %v", err)
+	}
+	var c collection
+	if err := json.Unmarshal(data, &c); err != nil {
+		t.Fatalf("failed to parse collection: %v", err)
+	}
+
+	s := newServer(t)
+	vars := make(map[string]string)
+	for _, v := range c.Variable {
+		vars[v.Key] = v.Value
+	}
+	covered := make(map[string]bool)
+
+	var run func(items []collectionItem, prefix string)
+	run = func(items []collectionItem, prefix string) {
+		for _, item := range items {
+			name := prefix + item.Name
+			if item.Request == nil {
+				run(item.Item, name+" / ")
+				continue
+			}
+			if !t.Run(name, func(t *testing.T) { runItem(t, s, &c, item, vars, covered) }) {
+				// Later requests depend on variables set by earlier ones
+				t.FailNow()
+			}
+		}
+	}
+	run(c.Item, "")
+
+	for _, route := range s.engine.Routes() {
+		if key := route.Method + " " + route.Path; !covered[key] {
+			t.Errorf("route %s is not documented in the Postman collection", key)
+		}
+	}
+}
+
+// runItem sends one collection request and applies its test script
+func runItem(t *testing.T, s *server, c *collection, item collectionItem, vars map[string]string, covered map[string]bool) {
+	req := item.Request
+	substitute := func(text string) string {
+		return variablePattern.ReplaceAllStringFunc(text, func(ref string) string {
+			name := variablePattern.FindStringSubmatch(ref)[1]
+			value, ok := vars[name]
+			if !ok || value == "" {
+				t.Fatalf("variable %q is not set by an earlier request", name)
+			}
+			return value
+		})
+	}
+
+	target, err := url.Parse(substitute(req.URL.Raw))
+	if err != nil {
+		t.Fatalf("invalid url %q: %v", req.URL.Raw, err)
+	}
+
+	var body io.Reader
+	contentType := ""
+	if req.Body != nil {
+		switch req.Body.Mode {
+		case "raw":
+			body = strings.NewReader(substitute(req.Body.Raw))
+		case "formdata":
+			body, contentType = multipartBody(t, req.Body.FormData, substitute)
+		}
+	}
+
+	httpReq, err := http.NewRequest(req.Method, target.RequestURI(), body)
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+	if contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+	for _, h := range req.Header {
+		if !h.Disabled {
+			httpReq.Header.Set(h.Key, substitute(h.Value))
+		}
+	}
+	if httpReq.Header.Get("Authorization") == "" && c.Auth != nil && c.Auth.Type == "bearer" {
+		for _, kv := range c.Auth.Bearer {
+			if kv.Key == "token" {
+				if token := variablePattern.ReplaceAllStringFunc(kv.Value, func(ref string) string {
+					return vars[variablePattern.FindStringSubmatch(ref)[1]]
+				}); token != "" {
+					httpReq.Header.Set("Authorization", "Bearer "+token)
+				}
+			}
+		}
+	}
+
+	res := s.serve(httpReq)
+	if res.Status < 200 || res.Status > 299 {
+		t.Fatalf("%s %s: expected success, got %d: %s", req.Method, target.Path, res.Status, res.Raw)
+	}
+	if route, ok := s.route(req.Method, target.Path); ok {
+		covered[req.Method+" "+route] = true
+	} else {
+		t.Fatalf("%s %s does not match a registered route", req.Method, target.Path)
+	}
+
+	for _, ev := range item.Event {
+		if ev.Listen == "test" {
+			applyScript(t, ev.Script.Exec, res.Raw, vars)
+		}
+	}
+}
+
+// multipartBody encodes form fields, reading file fields from the docs directory
+func multipartBody(t *testing.T, fields []formField, substitute func(string) string) (io.Reader, string) {
+	t.Helper()
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	for _, field := range fields {
+		if field.Disabled {
+			continue
+		}
+		if field.Type != "file" {
+			if err := form.WriteField(field.Key, substitute(field.Value)); err != nil {
+				t.Fatalf("failed to write field %s: %v", field.Key, err)
+			}
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(filepath.Dir(collectionPath), field.Src))
+		if err != nil {
+			t.Fatalf("failed to read file %s: %v", field.Src, err)
+		}
+		part, err := form.CreateFormFile(field.Key, filepath.Base(field.Src))
+		if err != nil {
+			t.Fatalf("failed to create form file: %v", err)
+		}
+		if _, err := part.Write(content); err != nil {
+			t.Fatalf("failed to write form file: %v", err)
+		}
+	}
+	if err := form.Close(); err != nil {
+		t.Fatalf("failed to close form: %v", err)
+	}
+	return &body, form.FormDataContentType()
+}
+
+// applyScript supports the collection's only script idiom: copying fields of
+// the JSON response into collection variables. Anything else fails the test,
+// so the collection never relies on behaviour the runner does not check.
+func applyScript(t *testing.T, lines []string, raw []byte, vars map[string]string) {
+	t.Helper()
+	var response interface{}
+	if err := json.Unmarshal(raw, &response); err != nil {
+		t.Fatalf("response is not JSON: %s", raw)
+	}
+
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if match := setVariablePattern.FindStringSubmatch(line); match != nil {
+			value, ok := lookup(response, strings.Split(match[2], "."))
+			if !ok {
+				t.Fatalf("script reads missing field jsonData.%s: %s", match[2], raw)
+			}
+			vars[match[1]] = value
+			continue
+		}
+		if strings.Contains(line, "pm.") && line != "var jsonData = pm.response.json();" {
+			t.Fatalf("unsupported script line: %s", line)
+		}
+	}
+}
+
+// lookup walks a decoded JSON document along path and returns the string found
+func lookup(doc interface{}, path []string) (string, bool) {
+	for _, key := range path {
+		object, ok := doc.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		if doc, ok = object[key]; !ok {
+			return "", false
+		}
+	}
+	value, ok := doc.(string)
+	return value, ok
+}
+
+// route returns the registered route pattern that serves method and path
+func (s *server) route(method, path string) (string, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for _, route := range s.engine.Routes() {
+		if route.Method != method {
+			continue
+		}
+		pattern := strings.Split(strings.Trim(route.Path, "/"), "/")
+		parts := segments
+		// A trailing catch-all parameter matches the remaining segments
+		if n := len(pattern); strings.HasPrefix(pattern[n-1], "*") && len(parts) > n {
+			parts = append(parts[:n-1:n-1], strings.Join(parts[n-1:], "/"))
+		}
+		if len(pattern) != len(parts) {
+			continue
+		}
+		matched := true
+		for i, part := range pattern {
+			if !strings.HasPrefix(part, ":") && !strings.HasPrefix(part, "*") && part != parts[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return route.Path, true
+		}
+	}
+	return "", false
+}